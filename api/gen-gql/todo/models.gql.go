@@ -6,17 +6,54 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
+type BatchInput struct {
+	Atomic       *bool               `json:"atomic,omitempty"`
+	Create       []CreateInput       `json:"create,omitempty"`
+	UpdateStatus []UpdateStatusInput `json:"update_status,omitempty"`
+	Delete       []string            `json:"delete,omitempty"`
+}
+
+type BatchOutput struct {
+	Create       []BatchResult `json:"create"`
+	UpdateStatus []BatchResult `json:"update_status"`
+	Delete       []BatchResult `json:"delete"`
+}
+
+type BatchResult struct {
+	ID    string  `json:"id"`
+	Error *string `json:"error,omitempty"`
+}
+
+type Collaborator struct {
+	Email      string     `json:"email"`
+	UserID     string     `json:"user_id"`
+	Role       Role       `json:"role"`
+	InvitedAt  time.Time  `json:"invited_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+}
+
 type CreateInput struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Priority    *Priority  `json:"priority,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
 }
 
 type FetchInput struct {
-	Cursor *string `json:"cursor,omitempty"`
-	Limit  *string `json:"limit,omitempty"`
-	Status *Status `json:"status,omitempty"`
+	Cursor   *string    `json:"cursor,omitempty"`
+	Limit    *string    `json:"limit,omitempty"`
+	Status   *Status    `json:"status,omitempty"`
+	UserID   *string    `json:"user_id,omitempty"`
+	Q        *string    `json:"q,omitempty"`
+	Tags     []string   `json:"tags,omitempty"`
+	Priority *Priority  `json:"priority,omitempty"`
+	DueFrom  *time.Time `json:"due_from,omitempty"`
+	DueTo    *time.Time `json:"due_to,omitempty"`
+	Sort     *string    `json:"sort,omitempty"`
 }
 
 type FetchOutput struct {
@@ -35,19 +72,53 @@ type Pagination struct {
 type Query struct {
 }
 
+type ShareInput struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Role  Role   `json:"role"`
+}
+
+type ShareOutput struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Role  Role   `json:"role"`
+}
+
+type Subscription struct {
+}
+
 type Todo struct {
-	ID          string `json:"id"`
-	UserID      string `json:"user_id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Status      Status `json:"status"`
+	ID            string         `json:"id"`
+	UserID        string         `json:"user_id"`
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	Status        Status         `json:"status"`
+	Priority      Priority       `json:"priority"`
+	DueAt         *time.Time     `json:"due_at,omitempty"`
+	CompletedAt   *time.Time     `json:"completed_at,omitempty"`
+	Tags          []string       `json:"tags"`
+	Collaborators []Collaborator `json:"collaborators"`
+}
+
+type TodoEvent struct {
+	ID   string        `json:"id"`
+	Type TodoEventType `json:"type"`
+	Todo *Todo         `json:"todo"`
+}
+
+type UnshareInput struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
 }
 
 type UpdateInput struct {
-	ID          string `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Status      Status `json:"status"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      Status     `json:"status"`
+	Priority    *Priority  `json:"priority,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
 }
 
 type UpdateStatusInput struct {
@@ -60,6 +131,94 @@ type UpdateStatusOutput struct {
 	Status Status `json:"status"`
 }
 
+type Priority string
+
+const (
+	PriorityUnknown Priority = "UNKNOWN"
+	PriorityLow     Priority = "LOW"
+	PriorityMedium  Priority = "MEDIUM"
+	PriorityHigh    Priority = "HIGH"
+)
+
+var AllPriority = []Priority{
+	PriorityUnknown,
+	PriorityLow,
+	PriorityMedium,
+	PriorityHigh,
+}
+
+func (e Priority) IsValid() bool {
+	switch e {
+	case PriorityUnknown, PriorityLow, PriorityMedium, PriorityHigh:
+		return true
+	}
+	return false
+}
+
+func (e Priority) String() string {
+	return string(e)
+}
+
+func (e *Priority) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Priority(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Priority", str)
+	}
+	return nil
+}
+
+func (e Priority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Role string
+
+const (
+	RoleUnknown Role = "UNKNOWN"
+	RoleViewer  Role = "VIEWER"
+	RoleEditor  Role = "EDITOR"
+)
+
+var AllRole = []Role{
+	RoleUnknown,
+	RoleViewer,
+	RoleEditor,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleUnknown, RoleViewer, RoleEditor:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Status string
 
 const (
@@ -106,3 +265,48 @@ func (e *Status) UnmarshalGQL(v interface{}) error {
 func (e Status) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type TodoEventType string
+
+const (
+	TodoEventTypeTodoCreated       TodoEventType = "TODO_CREATED"
+	TodoEventTypeTodoUpdated       TodoEventType = "TODO_UPDATED"
+	TodoEventTypeTodoStatusUpdated TodoEventType = "TODO_STATUS_UPDATED"
+	TodoEventTypeTodoDeleted       TodoEventType = "TODO_DELETED"
+)
+
+var AllTodoEventType = []TodoEventType{
+	TodoEventTypeTodoCreated,
+	TodoEventTypeTodoUpdated,
+	TodoEventTypeTodoStatusUpdated,
+	TodoEventTypeTodoDeleted,
+}
+
+func (e TodoEventType) IsValid() bool {
+	switch e {
+	case TodoEventTypeTodoCreated, TodoEventTypeTodoUpdated, TodoEventTypeTodoStatusUpdated, TodoEventTypeTodoDeleted:
+		return true
+	}
+	return false
+}
+
+func (e TodoEventType) String() string {
+	return string(e)
+}
+
+func (e *TodoEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoEventType", str)
+	}
+	return nil
+}
+
+func (e TodoEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
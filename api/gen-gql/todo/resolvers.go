@@ -29,6 +29,36 @@ func (r *mutationResolver) Update(ctx context.Context, in UpdateInput) (*Todo, e
 	panic(fmt.Errorf("not implemented: Update - update"))
 }
 
+// Restore is the resolver for the restore field.
+func (r *mutationResolver) Restore(ctx context.Context, id string) (string, error) {
+	panic(fmt.Errorf("not implemented: Restore - restore"))
+}
+
+// Purge is the resolver for the purge field.
+func (r *mutationResolver) Purge(ctx context.Context, id string) (string, error) {
+	panic(fmt.Errorf("not implemented: Purge - purge"))
+}
+
+// Batch is the resolver for the batch field.
+func (r *mutationResolver) Batch(ctx context.Context, in BatchInput) (*BatchOutput, error) {
+	panic(fmt.Errorf("not implemented: Batch - batch"))
+}
+
+// Share is the resolver for the share field.
+func (r *mutationResolver) Share(ctx context.Context, in ShareInput) (*ShareOutput, error) {
+	panic(fmt.Errorf("not implemented: Share - share"))
+}
+
+// Unshare is the resolver for the unshare field.
+func (r *mutationResolver) Unshare(ctx context.Context, in UnshareInput) (string, error) {
+	panic(fmt.Errorf("not implemented: Unshare - unshare"))
+}
+
+// Accept is the resolver for the accept field.
+func (r *mutationResolver) Accept(ctx context.Context, id string) (string, error) {
+	panic(fmt.Errorf("not implemented: Accept - accept"))
+}
+
 // Fetch is the resolver for the fetch field.
 func (r *queryResolver) Fetch(ctx context.Context, in *FetchInput) (*FetchOutput, error) {
 	panic(fmt.Errorf("not implemented: Fetch - fetch"))
@@ -39,11 +69,20 @@ func (r *queryResolver) Find(ctx context.Context, id string) (*Todo, error) {
 	panic(fmt.Errorf("not implemented: Find - find"))
 }
 
+// TodoChanged is the resolver for the todoChanged field.
+func (r *subscriptionResolver) TodoChanged(ctx context.Context, status *Status) (<-chan *TodoEvent, error) {
+	panic(fmt.Errorf("not implemented: TodoChanged - todoChanged"))
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
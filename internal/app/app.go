@@ -20,9 +20,10 @@ import (
 	"github.com/shandysiswandi/goreng/jwt"
 	"github.com/shandysiswandi/goreng/messaging"
 	"github.com/shandysiswandi/goreng/task"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/pkg/blobstore"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"google.golang.org/grpc"
@@ -40,10 +41,14 @@ type App struct {
 	codecMsgPack   codec.Codec
 	validator      validation.Validator
 	protoValidator validation.Validator
-	telemetry      *telemetry.Telemetry
+	telemetry      *lib.Telemetry
 	sqlkitDB       *sqlkit.DB
 	redisDB        *redis.Client
 	messaging      messaging.Client
+	blobStore      blobstore.BlobStore
+	rateLimit      framework.RateLimitConfig
+	allowed        func(ctx context.Context, permission string) (bool, error)
+	tlsCert        *framework.CertReloader
 	httpServer     *http.Server
 	gqlServer      *http.Server
 	grpcServer     *grpc.Server
@@ -73,7 +78,10 @@ func New() *App {
 	app.initDatabase()
 	app.initRedis()
 	app.initMessaging()
+	app.initRateLimit()
+	app.initTLS()
 	app.initHTTPServer()
+	app.initBlobStore()
 	app.initGQLServer()
 	app.initGRPCServer()
 	app.initModules()
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newTestApp returns the app with every module registering its routes, like New
+// does, without the connections to the database and the other services.
+func newTestApp(t *testing.T) *App {
+	t.Helper()
+
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	mc := mocker.NewMockConfig(t)
+	mc.EXPECT().GetBool("feature.flag.openapi").Return(true)
+	mc.EXPECT().GetBool(mock.MatchedBy(func(k string) bool {
+		return strings.HasPrefix(k, "module.flag.")
+	})).Return(true)
+	mc.EXPECT().GetString("blob.local.dir").Return(t.TempDir())
+	mc.EXPECT().GetString("blob.local.url").Return("/blobs")
+	mc.EXPECT().GetString("blob.secret").Return("secret")
+	mc.EXPECT().GetString("telemetry.name").Return("gostarter")
+	// the other keys keep their zero value, as when they are not configured
+	mc.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+	mc.EXPECT().GetInt(mock.Anything).Return(0).Maybe()
+	mc.EXPECT().GetFloat(mock.Anything).Return(0).Maybe()
+	mc.EXPECT().GetString(mock.Anything).Return("").Maybe()
+	mc.EXPECT().GetArray(mock.Anything).Return(nil).Maybe()
+	mc.EXPECT().GetMap(mock.Anything).Return(nil).Maybe()
+
+	tel := lib.NewTelemetry()
+	a := &App{config: mc, telemetry: tel, sqlkitDB: sqlkit.New(sqlkit.MySQLDriver, db, tel.Logger())}
+	a.initLibraries()
+	a.initRateLimit()
+	a.initHTTPServer()
+	a.initBlobStore()
+	a.initGQLServer()
+	a.initGRPCServer()
+	a.initModules()
+
+	return a
+}
+
+func TestApp_HTTPRouter(t *testing.T) {
+	a := newTestApp(t)
+
+	err := a.blobStore.Put(context.Background(), "todos/1/note.txt", strings.NewReader("hello"), 5, "text/plain")
+	assert.NoError(t, err)
+	link, err := a.blobStore.SignedURL(context.Background(), "todos/1/note.txt", time.Minute)
+	assert.NoError(t, err)
+
+	assert.Empty(t, a.httpRouter.Undocumented())
+
+	tests := []struct {
+		name     string
+		path     string
+		wantCode int
+		wantType string
+		wantBody string
+	}{
+		{
+			name:     "OpenAPIDocument",
+			path:     "/openapi.json",
+			wantCode: http.StatusOK,
+			wantType: "application/json",
+			wantBody: `"/blobs/{key}"`,
+		},
+		{
+			name:     "OpenAPIDocs",
+			path:     "/docs",
+			wantCode: http.StatusOK,
+			wantType: "text/html",
+			wantBody: "/openapi.json",
+		},
+		{
+			name:     "BlobWithoutSignature",
+			path:     "/blobs/todos/1/note.txt",
+			wantCode: http.StatusForbidden,
+			wantType: "text/plain",
+			wantBody: "invalid signature",
+		},
+		{
+			name:     "BlobSigned",
+			path:     link,
+			wantCode: http.StatusOK,
+			wantType: "text/plain",
+			wantBody: "hello",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			a.httpServer.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Contains(t, rec.Header().Get("Content-Type"), tt.wantType)
+			assert.Contains(t, rec.Body.String(), tt.wantBody)
+		})
+	}
+}
@@ -2,17 +2,19 @@ package app
 
 import (
 	"context"
+	"crypto/subtle"
+	"crypto/tls"
 	"database/sql"
 	"fmt"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/redis/go-redis/v9"
-	"github.com/rs/cors"
 	"github.com/shandysiswandi/goreng/clock"
 	"github.com/shandysiswandi/goreng/codec"
 	"github.com/shandysiswandi/goreng/config"
@@ -25,9 +27,12 @@ import (
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/pkg/blobstore"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -54,10 +59,11 @@ func (a *App) initConfig() {
 // initTelemetry sets up telemetry for the application, configuring it to use a Zap logger
 // with the specified logging level. This enables logging and monitoring capabilities
 // across the application, allowing tracking of application metrics and logs for observability.
+// Every line logged for a request carries its ID, see lib.Telemetry.
 func (a *App) initTelemetry() {
 	filterKeys := []string{"authorization", "password", "access_token", "refresh_token"}
 
-	a.telemetry = telemetry.NewTelemetry(
+	a.telemetry = lib.NewTelemetry(
 		telemetry.WithServiceName(a.config.GetString("telemetry.name")),
 		telemetry.WithVerbose(),
 		telemetry.WithLogFilter(filterKeys...),
@@ -121,7 +127,7 @@ func (a *App) initLibraries() {
 
 // dsnMySQL constructs a Data Source Name (DSN) for connecting to a MySQL database
 // using the application's configuration. It includes connection options such as
-// time zone and parseTime settings.
+// time zone, parseTime and clientFoundRows settings.
 func (a *App) dsnMySQL() string {
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s",
 		a.config.GetString(`database.user`),
@@ -133,6 +139,9 @@ func (a *App) dsnMySQL() string {
 	val := url.Values{}
 	val.Add("parseTime", "1")
 	val.Add("loc", a.config.GetString(`tz`))
+	// rows affected counts the rows matched, like PostgreSQL, so an update
+	// conditioned on the current state tells unchanged values from a miss
+	val.Add("clientFoundRows", "true")
 	val.Encode()
 
 	return fmt.Sprintf("%s?%s", dsn, val.Encode())
@@ -222,65 +231,365 @@ func (a *App) initMessaging() {
 	a.messaging = msg
 }
 
-// initHTTPServer initializes the HTTP server with settings from the configuration,
-// such as address, timeouts, and the router handler wrapped with CORS middleware.
-// This method should be called after initializing the router to ensure the server
-// is ready to handle incoming requests.
+// initRateLimit initializes the rate limit shared by the HTTP, GQL and gRPC servers,
+// it stays without a limiter when disabled. A route is limited by the rate of its
+// class, see framework.Group.RateLimit, the gRPC auth service has the auth class.
+func (a *App) initRateLimit() {
+	if !a.config.GetBool("ratelimit.enable") {
+		return
+	}
+
+	rates := make(map[string]framework.Rate)
+	for class, value := range a.config.GetMap("ratelimit.rates") {
+		rate, err := framework.ParseRate(value)
+		if err != nil {
+			log.Fatalln("failed to init rate limit", err)
+		}
+
+		rates[class] = rate
+	}
+
+	keys := map[string]func(framework.RateLimitIdentity) string{
+		"identity": framework.RateLimitByIdentity,
+		"ip":       framework.RateLimitByIP,
+		"auth":     framework.RateLimitByAuthID,
+		"apikey":   framework.RateLimitByAPIKey,
+	}
+	key, ok := keys[a.config.GetString("ratelimit.key")]
+	if !ok {
+		log.Fatalln("failed to init rate limit", "unknown key", a.config.GetString("ratelimit.key"))
+	}
+
+	var limiter framework.RateLimiter = framework.NewRateLimitMemory()
+	if a.config.GetString("ratelimit.store") == "redis" {
+		limiter = framework.NewRateLimitRedis(a.redisDB)
+	}
+
+	a.rateLimit = framework.RateLimitConfig{
+		Limiter: limiter,
+		Rates:   rates,
+		Methods: map[string]string{"/gostarter.api.auth.AuthService": "auth"},
+		Key:     key,
+		APIKey:  apiKeys(a.config.GetString("ratelimit.api.keys")),
+	}
+}
+
+// apiKeys reports whether a key is one of the comma separated accepted keys.
+// Keys are compared in constant time, so the time taken tells nothing of them.
+func apiKeys(accepted string) func(ctx context.Context, key string) bool {
+	var keys [][]byte
+	for _, k := range strings.Split(accepted, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keys = append(keys, []byte(k))
+		}
+	}
+
+	return func(_ context.Context, key string) bool {
+		ok := 0
+		for _, k := range keys {
+			ok |= subtle.ConstantTimeCompare(k, []byte(key))
+		}
+
+		return ok == 1
+	}
+}
+
+// rateLimitMiddlewares returns the rate limit middleware, none when it is disabled.
+func (a *App) rateLimitMiddlewares() []framework.Middleware {
+	if a.rateLimit.Limiter == nil {
+		return nil
+	}
+
+	return []framework.Middleware{framework.RateLimit(a.rateLimit)}
+}
+
+// initHTTPServer initializes the HTTP router and the server handling it. Once a
+// route is matched the router checks the token, the permission, the rate and the
+// idempotency key of its request. The server wraps the router with the request ID,
+// recovery, security headers, compression, the CORS policy of the server.http.cors
+// keys and telemetry. The modules register their routes on the router afterwards.
 func (a *App) initHTTPServer() {
-	a.httpRouter = framework.NewRouter()
-	a.httpServer = &http.Server{
-		Addr: a.config.GetString("server.address.http"),
-		Handler: framework.Chain(
-			a.httpRouter,
-			framework.Recovery,
-			cors.Default().Handler,
-			instrument.UseTelemetryServer(a.telemetry),
-			framework.JWT("gostarter.access.token", "/auth"),
-		),
-		ReadTimeout:       5 * time.Second,
-		ReadHeaderTimeout: 2 * time.Second,
-		WriteTimeout:      10 * time.Second,
-		IdleTimeout:       30 * time.Second,
+	var idempotencyStore framework.IdempotencyStore = framework.NewIdempotencyRedis(a.redisDB)
+	if a.config.GetString("idempotency.store") == "sql" {
+		idempotencyStore = framework.NewIdempotencySQL(a.sqlkitDB)
+	}
+	idempotencyTTL := time.Duration(a.config.GetInt("idempotency.ttl")) * time.Hour
+	idempotencyInFlight := time.Duration(a.config.GetInt("idempotency.inflight")) * time.Second
+	maxBodySize := a.config.GetInt("server.http.max.body.size") << 20
+
+	routerOpts := []framework.RouterOption{
+		framework.WithValidator(a.validator),
+		framework.WithMaxBodySize(maxBodySize),
+		framework.WithCodec(framework.MediaTypeMsgPack, a.codecMsgPack),
+		framework.WithCodec(framework.MediaTypeProtobuf, framework.NewProtoCodec()),
+		framework.WithCodec(framework.MediaTypeXML+"; charset=utf-8", framework.NewXMLCodec()),
+	}
+	if a.config.GetBool("server.http.disallow.unknown.fields") {
+		routerOpts = append(routerOpts, framework.WithDisallowUnknownFields())
+	}
+
+	// run once a route is matched, so they read whether it is public from its metadata
+	a.httpRouter = framework.NewRouter(routerOpts...)
+	a.httpRouter.Use(framework.JWT("gostarter.access.token"))
+	a.httpRouter.Use(framework.Authorize(a.authorize))
+	a.httpRouter.Use(a.rateLimitMiddlewares()...)
+	a.httpRouter.Use(framework.Idempotency(idempotencyStore, idempotencyTTL, idempotencyInFlight, maxBodySize))
+
+	if a.config.GetBool("feature.flag.openapi") {
+		docs := a.httpRouter.Group("").Public()
+		docs.Doc(framework.RouteDoc{
+			Summary:      "Get the OpenAPI document",
+			Tags:         []string{"docs"},
+			ResponseType: "application/json",
+		}).Handler(http.MethodGet, "/openapi.json", a.httpRouter.OpenAPIHandler(framework.OpenAPIInfo{
+			Title:   a.config.GetString("telemetry.name"),
+			Version: "1.0.0",
+		}))
+		docs.Doc(framework.RouteDoc{
+			Summary:      "Read the API documentation",
+			Tags:         []string{"docs"},
+			ResponseType: "text/html",
+		}).Handler(http.MethodGet, "/docs", framework.OpenAPIDocsHandler("gostarter API", "/openapi.json"))
+	}
+
+	mws := a.withProblemDetails(framework.RequestID(a.uuid.Generate), framework.Recovery, a.secureHeaders())
+	mws = append(mws, a.compressMiddlewares()...)
+	mws = append(mws, a.cors("http"), instrument.UseTelemetryServer(a.telemetry.Telemetry))
+
+	a.httpServer = a.newHTTPServer("http", framework.Chain(a.httpRouter, mws...))
+}
+
+// newHTTPServer returns the HTTP server of name, with the address, timeouts and
+// h2c of its server.<name> keys. It serves TLS when initTLS loaded a certificate,
+// HTTP/2 is then negotiated with the client, h2c only applies without TLS.
+func (a *App) newHTTPServer(name string, h http.Handler) *http.Server {
+	// a timeout that is not set keeps the value the servers always had
+	seconds := func(key string, def time.Duration) time.Duration {
+		if v := a.config.GetInt("server." + name + ".timeout." + key); v > 0 {
+			return time.Duration(v) * time.Second
+		}
+
+		return def
+	}
+
+	srv := &http.Server{
+		Addr:              a.config.GetString("server.address." + name),
+		Handler:           h,
+		ReadTimeout:       seconds("read", 5*time.Second),
+		ReadHeaderTimeout: seconds("read.header", 2*time.Second),
+		WriteTimeout:      seconds("write", 10*time.Second),
+		IdleTimeout:       seconds("idle", 30*time.Second),
+	}
+
+	if a.tlsCert != nil {
+		srv.TLSConfig = a.tlsCert.TLSConfig()
+	} else if a.config.GetBool("server." + name + ".h2c") {
+		srv.Protocols = new(http.Protocols)
+		srv.Protocols.SetHTTP1(true)
+		srv.Protocols.SetUnencryptedHTTP2(true)
+	}
+
+	return srv
+}
+
+// initTLS loads the certificate the servers use for TLS, they stay in plain text
+// when it is disabled. The certificate is reloaded once its files change.
+func (a *App) initTLS() {
+	if !a.config.GetBool("server.tls.enable") {
+		return
+	}
+
+	cert, err := framework.NewCertReloader(
+		a.config.GetString("server.tls.cert.file"),
+		a.config.GetString("server.tls.key.file"),
+	)
+	if err != nil {
+		log.Fatalln("failed to init tls", err)
+	}
+
+	a.tlsCert = cert
+}
+
+// withProblemDetails puts framework.ProblemDetails in front of mws when the
+// errors of the HTTP servers are configured as problem+json.
+func (a *App) withProblemDetails(mws ...framework.Middleware) []framework.Middleware {
+	if !a.config.GetBool("server.http.problem.details") {
+		return mws
+	}
+
+	return append([]framework.Middleware{
+		framework.ProblemDetails(a.config.GetString("server.http.problem.type.base")),
+	}, mws...)
+}
+
+// cors returns the CORS middleware of a server, configured by its server.<name>.cors
+// keys. The methods and headers the framework uses are allowed when not configured.
+func (a *App) cors(server string) framework.Middleware {
+	prefix := "server." + server + ".cors."
+	mw, err := framework.CORS(framework.CORSConfig{
+		AllowedOrigins:   a.config.GetArray(prefix + "origins"),
+		AllowedMethods:   a.config.GetArray(prefix + "methods"),
+		AllowedHeaders:   a.config.GetArray(prefix + "headers"),
+		ExposedHeaders:   a.config.GetArray(prefix + "exposed.headers"),
+		AllowCredentials: a.config.GetBool(prefix + "credentials"),
+		MaxAge:           time.Duration(a.config.GetInt(prefix+"max.age")) * time.Second,
+	})
+	if err != nil {
+		log.Fatalln("failed to init cors of", server, err)
+	}
+
+	return mw
+}
+
+// secureHeaders returns the middleware setting the security headers of the HTTP
+// servers, an empty policy takes the default of the framework.
+func (a *App) secureHeaders() framework.Middleware {
+	return framework.SecureHeaders(framework.SecureHeadersConfig{
+		HSTSMaxAge:            time.Duration(a.config.GetInt("server.secure.hsts.max.age")) * time.Second,
+		HSTSIncludeSubdomains: a.config.GetBool("server.secure.hsts.include.subdomains"),
+		ContentSecurityPolicy: a.config.GetString("server.secure.csp"),
+		FrameOptions:          a.config.GetString("server.secure.frame.options"),
+		ReferrerPolicy:        a.config.GetString("server.secure.referrer.policy"),
+	})
+}
+
+// compressMiddlewares returns the middleware compressing the responses of the
+// HTTP servers, none when it is disabled.
+func (a *App) compressMiddlewares() []framework.Middleware {
+	if !a.config.GetBool("server.http.compress") {
+		return nil
+	}
+
+	return []framework.Middleware{framework.Compress(framework.CompressConfig{
+		MinSize: int(a.config.GetInt("server.http.compress.min.size")),
+	})}
+}
+
+// initBlobStore initializes the store of uploaded files. The local driver keeps
+// them on disk and serves its signed links under /blobs of the HTTP router, so
+// it must be called after initHTTPServer.
+func (a *App) initBlobStore() {
+	switch a.config.GetString("blob.driver") {
+	case "s3":
+		s3, err := blobstore.NewS3(blobstore.S3Config{
+			Endpoint:  a.config.GetString("blob.s3.endpoint"),
+			Region:    a.config.GetString("blob.s3.region"),
+			Bucket:    a.config.GetString("blob.s3.bucket"),
+			AccessKey: a.config.GetString("blob.s3.access.key"),
+			SecretKey: a.config.GetString("blob.s3.secret.key"),
+		}, &http.Client{Timeout: time.Minute})
+		if err != nil {
+			log.Fatalln("failed to init blob store", err)
+		}
+
+		a.blobStore = s3
+	default:
+		local, err := blobstore.NewLocal(
+			a.config.GetString("blob.local.dir"),
+			a.config.GetString("blob.local.url"),
+			[]byte(a.config.GetString("blob.secret")),
+		)
+		if err != nil {
+			log.Fatalln("failed to init blob store", err)
+		}
+		a.httpRouter.Group("/blobs").Public().Doc(framework.RouteDoc{
+			Summary:      "Download an uploaded file",
+			Description:  "The link is signed, it is only valid until the expiry in its query.",
+			ResponseType: "application/octet-stream",
+		}).Handler(http.MethodGet, "/*key", framework.Chain(
+			local.Handler("/blobs"),
+			// a large file takes longer to send than the other responses
+			framework.WriteTimeout(time.Duration(a.config.GetInt("server.http.timeout.download"))*time.Second),
+		))
+
+		a.blobStore = local
 	}
 }
 
+// playgroundPolicy lets the GraphQL playground load GraphiQL, run its inline
+// setup script and reach the endpoint, also over websocket for subscriptions.
+const playgroundPolicy = "default-src 'none'; script-src 'unsafe-inline' https://cdn.jsdelivr.net; " +
+	"style-src 'unsafe-inline' https://cdn.jsdelivr.net; font-src data: https://cdn.jsdelivr.net; " +
+	"img-src data: https:; connect-src 'self' ws: wss:; frame-ancestors 'none'"
+
 func (a *App) initGQLServer() {
 	a.gqlRouter = framework.NewRouter()
 
 	if a.config.GetBool("feature.flag.graphql.playground") {
-		a.gqlRouter.Handler(http.MethodGet, "/graphql/playground",
-			playground.Handler("GraphQL playground", "/graphql"))
+		a.gqlRouter.Handler(http.MethodGet, "/graphql/playground", framework.Chain(
+			playground.Handler("GraphQL playground", "/graphql"),
+			framework.ContentSecurityPolicy(playgroundPolicy),
+		))
 	}
 
-	a.gqlServer = &http.Server{
-		Addr: a.config.GetString("server.address.gql"),
-		Handler: framework.Chain(
-			a.gqlRouter,
-			framework.Recovery,
-			instrument.UseTelemetryServer(a.telemetry),
-			framework.JWT("gostarter.access.token", "/graphql/playground"),
-		),
-		ReadTimeout:       5 * time.Second,
-		ReadHeaderTimeout: 2 * time.Second,
-		WriteTimeout:      10 * time.Second,
-		IdleTimeout:       30 * time.Second,
-	}
+	mws := a.withProblemDetails(framework.RequestID(a.uuid.Generate), framework.Recovery, a.secureHeaders())
+	mws = append(mws, a.compressMiddlewares()...)
+	mws = append(mws,
+		a.cors("gql"),
+		instrument.UseTelemetryServer(a.telemetry.Telemetry),
+		framework.JWTWithWebsocket("gostarter.access.token", "/graphql/playground"),
+	)
+	mws = append(mws, a.rateLimitMiddlewares()...)
+
+	a.gqlServer = a.newHTTPServer("gql", framework.Chain(a.gqlRouter, mws...))
 }
 
 func (a *App) initGRPCServer() {
-	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(framework.UnaryServerRecovery)}
-	opts = append(opts, instrument.UnaryTelemetryServerInterceptor(a.telemetry, a.uuid.Generate)...)
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			framework.UnaryServerRequestID(a.uuid.Generate),
+			framework.UnaryServerRecovery,
+		),
+		grpc.ChainStreamInterceptor(
+			framework.StreamServerRequestID(a.uuid.Generate),
+			framework.StreamServerRecovery,
+		),
+	}
+	opts = append(opts, instrument.UnaryTelemetryServerInterceptor(a.telemetry.Telemetry, a.uuid.Generate)...)
 	opts = append(opts, grpc.ChainUnaryInterceptor(
 		framework.UnaryServerError,
 		framework.UnaryServerJWT("gostarter.access.token", "/gostarter.api.auth.AuthService"),
-		framework.UnaryServerProtoValidate(a.protoValidator),
 	))
+	if a.rateLimit.Limiter != nil {
+		opts = append(opts, grpc.ChainUnaryInterceptor(framework.UnaryServerRateLimit(a.rateLimit)))
+	}
+	opts = append(opts, grpc.ChainUnaryInterceptor(framework.UnaryServerProtoValidate(a.protoValidator)))
+	opts = append(opts, grpc.ChainStreamInterceptor(
+		framework.StreamServerError,
+		framework.StreamServerJWT("gostarter.access.token", "/gostarter.api.auth.AuthService"),
+	))
+
+	if a.tlsCert != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(a.grpcTLSConfig())))
+	} else if a.config.GetBool("server.grpc.mtls.enable") {
+		log.Fatalln("failed to init grpc server", "mtls needs server.tls.enable")
+	}
 
 	server := grpc.NewServer(opts...)
 	reflection.Register(server)
 	a.grpcServer = server
 }
 
+// grpcTLSConfig returns the TLS config of the gRPC server, which asks every client
+// for a certificate signed by the authorities of server.grpc.mtls.client.ca.file
+// when mutual TLS is enabled.
+func (a *App) grpcTLSConfig() *tls.Config {
+	cfg := a.tlsCert.TLSConfig()
+	if !a.config.GetBool("server.grpc.mtls.enable") {
+		return cfg
+	}
+
+	pool, err := framework.ClientCAPool(a.config.GetString("server.grpc.mtls.client.ca.file"))
+	if err != nil {
+		log.Fatalln("failed to init grpc mtls", err)
+	}
+
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	cfg.ClientCAs = pool
+
+	return cfg
+}
+
 // initTasks starts all background tasks or services registered with the application.
 // If any task fails to start, the application will log a fatal error and terminate.
 func (a *App) initTasks() {
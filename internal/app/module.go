@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"log"
 
 	"github.com/shandysiswandi/gostarter/internal/auth"
@@ -40,7 +41,9 @@ func (a *App) moduleAuth() {
 
 func (a *App) moduleRBAC() {
 	if a.config.GetBool("module.flag.rbac") {
-		_, err := rbac.New(rbac.Dependency{
+		expRBAC, err := rbac.New(rbac.Dependency{
+			SQLKitDB:  a.sqlkitDB,
+			Config:    a.config,
 			Telemetry: a.telemetry,
 			Router:    a.httpRouter,
 			Validator: a.validator,
@@ -50,12 +53,17 @@ func (a *App) moduleRBAC() {
 		if err != nil {
 			log.Fatalln("failed to init module rbac", err)
 		}
+
+		a.allowed = expRBAC.Allowed
 	}
 }
 
 func (a *App) modulePayment() {
 	if a.config.GetBool("module.flag.payment") {
-		_, err := payment.New(payment.Dependency{
+		expPayment, err := payment.New(payment.Dependency{
+			SQLKitDB:  a.sqlkitDB,
+			Config:    a.config,
+			CodecJSON: a.codecJSON,
 			UIDNumber: a.uidNumber,
 			Validator: a.validator,
 			Router:    a.httpRouter,
@@ -67,21 +75,27 @@ func (a *App) modulePayment() {
 		if err != nil {
 			log.Fatalln("failed to init module payment", err)
 		}
+
+		a.runnables = append(a.runnables, expPayment.Tasks...)
 	}
 }
 
 func (a *App) moduleTodo() {
 	if a.config.GetBool("module.flag.todo") {
 		expTodo, err := todo.New(todo.Dependency{
+			SQLKitDB:   a.sqlkitDB,
 			Messaging:  a.messaging,
 			Config:     a.config,
+			Clock:      a.clock,
 			UIDNumber:  a.uidNumber,
+			UIDString:  a.uuid,
 			CodecJSON:  a.codecJSON,
 			Validator:  a.validator,
 			Router:     a.httpRouter,
 			GQLRouter:  a.gqlRouter,
 			GRPCServer: a.grpcServer,
 			Telemetry:  a.telemetry,
+			Blob:       a.blobStore,
 		})
 		if err != nil {
 			log.Fatalln("failed to init module todo", err)
@@ -104,3 +118,13 @@ func (a *App) moduleUser() {
 		}
 	}
 }
+
+// authorize is the permission check of the HTTP router. The permissions are kept
+// by the rbac module, without it a route requiring one is denied.
+func (a *App) authorize(ctx context.Context, permission string) (bool, error) {
+	if a.allowed == nil {
+		return false, nil
+	}
+
+	return a.allowed(ctx, permission)
+}
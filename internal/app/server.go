@@ -24,21 +24,8 @@ import (
 func (a *App) Start() <-chan struct{} {
 	terminateChan := make(chan struct{})
 
-	go func() {
-		log.Println("http server listening", "address", a.httpServer.Addr)
-		err := a.httpServer.ListenAndServe()
-		if !errors.Is(err, http.ErrServerClosed) {
-			log.Fatalln("http server:", err)
-		}
-	}()
-
-	go func() {
-		log.Println("gql server listening", "address", a.gqlServer.Addr)
-		err := a.gqlServer.ListenAndServe()
-		if !errors.Is(err, http.ErrServerClosed) {
-			log.Fatalln("gql server:", err)
-		}
-	}()
+	go serveHTTP("http", a.httpServer)
+	go serveHTTP("gql", a.gqlServer)
 
 	go func() {
 		grpcPort := a.config.GetString("server.address.grpc")
@@ -70,6 +57,23 @@ func (a *App) Start() <-chan struct{} {
 	return terminateChan
 }
 
+// serveHTTP serves srv until it is shut down, with TLS when it has a TLS config.
+func serveHTTP(name string, srv *http.Server) {
+	log.Println(name+" server listening", "address", srv.Addr, "tls", srv.TLSConfig != nil)
+
+	var err error
+	if srv.TLSConfig != nil {
+		// the certificate comes from TLSConfig.GetCertificate
+		err = srv.ListenAndServeTLS("", "")
+	} else {
+		err = srv.ListenAndServe()
+	}
+
+	if !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalln(name+" server:", err)
+	}
+}
+
 // Stop gracefully stops the application by closing any active tasks or jobs
 // and releasing any resources held by the application.
 //
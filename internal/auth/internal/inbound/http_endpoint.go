@@ -1,19 +1,15 @@
 package inbound
 
 import (
-	"encoding/json"
 	"time"
 
-	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 )
 
-var errInvalidBody = goerror.NewInvalidFormat("Request payload malformed")
-
 type httpEndpoint struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 
 	loginUC          domain.Login
 	registerUC       domain.Register
@@ -27,9 +23,9 @@ func (h *httpEndpoint) Login(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "auth.inbound.http.Login")
 	defer span.End()
 
-	var req LoginRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[LoginRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.loginUC.Call(ctx, domain.LoginInput{
@@ -52,9 +48,9 @@ func (h *httpEndpoint) Register(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "auth.inbound.http.Register")
 	defer span.End()
 
-	var req RegisterRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[RegisterRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.registerUC.Call(ctx, domain.RegisterInput{
@@ -73,9 +69,9 @@ func (h *httpEndpoint) Verify(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "auth.inbound.http.Verify")
 	defer span.End()
 
-	var req VerifyRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[VerifyRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.verifyUC.Call(ctx, domain.VerifyInput{
@@ -96,9 +92,9 @@ func (h *httpEndpoint) RefreshToken(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "auth.inbound.http.RefreshToken")
 	defer span.End()
 
-	var req RefreshTokenRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[RefreshTokenRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.refreshTokenUC.Call(ctx, domain.RefreshTokenInput{RefreshToken: req.RefreshToken})
@@ -118,9 +114,9 @@ func (h *httpEndpoint) ForgotPassword(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "auth.inbound.http.RefreshToken")
 	defer span.End()
 
-	var req ForgotPasswordRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[ForgotPasswordRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.forgotPasswordUC.Call(ctx, domain.ForgotPasswordInput{Email: req.Email})
@@ -138,9 +134,9 @@ func (h *httpEndpoint) ResetPassword(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "auth.inbound.http.RefreshToken")
 	defer span.End()
 
-	var req ResetPasswordRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[ResetPasswordRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.resetPasswordUC.Call(ctx, domain.ResetPasswordInput{
@@ -8,9 +8,9 @@ import (
 	"time"
 
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/mockz"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,7 +33,7 @@ func Test_httpEndpoint_Login(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidFormat("Request payload malformed"),
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "auth.inbound.http.Login")
 				defer span.End()
@@ -54,7 +54,7 @@ func Test_httpEndpoint_Login(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				loginMock := mockz.NewMockLogin(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.Login")
 				defer span.End()
@@ -89,7 +89,7 @@ func Test_httpEndpoint_Login(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				loginMock := mockz.NewMockLogin(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.Login")
 				defer span.End()
@@ -145,7 +145,7 @@ func Test_httpEndpoint_Register(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidFormat("Request payload malformed"),
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "auth.inbound.http.Register")
 				defer span.End()
@@ -166,7 +166,7 @@ func Test_httpEndpoint_Register(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				registerMock := mockz.NewMockRegister(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.Register")
 				defer span.End()
@@ -197,7 +197,7 @@ func Test_httpEndpoint_Register(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				registerMock := mockz.NewMockRegister(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.Register")
 				defer span.End()
@@ -249,7 +249,7 @@ func Test_httpEndpoint_Verify(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidFormat("Request payload malformed"),
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "auth.inbound.http.Verify")
 				defer span.End()
@@ -270,7 +270,7 @@ func Test_httpEndpoint_Verify(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				verifyMock := mockz.NewMockVerify(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.Verify")
 				defer span.End()
@@ -303,7 +303,7 @@ func Test_httpEndpoint_Verify(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				verifyMock := mockz.NewMockVerify(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.Verify")
 				defer span.End()
@@ -357,7 +357,7 @@ func Test_httpEndpoint_RefreshToken(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidFormat("Request payload malformed"),
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "auth.inbound.http.RefreshToken")
 				defer span.End()
@@ -378,7 +378,7 @@ func Test_httpEndpoint_RefreshToken(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				rtMock := mockz.NewMockRefreshToken(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.RefreshToken")
 				defer span.End()
@@ -410,7 +410,7 @@ func Test_httpEndpoint_RefreshToken(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				rtMock := mockz.NewMockRefreshToken(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.RefreshToken")
 				defer span.End()
@@ -463,7 +463,7 @@ func Test_httpEndpoint_ForgotPassword(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidFormat("Request payload malformed"),
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "auth.inbound.http.ForgotPassword")
 				defer span.End()
@@ -484,7 +484,7 @@ func Test_httpEndpoint_ForgotPassword(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				fpMock := mockz.NewMockForgotPassword(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.ForgotPassword")
 				defer span.End()
@@ -514,7 +514,7 @@ func Test_httpEndpoint_ForgotPassword(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				fpMock := mockz.NewMockForgotPassword(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.ForgotPassword")
 				defer span.End()
@@ -565,7 +565,7 @@ func Test_httpEndpoint_ResetPassword(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidFormat("Request payload malformed"),
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "auth.inbound.http.ForgotPassword")
 				defer span.End()
@@ -586,7 +586,7 @@ func Test_httpEndpoint_ResetPassword(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				rpMock := mockz.NewMockResetPassword(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.ForgotPassword")
 				defer span.End()
@@ -616,7 +616,7 @@ func Test_httpEndpoint_ResetPassword(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				rpMock := mockz.NewMockResetPassword(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "auth.inbound.http.ForgotPassword")
 				defer span.End()
@@ -3,14 +3,14 @@ package inbound
 import (
 	"net/http"
 
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 )
 
 type Inbound struct {
 	Router    *framework.Router
-	Telemetry *telemetry.Telemetry
+	Telemetry *lib.Telemetry
 	//
 	LoginUC          domain.Login
 	RegisterUC       domain.Register
@@ -32,10 +32,37 @@ func (in Inbound) RegisterAuthServiceServer() {
 		resetPasswordUC:  in.ResetPasswordUC,
 	}
 
-	in.Router.Endpoint(http.MethodPost, "/auth/login", he.Login)
-	in.Router.Endpoint(http.MethodPost, "/auth/register", he.Register)
-	in.Router.Endpoint(http.MethodPost, "/auth/verify", he.Verify)
-	in.Router.Endpoint(http.MethodPost, "/auth/refresh-token", he.RefreshToken)
-	in.Router.Endpoint(http.MethodPost, "/auth/forgot-password", he.ForgotPassword)
-	in.Router.Endpoint(http.MethodPost, "/auth/reset-password", he.ResetPassword)
+	auth := in.Router.Group("/auth").Public().RateLimit("auth")
+	auth.Doc(framework.RouteDoc{
+		Summary:  "Log in",
+		Request:  LoginRequest{},
+		Response: LoginResponse{},
+	}).Endpoint(http.MethodPost, "/login", he.Login)
+	auth.Doc(framework.RouteDoc{
+		Summary:     "Register an account",
+		Description: "Sends a verification code to the email, the account is verified with it.",
+		Request:     RegisterRequest{},
+		Response:    RegisterResponse{},
+	}).Endpoint(http.MethodPost, "/register", he.Register)
+	auth.Doc(framework.RouteDoc{
+		Summary:  "Verify an account",
+		Request:  VerifyRequest{},
+		Response: VerifyResponse{},
+	}).Endpoint(http.MethodPost, "/verify", he.Verify)
+	auth.Doc(framework.RouteDoc{
+		Summary:  "Refresh the tokens",
+		Request:  RefreshTokenRequest{},
+		Response: RefreshTokenResponse{},
+	}).Endpoint(http.MethodPost, "/refresh-token", he.RefreshToken)
+	auth.Doc(framework.RouteDoc{
+		Summary:     "Forgot the password",
+		Description: "Sends a link to reset the password to the email.",
+		Request:     ForgotPasswordRequest{},
+		Response:    ForgotPasswordResponse{},
+	}).Endpoint(http.MethodPost, "/forgot-password", he.ForgotPassword)
+	auth.Doc(framework.RouteDoc{
+		Summary:  "Reset the password",
+		Request:  ResetPasswordRequest{},
+		Response: ResetPasswordResponse{},
+	}).Endpoint(http.MethodPost, "/reset-password", he.ResetPassword)
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/shandysiswandi/gostarter/pkg/framework"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestInbound_RegisterAuthServiceServer(t *testing.T) {
@@ -27,6 +28,7 @@ func TestInbound_RegisterAuthServiceServer(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			tt.in.RegisterAuthServiceServer()
+			assert.Empty(t, tt.in.Router.Undocumented())
 		})
 	}
 }
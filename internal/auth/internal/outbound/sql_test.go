@@ -6,8 +6,8 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,7 +15,7 @@ import (
 func TestNewSQLAuth(t *testing.T) {
 	type args struct {
 		db        *sqlkit.DB
-		telemetry *telemetry.Telemetry
+		telemetry *lib.Telemetry
 	}
 	tests := []struct {
 		name string
@@ -26,11 +26,11 @@ func TestNewSQLAuth(t *testing.T) {
 			name: "Success",
 			args: args{
 				db:        &sqlkit.DB{},
-				telemetry: telemetry.NewTelemetry(),
+				telemetry: lib.NewTelemetry(),
 			},
 			want: &SQL{
 				db:        &sqlkit.DB{},
-				telemetry: telemetry.NewTelemetry(),
+				telemetry: lib.NewTelemetry(),
 			},
 		},
 	}
@@ -45,7 +45,7 @@ func TestNewSQLAuth(t *testing.T) {
 }
 
 func TestSQL_UserByEmail(t *testing.T) {
-	tel := telemetry.NewTelemetry()
+	tel := lib.NewTelemetry()
 
 	type args struct {
 		ctx   context.Context
@@ -87,7 +87,7 @@ func TestSQL_UserByEmail(t *testing.T) {
 
 				return &SQL{
 					db:        sqlkit.New("mysql", db, tel.Logger()),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -143,7 +143,7 @@ func TestSQL_SaveUser(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -171,7 +171,7 @@ func TestSQL_SaveUser(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -199,7 +199,7 @@ func TestSQL_SaveUser(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -251,7 +251,7 @@ func TestSQLAuth_SaveAccount(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -279,7 +279,7 @@ func TestSQLAuth_SaveAccount(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -307,7 +307,7 @@ func TestSQLAuth_SaveAccount(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -361,7 +361,7 @@ func TestSQLAuth_UpdateUserPassword(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -390,7 +390,7 @@ func TestSQLAuth_UpdateUserPassword(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -451,7 +451,7 @@ func TestSQLAuth_FindTokenByUserID(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -487,7 +487,7 @@ func TestSQLAuth_FindTokenByUserID(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -548,7 +548,7 @@ func TestSQLAuth_FindTokenByUserID(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -610,7 +610,7 @@ func TestSQLAuth_FindTokenByRefresh(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -646,7 +646,7 @@ func TestSQLAuth_FindTokenByRefresh(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -707,7 +707,7 @@ func TestSQLAuth_FindTokenByRefresh(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -774,7 +774,7 @@ func TestSQLAuth_SaveToken(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -816,7 +816,7 @@ func TestSQLAuth_SaveToken(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -858,7 +858,7 @@ func TestSQLAuth_SaveToken(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -916,7 +916,7 @@ func TestSQLAuth_UpdateToken(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -950,7 +950,7 @@ func TestSQLAuth_UpdateToken(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1004,7 +1004,7 @@ func TestSQLAuth_FindPasswordResetByUserID(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1033,7 +1033,7 @@ func TestSQLAuth_FindPasswordResetByUserID(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1071,7 +1071,7 @@ func TestSQLAuth_FindPasswordResetByUserID(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1126,7 +1126,7 @@ func TestSQLAuth_FindPasswordResetByToken(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1155,7 +1155,7 @@ func TestSQLAuth_FindPasswordResetByToken(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1193,7 +1193,7 @@ func TestSQLAuth_FindPasswordResetByToken(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1246,7 +1246,7 @@ func TestSQLAuth_SavePasswordReset(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1274,7 +1274,7 @@ func TestSQLAuth_SavePasswordReset(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1302,7 +1302,7 @@ func TestSQLAuth_SavePasswordReset(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1350,7 +1350,7 @@ func TestSQLAuth_DeletePasswordReset(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -1374,7 +1374,7 @@ func TestSQLAuth_DeletePasswordReset(t *testing.T) {
 				return &SQLAuth{
 					db:        db,
 					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 				}, db.Close
 			},
 		},
@@ -8,11 +8,11 @@ import (
 	"github.com/shandysiswandi/goreng/clock"
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/hash"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 )
 
 const msgSuccess = "If an account with this email exists, you'll receive a password reset email shortly."
@@ -25,7 +25,7 @@ type ForgotPasswordStore interface {
 }
 
 type ForgotPassword struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 	validator validation.Validator
 	idnum     uid.NumberID
 	secHash   hash.Hash
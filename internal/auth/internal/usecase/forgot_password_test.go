@@ -8,9 +8,9 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/mockz"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -60,7 +60,7 @@ func TestForgotPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *ForgotPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 
 				_, span := tel.Tracer().Start(a.ctx, "auth.usecase.ForgotPassword")
@@ -89,7 +89,7 @@ func TestForgotPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *ForgotPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockForgotPasswordStore(t)
 
@@ -126,7 +126,7 @@ func TestForgotPassword_Call(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *ForgotPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockForgotPasswordStore(t)
 
@@ -142,7 +142,7 @@ func TestForgotPassword_Call(t *testing.T) {
 					Return(nil, nil)
 
 				return &ForgotPassword{
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 					validator: validatorMock,
 					idnum:     nil,
 					secHash:   nil,
@@ -160,7 +160,7 @@ func TestForgotPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *ForgotPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockForgotPasswordStore(t)
 
@@ -186,7 +186,7 @@ func TestForgotPassword_Call(t *testing.T) {
 					Return(nil, assert.AnError)
 
 				return &ForgotPassword{
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 					validator: validatorMock,
 					idnum:     nil,
 					secHash:   nil,
@@ -207,7 +207,7 @@ func TestForgotPassword_Call(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *ForgotPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockForgotPasswordStore(t)
 				clockMock := mocker.NewMockClocker(t)
@@ -245,7 +245,7 @@ func TestForgotPassword_Call(t *testing.T) {
 					Return(ps, nil)
 
 				return &ForgotPassword{
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 					validator: validatorMock,
 					idnum:     nil,
 					secHash:   nil,
@@ -263,7 +263,7 @@ func TestForgotPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *ForgotPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockForgotPasswordStore(t)
 				clockMock := mocker.NewMockClocker(t)
@@ -305,7 +305,7 @@ func TestForgotPassword_Call(t *testing.T) {
 					Return(assert.AnError)
 
 				return &ForgotPassword{
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 					validator: validatorMock,
 					idnum:     nil,
 					secHash:   nil,
@@ -323,7 +323,7 @@ func TestForgotPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *ForgotPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockForgotPasswordStore(t)
 				secHashMock := mocker.NewMockHash(t)
@@ -370,7 +370,7 @@ func TestForgotPassword_Call(t *testing.T) {
 					Return(nil, assert.AnError)
 
 				return &ForgotPassword{
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 					validator: validatorMock,
 					idnum:     nil,
 					secHash:   secHashMock,
@@ -388,7 +388,7 @@ func TestForgotPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *ForgotPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockForgotPasswordStore(t)
 				secHashMock := mocker.NewMockHash(t)
@@ -451,7 +451,7 @@ func TestForgotPassword_Call(t *testing.T) {
 					Return(assert.AnError)
 
 				return &ForgotPassword{
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 					validator: validatorMock,
 					idnum:     idnumMock,
 					secHash:   secHashMock,
@@ -472,7 +472,7 @@ func TestForgotPassword_Call(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *ForgotPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockForgotPasswordStore(t)
 				secHashMock := mocker.NewMockHash(t)
@@ -535,7 +535,7 @@ func TestForgotPassword_Call(t *testing.T) {
 					Return(nil)
 
 				return &ForgotPassword{
-					telemetry: telemetry.NewTelemetry(),
+					telemetry: lib.NewTelemetry(),
 					validator: validatorMock,
 					idnum:     idnumMock,
 					secHash:   secHashMock,
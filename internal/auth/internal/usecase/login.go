@@ -7,10 +7,10 @@ import (
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/hash"
 	"github.com/shandysiswandi/goreng/jwt"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 )
 
 type LoginStore interface {
@@ -21,7 +21,7 @@ type LoginStore interface {
 }
 
 type Login struct {
-	tel       *telemetry.Telemetry
+	tel       *lib.Telemetry
 	validator validation.Validator
 	hash      hash.Hash
 	secHash   hash.Hash
@@ -8,7 +8,6 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/mockz"
 	"github.com/shandysiswandi/gostarter/internal/lib"
@@ -62,7 +61,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 
 				_, span := tel.Tracer().Start(a.ctx, "auth.usecase.Login")
@@ -94,7 +93,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 
@@ -131,7 +130,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Invalid credentials", goerror.CodeUnauthorized),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 
@@ -168,7 +167,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Account isn't verified, please check email", goerror.CodeForbidden),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 
@@ -212,7 +211,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Invalid credentials", goerror.CodeUnauthorized),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -261,7 +260,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -314,7 +313,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -394,7 +393,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -485,7 +484,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -581,7 +580,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -682,7 +681,7 @@ func TestLogin_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -806,7 +805,7 @@ func TestLogin_Call(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *Login {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockLoginStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -8,7 +8,6 @@ import (
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/hash"
 	"github.com/shandysiswandi/goreng/jwt"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
@@ -22,7 +21,7 @@ type RefreshTokenStore interface {
 }
 
 type RefreshToken struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 	validator validation.Validator
 	secHash   hash.Hash
 	jwt       jwt.JWT
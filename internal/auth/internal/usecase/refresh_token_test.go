@@ -7,7 +7,6 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/mockz"
 	"github.com/shandysiswandi/gostarter/internal/lib"
@@ -62,7 +61,7 @@ func TestRefreshToken_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *RefreshToken {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 
 				_, span := tel.Tracer().Start(a.ctx, "auth.usecase.RefreshToken")
@@ -92,7 +91,7 @@ func TestRefreshToken_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *RefreshToken {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				secHashMock := mocker.NewMockHash(t)
 
@@ -127,7 +126,7 @@ func TestRefreshToken_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *RefreshToken {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				secHashMock := mocker.NewMockHash(t)
 				storeMock := mockz.NewMockRefreshTokenStore(t)
@@ -167,7 +166,7 @@ func TestRefreshToken_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Invalid credentials", goerror.CodeUnauthorized),
 			mockFn: func(a args) *RefreshToken {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				secHashMock := mocker.NewMockHash(t)
 				storeMock := mockz.NewMockRefreshTokenStore(t)
@@ -207,7 +206,7 @@ func TestRefreshToken_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Token has expired", goerror.CodeUnauthorized),
 			mockFn: func(a args) *RefreshToken {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				secHashMock := mocker.NewMockHash(t)
 				storeMock := mockz.NewMockRefreshTokenStore(t)
@@ -255,7 +254,7 @@ func TestRefreshToken_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Invalid credentials", goerror.CodeUnauthorized),
 			mockFn: func(a args) *RefreshToken {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				secHashMock := mocker.NewMockHash(t)
 				storeMock := mockz.NewMockRefreshTokenStore(t)
@@ -303,7 +302,7 @@ func TestRefreshToken_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *RefreshToken {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				secHashMock := mocker.NewMockHash(t)
 				storeMock := mockz.NewMockRefreshTokenStore(t)
@@ -414,7 +413,7 @@ func TestRefreshToken_Call(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *RefreshToken {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				secHashMock := mocker.NewMockHash(t)
 				storeMock := mockz.NewMockRefreshTokenStore(t)
@@ -5,11 +5,11 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/hash"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 )
 
@@ -20,7 +20,7 @@ type RegisterStore interface {
 }
 
 type Register struct {
-	tele      *telemetry.Telemetry
+	tele      *lib.Telemetry
 	validator validation.Validator
 	uidnumber uid.NumberID
 	hash      hash.Hash
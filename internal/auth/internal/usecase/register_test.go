@@ -6,9 +6,9 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/mockz"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"github.com/stretchr/testify/assert"
 )
@@ -63,7 +63,7 @@ func TestRegister_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *Register {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 
 				_, span := tel.Tracer().Start(a.ctx, "auth.usecase.Register")
@@ -95,7 +95,7 @@ func TestRegister_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Register {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockRegisterStore(t)
 
@@ -132,7 +132,7 @@ func TestRegister_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Email already registered", goerror.CodeConflict),
 			mockFn: func(a args) *Register {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockRegisterStore(t)
 
@@ -175,7 +175,7 @@ func TestRegister_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Register {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockRegisterStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -217,7 +217,7 @@ func TestRegister_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Register {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockRegisterStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -276,7 +276,7 @@ func TestRegister_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Register {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockRegisterStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -348,7 +348,7 @@ func TestRegister_Call(t *testing.T) {
 			want:    &domain.RegisterOutput{Email: "email"},
 			wantErr: nil,
 			mockFn: func(a args) *Register {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockRegisterStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -6,9 +6,9 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/hash"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 )
 
 type ResetPasswordStore interface {
@@ -18,7 +18,7 @@ type ResetPasswordStore interface {
 }
 
 type ResetPassword struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 	validator validation.Validator
 	hash      hash.Hash
 	store     ResetPasswordStore
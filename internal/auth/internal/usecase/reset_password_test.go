@@ -7,9 +7,9 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/mockz"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -62,7 +62,7 @@ func TestResetPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *ResetPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 
 				_, span := tel.Tracer().Start(a.ctx, "auth.usecase.ResetPassword")
@@ -92,7 +92,7 @@ func TestResetPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *ResetPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockResetPasswordStore(t)
 
@@ -127,7 +127,7 @@ func TestResetPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("invalid token", goerror.CodeUnauthorized),
 			mockFn: func(a args) *ResetPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockResetPasswordStore(t)
 
@@ -162,7 +162,7 @@ func TestResetPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Token has expired", goerror.CodeUnauthorized),
 			mockFn: func(a args) *ResetPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockResetPasswordStore(t)
 
@@ -203,7 +203,7 @@ func TestResetPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *ResetPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockResetPasswordStore(t)
 
@@ -248,7 +248,7 @@ func TestResetPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *ResetPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockResetPasswordStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -298,7 +298,7 @@ func TestResetPassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *ResetPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockResetPasswordStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -354,7 +354,7 @@ func TestResetPassword_Call(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *ResetPassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mocker.NewMockValidator(t)
 				storeMock := mockz.NewMockResetPasswordStore(t)
 				hashMock := mocker.NewMockHash(t)
@@ -8,7 +8,6 @@ import (
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/hash"
 	"github.com/shandysiswandi/goreng/jwt"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
@@ -22,7 +21,7 @@ type tokenSaver interface {
 
 type tokenGenSaver struct {
 	jwt       jwt.JWT
-	tel       *telemetry.Telemetry
+	tel       *lib.Telemetry
 	secHash   hash.Hash
 	uidnumber uid.NumberID
 	clock     clock.Clocker
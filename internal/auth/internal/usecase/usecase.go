@@ -4,14 +4,14 @@ import (
 	"github.com/shandysiswandi/goreng/clock"
 	"github.com/shandysiswandi/goreng/hash"
 	"github.com/shandysiswandi/goreng/jwt"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 )
 
 type Dependency struct {
-	Telemetry   *telemetry.Telemetry
+	Telemetry   *lib.Telemetry
 	Validator   validation.Validator
 	UIDNumber   uid.NumberID
 	Hash        hash.Hash
@@ -5,10 +5,10 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/hash"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 )
 
 type VerifyStore interface {
@@ -17,7 +17,7 @@ type VerifyStore interface {
 }
 
 type Verify struct {
-	tel       *telemetry.Telemetry
+	tel       *lib.Telemetry
 	validator validation.Validator
 	secHash   hash.Hash
 	store     VerifyStore
@@ -4,12 +4,12 @@ import (
 	"github.com/shandysiswandi/goreng/clock"
 	"github.com/shandysiswandi/goreng/hash"
 	"github.com/shandysiswandi/goreng/jwt"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/inbound"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/outbound"
 	"github.com/shandysiswandi/gostarter/internal/auth/internal/usecase"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"google.golang.org/grpc"
@@ -19,7 +19,7 @@ type Expose struct{}
 
 type Dependency struct {
 	SQLKitDB   *sqlkit.DB
-	Telemetry  *telemetry.Telemetry
+	Telemetry  *lib.Telemetry
 	Router     *framework.Router
 	GRPCServer *grpc.Server
 	Validator  validation.Validator
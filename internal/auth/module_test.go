@@ -3,7 +3,7 @@ package auth
 import (
 	"testing"
 
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
@@ -19,7 +19,7 @@ func TestNew(t *testing.T) {
 			name: "Success",
 			dep: func() Dependency {
 				return Dependency{
-					Telemetry:  telemetry.NewTelemetry(),
+					Telemetry:  lib.NewTelemetry(),
 					Router:     framework.NewRouter(),
 					GRPCServer: grpc.NewServer(),
 					Validator:  nil,
@@ -0,0 +1,43 @@
+package lib
+
+import "context"
+
+// HeaderRequestID carries the ID of a request. A client may send its own to
+// find the request in our logs, the response echoes the one in use.
+const HeaderRequestID = "X-Request-ID"
+
+// AttributeRequestID is the message attribute carrying the ID of the request a
+// message was published for.
+const AttributeRequestID = "request_id"
+
+// maxRequestIDLength keeps an ID sent by a client from bloating every log line.
+const maxRequestIDLength = 128
+
+type contextRequestIDKey struct{}
+
+func SetRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextRequestIDKey{}, id)
+}
+
+// GetRequestID returns the ID of the request of ctx, empty when there is none.
+func GetRequestID(ctx context.Context) string {
+	id, _ := ctx.Value(contextRequestIDKey{}).(string)
+
+	return id
+}
+
+// ValidRequestID reports whether an ID received from outside can be used as it
+// is, printable ASCII without spaces so it can not forge a log line.
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for i := range len(id) {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,47 @@
+package lib
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "Empty", ctx: context.Background(), want: ""},
+		{name: "Success", ctx: SetRequestID(context.Background(), "req-1"), want: "req-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, GetRequestID(tt.ctx))
+		})
+	}
+}
+
+func TestValidRequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{name: "Empty", id: "", want: false},
+		{name: "TooLong", id: strings.Repeat("a", 129), want: false},
+		{name: "Space", id: "req 1", want: false},
+		{name: "NewLine", id: "req\n1", want: false},
+		{name: "NonASCII", id: "réq", want: false},
+		{name: "UUID", id: "0b0e2ffa-6bb5-4b7c-9f4a-8e0d2b0c7a51", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, ValidRequestID(tt.id))
+		})
+	}
+}
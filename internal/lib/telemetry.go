@@ -0,0 +1,57 @@
+package lib
+
+import (
+	"context"
+
+	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+)
+
+// Telemetry is the telemetry.Telemetry handed to the modules. Its logger adds
+// the ID of the request of ctx to every line, so a request can be followed
+// through the logs.
+type Telemetry struct {
+	*telemetry.Telemetry
+	log logger.Logger
+}
+
+func NewTelemetry(opts ...telemetry.Option) *Telemetry {
+	tel := telemetry.NewTelemetry(opts...)
+
+	return &Telemetry{Telemetry: tel, log: requestIDLogger{Logger: tel.Logger()}}
+}
+
+func (t *Telemetry) Logger() logger.Logger {
+	return t.log
+}
+
+type requestIDLogger struct {
+	logger.Logger
+}
+
+func (l requestIDLogger) Debug(ctx context.Context, msg string, fields ...logger.Field) {
+	l.Logger.Debug(ctx, msg, withRequestID(ctx, fields)...)
+}
+
+func (l requestIDLogger) Info(ctx context.Context, msg string, fields ...logger.Field) {
+	l.Logger.Info(ctx, msg, withRequestID(ctx, fields)...)
+}
+
+func (l requestIDLogger) Warn(ctx context.Context, msg string, fields ...logger.Field) {
+	l.Logger.Warn(ctx, msg, withRequestID(ctx, fields)...)
+}
+
+func (l requestIDLogger) Error(ctx context.Context, msg string, err error, fields ...logger.Field) {
+	l.Logger.Error(ctx, msg, err, withRequestID(ctx, fields)...)
+}
+
+// withRequestID appends the request ID of ctx to fields, a line written outside
+// of a request is left as it is.
+func withRequestID(ctx context.Context, fields []logger.Field) []logger.Field {
+	id := GetRequestID(ctx)
+	if id == "" {
+		return fields
+	}
+
+	return append(fields, logger.KeyVal(AttributeRequestID, id))
+}
@@ -0,0 +1,52 @@
+package lib
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+type fieldsLogger struct {
+	logger.Logger
+	fields []logger.Field
+}
+
+func (l *fieldsLogger) Info(_ context.Context, _ string, fields ...logger.Field) {
+	l.fields = fields
+}
+
+func TestRequestIDLogger(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []logger.Field
+	}{
+		{
+			name: "WithoutRequest",
+			ctx:  context.Background(),
+			want: []logger.Field{logger.KeyVal("id", 1)},
+		},
+		{
+			name: "Success",
+			ctx:  SetRequestID(context.Background(), "req-1"),
+			want: []logger.Field{logger.KeyVal("id", 1), logger.KeyVal(AttributeRequestID, "req-1")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fl := &fieldsLogger{}
+			requestIDLogger{Logger: fl}.Info(tt.ctx, "message", logger.KeyVal("id", 1))
+			assert.Equal(t, tt.want, fl.fields)
+		})
+	}
+}
+
+func TestNewTelemetry(t *testing.T) {
+	tel := NewTelemetry()
+
+	assert.NotNil(t, tel.Telemetry)
+	assert.IsType(t, requestIDLogger{}, tel.Logger())
+}
@@ -9,15 +9,12 @@ import (
 var ErrAccountNoRowsAffected = errors.New("account not created or update")
 
 type Account struct {
-	ID       uint64
-	UserID   uint64
-	Balanace decimal.Decimal
+	ID       uint64          `db:"id"`
+	UserID   uint64          `db:"user_id"`
+	Currency Currency        `db:"currency"`
+	Balanace decimal.Decimal `db:"balance"`
 }
 
-func (a *Account) ScanColumn() []any {
-	return []any{
-		&a.ID,
-		&a.UserID,
-		&a.Balanace,
-	}
+func (Account) Table() string {
+	return "accounts"
 }
@@ -6,29 +6,23 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestAccount_ScanColumn(t *testing.T) {
+func TestAccount_Table(t *testing.T) {
 	tests := []struct {
-		name   string
-		entity *Account
-		want   func(a *Account) []any
+		name string
+		pr   Account
+		want string
 	}{
 		{
-			name:   "Success",
-			entity: &Account{},
-			want: func(entity *Account) []any {
-				return []any{
-					&entity.ID,
-					&entity.UserID,
-					&entity.Balanace,
-				}
-			},
+			name: "Success",
+			pr:   Account{},
+			want: "accounts",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := tt.entity.ScanColumn()
-			assert.Equal(t, tt.want(tt.entity), got)
+			got := tt.pr.Table()
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
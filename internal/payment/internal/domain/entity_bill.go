@@ -26,19 +26,13 @@ func (bt BillType) Values() map[enum.Enumerate]string {
 }
 
 type Bill struct {
-	ID            uint64
-	TransactionID uint64
-	ReferenceID   string
-	Type          BillType
-	Amount        decimal.Decimal
+	ID            uint64          `db:"id"`
+	TransactionID uint64          `db:"transaction_id"`
+	ReferenceID   string          `db:"reference_id"`
+	Type          BillType        `db:"type"`
+	Amount        decimal.Decimal `db:"amount"`
 }
 
-func (b *Bill) ScanColumn() []any {
-	return []any{
-		&b.ID,
-		&b.TransactionID,
-		&b.ReferenceID,
-		&b.Type,
-		&b.Amount,
-	}
+func (Bill) Table() string {
+	return "bills"
 }
@@ -31,31 +31,23 @@ func TestBillType_Values(t *testing.T) {
 	}
 }
 
-func TestBill_ScanColumn(t *testing.T) {
+func TestBill_Table(t *testing.T) {
 	tests := []struct {
-		name   string
-		entity *Bill
-		want   func(a *Bill) []any
+		name string
+		pr   Bill
+		want string
 	}{
 		{
-			name:   "Success",
-			entity: &Bill{},
-			want: func(entity *Bill) []any {
-				return []any{
-					&entity.ID,
-					&entity.TransactionID,
-					&entity.ReferenceID,
-					&entity.Type,
-					&entity.Amount,
-				}
-			},
+			name: "Success",
+			pr:   Bill{},
+			want: "bills",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := tt.entity.ScanColumn()
-			assert.Equal(t, tt.want(tt.entity), got)
+			got := tt.pr.Table()
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
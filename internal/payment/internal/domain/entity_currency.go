@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrCurrencyNotSupported = errors.New("currency not supported")
+	ErrAmountNotPositive    = errors.New("amount must be greater than zero")
+	ErrAmountPrecision      = errors.New("amount has more decimal places than the currency allows")
+)
+
+// Currency is an ISO 4217 alphabetic currency code.
+type Currency string
+
+const (
+	CurrencyIDR Currency = "IDR"
+	CurrencyUSD Currency = "USD"
+	CurrencyEUR Currency = "EUR"
+	CurrencySGD Currency = "SGD"
+	CurrencyJPY Currency = "JPY"
+	CurrencyKWD Currency = "KWD"
+)
+
+// currencyMinorUnits holds the number of decimal places of each supported currency.
+var currencyMinorUnits = map[Currency]int32{
+	CurrencyIDR: 2,
+	CurrencyUSD: 2,
+	CurrencyEUR: 2,
+	CurrencySGD: 2,
+	CurrencyJPY: 0,
+	CurrencyKWD: 3,
+}
+
+// Currencies returns every supported currency.
+func Currencies() []Currency {
+	return []Currency{CurrencyIDR, CurrencyUSD, CurrencyEUR, CurrencySGD, CurrencyJPY, CurrencyKWD}
+}
+
+// ParseCurrency returns the supported currency for code, case-insensitively.
+func ParseCurrency(code string) (Currency, error) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if _, ok := currencyMinorUnits[c]; !ok {
+		return "", ErrCurrencyNotSupported
+	}
+
+	return c, nil
+}
+
+// MinorUnit returns the number of decimal places used by the currency.
+func (c Currency) MinorUnit() int32 {
+	return currencyMinorUnits[c]
+}
+
+// Round rounds amount to the minor unit of the currency using banker's rounding.
+func (c Currency) Round(amount decimal.Decimal) decimal.Decimal {
+	return amount.RoundBank(c.MinorUnit())
+}
+
+// Fits reports whether amount has no more decimal places than the currency allows.
+func (c Currency) Fits(amount decimal.Decimal) bool {
+	return amount.Equal(amount.Truncate(c.MinorUnit()))
+}
+
+// ValidateAmount checks that amount is positive and representable in the currency.
+func (c Currency) ValidateAmount(amount decimal.Decimal) error {
+	if !amount.IsPositive() {
+		return ErrAmountNotPositive
+	}
+
+	if !c.Fits(amount) {
+		return ErrAmountPrecision
+	}
+
+	return nil
+}
+
+func (c Currency) String() string {
+	return string(c)
+}
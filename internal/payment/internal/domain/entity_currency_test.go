@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCurrency(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    Currency
+		wantErr error
+	}{
+		{name: "Unsupported", code: "XXX", want: "", wantErr: ErrCurrencyNotSupported},
+		{name: "Empty", code: "", want: "", wantErr: ErrCurrencyNotSupported},
+		{name: "Lowercase", code: " usd ", want: CurrencyUSD, wantErr: nil},
+		{name: "Success", code: "IDR", want: CurrencyIDR, wantErr: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseCurrency(tt.code)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCurrency_Round(t *testing.T) {
+	tests := []struct {
+		name   string
+		c      Currency
+		amount string
+		want   string
+	}{
+		{name: "JPYHasNoMinorUnit", c: CurrencyJPY, amount: "100.5", want: "100"},
+		{name: "USDTwoDecimals", c: CurrencyUSD, amount: "1.005", want: "1"},
+		{name: "USDRoundUp", c: CurrencyUSD, amount: "1.016", want: "1.02"},
+		{name: "KWDThreeDecimals", c: CurrencyKWD, amount: "1.23456", want: "1.235"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.c.Round(decimal.RequireFromString(tt.amount))
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestCurrency_Fits(t *testing.T) {
+	assert.True(t, CurrencyUSD.Fits(decimal.RequireFromString("10.25")))
+	assert.False(t, CurrencyUSD.Fits(decimal.RequireFromString("10.255")))
+	assert.True(t, CurrencyJPY.Fits(decimal.RequireFromString("10")))
+	assert.False(t, CurrencyJPY.Fits(decimal.RequireFromString("10.5")))
+	assert.Equal(t, "IDR", CurrencyIDR.String())
+	assert.Equal(t, int32(3), CurrencyKWD.MinorUnit())
+}
+
+func TestCurrency_ValidateAmount(t *testing.T) {
+	assert.NoError(t, CurrencyUSD.ValidateAmount(decimal.RequireFromString("0.01")))
+	assert.Equal(t, ErrAmountNotPositive, CurrencyUSD.ValidateAmount(decimal.Zero))
+	assert.Equal(t, ErrAmountNotPositive, CurrencyUSD.ValidateAmount(decimal.RequireFromString("-1")))
+	assert.Equal(t, ErrAmountPrecision, CurrencyJPY.ValidateAmount(decimal.RequireFromString("1.5")))
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// rateInversePrecision is the number of decimal places kept when a rate is inverted.
+const rateInversePrecision = 10
+
+// ExchangeRate says how many units of Quote one unit of Base buys.
+type ExchangeRate struct {
+	Base      Currency        `db:"base_currency"`
+	Quote     Currency        `db:"quote_currency"`
+	Rate      decimal.Decimal `db:"rate"`
+	UpdatedAt time.Time       `db:"updated_at"`
+}
+
+func (ExchangeRate) Table() string {
+	return "exchange_rates"
+}
+
+// Inverse returns the rate for converting Quote back into Base.
+func (er ExchangeRate) Inverse() ExchangeRate {
+	return ExchangeRate{
+		Base:      er.Quote,
+		Quote:     er.Base,
+		Rate:      decimal.NewFromInt(1).DivRound(er.Rate, rateInversePrecision),
+		UpdatedAt: er.UpdatedAt,
+	}
+}
+
+// Convert converts amount in Base into Quote, rounded to the minor unit of Quote.
+func (er ExchangeRate) Convert(amount decimal.Decimal) decimal.Decimal {
+	return er.Quote.Round(amount.Mul(er.Rate))
+}
+
+// SameCurrencyRate is the identity rate used when no conversion is needed.
+func SameCurrencyRate(c Currency) ExchangeRate {
+	return ExchangeRate{Base: c, Quote: c, Rate: decimal.NewFromInt(1)}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExchangeRate_Table(t *testing.T) {
+	assert.Equal(t, "exchange_rates", ExchangeRate{}.Table())
+}
+
+func TestExchangeRate_Convert(t *testing.T) {
+	tests := []struct {
+		name   string
+		rate   ExchangeRate
+		amount string
+		want   string
+	}{
+		{
+			name:   "USDToIDRHalfToEven",
+			rate:   ExchangeRate{Base: CurrencyUSD, Quote: CurrencyIDR, Rate: decimal.RequireFromString("16250.5")},
+			amount: "10.01",
+			want:   "162667.5",
+		},
+		{
+			name:   "IDRToJPYRoundsToWholeYen",
+			rate:   ExchangeRate{Base: CurrencyIDR, Quote: CurrencyJPY, Rate: decimal.RequireFromString("0.0093")},
+			amount: "10050",
+			want:   "93",
+		},
+		{
+			name:   "InverseRate",
+			rate:   ExchangeRate{Base: CurrencyUSD, Quote: CurrencyEUR, Rate: decimal.RequireFromString("0.8")}.Inverse(),
+			amount: "8",
+			want:   "10",
+		},
+		{
+			name:   "SameCurrency",
+			rate:   SameCurrencyRate(CurrencyIDR),
+			amount: "123.45",
+			want:   "123.45",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.rate.Convert(decimal.RequireFromString(tt.amount))
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrRiskDecisionNoRowsAffected = errors.New("risk decision not created or update")
+	ErrRiskOperationNotSupported  = errors.New("risk operation not supported")
+)
+
+// RiskOperation is the kind of money movement a risk decision was made for.
+type RiskOperation string
+
+const (
+	RiskOperationTopup    RiskOperation = "TOPUP"
+	RiskOperationTransfer RiskOperation = "TRANSFER"
+)
+
+// RiskInput is everything a risk rule may look at. DailyTotal and MonthlyTotal
+// are the amounts the user already moved with the same Operation in Currency
+// today and this month, without the current request.
+type RiskInput struct {
+	UserID       uint64
+	Operation    RiskOperation
+	Amount       decimal.Decimal
+	Currency     Currency
+	DailyTotal   decimal.Decimal
+	MonthlyTotal decimal.Decimal
+}
+
+// RiskRule is a single check of the risk engine. Evaluate returns false with
+// a human readable reason when the request must be rejected.
+type RiskRule interface {
+	Name() string
+	Evaluate(in RiskInput) (string, bool)
+}
+
+// RiskLimits holds per currency amounts, a currency without an entry is not limited.
+type RiskLimits map[Currency]decimal.Decimal
+
+type MinAmountRule struct{ Limits RiskLimits }
+
+func (MinAmountRule) Name() string { return "min_amount" }
+
+func (r MinAmountRule) Evaluate(in RiskInput) (string, bool) {
+	limit, ok := r.Limits[in.Currency]
+	if !ok || in.Amount.GreaterThanOrEqual(limit) {
+		return "", true
+	}
+
+	return "amount is below the minimum of " + limit.String() + " " + in.Currency.String(), false
+}
+
+type MaxAmountRule struct{ Limits RiskLimits }
+
+func (MaxAmountRule) Name() string { return "max_amount" }
+
+func (r MaxAmountRule) Evaluate(in RiskInput) (string, bool) {
+	limit, ok := r.Limits[in.Currency]
+	if !ok || in.Amount.LessThanOrEqual(limit) {
+		return "", true
+	}
+
+	return "amount is above the maximum of " + limit.String() + " " + in.Currency.String(), false
+}
+
+type DailyLimitRule struct{ Limits RiskLimits }
+
+func (DailyLimitRule) Name() string { return "daily_limit" }
+
+func (r DailyLimitRule) Evaluate(in RiskInput) (string, bool) {
+	limit, ok := r.Limits[in.Currency]
+	if !ok || in.DailyTotal.Add(in.Amount).LessThanOrEqual(limit) {
+		return "", true
+	}
+
+	return "daily limit of " + limit.String() + " " + in.Currency.String() + " exceeded", false
+}
+
+type MonthlyLimitRule struct{ Limits RiskLimits }
+
+func (MonthlyLimitRule) Name() string { return "monthly_limit" }
+
+func (r MonthlyLimitRule) Evaluate(in RiskInput) (string, bool) {
+	limit, ok := r.Limits[in.Currency]
+	if !ok || in.MonthlyTotal.Add(in.Amount).LessThanOrEqual(limit) {
+		return "", true
+	}
+
+	return "monthly limit of " + limit.String() + " " + in.Currency.String() + " exceeded", false
+}
+
+type BlockedUserRule struct{ UserIDs map[uint64]struct{} }
+
+func (BlockedUserRule) Name() string { return "blocked_user" }
+
+func (r BlockedUserRule) Evaluate(in RiskInput) (string, bool) {
+	if _, ok := r.UserIDs[in.UserID]; ok {
+		return "user is blocked from making payments", false
+	}
+
+	return "", true
+}
+
+// EvaluateRisk runs rules in order and stops at the first one that rejects.
+// It returns the decision without ID and CreatedAt, which are set by the caller.
+func EvaluateRisk(rules []RiskRule, in RiskInput) RiskDecision {
+	decision := RiskDecision{
+		UserID:    in.UserID,
+		Operation: in.Operation,
+		Amount:    in.Amount,
+		Currency:  in.Currency,
+		Allowed:   true,
+	}
+
+	for _, rule := range rules {
+		if reason, ok := rule.Evaluate(in); !ok {
+			decision.Allowed = false
+			decision.Rule = rule.Name()
+			decision.Reason = reason
+
+			break
+		}
+	}
+
+	return decision
+}
+
+// RiskDecision records the outcome of the risk engine for one request.
+// Rule and Reason are empty when the request was allowed.
+type RiskDecision struct {
+	ID        uint64          `db:"id"`
+	UserID    uint64          `db:"user_id"`
+	Operation RiskOperation   `db:"operation"`
+	Amount    decimal.Decimal `db:"amount"`
+	Currency  Currency        `db:"currency"`
+	Allowed   bool            `db:"allowed"`
+	Rule      string          `db:"rule"`
+	Reason    string          `db:"reason"`
+	CreatedAt time.Time       `db:"created_at"`
+}
+
+func (RiskDecision) Table() string {
+	return "risk_decisions"
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateRisk(t *testing.T) {
+	usd := func(s string) decimal.Decimal { return decimal.RequireFromString(s) }
+	rules := []RiskRule{
+		BlockedUserRule{UserIDs: map[uint64]struct{}{7: {}}},
+		MinAmountRule{Limits: RiskLimits{CurrencyUSD: usd("1")}},
+		MaxAmountRule{Limits: RiskLimits{CurrencyUSD: usd("1000")}},
+		DailyLimitRule{Limits: RiskLimits{CurrencyUSD: usd("1500")}},
+		MonthlyLimitRule{Limits: RiskLimits{CurrencyUSD: usd("5000")}},
+	}
+
+	tests := []struct {
+		name       string
+		in         RiskInput
+		wantRule   string
+		wantReason string
+	}{
+		{
+			name:       "BlockedUser",
+			in:         RiskInput{UserID: 7, Amount: usd("10"), Currency: CurrencyUSD},
+			wantRule:   "blocked_user",
+			wantReason: "user is blocked from making payments",
+		},
+		{
+			name:       "BelowMinimum",
+			in:         RiskInput{UserID: 1, Amount: usd("0.5"), Currency: CurrencyUSD},
+			wantRule:   "min_amount",
+			wantReason: "amount is below the minimum of 1 USD",
+		},
+		{
+			name:       "AboveMaximum",
+			in:         RiskInput{UserID: 1, Amount: usd("1000.01"), Currency: CurrencyUSD},
+			wantRule:   "max_amount",
+			wantReason: "amount is above the maximum of 1000 USD",
+		},
+		{
+			name:       "DailyExceeded",
+			in:         RiskInput{UserID: 1, Amount: usd("600"), Currency: CurrencyUSD, DailyTotal: usd("1000")},
+			wantRule:   "daily_limit",
+			wantReason: "daily limit of 1500 USD exceeded",
+		},
+		{
+			name: "MonthlyExceeded",
+			in: RiskInput{
+				UserID: 1, Amount: usd("600"), Currency: CurrencyUSD, DailyTotal: usd("0"), MonthlyTotal: usd("4500"),
+			},
+			wantRule:   "monthly_limit",
+			wantReason: "monthly limit of 5000 USD exceeded",
+		},
+		{
+			name: "AllowedAtLimit",
+			in: RiskInput{
+				UserID: 1, Amount: usd("500"), Currency: CurrencyUSD, DailyTotal: usd("1000"), MonthlyTotal: usd("4500"),
+			},
+		},
+		{
+			name: "AllowedUnlimitedCurrency",
+			in:   RiskInput{UserID: 1, Amount: usd("0.01"), Currency: CurrencyIDR},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := EvaluateRisk(rules, tt.in)
+			assert.Equal(t, tt.wantRule == "", got.Allowed)
+			assert.Equal(t, tt.wantRule, got.Rule)
+			assert.Equal(t, tt.wantReason, got.Reason)
+			assert.Equal(t, tt.in.UserID, got.UserID)
+		})
+	}
+}
+
+func TestRiskDecision_Table(t *testing.T) {
+	assert.Equal(t, "risk_decisions", RiskDecision{}.Table())
+}
@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrScheduleInvalid                   = errors.New("schedule expression is invalid")
+	ErrScheduledPaymentNoRowsAffected    = errors.New("scheduled payment not created or update")
+	ErrScheduledPaymentRunNoRowsAffected = errors.New("scheduled payment run not created or update")
+)
+
+// minScheduleInterval keeps `@every` schedules from hammering the runner.
+const minScheduleInterval = time.Minute
+
+// cronHorizon bounds the search for the next cron occurrence, so impossible
+// expressions like `0 0 31 2 *` end instead of looping forever.
+const cronHorizon = 5 * 366 * 24 * time.Hour
+
+// Schedule computes when a scheduled payment runs next.
+type Schedule interface {
+	// Next returns the first run strictly after t, or the zero time when
+	// the schedule has no more runs.
+	Next(t time.Time) time.Time
+}
+
+// ParseSchedule parses a schedule expression:
+//
+//	@once             run a single time at the requested start time
+//	@every 720h       run every interval, at least one minute
+//	@hourly, @daily, @weekly, @monthly
+//	30 9 1 * *        standard five field cron: minute hour day-of-month month day-of-week
+//
+// Cron fields accept `*`, numbers, lists `1,15`, ranges `1-5` and steps `*/15` or `1-30/2`.
+func ParseSchedule(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+
+	switch expr {
+	case "@once":
+		return onceSchedule{}, nil
+	case "@hourly":
+		expr = "0 * * * *"
+	case "@daily":
+		expr = "0 0 * * *"
+	case "@weekly":
+		expr = "0 0 * * 0"
+	case "@monthly":
+		expr = "0 0 1 * *"
+	}
+
+	if every, ok := strings.CutPrefix(expr, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(every))
+		if err != nil || d < minScheduleInterval {
+			return nil, ErrScheduleInvalid
+		}
+
+		return intervalSchedule{every: d}, nil
+	}
+
+	return parseCron(expr)
+}
+
+type onceSchedule struct{}
+
+func (onceSchedule) Next(time.Time) time.Time {
+	return time.Time{}
+}
+
+type intervalSchedule struct {
+	every time.Duration
+}
+
+func (s intervalSchedule) Next(t time.Time) time.Time {
+	return t.Add(s.every)
+}
+
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar follow cron: when both day fields are restricted,
+	// a day matches if either of them matches.
+	domStar, dowStar bool
+}
+
+func parseCron(expr string) (Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, ErrScheduleInvalid
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+	bits := [5]uint64{}
+	for i, f := range fields {
+		b, err := parseCronField(f, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, err
+		}
+		bits[i] = b
+	}
+
+	return cronSchedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}, nil
+}
+
+func parseCronField(field string, low, high int) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			s, err := strconv.Atoi(stepStr)
+			if err != nil || s <= 0 {
+				return 0, ErrScheduleInvalid
+			}
+			step = s
+		}
+
+		start, end := low, high
+		if rng != "*" {
+			from, to, isRange := strings.Cut(rng, "-")
+
+			var err error
+			if start, err = strconv.Atoi(from); err != nil {
+				return 0, ErrScheduleInvalid
+			}
+
+			end = start
+			if isRange {
+				if end, err = strconv.Atoi(to); err != nil {
+					return 0, ErrScheduleInvalid
+				}
+			} else if hasStep {
+				end = high
+			}
+		}
+
+		if start < low || end > high || start > end {
+			return 0, ErrScheduleInvalid
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+func (s cronSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronHorizon)
+
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s cronSchedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// ScheduledPayment is a transfer the runner executes on behalf of UserID
+// whenever NextRunAt is due. LeaseOwner and LeaseUntil are set while one
+// runner instance holds the schedule, so other instances skip it.
+type ScheduledPayment struct {
+	ID             uint64          `db:"id"`
+	UserID         uint64          `db:"user_id"`
+	RecipientID    uint64          `db:"recipient_id"`
+	Amount         decimal.Decimal `db:"amount"`
+	Currency       Currency        `db:"currency"`
+	TargetCurrency Currency        `db:"target_currency"`
+	Schedule       string          `db:"schedule"`
+	NextRunAt      time.Time       `db:"next_run_at"`
+	Active         bool            `db:"active"`
+	Attempts       int             `db:"attempts"`
+	LeaseOwner     string          `db:"lease_owner"`
+	LeaseUntil     *time.Time      `db:"lease_until"`
+	CreatedAt      time.Time       `db:"created_at"`
+}
+
+func (ScheduledPayment) Table() string {
+	return "scheduled_payments"
+}
+
+type ScheduledRunStatus int
+
+const (
+	ScheduledRunStatusUnknown ScheduledRunStatus = iota
+	ScheduledRunStatusSuccess
+	ScheduledRunStatusRetry
+	ScheduledRunStatusFailed
+)
+
+func (s ScheduledRunStatus) Values() map[enum.Enumerate]string {
+	return map[enum.Enumerate]string{
+		ScheduledRunStatusUnknown: "UNKNOWN",
+		ScheduledRunStatusSuccess: "SUCCESS",
+		ScheduledRunStatusRetry:   "RETRY",
+		ScheduledRunStatusFailed:  "FAILED",
+	}
+}
+
+// ScheduledPaymentRun records the outcome of one execution attempt.
+type ScheduledPaymentRun struct {
+	ID         uint64                        `db:"id"`
+	ScheduleID uint64                        `db:"schedule_id"`
+	Attempt    int                           `db:"attempt"`
+	Status     enum.Enum[ScheduledRunStatus] `db:"status"`
+	TransferID uint64                        `db:"transfer_id"`
+	Error      string                        `db:"error"`
+	RunAt      time.Time                     `db:"run_at"`
+}
+
+func (ScheduledPaymentRun) Table() string {
+	return "scheduled_payment_runs"
+}
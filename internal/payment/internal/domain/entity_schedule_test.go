@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSchedule(t *testing.T) {
+	// Friday, 14 March 2025 15:09:26 UTC
+	from := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    time.Time
+		wantErr error
+	}{
+		{name: "Once", expr: "@once", want: time.Time{}},
+		{name: "Every", expr: "@every 2h", want: from.Add(2 * time.Hour)},
+		{name: "ErrorEveryTooShort", expr: "@every 30s", wantErr: ErrScheduleInvalid},
+		{name: "ErrorEveryMalformed", expr: "@every soon", wantErr: ErrScheduleInvalid},
+		{name: "Hourly", expr: "@hourly", want: time.Date(2025, 3, 14, 16, 0, 0, 0, time.UTC)},
+		{name: "Daily", expr: "@daily", want: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "Weekly", expr: "@weekly", want: time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{name: "Monthly", expr: "@monthly", want: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "CronSameHour", expr: "30 15 * * *", want: time.Date(2025, 3, 14, 15, 30, 0, 0, time.UTC)},
+		{name: "CronStep", expr: "*/15 * * * *", want: time.Date(2025, 3, 14, 15, 15, 0, 0, time.UTC)},
+		{name: "CronList", expr: "0 9 1,15 * *", want: time.Date(2025, 3, 15, 9, 0, 0, 0, time.UTC)},
+		{name: "CronRangeWeekdays", expr: "0 9 * * 1-5", want: time.Date(2025, 3, 17, 9, 0, 0, 0, time.UTC)},
+		{name: "CronDayOrWeekday", expr: "0 0 20 * 6", want: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "CronNextYear", expr: "0 0 1 1 *", want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "CronImpossible", expr: "0 0 31 2 *", want: time.Time{}},
+		{name: "ErrorCronFieldCount", expr: "* * *", wantErr: ErrScheduleInvalid},
+		{name: "ErrorCronOutOfRange", expr: "60 * * * *", wantErr: ErrScheduleInvalid},
+		{name: "ErrorCronBadStep", expr: "*/0 * * * *", wantErr: ErrScheduleInvalid},
+		{name: "ErrorCronBadRange", expr: "0 5-1 * * *", wantErr: ErrScheduleInvalid},
+		{name: "ErrorCronNotNumber", expr: "a * * * *", wantErr: ErrScheduleInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, err := ParseSchedule(tt.expr)
+			assert.Equal(t, tt.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, s.Next(from))
+		})
+	}
+}
+
+func TestScheduledPayment_Table(t *testing.T) {
+	assert.Equal(t, "scheduled_payments", ScheduledPayment{}.Table())
+	assert.Equal(t, "scheduled_payment_runs", ScheduledPaymentRun{}.Table())
+}
@@ -1,19 +1,20 @@
 package domain
 
-import "github.com/shopspring/decimal"
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrTopupNoRowsAffected = errors.New("topup not created or update")
 
 type Topup struct {
-	ID            uint64
-	TransactionID uint64
-	ReferenceID   string
-	Amount        decimal.Decimal
+	ID            uint64          `db:"id"`
+	TransactionID uint64          `db:"transaction_id"`
+	ReferenceID   string          `db:"reference_id"`
+	Amount        decimal.Decimal `db:"amount"`
 }
 
-func (t *Topup) ScanColumn() []any {
-	return []any{
-		&t.ID,
-		&t.TransactionID,
-		&t.ReferenceID,
-		&t.Amount,
-	}
+func (Topup) Table() string {
+	return "topups"
 }
@@ -6,30 +6,23 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestTopup_ScanColumn(t *testing.T) {
+func TestTopup_Table(t *testing.T) {
 	tests := []struct {
-		name   string
-		entity *Topup
-		want   func(a *Topup) []any
+		name string
+		pr   Topup
+		want string
 	}{
 		{
-			name:   "Success",
-			entity: &Topup{},
-			want: func(entity *Topup) []any {
-				return []any{
-					&entity.ID,
-					&entity.TransactionID,
-					&entity.ReferenceID,
-					&entity.Amount,
-				}
-			},
+			name: "Success",
+			pr:   Topup{},
+			want: "topups",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := tt.entity.ScanColumn()
-			assert.Equal(t, tt.want(tt.entity), got)
+			got := tt.pr.Table()
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
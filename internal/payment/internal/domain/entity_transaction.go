@@ -45,23 +45,16 @@ func (tt TransactionType) Values() map[enum.Enumerate]string {
 }
 
 type Transaction struct {
-	ID       uint64
-	UserID   uint64
-	Amount   decimal.Decimal
-	Type     TransactionType
-	Status   TransactionStatus
-	Remark   string
-	CreateAt time.Time
+	ID       uint64                       `db:"id"`
+	UserID   uint64                       `db:"user_id"`
+	Amount   decimal.Decimal              `db:"amount"`
+	Currency Currency                     `db:"currency"`
+	Type     enum.Enum[TransactionType]   `db:"type"`
+	Status   enum.Enum[TransactionStatus] `db:"status"`
+	Remark   string                       `db:"remark"`
+	CreateAt time.Time                    `db:"created_at"`
 }
 
-func (t *Transaction) ScanColumn() []any {
-	return []any{
-		&t.ID,
-		&t.UserID,
-		&t.Amount,
-		&t.Type,
-		&t.Status,
-		&t.Remark,
-		&t.CreateAt,
-	}
+func (Transaction) Table() string {
+	return "transactions"
 }
@@ -52,33 +52,23 @@ func TestTransactionType_Values(t *testing.T) {
 	}
 }
 
-func TestTransaction_ScanColumn(t *testing.T) {
+func TestTransaction_Table(t *testing.T) {
 	tests := []struct {
-		name   string
-		entity *Transaction
-		want   func(a *Transaction) []any
+		name string
+		pr   Transaction
+		want string
 	}{
 		{
-			name:   "Success",
-			entity: &Transaction{},
-			want: func(entity *Transaction) []any {
-				return []any{
-					&entity.ID,
-					&entity.UserID,
-					&entity.Amount,
-					&entity.Type,
-					&entity.Status,
-					&entity.Remark,
-					&entity.CreateAt,
-				}
-			},
+			name: "Success",
+			pr:   Transaction{},
+			want: "transactions",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := tt.entity.ScanColumn()
-			assert.Equal(t, tt.want(tt.entity), got)
+			got := tt.pr.Table()
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -1,21 +1,31 @@
 package domain
 
-import "github.com/shopspring/decimal"
+import (
+	"errors"
 
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrTransferNoRowsAffected = errors.New("transfer not created or update")
+	ErrTransferToSelf         = errors.New("cannot transfer to yourself")
+)
+
+// Transfer moves Amount in Currency out of the sender and TargetAmount in
+// TargetCurrency into the recipient. Rate is the exchange rate that was applied,
+// which is 1 when both currencies are the same.
 type Transfer struct {
-	ID            uint64
-	TransactionID uint64
-	SenderID      uint64
-	RecipientID   uint64
-	Amount        decimal.Decimal
+	ID             uint64          `db:"id"`
+	TransactionID  uint64          `db:"transaction_id"`
+	SenderID       uint64          `db:"sender_id"`
+	RecipientID    uint64          `db:"recipient_id"`
+	Amount         decimal.Decimal `db:"amount"`
+	Currency       Currency        `db:"currency"`
+	TargetAmount   decimal.Decimal `db:"target_amount"`
+	TargetCurrency Currency        `db:"target_currency"`
+	Rate           decimal.Decimal `db:"rate"`
 }
 
-func (t *Transfer) ScanColumn() []any {
-	return []any{
-		&t.ID,
-		&t.TransactionID,
-		&t.SenderID,
-		&t.RecipientID,
-		&t.Amount,
-	}
+func (Transfer) Table() string {
+	return "transfers"
 }
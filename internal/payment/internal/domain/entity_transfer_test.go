@@ -6,31 +6,23 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestTransfer_ScanColumn(t *testing.T) {
+func TestTransfer_Table(t *testing.T) {
 	tests := []struct {
-		name   string
-		entity *Transfer
-		want   func(a *Transfer) []any
+		name string
+		pr   Transfer
+		want string
 	}{
 		{
-			name:   "Success",
-			entity: &Transfer{},
-			want: func(entity *Transfer) []any {
-				return []any{
-					&entity.ID,
-					&entity.TransactionID,
-					&entity.SenderID,
-					&entity.RecipientID,
-					&entity.Amount,
-				}
-			},
+			name: "Success",
+			pr:   Transfer{},
+			want: "transfers",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := tt.entity.ScanColumn()
-			assert.Equal(t, tt.want(tt.entity), got)
+			got := tt.pr.Table()
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
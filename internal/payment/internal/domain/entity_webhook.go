@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/shopspring/decimal"
+)
+
+// WebhookEvent is the settlement notification a payment provider sends once
+// it knows the outcome of a topup identified by ReferenceID.
+type WebhookEvent struct {
+	ReferenceID string          `json:"reference_id"`
+	Status      string          `json:"status"` // SUCCESS or FAILED
+	Amount      decimal.Decimal `json:"amount"`
+	Currency    string          `json:"currency"`
+}
+
+// SignWebhook returns the hex encoded HMAC-SHA256 of payload using secret.
+// Providers send this value in the X-Signature header.
+func SignWebhook(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook reports whether signature matches the HMAC-SHA256 of payload
+// using secret. The comparison is done in constant time.
+func VerifyWebhook(secret string, payload []byte, signature string) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hmac.Equal(sig, mac.Sum(nil))
+}
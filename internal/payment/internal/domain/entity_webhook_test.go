@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		payload []byte
+		want    string
+	}{
+		{
+			name:    "Success",
+			secret:  "secret",
+			payload: []byte(`{"reference_id":"uuid"}`),
+			want:    "031905792e150ff21c05373badd29c38a38da3b33e3b4d7e320d4696247cff04",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := SignWebhook(tt.secret, tt.payload)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	payload := []byte(`{"reference_id":"uuid"}`)
+
+	tests := []struct {
+		name      string
+		secret    string
+		payload   []byte
+		signature string
+		want      bool
+	}{
+		{
+			name:      "ErrorNotHex",
+			secret:    "secret",
+			payload:   payload,
+			signature: "not-hex",
+			want:      false,
+		},
+		{
+			name:      "ErrorWrongSecret",
+			secret:    "another",
+			payload:   payload,
+			signature: SignWebhook("secret", payload),
+			want:      false,
+		},
+		{
+			name:      "ErrorTamperedPayload",
+			secret:    "secret",
+			payload:   []byte(`{"reference_id":"other"}`),
+			signature: SignWebhook("secret", payload),
+			want:      false,
+		},
+		{
+			name:      "Success",
+			secret:    "secret",
+			payload:   payload,
+			signature: SignWebhook("secret", payload),
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := VerifyWebhook(tt.secret, tt.payload, tt.signature)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
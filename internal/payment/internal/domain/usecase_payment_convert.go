@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+type PaymentConvert interface {
+	Call(ctx context.Context, in PaymentConvertInput) (*PaymentConvertOutput, error)
+}
+
+type PaymentConvertInput struct {
+	From   string          `validate:"required,len=3"`
+	To     string          `validate:"required,len=3"`
+	Amount decimal.Decimal `validate:"required"`
+}
+
+type PaymentConvertOutput struct {
+	From      Currency
+	To        Currency
+	Amount    decimal.Decimal
+	Converted decimal.Decimal
+	Rate      decimal.Decimal
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type PaymentScheduleCreate interface {
+	Call(ctx context.Context, in PaymentScheduleCreateInput) (*PaymentScheduleCreateOutput, error)
+}
+
+type PaymentScheduleCreateInput struct {
+	RecipientID    uint64          `validate:"required"`
+	Amount         decimal.Decimal `validate:"required"`
+	Currency       string          `validate:"required,len=3"`
+	TargetCurrency string          `validate:"omitempty,len=3"` // defaults to Currency
+	Schedule       string          `validate:"required"`
+	StartAt        time.Time       // first run, required for `@once`
+}
+
+type PaymentScheduleCreateOutput struct {
+	ID        uint64
+	Schedule  string
+	NextRunAt time.Time
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+)
+
+type PaymentScheduleExecute interface {
+	Call(ctx context.Context, in PaymentScheduleExecuteInput) (*PaymentScheduleExecuteOutput, error)
+}
+
+type PaymentScheduleExecuteInput struct {
+	// Owner identifies the runner instance holding the lease.
+	Owner string `validate:"required"`
+}
+
+type PaymentScheduleExecuteOutput struct {
+	Succeeded int
+	Retried   int
+	Failed    int
+}
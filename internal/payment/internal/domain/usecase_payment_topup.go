@@ -13,10 +13,12 @@ type PaymentTopup interface {
 type PaymentTopupInput struct {
 	ReferenceID string          `validate:"required"`
 	Amount      decimal.Decimal `validate:"required"`
+	Currency    string          `validate:"required,len=3"`
 }
 
 type PaymentTopupOutput struct {
 	ReferenceID string
 	Amount      decimal.Decimal
-	Balance     decimal.Decimal
+	Currency    Currency
+	Status      TransactionStatus
 }
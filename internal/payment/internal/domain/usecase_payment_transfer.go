@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+type PaymentTransfer interface {
+	Call(ctx context.Context, in PaymentTransferInput) (*PaymentTransferOutput, error)
+}
+
+type PaymentTransferInput struct {
+	RecipientID    uint64          `validate:"required"`
+	Amount         decimal.Decimal `validate:"required"`
+	Currency       string          `validate:"required,len=3"`
+	TargetCurrency string          `validate:"omitempty,len=3"` // defaults to Currency
+}
+
+type PaymentTransferOutput struct {
+	ID             uint64
+	Amount         decimal.Decimal
+	Currency       Currency
+	TargetAmount   decimal.Decimal
+	TargetCurrency Currency
+	Rate           decimal.Decimal
+}
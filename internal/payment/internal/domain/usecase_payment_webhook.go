@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+type PaymentWebhook interface {
+	Call(ctx context.Context, in PaymentWebhookInput) (*PaymentWebhookOutput, error)
+}
+
+type PaymentWebhookInput struct {
+	Provider  string `validate:"required"`
+	Signature string `validate:"required"`
+	Payload   []byte `validate:"required"`
+}
+
+type PaymentWebhookOutput struct {
+	ReferenceID string
+	Status      TransactionStatus
+}
@@ -1,30 +1,38 @@
 package inbound
 
 import (
-	"encoding/json"
+	"io"
 
+	"github.com/shandysiswandi/goreng/enum"
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/shopspring/decimal"
 )
 
+// maxWebhookBody caps the payload read from a provider callback.
+const maxWebhookBody = 1 << 20
+
 var errInvalidBody = goerror.NewInvalidFormat("Request payload malformed")
 
 type httpEndpoint struct {
-	tel *telemetry.Telemetry
+	tel *lib.Telemetry
 
-	paymentTopupUC domain.PaymentTopup
+	paymentTopupUC    domain.PaymentTopup
+	paymentWebhookUC  domain.PaymentWebhook
+	paymentConvertUC  domain.PaymentConvert
+	paymentTransferUC domain.PaymentTransfer
+	paymentScheduleUC domain.PaymentScheduleCreate
 }
 
 func (h *httpEndpoint) PaymentTopup(c framework.Context) (any, error) {
 	ctx, span := h.tel.Tracer().Start(c.Context(), "payment.inbound.httpEndpoint.PaymentTopup")
 	defer span.End()
 
-	var req PaymentTopupRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[PaymentTopupRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	amo, err := decimal.NewFromString(req.Amount)
@@ -35,6 +43,7 @@ func (h *httpEndpoint) PaymentTopup(c framework.Context) (any, error) {
 	resp, err := h.paymentTopupUC.Call(ctx, domain.PaymentTopupInput{
 		ReferenceID: req.ReferenceID,
 		Amount:      amo,
+		Currency:    req.Currency,
 	})
 	if err != nil {
 		return nil, err
@@ -42,7 +51,127 @@ func (h *httpEndpoint) PaymentTopup(c framework.Context) (any, error) {
 
 	return PaymentTopupResponse{
 		ReferenceID: resp.ReferenceID,
-		Amount:      resp.Amount.StringFixed(2),
-		Balance:     resp.Balance.StringFixed(2),
+		Amount:      resp.Amount.StringFixed(resp.Currency.MinorUnit()),
+		Currency:    resp.Currency.String(),
+		Status:      enum.New(resp.Status).String(),
+	}, nil
+}
+
+func (h *httpEndpoint) PaymentWebhook(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "payment.inbound.httpEndpoint.PaymentWebhook")
+	defer span.End()
+
+	// the raw payload is required as-is because the signature is computed over it
+	payload, err := io.ReadAll(io.LimitReader(c.Body(), maxWebhookBody))
+	if err != nil {
+		return nil, errInvalidBody
+	}
+
+	resp, err := h.paymentWebhookUC.Call(ctx, domain.PaymentWebhookInput{
+		Provider:  c.Param("provider"),
+		Signature: c.Header().Get("X-Signature"),
+		Payload:   payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return PaymentWebhookResponse{
+		ReferenceID: resp.ReferenceID,
+		Status:      enum.New(resp.Status).String(),
+	}, nil
+}
+
+func (h *httpEndpoint) PaymentConvert(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "payment.inbound.httpEndpoint.PaymentConvert")
+	defer span.End()
+
+	amo, err := decimal.NewFromString(c.Query("amount"))
+	if err != nil {
+		return nil, goerror.NewInvalidFormat("Query parameter amount malformed")
+	}
+
+	resp, err := h.paymentConvertUC.Call(ctx, domain.PaymentConvertInput{
+		From:   c.Query("from"),
+		To:     c.Query("to"),
+		Amount: amo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return PaymentConvertResponse{
+		From:      resp.From.String(),
+		To:        resp.To.String(),
+		Amount:    resp.Amount.StringFixed(resp.From.MinorUnit()),
+		Converted: resp.Converted.StringFixed(resp.To.MinorUnit()),
+		Rate:      resp.Rate.String(),
+	}, nil
+}
+
+func (h *httpEndpoint) PaymentTransfer(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "payment.inbound.httpEndpoint.PaymentTransfer")
+	defer span.End()
+
+	req, err := framework.Bind[PaymentTransferRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	amo, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, errInvalidBody
+	}
+
+	resp, err := h.paymentTransferUC.Call(ctx, domain.PaymentTransferInput{
+		RecipientID:    req.RecipientID,
+		Amount:         amo,
+		Currency:       req.Currency,
+		TargetCurrency: req.TargetCurrency,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return PaymentTransferResponse{
+		ID:             resp.ID,
+		Amount:         resp.Amount.StringFixed(resp.Currency.MinorUnit()),
+		Currency:       resp.Currency.String(),
+		TargetAmount:   resp.TargetAmount.StringFixed(resp.TargetCurrency.MinorUnit()),
+		TargetCurrency: resp.TargetCurrency.String(),
+		Rate:           resp.Rate.String(),
+	}, nil
+}
+
+func (h *httpEndpoint) PaymentScheduleCreate(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "payment.inbound.httpEndpoint.PaymentScheduleCreate")
+	defer span.End()
+
+	req, err := framework.Bind[PaymentScheduleCreateRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	amo, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return nil, errInvalidBody
+	}
+
+	resp, err := h.paymentScheduleUC.Call(ctx, domain.PaymentScheduleCreateInput{
+		RecipientID:    req.RecipientID,
+		Amount:         amo,
+		Currency:       req.Currency,
+		TargetCurrency: req.TargetCurrency,
+		Schedule:       req.Schedule,
+		StartAt:        req.StartAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return PaymentScheduleCreateResponse{
+		ID:        resp.ID,
+		Schedule:  resp.Schedule,
+		NextRunAt: resp.NextRunAt,
 	}, nil
 }
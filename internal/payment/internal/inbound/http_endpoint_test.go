@@ -5,10 +5,14 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"testing/iotest"
+	"time"
 
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/mockz"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/outbound"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -33,7 +37,7 @@ func Test_httpEndpoint_PaymentTopup(t *testing.T) {
 			want:    nil,
 			wantErr: errInvalidBody,
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentTopup")
 				defer span.End()
@@ -54,7 +58,7 @@ func Test_httpEndpoint_PaymentTopup(t *testing.T) {
 			want:    nil,
 			wantErr: errInvalidBody,
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentTopup")
 				defer span.End()
@@ -67,7 +71,7 @@ func Test_httpEndpoint_PaymentTopup(t *testing.T) {
 		{
 			name: "ErrorCallUC",
 			c: func() framework.Context {
-				body := bytes.NewBufferString(`{"reference_id":"uuid", "amount":"1000.00"}`)
+				body := bytes.NewBufferString(`{"reference_id":"uuid", "amount":"1000.00", "currency":"IDR"}`)
 				c := framework.NewTestContext(http.MethodPost, "/payments/topup", body)
 
 				return c.Build()
@@ -76,7 +80,7 @@ func Test_httpEndpoint_PaymentTopup(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				ptMock := mockz.NewMockPaymentTopup(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentTopup")
 				defer span.End()
@@ -85,6 +89,7 @@ func Test_httpEndpoint_PaymentTopup(t *testing.T) {
 				in := domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      amo,
+					Currency:    "IDR",
 				}
 				ptMock.EXPECT().
 					Call(ctx, in).
@@ -99,7 +104,7 @@ func Test_httpEndpoint_PaymentTopup(t *testing.T) {
 		{
 			name: "Success",
 			c: func() framework.Context {
-				body := bytes.NewBufferString(`{"reference_id":"uuid", "amount":"1000.00"}`)
+				body := bytes.NewBufferString(`{"reference_id":"uuid", "amount":"1000.00", "currency":"IDR"}`)
 				c := framework.NewTestContext(http.MethodPost, "/payments/topup", body)
 
 				return c.Build()
@@ -107,12 +112,13 @@ func Test_httpEndpoint_PaymentTopup(t *testing.T) {
 			want: PaymentTopupResponse{
 				ReferenceID: "uuid",
 				Amount:      "1000.00",
-				Balance:     "2000.00",
+				Currency:    "IDR",
+				Status:      "PENDING",
 			},
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				ptMock := mockz.NewMockPaymentTopup(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentTopup")
 				defer span.End()
@@ -121,11 +127,13 @@ func Test_httpEndpoint_PaymentTopup(t *testing.T) {
 				in := domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      amo,
+					Currency:    "IDR",
 				}
 				out := &domain.PaymentTopupOutput{
 					ReferenceID: in.ReferenceID,
 					Amount:      in.Amount,
-					Balance:     in.Amount.Add(in.Amount),
+					Currency:    domain.CurrencyIDR,
+					Status:      domain.TransactionStatusPending,
 				}
 				ptMock.EXPECT().
 					Call(ctx, in).
@@ -149,3 +157,510 @@ func Test_httpEndpoint_PaymentTopup(t *testing.T) {
 		})
 	}
 }
+
+func Test_httpEndpoint_PaymentWebhook(t *testing.T) {
+	provider := outbound.NewFakeProvider("fakepay", "secret")
+	payload, sig := provider.Event("uuid", domain.TransactionStatusSuccess, decimal.NewFromInt(1000), domain.CurrencyIDR)
+
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorReadBody",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/payments/webhooks/fakepay",
+					iotest.ErrReader(assert.AnError))
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errInvalidBody,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentWebhook")
+				defer span.End()
+
+				return &httpEndpoint{
+					tel: tel,
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/payments/webhooks/fakepay",
+					bytes.NewBuffer(payload))
+				c.SetParam("provider", provider.Name())
+				c.SetHeader("X-Signature", sig)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				pwMock := mockz.NewMockPaymentWebhook(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentWebhook")
+				defer span.End()
+
+				in := domain.PaymentWebhookInput{
+					Provider:  provider.Name(),
+					Signature: sig,
+					Payload:   payload,
+				}
+				pwMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:              tel,
+					paymentWebhookUC: pwMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/payments/webhooks/fakepay",
+					bytes.NewBuffer(payload))
+				c.SetParam("provider", provider.Name())
+				c.SetHeader("X-Signature", sig)
+
+				return c.Build()
+			},
+			want: PaymentWebhookResponse{
+				ReferenceID: "uuid",
+				Status:      "SUCCESS",
+			},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				pwMock := mockz.NewMockPaymentWebhook(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentWebhook")
+				defer span.End()
+
+				in := domain.PaymentWebhookInput{
+					Provider:  provider.Name(),
+					Signature: sig,
+					Payload:   payload,
+				}
+				out := &domain.PaymentWebhookOutput{
+					ReferenceID: "uuid",
+					Status:      domain.TransactionStatusSuccess,
+				}
+				pwMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:              tel,
+					paymentWebhookUC: pwMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.PaymentWebhook(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_PaymentConvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseAmount",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/payments/convert", nil)
+				c.SetQuery("from", "USD")
+				c.SetQuery("to", "IDR")
+				c.SetQuery("amount", "zzz")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidFormat("Query parameter amount malformed"),
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				return &httpEndpoint{
+					tel: lib.NewTelemetry(),
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/payments/convert", nil)
+				c.SetQuery("from", "USD")
+				c.SetQuery("to", "IDR")
+				c.SetQuery("amount", "10")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				pcMock := mockz.NewMockPaymentConvert(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentConvert")
+				defer span.End()
+
+				in := domain.PaymentConvertInput{From: "USD", To: "IDR", Amount: decimal.NewFromInt(10)}
+				pcMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:              tel,
+					paymentConvertUC: pcMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/payments/convert", nil)
+				c.SetQuery("from", "USD")
+				c.SetQuery("to", "JPY")
+				c.SetQuery("amount", "10")
+
+				return c.Build()
+			},
+			want: PaymentConvertResponse{
+				From:      "USD",
+				To:        "JPY",
+				Amount:    "10.00",
+				Converted: "1502",
+				Rate:      "150.25",
+			},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				pcMock := mockz.NewMockPaymentConvert(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentConvert")
+				defer span.End()
+
+				in := domain.PaymentConvertInput{From: "USD", To: "JPY", Amount: decimal.NewFromInt(10)}
+				out := &domain.PaymentConvertOutput{
+					From:      domain.CurrencyUSD,
+					To:        domain.CurrencyJPY,
+					Amount:    in.Amount,
+					Converted: decimal.NewFromInt(1502),
+					Rate:      decimal.RequireFromString("150.25"),
+				}
+				pcMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:              tel,
+					paymentConvertUC: pcMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.PaymentConvert(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_PaymentTransfer(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorDecodeBody",
+			c: func() framework.Context {
+				body := bytes.NewBufferString("fake request")
+				c := framework.NewTestContext(http.MethodPost, "/payments/transfers", body)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errInvalidBody,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				return &httpEndpoint{
+					tel: lib.NewTelemetry(),
+				}
+			},
+		},
+		{
+			name: "ErrorParseAmount",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(`{"recipient_id":12, "amount":"zzz", "currency":"USD"}`)
+				c := framework.NewTestContext(http.MethodPost, "/payments/transfers", body)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errInvalidBody,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				return &httpEndpoint{
+					tel: lib.NewTelemetry(),
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(`{"recipient_id":12, "amount":"10", "currency":"USD"}`)
+				c := framework.NewTestContext(http.MethodPost, "/payments/transfers", body)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				ptMock := mockz.NewMockPaymentTransfer(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentTransfer")
+				defer span.End()
+
+				in := domain.PaymentTransferInput{RecipientID: 12, Amount: decimal.NewFromInt(10), Currency: "USD"}
+				ptMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:               tel,
+					paymentTransferUC: ptMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(
+					`{"recipient_id":12, "amount":"10", "currency":"USD", "target_currency":"IDR"}`)
+				c := framework.NewTestContext(http.MethodPost, "/payments/transfers", body)
+
+				return c.Build()
+			},
+			want: PaymentTransferResponse{
+				ID:             99,
+				Amount:         "10.00",
+				Currency:       "USD",
+				TargetAmount:   "162667.50",
+				TargetCurrency: "IDR",
+				Rate:           "16266.75",
+			},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				ptMock := mockz.NewMockPaymentTransfer(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentTransfer")
+				defer span.End()
+
+				in := domain.PaymentTransferInput{
+					RecipientID:    12,
+					Amount:         decimal.NewFromInt(10),
+					Currency:       "USD",
+					TargetCurrency: "IDR",
+				}
+				out := &domain.PaymentTransferOutput{
+					ID:             99,
+					Amount:         in.Amount,
+					Currency:       domain.CurrencyUSD,
+					TargetAmount:   decimal.RequireFromString("162667.5"),
+					TargetCurrency: domain.CurrencyIDR,
+					Rate:           decimal.RequireFromString("16266.75"),
+				}
+				ptMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:               tel,
+					paymentTransferUC: ptMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.PaymentTransfer(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_PaymentScheduleCreate(t *testing.T) {
+	startAt := time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorDecodeBody",
+			c: func() framework.Context {
+				body := bytes.NewBufferString("fake request")
+				c := framework.NewTestContext(http.MethodPost, "/payments/schedules", body)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errInvalidBody,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				return &httpEndpoint{
+					tel: lib.NewTelemetry(),
+				}
+			},
+		},
+		{
+			name: "ErrorParseStartAt",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(
+					`{"recipient_id":12, "amount":"10", "currency":"USD", "schedule":"@once", "start_at":"tomorrow"}`)
+				c := framework.NewTestContext(http.MethodPost, "/payments/schedules", body)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errInvalidBody,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				return &httpEndpoint{
+					tel: lib.NewTelemetry(),
+				}
+			},
+		},
+		{
+			name: "ErrorParseAmount",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(`{"recipient_id":12, "amount":"zzz", "currency":"USD", "schedule":"@daily"}`)
+				c := framework.NewTestContext(http.MethodPost, "/payments/schedules", body)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errInvalidBody,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				return &httpEndpoint{
+					tel: lib.NewTelemetry(),
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(`{"recipient_id":12, "amount":"10", "currency":"USD", "schedule":"@daily"}`)
+				c := framework.NewTestContext(http.MethodPost, "/payments/schedules", body)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				psMock := mockz.NewMockPaymentScheduleCreate(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentScheduleCreate")
+				defer span.End()
+
+				in := domain.PaymentScheduleCreateInput{
+					RecipientID: 12,
+					Amount:      decimal.NewFromInt(10),
+					Currency:    "USD",
+					Schedule:    "@daily",
+				}
+				psMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:               tel,
+					paymentScheduleUC: psMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(`{"recipient_id":12, "amount":"10", "currency":"USD",
+				"schedule":"0 9 1 * *", "start_at":"2025-02-01T09:00:00Z"}`)
+				c := framework.NewTestContext(http.MethodPost, "/payments/schedules", body)
+
+				return c.Build()
+			},
+			want: PaymentScheduleCreateResponse{
+				ID:        77,
+				Schedule:  "0 9 1 * *",
+				NextRunAt: startAt,
+			},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				psMock := mockz.NewMockPaymentScheduleCreate(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.inbound.httpEndpoint.PaymentScheduleCreate")
+				defer span.End()
+
+				in := domain.PaymentScheduleCreateInput{
+					RecipientID: 12,
+					Amount:      decimal.NewFromInt(10),
+					Currency:    "USD",
+					Schedule:    "0 9 1 * *",
+					StartAt:     startAt,
+				}
+				out := &domain.PaymentScheduleCreateOutput{ID: 77, Schedule: in.Schedule, NextRunAt: startAt}
+				psMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:               tel,
+					paymentScheduleUC: psMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.PaymentScheduleCreate(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,14 +1,76 @@
 package inbound
 
+import "time"
+
 type (
 	PaymentTopupRequest struct {
 		ReferenceID string `json:"reference_id"`
 		Amount      string `json:"amount"`
+		Currency    string `json:"currency"`
 	}
 
 	PaymentTopupResponse struct {
 		ReferenceID string `json:"reference_id"`
 		Amount      string `json:"amount"`
-		Balance     string `json:"balance"`
+		Currency    string `json:"currency"`
+		Status      string `json:"status"`
+	}
+)
+
+type (
+	PaymentWebhookResponse struct {
+		ReferenceID string `json:"reference_id"`
+		Status      string `json:"status"`
+	}
+)
+
+type (
+	PaymentConvertRequest struct {
+		From   string `query:"from"`
+		To     string `query:"to"`
+		Amount string `query:"amount"`
+	}
+
+	PaymentConvertResponse struct {
+		From      string `json:"from"`
+		To        string `json:"to"`
+		Amount    string `json:"amount"`
+		Converted string `json:"converted"`
+		Rate      string `json:"rate"`
+	}
+)
+
+type (
+	PaymentTransferRequest struct {
+		RecipientID    uint64 `json:"recipient_id"`
+		Amount         string `json:"amount"`
+		Currency       string `json:"currency"`
+		TargetCurrency string `json:"target_currency"`
+	}
+
+	PaymentTransferResponse struct {
+		ID             uint64 `json:"id"`
+		Amount         string `json:"amount"`
+		Currency       string `json:"currency"`
+		TargetAmount   string `json:"target_amount"`
+		TargetCurrency string `json:"target_currency"`
+		Rate           string `json:"rate"`
+	}
+)
+
+type (
+	PaymentScheduleCreateRequest struct {
+		RecipientID    uint64    `json:"recipient_id"`
+		Amount         string    `json:"amount"`
+		Currency       string    `json:"currency"`
+		TargetCurrency string    `json:"target_currency"`
+		Schedule       string    `json:"schedule"`
+		StartAt        time.Time `json:"start_at"`
+	}
+
+	PaymentScheduleCreateResponse struct {
+		ID        uint64    `json:"id"`
+		Schedule  string    `json:"schedule"`
+		NextRunAt time.Time `json:"next_run_at"`
 	}
 )
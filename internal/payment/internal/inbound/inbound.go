@@ -3,24 +3,57 @@ package inbound
 import (
 	"net/http"
 
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 )
 
 type Inbound struct {
 	Router    *framework.Router
-	Telemetry *telemetry.Telemetry
+	Telemetry *lib.Telemetry
 	//
-	PaymentTopupUC domain.PaymentTopup
+	PaymentTopupUC    domain.PaymentTopup
+	PaymentWebhookUC  domain.PaymentWebhook
+	PaymentConvertUC  domain.PaymentConvert
+	PaymentTransferUC domain.PaymentTransfer
+	PaymentScheduleUC domain.PaymentScheduleCreate
 }
 
 func (in Inbound) RegisterPaymentServiceServer() {
 	he := &httpEndpoint{
 		tel: in.Telemetry,
 		//
-		paymentTopupUC: in.PaymentTopupUC,
+		paymentTopupUC:    in.PaymentTopupUC,
+		paymentWebhookUC:  in.PaymentWebhookUC,
+		paymentConvertUC:  in.PaymentConvertUC,
+		paymentTransferUC: in.PaymentTransferUC,
+		paymentScheduleUC: in.PaymentScheduleUC,
 	}
 
-	in.Router.Endpoint(http.MethodPost, "/payments/topup", he.PaymentTopup)
+	payments := in.Router.Group("/payments")
+	payments.Doc(framework.RouteDoc{
+		Summary:  "Top up the balance",
+		Request:  PaymentTopupRequest{},
+		Response: PaymentTopupResponse{},
+	}).Endpoint(http.MethodPost, "/topup", he.PaymentTopup)
+	payments.Public().Doc(framework.RouteDoc{
+		Summary:     "Receive a payment provider webhook",
+		Description: "The raw payload is verified against the signature in the X-Signature header.",
+		Response:    PaymentWebhookResponse{},
+	}).Endpoint(http.MethodPost, "/webhooks/:provider", he.PaymentWebhook)
+	payments.Doc(framework.RouteDoc{
+		Summary:  "Convert an amount between currencies",
+		Request:  PaymentConvertRequest{},
+		Response: PaymentConvertResponse{},
+	}).Endpoint(http.MethodGet, "/convert", he.PaymentConvert)
+	payments.Doc(framework.RouteDoc{
+		Summary:  "Transfer to another user",
+		Request:  PaymentTransferRequest{},
+		Response: PaymentTransferResponse{},
+	}).Endpoint(http.MethodPost, "/transfers", he.PaymentTransfer)
+	payments.Doc(framework.RouteDoc{
+		Summary:  "Schedule a recurring transfer",
+		Request:  PaymentScheduleCreateRequest{},
+		Response: PaymentScheduleCreateResponse{},
+	}).Endpoint(http.MethodPost, "/schedules", he.PaymentScheduleCreate)
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/shandysiswandi/gostarter/pkg/framework"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestInbound_RegisterPaymentServiceServer(t *testing.T) {
@@ -17,7 +18,11 @@ func TestInbound_RegisterPaymentServiceServer(t *testing.T) {
 				return Inbound{
 					Router: framework.NewRouter(),
 					//
-					PaymentTopupUC: nil,
+					PaymentTopupUC:    nil,
+					PaymentWebhookUC:  nil,
+					PaymentConvertUC:  nil,
+					PaymentTransferUC: nil,
+					PaymentScheduleUC: nil,
 				}
 			},
 		},
@@ -25,7 +30,9 @@ func TestInbound_RegisterPaymentServiceServer(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			tt.in().RegisterPaymentServiceServer()
+			in := tt.in()
+			in.RegisterPaymentServiceServer()
+			assert.Empty(t, in.Router.Undocumented())
 		})
 	}
 }
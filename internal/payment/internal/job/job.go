@@ -0,0 +1,42 @@
+package job
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/shandysiswandi/goreng/config"
+	"github.com/shandysiswandi/goreng/task"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+)
+
+const defaultScheduleInterval = 30 * time.Second
+
+type Dependency struct {
+	Config                config.Config
+	Telemetry             *lib.Telemetry
+	DomainScheduleExecute domain.PaymentScheduleExecute
+}
+
+func New(dep Dependency) []task.Runner {
+	if !dep.Config.GetBool("feature.flag.payment.schedule") {
+		return nil
+	}
+
+	interval := defaultScheduleInterval
+	if v := dep.Config.GetInt("payment.schedule.interval"); v > 0 {
+		interval = time.Duration(v) * time.Second
+	}
+
+	hostname, _ := os.Hostname()
+
+	return []task.Runner{
+		&scheduleRunner{
+			tel:       dep.Telemetry,
+			executeUC: dep.DomainScheduleExecute,
+			owner:     fmt.Sprintf("%s:%d", hostname, os.Getpid()),
+			interval:  interval,
+		},
+	}
+}
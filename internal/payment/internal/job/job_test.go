@@ -0,0 +1,88 @@
+package job
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/goreng/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	hostname, _ := os.Hostname()
+	owner := fmt.Sprintf("%s:%d", hostname, os.Getpid())
+
+	tests := []struct {
+		name string
+		dep  func() Dependency
+		want []task.Runner
+	}{
+		{
+			name: "FeatureFlagOff",
+			dep: func() Dependency {
+				configMock := mocker.NewMockConfig(t)
+
+				configMock.EXPECT().
+					GetBool("feature.flag.payment.schedule").
+					Return(false)
+
+				return Dependency{
+					Config: configMock,
+				}
+			},
+			want: nil,
+		},
+		{
+			name: "FeatureFlagOnDefaultInterval",
+			dep: func() Dependency {
+				configMock := mocker.NewMockConfig(t)
+
+				configMock.EXPECT().
+					GetBool("feature.flag.payment.schedule").
+					Return(true)
+
+				configMock.EXPECT().
+					GetInt("payment.schedule.interval").
+					Return(0)
+
+				return Dependency{
+					Config: configMock,
+				}
+			},
+			want: []task.Runner{
+				&scheduleRunner{owner: owner, interval: defaultScheduleInterval},
+			},
+		},
+		{
+			name: "FeatureFlagOn",
+			dep: func() Dependency {
+				configMock := mocker.NewMockConfig(t)
+
+				configMock.EXPECT().
+					GetBool("feature.flag.payment.schedule").
+					Return(true)
+
+				configMock.EXPECT().
+					GetInt("payment.schedule.interval").
+					Return(5)
+
+				return Dependency{
+					Config: configMock,
+				}
+			},
+			want: []task.Runner{
+				&scheduleRunner{owner: owner, interval: 5 * time.Second},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := New(tt.dep())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+)
+
+// scheduleRunner executes the due scheduled payments every interval until it
+// is stopped. Each instance runs under its own owner name, so the lease taken
+// by the use case keeps instances from executing the same schedule twice.
+type scheduleRunner struct {
+	tel       *lib.Telemetry
+	executeUC domain.PaymentScheduleExecute
+	owner     string
+	interval  time.Duration
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+func (e *scheduleRunner) Start() error {
+	ctx, span := e.tel.Tracer().Start(context.Background(), "payment.job.scheduleRunner.Start")
+	defer span.End()
+
+	e.tel.Logger().Info(ctx, "payment schedule runner has started", logger.KeyVal("owner", e.owner))
+
+	e.stop = make(chan struct{})
+	e.done = make(chan struct{})
+
+	go func() {
+		defer close(e.done)
+
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		for {
+			e.tick()
+
+			select {
+			case <-e.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (e *scheduleRunner) tick() {
+	ctx, span := e.tel.Tracer().Start(context.Background(), "payment.job.scheduleRunner.tick")
+	defer span.End()
+
+	out, err := e.executeUC.Call(ctx, domain.PaymentScheduleExecuteInput{Owner: e.owner})
+	if err != nil {
+		e.tel.Logger().Error(ctx, "failed to execute scheduled payments", err)
+
+		return
+	}
+
+	if out.Succeeded+out.Retried+out.Failed > 0 {
+		e.tel.Logger().Info(ctx, "scheduled payments executed",
+			logger.KeyVal("succeeded", out.Succeeded),
+			logger.KeyVal("retried", out.Retried),
+			logger.KeyVal("failed", out.Failed))
+	}
+}
+
+func (e *scheduleRunner) Stop(ctx context.Context) error {
+	if e.stop == nil {
+		e.tel.Logger().Info(ctx, "payment schedule runner has stopped")
+
+		return nil
+	}
+
+	e.once.Do(func() { close(e.stop) })
+
+	select {
+	case <-e.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	e.tel.Logger().Info(ctx, "payment schedule runner has stopped")
+
+	return nil
+}
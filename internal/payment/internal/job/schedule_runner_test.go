@@ -0,0 +1,123 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/mockz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_scheduleRunner_Start(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr error
+		mockFn  func() *scheduleRunner
+	}{
+		{
+			name:    "ErrorExecute",
+			wantErr: nil,
+			mockFn: func() *scheduleRunner {
+				executeMock := mockz.NewMockPaymentScheduleExecute(t)
+
+				executeMock.EXPECT().
+					Call(mock.Anything, domain.PaymentScheduleExecuteInput{Owner: "owner"}).
+					Return(nil, assert.AnError)
+
+				return &scheduleRunner{
+					tel:       lib.NewTelemetry(),
+					executeUC: executeMock,
+					owner:     "owner",
+					interval:  time.Hour,
+				}
+			},
+		},
+		{
+			name:    "Success",
+			wantErr: nil,
+			mockFn: func() *scheduleRunner {
+				executeMock := mockz.NewMockPaymentScheduleExecute(t)
+
+				executeMock.EXPECT().
+					Call(mock.Anything, domain.PaymentScheduleExecuteInput{Owner: "owner"}).
+					Return(&domain.PaymentScheduleExecuteOutput{Succeeded: 1, Retried: 1, Failed: 1}, nil)
+
+				return &scheduleRunner{
+					tel:       lib.NewTelemetry(),
+					executeUC: executeMock,
+					owner:     "owner",
+					interval:  time.Hour,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			runner := tt.mockFn()
+			err := runner.Start()
+			assert.Equal(t, tt.wantErr, err)
+			time.Sleep(100 * time.Millisecond) // wait first tick
+			assert.NoError(t, runner.Stop(context.Background()))
+		})
+	}
+}
+
+func Test_scheduleRunner_Stop(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+		mockFn  func() *scheduleRunner
+	}{
+		{
+			name:    "NotStarted",
+			ctx:     context.Background(),
+			wantErr: nil,
+			mockFn: func() *scheduleRunner {
+				return &scheduleRunner{tel: lib.NewTelemetry()}
+			},
+		},
+		{
+			name:    "ErrorContextDone",
+			ctx:     canceled,
+			wantErr: context.Canceled,
+			mockFn: func() *scheduleRunner {
+				return &scheduleRunner{
+					tel:  lib.NewTelemetry(),
+					stop: make(chan struct{}),
+					done: make(chan struct{}),
+				}
+			},
+		},
+		{
+			name:    "Success",
+			ctx:     context.Background(),
+			wantErr: nil,
+			mockFn: func() *scheduleRunner {
+				done := make(chan struct{})
+				close(done)
+
+				return &scheduleRunner{
+					tel:  lib.NewTelemetry(),
+					stop: make(chan struct{}),
+					done: done,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.mockFn().Stop(tt.ctx)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
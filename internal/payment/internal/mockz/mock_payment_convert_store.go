@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPaymentConvertStore is an autogenerated mock type for the PaymentConvertStore type
+type MockPaymentConvertStore struct {
+	mock.Mock
+}
+
+type MockPaymentConvertStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentConvertStore) EXPECT() *MockPaymentConvertStore_Expecter {
+	return &MockPaymentConvertStore_Expecter{mock: &_m.Mock}
+}
+
+// FindExchangeRate provides a mock function with given fields: ctx, base, quote
+func (_m *MockPaymentConvertStore) FindExchangeRate(ctx context.Context, base domain.Currency, quote domain.Currency) (*domain.ExchangeRate, error) {
+	ret := _m.Called(ctx, base, quote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExchangeRate")
+	}
+
+	var r0 *domain.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Currency, domain.Currency) (*domain.ExchangeRate, error)); ok {
+		return rf(ctx, base, quote)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Currency, domain.Currency) *domain.ExchangeRate); ok {
+		r0 = rf(ctx, base, quote)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Currency, domain.Currency) error); ok {
+		r1 = rf(ctx, base, quote)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentConvertStore_FindExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExchangeRate'
+type MockPaymentConvertStore_FindExchangeRate_Call struct {
+	*mock.Call
+}
+
+// FindExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - base domain.Currency
+//   - quote domain.Currency
+func (_e *MockPaymentConvertStore_Expecter) FindExchangeRate(ctx interface{}, base interface{}, quote interface{}) *MockPaymentConvertStore_FindExchangeRate_Call {
+	return &MockPaymentConvertStore_FindExchangeRate_Call{Call: _e.mock.On("FindExchangeRate", ctx, base, quote)}
+}
+
+func (_c *MockPaymentConvertStore_FindExchangeRate_Call) Run(run func(ctx context.Context, base domain.Currency, quote domain.Currency)) *MockPaymentConvertStore_FindExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Currency), args[2].(domain.Currency))
+	})
+	return _c
+}
+
+func (_c *MockPaymentConvertStore_FindExchangeRate_Call) Return(_a0 *domain.ExchangeRate, _a1 error) *MockPaymentConvertStore_FindExchangeRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentConvertStore_FindExchangeRate_Call) RunAndReturn(run func(context.Context, domain.Currency, domain.Currency) (*domain.ExchangeRate, error)) *MockPaymentConvertStore_FindExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPaymentConvertStore creates a new instance of MockPaymentConvertStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentConvertStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentConvertStore {
+	mock := &MockPaymentConvertStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
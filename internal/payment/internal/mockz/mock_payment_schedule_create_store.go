@@ -0,0 +1,84 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPaymentScheduleCreateStore is an autogenerated mock type for the PaymentScheduleCreateStore type
+type MockPaymentScheduleCreateStore struct {
+	mock.Mock
+}
+
+type MockPaymentScheduleCreateStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentScheduleCreateStore) EXPECT() *MockPaymentScheduleCreateStore_Expecter {
+	return &MockPaymentScheduleCreateStore_Expecter{mock: &_m.Mock}
+}
+
+// SaveScheduledPayment provides a mock function with given fields: ctx, sp
+func (_m *MockPaymentScheduleCreateStore) SaveScheduledPayment(ctx context.Context, sp domain.ScheduledPayment) error {
+	ret := _m.Called(ctx, sp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveScheduledPayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ScheduledPayment) error); ok {
+		r0 = rf(ctx, sp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPaymentScheduleCreateStore_SaveScheduledPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveScheduledPayment'
+type MockPaymentScheduleCreateStore_SaveScheduledPayment_Call struct {
+	*mock.Call
+}
+
+// SaveScheduledPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sp domain.ScheduledPayment
+func (_e *MockPaymentScheduleCreateStore_Expecter) SaveScheduledPayment(ctx interface{}, sp interface{}) *MockPaymentScheduleCreateStore_SaveScheduledPayment_Call {
+	return &MockPaymentScheduleCreateStore_SaveScheduledPayment_Call{Call: _e.mock.On("SaveScheduledPayment", ctx, sp)}
+}
+
+func (_c *MockPaymentScheduleCreateStore_SaveScheduledPayment_Call) Run(run func(ctx context.Context, sp domain.ScheduledPayment)) *MockPaymentScheduleCreateStore_SaveScheduledPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ScheduledPayment))
+	})
+	return _c
+}
+
+func (_c *MockPaymentScheduleCreateStore_SaveScheduledPayment_Call) Return(_a0 error) *MockPaymentScheduleCreateStore_SaveScheduledPayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentScheduleCreateStore_SaveScheduledPayment_Call) RunAndReturn(run func(context.Context, domain.ScheduledPayment) error) *MockPaymentScheduleCreateStore_SaveScheduledPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPaymentScheduleCreateStore creates a new instance of MockPaymentScheduleCreateStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentScheduleCreateStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentScheduleCreateStore {
+	mock := &MockPaymentScheduleCreateStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
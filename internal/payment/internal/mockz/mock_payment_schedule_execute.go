@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPaymentScheduleExecute is an autogenerated mock type for the PaymentScheduleExecute type
+type MockPaymentScheduleExecute struct {
+	mock.Mock
+}
+
+type MockPaymentScheduleExecute_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentScheduleExecute) EXPECT() *MockPaymentScheduleExecute_Expecter {
+	return &MockPaymentScheduleExecute_Expecter{mock: &_m.Mock}
+}
+
+// Call provides a mock function with given fields: ctx, in
+func (_m *MockPaymentScheduleExecute) Call(ctx context.Context, in domain.PaymentScheduleExecuteInput) (*domain.PaymentScheduleExecuteOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Call")
+	}
+
+	var r0 *domain.PaymentScheduleExecuteOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaymentScheduleExecuteInput) (*domain.PaymentScheduleExecuteOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaymentScheduleExecuteInput) *domain.PaymentScheduleExecuteOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PaymentScheduleExecuteOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PaymentScheduleExecuteInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentScheduleExecute_Call_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Call'
+type MockPaymentScheduleExecute_Call_Call struct {
+	*mock.Call
+}
+
+// Call is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in domain.PaymentScheduleExecuteInput
+func (_e *MockPaymentScheduleExecute_Expecter) Call(ctx interface{}, in interface{}) *MockPaymentScheduleExecute_Call_Call {
+	return &MockPaymentScheduleExecute_Call_Call{Call: _e.mock.On("Call", ctx, in)}
+}
+
+func (_c *MockPaymentScheduleExecute_Call_Call) Run(run func(ctx context.Context, in domain.PaymentScheduleExecuteInput)) *MockPaymentScheduleExecute_Call_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PaymentScheduleExecuteInput))
+	})
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecute_Call_Call) Return(_a0 *domain.PaymentScheduleExecuteOutput, _a1 error) *MockPaymentScheduleExecute_Call_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecute_Call_Call) RunAndReturn(run func(context.Context, domain.PaymentScheduleExecuteInput) (*domain.PaymentScheduleExecuteOutput, error)) *MockPaymentScheduleExecute_Call_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPaymentScheduleExecute creates a new instance of MockPaymentScheduleExecute. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentScheduleExecute(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentScheduleExecute {
+	mock := &MockPaymentScheduleExecute{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
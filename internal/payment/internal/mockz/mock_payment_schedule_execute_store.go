@@ -0,0 +1,254 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockPaymentScheduleExecuteStore is an autogenerated mock type for the PaymentScheduleExecuteStore type
+type MockPaymentScheduleExecuteStore struct {
+	mock.Mock
+}
+
+type MockPaymentScheduleExecuteStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentScheduleExecuteStore) EXPECT() *MockPaymentScheduleExecuteStore_Expecter {
+	return &MockPaymentScheduleExecuteStore_Expecter{mock: &_m.Mock}
+}
+
+// AcquireScheduledPayment provides a mock function with given fields: ctx, id, owner, now, until
+func (_m *MockPaymentScheduleExecuteStore) AcquireScheduledPayment(ctx context.Context, id uint64, owner string, now time.Time, until time.Time) (bool, error) {
+	ret := _m.Called(ctx, id, owner, now, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireScheduledPayment")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, id, owner, now, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, id, owner, now, until)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, id, owner, now, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireScheduledPayment'
+type MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call struct {
+	*mock.Call
+}
+
+// AcquireScheduledPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner string
+//   - now time.Time
+//   - until time.Time
+func (_e *MockPaymentScheduleExecuteStore_Expecter) AcquireScheduledPayment(ctx interface{}, id interface{}, owner interface{}, now interface{}, until interface{}) *MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call {
+	return &MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call{Call: _e.mock.On("AcquireScheduledPayment", ctx, id, owner, now, until)}
+}
+
+func (_c *MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call) Run(run func(ctx context.Context, id uint64, owner string, now time.Time, until time.Time)) *MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call) Return(_a0 bool, _a1 error) *MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call) RunAndReturn(run func(context.Context, uint64, string, time.Time, time.Time) (bool, error)) *MockPaymentScheduleExecuteStore_AcquireScheduledPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDueScheduledPayments provides a mock function with given fields: ctx, now, limit
+func (_m *MockPaymentScheduleExecuteStore) FindDueScheduledPayments(ctx context.Context, now time.Time, limit int) ([]domain.ScheduledPayment, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDueScheduledPayments")
+	}
+
+	var r0 []domain.ScheduledPayment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]domain.ScheduledPayment, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []domain.ScheduledPayment); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ScheduledPayment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDueScheduledPayments'
+type MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call struct {
+	*mock.Call
+}
+
+// FindDueScheduledPayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *MockPaymentScheduleExecuteStore_Expecter) FindDueScheduledPayments(ctx interface{}, now interface{}, limit interface{}) *MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call {
+	return &MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call{Call: _e.mock.On("FindDueScheduledPayments", ctx, now, limit)}
+}
+
+func (_c *MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call) Return(_a0 []domain.ScheduledPayment, _a1 error) *MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]domain.ScheduledPayment, error)) *MockPaymentScheduleExecuteStore_FindDueScheduledPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseScheduledPayment provides a mock function with given fields: ctx, sp, owner
+func (_m *MockPaymentScheduleExecuteStore) ReleaseScheduledPayment(ctx context.Context, sp domain.ScheduledPayment, owner string) error {
+	ret := _m.Called(ctx, sp, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseScheduledPayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ScheduledPayment, string) error); ok {
+		r0 = rf(ctx, sp, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseScheduledPayment'
+type MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call struct {
+	*mock.Call
+}
+
+// ReleaseScheduledPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sp domain.ScheduledPayment
+//   - owner string
+func (_e *MockPaymentScheduleExecuteStore_Expecter) ReleaseScheduledPayment(ctx interface{}, sp interface{}, owner interface{}) *MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call {
+	return &MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call{Call: _e.mock.On("ReleaseScheduledPayment", ctx, sp, owner)}
+}
+
+func (_c *MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call) Run(run func(ctx context.Context, sp domain.ScheduledPayment, owner string)) *MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ScheduledPayment), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call) Return(_a0 error) *MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call) RunAndReturn(run func(context.Context, domain.ScheduledPayment, string) error) *MockPaymentScheduleExecuteStore_ReleaseScheduledPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveScheduledPaymentRun provides a mock function with given fields: ctx, run
+func (_m *MockPaymentScheduleExecuteStore) SaveScheduledPaymentRun(ctx context.Context, run domain.ScheduledPaymentRun) error {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveScheduledPaymentRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ScheduledPaymentRun) error); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveScheduledPaymentRun'
+type MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call struct {
+	*mock.Call
+}
+
+// SaveScheduledPaymentRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run domain.ScheduledPaymentRun
+func (_e *MockPaymentScheduleExecuteStore_Expecter) SaveScheduledPaymentRun(ctx interface{}, run interface{}) *MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call {
+	return &MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call{Call: _e.mock.On("SaveScheduledPaymentRun", ctx, run)}
+}
+
+func (_c *MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call) Run(run func(ctx context.Context, run domain.ScheduledPaymentRun)) *MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ScheduledPaymentRun))
+	})
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call) Return(_a0 error) *MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call) RunAndReturn(run func(context.Context, domain.ScheduledPaymentRun) error) *MockPaymentScheduleExecuteStore_SaveScheduledPaymentRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPaymentScheduleExecuteStore creates a new instance of MockPaymentScheduleExecuteStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentScheduleExecuteStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentScheduleExecuteStore {
+	mock := &MockPaymentScheduleExecuteStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,29 +22,29 @@ func (_m *MockPaymentTopupStore) EXPECT() *MockPaymentTopupStore_Expecter {
 	return &MockPaymentTopupStore_Expecter{mock: &_m.Mock}
 }
 
-// FindAccountByUserID provides a mock function with given fields: ctx, userID
-func (_m *MockPaymentTopupStore) FindAccountByUserID(ctx context.Context, userID uint64) (*domain.Account, error) {
-	ret := _m.Called(ctx, userID)
+// FindTopupByReferenceID provides a mock function with given fields: ctx, refID
+func (_m *MockPaymentTopupStore) FindTopupByReferenceID(ctx context.Context, refID string) (*domain.Topup, error) {
+	ret := _m.Called(ctx, refID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindAccountByUserID")
+		panic("no return value specified for FindTopupByReferenceID")
 	}
 
-	var r0 *domain.Account
+	var r0 *domain.Topup
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*domain.Account, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Topup, error)); ok {
+		return rf(ctx, refID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) *domain.Account); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Topup); ok {
+		r0 = rf(ctx, refID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Account)
+			r0 = ret.Get(0).(*domain.Topup)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,58 +52,58 @@ func (_m *MockPaymentTopupStore) FindAccountByUserID(ctx context.Context, userID
 	return r0, r1
 }
 
-// MockPaymentTopupStore_FindAccountByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAccountByUserID'
-type MockPaymentTopupStore_FindAccountByUserID_Call struct {
+// MockPaymentTopupStore_FindTopupByReferenceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTopupByReferenceID'
+type MockPaymentTopupStore_FindTopupByReferenceID_Call struct {
 	*mock.Call
 }
 
-// FindAccountByUserID is a helper method to define mock.On call
+// FindTopupByReferenceID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uint64
-func (_e *MockPaymentTopupStore_Expecter) FindAccountByUserID(ctx interface{}, userID interface{}) *MockPaymentTopupStore_FindAccountByUserID_Call {
-	return &MockPaymentTopupStore_FindAccountByUserID_Call{Call: _e.mock.On("FindAccountByUserID", ctx, userID)}
+//   - refID string
+func (_e *MockPaymentTopupStore_Expecter) FindTopupByReferenceID(ctx interface{}, refID interface{}) *MockPaymentTopupStore_FindTopupByReferenceID_Call {
+	return &MockPaymentTopupStore_FindTopupByReferenceID_Call{Call: _e.mock.On("FindTopupByReferenceID", ctx, refID)}
 }
 
-func (_c *MockPaymentTopupStore_FindAccountByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockPaymentTopupStore_FindAccountByUserID_Call {
+func (_c *MockPaymentTopupStore_FindTopupByReferenceID_Call) Run(run func(ctx context.Context, refID string)) *MockPaymentTopupStore_FindTopupByReferenceID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_FindAccountByUserID_Call) Return(_a0 *domain.Account, _a1 error) *MockPaymentTopupStore_FindAccountByUserID_Call {
+func (_c *MockPaymentTopupStore_FindTopupByReferenceID_Call) Return(_a0 *domain.Topup, _a1 error) *MockPaymentTopupStore_FindTopupByReferenceID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_FindAccountByUserID_Call) RunAndReturn(run func(context.Context, uint64) (*domain.Account, error)) *MockPaymentTopupStore_FindAccountByUserID_Call {
+func (_c *MockPaymentTopupStore_FindTopupByReferenceID_Call) RunAndReturn(run func(context.Context, string) (*domain.Topup, error)) *MockPaymentTopupStore_FindTopupByReferenceID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindTopupByReferenceID provides a mock function with given fields: ctx, refID
-func (_m *MockPaymentTopupStore) FindTopupByReferenceID(ctx context.Context, refID string) (*domain.Topup, error) {
-	ret := _m.Called(ctx, refID)
+// LockAccount provides a mock function with given fields: ctx, userID, currency
+func (_m *MockPaymentTopupStore) LockAccount(ctx context.Context, userID uint64, currency domain.Currency) (*domain.Account, error) {
+	ret := _m.Called(ctx, userID, currency)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindTopupByReferenceID")
+		panic("no return value specified for LockAccount")
 	}
 
-	var r0 *domain.Topup
+	var r0 *domain.Account
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Topup, error)); ok {
-		return rf(ctx, refID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.Currency) (*domain.Account, error)); ok {
+		return rf(ctx, userID, currency)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Topup); ok {
-		r0 = rf(ctx, refID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.Currency) *domain.Account); ok {
+		r0 = rf(ctx, userID, currency)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Topup)
+			r0 = ret.Get(0).(*domain.Account)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, refID)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, domain.Currency) error); ok {
+		r1 = rf(ctx, userID, currency)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -111,46 +111,47 @@ func (_m *MockPaymentTopupStore) FindTopupByReferenceID(ctx context.Context, ref
 	return r0, r1
 }
 
-// MockPaymentTopupStore_FindTopupByReferenceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTopupByReferenceID'
-type MockPaymentTopupStore_FindTopupByReferenceID_Call struct {
+// MockPaymentTopupStore_LockAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockAccount'
+type MockPaymentTopupStore_LockAccount_Call struct {
 	*mock.Call
 }
 
-// FindTopupByReferenceID is a helper method to define mock.On call
+// LockAccount is a helper method to define mock.On call
 //   - ctx context.Context
-//   - refID string
-func (_e *MockPaymentTopupStore_Expecter) FindTopupByReferenceID(ctx interface{}, refID interface{}) *MockPaymentTopupStore_FindTopupByReferenceID_Call {
-	return &MockPaymentTopupStore_FindTopupByReferenceID_Call{Call: _e.mock.On("FindTopupByReferenceID", ctx, refID)}
+//   - userID uint64
+//   - currency domain.Currency
+func (_e *MockPaymentTopupStore_Expecter) LockAccount(ctx interface{}, userID interface{}, currency interface{}) *MockPaymentTopupStore_LockAccount_Call {
+	return &MockPaymentTopupStore_LockAccount_Call{Call: _e.mock.On("LockAccount", ctx, userID, currency)}
 }
 
-func (_c *MockPaymentTopupStore_FindTopupByReferenceID_Call) Run(run func(ctx context.Context, refID string)) *MockPaymentTopupStore_FindTopupByReferenceID_Call {
+func (_c *MockPaymentTopupStore_LockAccount_Call) Run(run func(ctx context.Context, userID uint64, currency domain.Currency)) *MockPaymentTopupStore_LockAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(domain.Currency))
 	})
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_FindTopupByReferenceID_Call) Return(_a0 *domain.Topup, _a1 error) *MockPaymentTopupStore_FindTopupByReferenceID_Call {
+func (_c *MockPaymentTopupStore_LockAccount_Call) Return(_a0 *domain.Account, _a1 error) *MockPaymentTopupStore_LockAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_FindTopupByReferenceID_Call) RunAndReturn(run func(context.Context, string) (*domain.Topup, error)) *MockPaymentTopupStore_FindTopupByReferenceID_Call {
+func (_c *MockPaymentTopupStore_LockAccount_Call) RunAndReturn(run func(context.Context, uint64, domain.Currency) (*domain.Account, error)) *MockPaymentTopupStore_LockAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveTopup provides a mock function with given fields: ctx, topup
-func (_m *MockPaymentTopupStore) SaveTopup(ctx context.Context, topup domain.Topup) error {
-	ret := _m.Called(ctx, topup)
+// SaveAccount provides a mock function with given fields: ctx, acc
+func (_m *MockPaymentTopupStore) SaveAccount(ctx context.Context, acc domain.Account) error {
+	ret := _m.Called(ctx, acc)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveTopup")
+		panic("no return value specified for SaveAccount")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Topup) error); ok {
-		r0 = rf(ctx, topup)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Account) error); ok {
+		r0 = rf(ctx, acc)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -158,45 +159,45 @@ func (_m *MockPaymentTopupStore) SaveTopup(ctx context.Context, topup domain.Top
 	return r0
 }
 
-// MockPaymentTopupStore_SaveTopup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTopup'
-type MockPaymentTopupStore_SaveTopup_Call struct {
+// MockPaymentTopupStore_SaveAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAccount'
+type MockPaymentTopupStore_SaveAccount_Call struct {
 	*mock.Call
 }
 
-// SaveTopup is a helper method to define mock.On call
+// SaveAccount is a helper method to define mock.On call
 //   - ctx context.Context
-//   - topup domain.Topup
-func (_e *MockPaymentTopupStore_Expecter) SaveTopup(ctx interface{}, topup interface{}) *MockPaymentTopupStore_SaveTopup_Call {
-	return &MockPaymentTopupStore_SaveTopup_Call{Call: _e.mock.On("SaveTopup", ctx, topup)}
+//   - acc domain.Account
+func (_e *MockPaymentTopupStore_Expecter) SaveAccount(ctx interface{}, acc interface{}) *MockPaymentTopupStore_SaveAccount_Call {
+	return &MockPaymentTopupStore_SaveAccount_Call{Call: _e.mock.On("SaveAccount", ctx, acc)}
 }
 
-func (_c *MockPaymentTopupStore_SaveTopup_Call) Run(run func(ctx context.Context, topup domain.Topup)) *MockPaymentTopupStore_SaveTopup_Call {
+func (_c *MockPaymentTopupStore_SaveAccount_Call) Run(run func(ctx context.Context, acc domain.Account)) *MockPaymentTopupStore_SaveAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Topup))
+		run(args[0].(context.Context), args[1].(domain.Account))
 	})
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_SaveTopup_Call) Return(_a0 error) *MockPaymentTopupStore_SaveTopup_Call {
+func (_c *MockPaymentTopupStore_SaveAccount_Call) Return(_a0 error) *MockPaymentTopupStore_SaveAccount_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_SaveTopup_Call) RunAndReturn(run func(context.Context, domain.Topup) error) *MockPaymentTopupStore_SaveTopup_Call {
+func (_c *MockPaymentTopupStore_SaveAccount_Call) RunAndReturn(run func(context.Context, domain.Account) error) *MockPaymentTopupStore_SaveAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveTransaction provides a mock function with given fields: ctx, topup
-func (_m *MockPaymentTopupStore) SaveTransaction(ctx context.Context, topup domain.Transaction) error {
+// SaveTopup provides a mock function with given fields: ctx, topup
+func (_m *MockPaymentTopupStore) SaveTopup(ctx context.Context, topup domain.Topup) error {
 	ret := _m.Called(ctx, topup)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveTransaction")
+		panic("no return value specified for SaveTopup")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Transaction) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Topup) error); ok {
 		r0 = rf(ctx, topup)
 	} else {
 		r0 = ret.Error(0)
@@ -205,46 +206,46 @@ func (_m *MockPaymentTopupStore) SaveTransaction(ctx context.Context, topup doma
 	return r0
 }
 
-// MockPaymentTopupStore_SaveTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTransaction'
-type MockPaymentTopupStore_SaveTransaction_Call struct {
+// MockPaymentTopupStore_SaveTopup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTopup'
+type MockPaymentTopupStore_SaveTopup_Call struct {
 	*mock.Call
 }
 
-// SaveTransaction is a helper method to define mock.On call
+// SaveTopup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - topup domain.Transaction
-func (_e *MockPaymentTopupStore_Expecter) SaveTransaction(ctx interface{}, topup interface{}) *MockPaymentTopupStore_SaveTransaction_Call {
-	return &MockPaymentTopupStore_SaveTransaction_Call{Call: _e.mock.On("SaveTransaction", ctx, topup)}
+//   - topup domain.Topup
+func (_e *MockPaymentTopupStore_Expecter) SaveTopup(ctx interface{}, topup interface{}) *MockPaymentTopupStore_SaveTopup_Call {
+	return &MockPaymentTopupStore_SaveTopup_Call{Call: _e.mock.On("SaveTopup", ctx, topup)}
 }
 
-func (_c *MockPaymentTopupStore_SaveTransaction_Call) Run(run func(ctx context.Context, topup domain.Transaction)) *MockPaymentTopupStore_SaveTransaction_Call {
+func (_c *MockPaymentTopupStore_SaveTopup_Call) Run(run func(ctx context.Context, topup domain.Topup)) *MockPaymentTopupStore_SaveTopup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Transaction))
+		run(args[0].(context.Context), args[1].(domain.Topup))
 	})
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_SaveTransaction_Call) Return(_a0 error) *MockPaymentTopupStore_SaveTransaction_Call {
+func (_c *MockPaymentTopupStore_SaveTopup_Call) Return(_a0 error) *MockPaymentTopupStore_SaveTopup_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_SaveTransaction_Call) RunAndReturn(run func(context.Context, domain.Transaction) error) *MockPaymentTopupStore_SaveTransaction_Call {
+func (_c *MockPaymentTopupStore_SaveTopup_Call) RunAndReturn(run func(context.Context, domain.Topup) error) *MockPaymentTopupStore_SaveTopup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateAccount provides a mock function with given fields: ctx, data
-func (_m *MockPaymentTopupStore) UpdateAccount(ctx context.Context, data map[string]any) error {
-	ret := _m.Called(ctx, data)
+// SaveTransaction provides a mock function with given fields: ctx, topup
+func (_m *MockPaymentTopupStore) SaveTransaction(ctx context.Context, topup domain.Transaction) error {
+	ret := _m.Called(ctx, topup)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAccount")
+		panic("no return value specified for SaveTransaction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, map[string]any) error); ok {
-		r0 = rf(ctx, data)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Transaction) error); ok {
+		r0 = rf(ctx, topup)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -252,31 +253,31 @@ func (_m *MockPaymentTopupStore) UpdateAccount(ctx context.Context, data map[str
 	return r0
 }
 
-// MockPaymentTopupStore_UpdateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccount'
-type MockPaymentTopupStore_UpdateAccount_Call struct {
+// MockPaymentTopupStore_SaveTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTransaction'
+type MockPaymentTopupStore_SaveTransaction_Call struct {
 	*mock.Call
 }
 
-// UpdateAccount is a helper method to define mock.On call
+// SaveTransaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data map[string]any
-func (_e *MockPaymentTopupStore_Expecter) UpdateAccount(ctx interface{}, data interface{}) *MockPaymentTopupStore_UpdateAccount_Call {
-	return &MockPaymentTopupStore_UpdateAccount_Call{Call: _e.mock.On("UpdateAccount", ctx, data)}
+//   - topup domain.Transaction
+func (_e *MockPaymentTopupStore_Expecter) SaveTransaction(ctx interface{}, topup interface{}) *MockPaymentTopupStore_SaveTransaction_Call {
+	return &MockPaymentTopupStore_SaveTransaction_Call{Call: _e.mock.On("SaveTransaction", ctx, topup)}
 }
 
-func (_c *MockPaymentTopupStore_UpdateAccount_Call) Run(run func(ctx context.Context, data map[string]any)) *MockPaymentTopupStore_UpdateAccount_Call {
+func (_c *MockPaymentTopupStore_SaveTransaction_Call) Run(run func(ctx context.Context, topup domain.Transaction)) *MockPaymentTopupStore_SaveTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(map[string]any))
+		run(args[0].(context.Context), args[1].(domain.Transaction))
 	})
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_UpdateAccount_Call) Return(_a0 error) *MockPaymentTopupStore_UpdateAccount_Call {
+func (_c *MockPaymentTopupStore_SaveTransaction_Call) Return(_a0 error) *MockPaymentTopupStore_SaveTransaction_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockPaymentTopupStore_UpdateAccount_Call) RunAndReturn(run func(context.Context, map[string]any) error) *MockPaymentTopupStore_UpdateAccount_Call {
+func (_c *MockPaymentTopupStore_SaveTransaction_Call) RunAndReturn(run func(context.Context, domain.Transaction) error) *MockPaymentTopupStore_SaveTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
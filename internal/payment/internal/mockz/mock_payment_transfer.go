@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPaymentTransfer is an autogenerated mock type for the PaymentTransfer type
+type MockPaymentTransfer struct {
+	mock.Mock
+}
+
+type MockPaymentTransfer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentTransfer) EXPECT() *MockPaymentTransfer_Expecter {
+	return &MockPaymentTransfer_Expecter{mock: &_m.Mock}
+}
+
+// Call provides a mock function with given fields: ctx, in
+func (_m *MockPaymentTransfer) Call(ctx context.Context, in domain.PaymentTransferInput) (*domain.PaymentTransferOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Call")
+	}
+
+	var r0 *domain.PaymentTransferOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaymentTransferInput) (*domain.PaymentTransferOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaymentTransferInput) *domain.PaymentTransferOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PaymentTransferOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PaymentTransferInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentTransfer_Call_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Call'
+type MockPaymentTransfer_Call_Call struct {
+	*mock.Call
+}
+
+// Call is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in domain.PaymentTransferInput
+func (_e *MockPaymentTransfer_Expecter) Call(ctx interface{}, in interface{}) *MockPaymentTransfer_Call_Call {
+	return &MockPaymentTransfer_Call_Call{Call: _e.mock.On("Call", ctx, in)}
+}
+
+func (_c *MockPaymentTransfer_Call_Call) Run(run func(ctx context.Context, in domain.PaymentTransferInput)) *MockPaymentTransfer_Call_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PaymentTransferInput))
+	})
+	return _c
+}
+
+func (_c *MockPaymentTransfer_Call_Call) Return(_a0 *domain.PaymentTransferOutput, _a1 error) *MockPaymentTransfer_Call_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentTransfer_Call_Call) RunAndReturn(run func(context.Context, domain.PaymentTransferInput) (*domain.PaymentTransferOutput, error)) *MockPaymentTransfer_Call_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPaymentTransfer creates a new instance of MockPaymentTransfer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentTransfer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentTransfer {
+	mock := &MockPaymentTransfer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,466 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	decimal "github.com/shopspring/decimal"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPaymentTransferStore is an autogenerated mock type for the PaymentTransferStore type
+type MockPaymentTransferStore struct {
+	mock.Mock
+}
+
+type MockPaymentTransferStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentTransferStore) EXPECT() *MockPaymentTransferStore_Expecter {
+	return &MockPaymentTransferStore_Expecter{mock: &_m.Mock}
+}
+
+// CreditAccount provides a mock function with given fields: ctx, acc
+func (_m *MockPaymentTransferStore) CreditAccount(ctx context.Context, acc domain.Account) error {
+	ret := _m.Called(ctx, acc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreditAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Account) error); ok {
+		r0 = rf(ctx, acc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPaymentTransferStore_CreditAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreditAccount'
+type MockPaymentTransferStore_CreditAccount_Call struct {
+	*mock.Call
+}
+
+// CreditAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - acc domain.Account
+func (_e *MockPaymentTransferStore_Expecter) CreditAccount(ctx interface{}, acc interface{}) *MockPaymentTransferStore_CreditAccount_Call {
+	return &MockPaymentTransferStore_CreditAccount_Call{Call: _e.mock.On("CreditAccount", ctx, acc)}
+}
+
+func (_c *MockPaymentTransferStore_CreditAccount_Call) Run(run func(ctx context.Context, acc domain.Account)) *MockPaymentTransferStore_CreditAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Account))
+	})
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_CreditAccount_Call) Return(_a0 error) *MockPaymentTransferStore_CreditAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_CreditAccount_Call) RunAndReturn(run func(context.Context, domain.Account) error) *MockPaymentTransferStore_CreditAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DecreaseAccountBalance provides a mock function with given fields: ctx, userID, currency, amount
+func (_m *MockPaymentTransferStore) DecreaseAccountBalance(ctx context.Context, userID uint64, currency domain.Currency, amount decimal.Decimal) error {
+	ret := _m.Called(ctx, userID, currency, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecreaseAccountBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.Currency, decimal.Decimal) error); ok {
+		r0 = rf(ctx, userID, currency, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPaymentTransferStore_DecreaseAccountBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecreaseAccountBalance'
+type MockPaymentTransferStore_DecreaseAccountBalance_Call struct {
+	*mock.Call
+}
+
+// DecreaseAccountBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - currency domain.Currency
+//   - amount decimal.Decimal
+func (_e *MockPaymentTransferStore_Expecter) DecreaseAccountBalance(ctx interface{}, userID interface{}, currency interface{}, amount interface{}) *MockPaymentTransferStore_DecreaseAccountBalance_Call {
+	return &MockPaymentTransferStore_DecreaseAccountBalance_Call{Call: _e.mock.On("DecreaseAccountBalance", ctx, userID, currency, amount)}
+}
+
+func (_c *MockPaymentTransferStore_DecreaseAccountBalance_Call) Run(run func(ctx context.Context, userID uint64, currency domain.Currency, amount decimal.Decimal)) *MockPaymentTransferStore_DecreaseAccountBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(domain.Currency), args[3].(decimal.Decimal))
+	})
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_DecreaseAccountBalance_Call) Return(_a0 error) *MockPaymentTransferStore_DecreaseAccountBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_DecreaseAccountBalance_Call) RunAndReturn(run func(context.Context, uint64, domain.Currency, decimal.Decimal) error) *MockPaymentTransferStore_DecreaseAccountBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAccount provides a mock function with given fields: ctx, userID, currency
+func (_m *MockPaymentTransferStore) FindAccount(ctx context.Context, userID uint64, currency domain.Currency) (*domain.Account, error) {
+	ret := _m.Called(ctx, userID, currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAccount")
+	}
+
+	var r0 *domain.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.Currency) (*domain.Account, error)); ok {
+		return rf(ctx, userID, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.Currency) *domain.Account); ok {
+		r0 = rf(ctx, userID, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, domain.Currency) error); ok {
+		r1 = rf(ctx, userID, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentTransferStore_FindAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAccount'
+type MockPaymentTransferStore_FindAccount_Call struct {
+	*mock.Call
+}
+
+// FindAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - currency domain.Currency
+func (_e *MockPaymentTransferStore_Expecter) FindAccount(ctx interface{}, userID interface{}, currency interface{}) *MockPaymentTransferStore_FindAccount_Call {
+	return &MockPaymentTransferStore_FindAccount_Call{Call: _e.mock.On("FindAccount", ctx, userID, currency)}
+}
+
+func (_c *MockPaymentTransferStore_FindAccount_Call) Run(run func(ctx context.Context, userID uint64, currency domain.Currency)) *MockPaymentTransferStore_FindAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(domain.Currency))
+	})
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_FindAccount_Call) Return(_a0 *domain.Account, _a1 error) *MockPaymentTransferStore_FindAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_FindAccount_Call) RunAndReturn(run func(context.Context, uint64, domain.Currency) (*domain.Account, error)) *MockPaymentTransferStore_FindAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExchangeRate provides a mock function with given fields: ctx, base, quote
+func (_m *MockPaymentTransferStore) FindExchangeRate(ctx context.Context, base domain.Currency, quote domain.Currency) (*domain.ExchangeRate, error) {
+	ret := _m.Called(ctx, base, quote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExchangeRate")
+	}
+
+	var r0 *domain.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Currency, domain.Currency) (*domain.ExchangeRate, error)); ok {
+		return rf(ctx, base, quote)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Currency, domain.Currency) *domain.ExchangeRate); ok {
+		r0 = rf(ctx, base, quote)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Currency, domain.Currency) error); ok {
+		r1 = rf(ctx, base, quote)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentTransferStore_FindExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExchangeRate'
+type MockPaymentTransferStore_FindExchangeRate_Call struct {
+	*mock.Call
+}
+
+// FindExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - base domain.Currency
+//   - quote domain.Currency
+func (_e *MockPaymentTransferStore_Expecter) FindExchangeRate(ctx interface{}, base interface{}, quote interface{}) *MockPaymentTransferStore_FindExchangeRate_Call {
+	return &MockPaymentTransferStore_FindExchangeRate_Call{Call: _e.mock.On("FindExchangeRate", ctx, base, quote)}
+}
+
+func (_c *MockPaymentTransferStore_FindExchangeRate_Call) Run(run func(ctx context.Context, base domain.Currency, quote domain.Currency)) *MockPaymentTransferStore_FindExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Currency), args[2].(domain.Currency))
+	})
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_FindExchangeRate_Call) Return(_a0 *domain.ExchangeRate, _a1 error) *MockPaymentTransferStore_FindExchangeRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_FindExchangeRate_Call) RunAndReturn(run func(context.Context, domain.Currency, domain.Currency) (*domain.ExchangeRate, error)) *MockPaymentTransferStore_FindExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockAccount provides a mock function with given fields: ctx, userID, currency
+func (_m *MockPaymentTransferStore) LockAccount(ctx context.Context, userID uint64, currency domain.Currency) (*domain.Account, error) {
+	ret := _m.Called(ctx, userID, currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockAccount")
+	}
+
+	var r0 *domain.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.Currency) (*domain.Account, error)); ok {
+		return rf(ctx, userID, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.Currency) *domain.Account); ok {
+		r0 = rf(ctx, userID, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, domain.Currency) error); ok {
+		r1 = rf(ctx, userID, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentTransferStore_LockAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockAccount'
+type MockPaymentTransferStore_LockAccount_Call struct {
+	*mock.Call
+}
+
+// LockAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - currency domain.Currency
+func (_e *MockPaymentTransferStore_Expecter) LockAccount(ctx interface{}, userID interface{}, currency interface{}) *MockPaymentTransferStore_LockAccount_Call {
+	return &MockPaymentTransferStore_LockAccount_Call{Call: _e.mock.On("LockAccount", ctx, userID, currency)}
+}
+
+func (_c *MockPaymentTransferStore_LockAccount_Call) Run(run func(ctx context.Context, userID uint64, currency domain.Currency)) *MockPaymentTransferStore_LockAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(domain.Currency))
+	})
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_LockAccount_Call) Return(_a0 *domain.Account, _a1 error) *MockPaymentTransferStore_LockAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_LockAccount_Call) RunAndReturn(run func(context.Context, uint64, domain.Currency) (*domain.Account, error)) *MockPaymentTransferStore_LockAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTransaction provides a mock function with given fields: ctx, trx
+func (_m *MockPaymentTransferStore) SaveTransaction(ctx context.Context, trx domain.Transaction) error {
+	ret := _m.Called(ctx, trx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Transaction) error); ok {
+		r0 = rf(ctx, trx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPaymentTransferStore_SaveTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTransaction'
+type MockPaymentTransferStore_SaveTransaction_Call struct {
+	*mock.Call
+}
+
+// SaveTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - trx domain.Transaction
+func (_e *MockPaymentTransferStore_Expecter) SaveTransaction(ctx interface{}, trx interface{}) *MockPaymentTransferStore_SaveTransaction_Call {
+	return &MockPaymentTransferStore_SaveTransaction_Call{Call: _e.mock.On("SaveTransaction", ctx, trx)}
+}
+
+func (_c *MockPaymentTransferStore_SaveTransaction_Call) Run(run func(ctx context.Context, trx domain.Transaction)) *MockPaymentTransferStore_SaveTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Transaction))
+	})
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_SaveTransaction_Call) Return(_a0 error) *MockPaymentTransferStore_SaveTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_SaveTransaction_Call) RunAndReturn(run func(context.Context, domain.Transaction) error) *MockPaymentTransferStore_SaveTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTransfer provides a mock function with given fields: ctx, transfer
+func (_m *MockPaymentTransferStore) SaveTransfer(ctx context.Context, transfer domain.Transfer) error {
+	ret := _m.Called(ctx, transfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTransfer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Transfer) error); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPaymentTransferStore_SaveTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTransfer'
+type MockPaymentTransferStore_SaveTransfer_Call struct {
+	*mock.Call
+}
+
+// SaveTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transfer domain.Transfer
+func (_e *MockPaymentTransferStore_Expecter) SaveTransfer(ctx interface{}, transfer interface{}) *MockPaymentTransferStore_SaveTransfer_Call {
+	return &MockPaymentTransferStore_SaveTransfer_Call{Call: _e.mock.On("SaveTransfer", ctx, transfer)}
+}
+
+func (_c *MockPaymentTransferStore_SaveTransfer_Call) Run(run func(ctx context.Context, transfer domain.Transfer)) *MockPaymentTransferStore_SaveTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Transfer))
+	})
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_SaveTransfer_Call) Return(_a0 error) *MockPaymentTransferStore_SaveTransfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_SaveTransfer_Call) RunAndReturn(run func(context.Context, domain.Transfer) error) *MockPaymentTransferStore_SaveTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserExists provides a mock function with given fields: ctx, userID
+func (_m *MockPaymentTransferStore) UserExists(ctx context.Context, userID uint64) (bool, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentTransferStore_UserExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserExists'
+type MockPaymentTransferStore_UserExists_Call struct {
+	*mock.Call
+}
+
+// UserExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockPaymentTransferStore_Expecter) UserExists(ctx interface{}, userID interface{}) *MockPaymentTransferStore_UserExists_Call {
+	return &MockPaymentTransferStore_UserExists_Call{Call: _e.mock.On("UserExists", ctx, userID)}
+}
+
+func (_c *MockPaymentTransferStore_UserExists_Call) Run(run func(ctx context.Context, userID uint64)) *MockPaymentTransferStore_UserExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_UserExists_Call) Return(_a0 bool, _a1 error) *MockPaymentTransferStore_UserExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentTransferStore_UserExists_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockPaymentTransferStore_UserExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPaymentTransferStore creates a new instance of MockPaymentTransferStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentTransferStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentTransferStore {
+	mock := &MockPaymentTransferStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPaymentWebhook is an autogenerated mock type for the PaymentWebhook type
+type MockPaymentWebhook struct {
+	mock.Mock
+}
+
+type MockPaymentWebhook_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentWebhook) EXPECT() *MockPaymentWebhook_Expecter {
+	return &MockPaymentWebhook_Expecter{mock: &_m.Mock}
+}
+
+// Call provides a mock function with given fields: ctx, in
+func (_m *MockPaymentWebhook) Call(ctx context.Context, in domain.PaymentWebhookInput) (*domain.PaymentWebhookOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Call")
+	}
+
+	var r0 *domain.PaymentWebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaymentWebhookInput) (*domain.PaymentWebhookOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaymentWebhookInput) *domain.PaymentWebhookOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PaymentWebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PaymentWebhookInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentWebhook_Call_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Call'
+type MockPaymentWebhook_Call_Call struct {
+	*mock.Call
+}
+
+// Call is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in domain.PaymentWebhookInput
+func (_e *MockPaymentWebhook_Expecter) Call(ctx interface{}, in interface{}) *MockPaymentWebhook_Call_Call {
+	return &MockPaymentWebhook_Call_Call{Call: _e.mock.On("Call", ctx, in)}
+}
+
+func (_c *MockPaymentWebhook_Call_Call) Run(run func(ctx context.Context, in domain.PaymentWebhookInput)) *MockPaymentWebhook_Call_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PaymentWebhookInput))
+	})
+	return _c
+}
+
+func (_c *MockPaymentWebhook_Call_Call) Return(_a0 *domain.PaymentWebhookOutput, _a1 error) *MockPaymentWebhook_Call_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentWebhook_Call_Call) RunAndReturn(run func(context.Context, domain.PaymentWebhookInput) (*domain.PaymentWebhookOutput, error)) *MockPaymentWebhook_Call_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPaymentWebhook creates a new instance of MockPaymentWebhook. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentWebhook(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentWebhook {
+	mock := &MockPaymentWebhook{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,255 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	decimal "github.com/shopspring/decimal"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPaymentWebhookStore is an autogenerated mock type for the PaymentWebhookStore type
+type MockPaymentWebhookStore struct {
+	mock.Mock
+}
+
+type MockPaymentWebhookStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPaymentWebhookStore) EXPECT() *MockPaymentWebhookStore_Expecter {
+	return &MockPaymentWebhookStore_Expecter{mock: &_m.Mock}
+}
+
+// FindTopupByReferenceID provides a mock function with given fields: ctx, refID
+func (_m *MockPaymentWebhookStore) FindTopupByReferenceID(ctx context.Context, refID string) (*domain.Topup, error) {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTopupByReferenceID")
+	}
+
+	var r0 *domain.Topup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Topup, error)); ok {
+		return rf(ctx, refID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Topup); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Topup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentWebhookStore_FindTopupByReferenceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTopupByReferenceID'
+type MockPaymentWebhookStore_FindTopupByReferenceID_Call struct {
+	*mock.Call
+}
+
+// FindTopupByReferenceID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID string
+func (_e *MockPaymentWebhookStore_Expecter) FindTopupByReferenceID(ctx interface{}, refID interface{}) *MockPaymentWebhookStore_FindTopupByReferenceID_Call {
+	return &MockPaymentWebhookStore_FindTopupByReferenceID_Call{Call: _e.mock.On("FindTopupByReferenceID", ctx, refID)}
+}
+
+func (_c *MockPaymentWebhookStore_FindTopupByReferenceID_Call) Run(run func(ctx context.Context, refID string)) *MockPaymentWebhookStore_FindTopupByReferenceID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPaymentWebhookStore_FindTopupByReferenceID_Call) Return(_a0 *domain.Topup, _a1 error) *MockPaymentWebhookStore_FindTopupByReferenceID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentWebhookStore_FindTopupByReferenceID_Call) RunAndReturn(run func(context.Context, string) (*domain.Topup, error)) *MockPaymentWebhookStore_FindTopupByReferenceID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTransactionByID provides a mock function with given fields: ctx, id
+func (_m *MockPaymentWebhookStore) FindTransactionByID(ctx context.Context, id uint64) (*domain.Transaction, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTransactionByID")
+	}
+
+	var r0 *domain.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*domain.Transaction, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *domain.Transaction); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentWebhookStore_FindTransactionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTransactionByID'
+type MockPaymentWebhookStore_FindTransactionByID_Call struct {
+	*mock.Call
+}
+
+// FindTransactionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+func (_e *MockPaymentWebhookStore_Expecter) FindTransactionByID(ctx interface{}, id interface{}) *MockPaymentWebhookStore_FindTransactionByID_Call {
+	return &MockPaymentWebhookStore_FindTransactionByID_Call{Call: _e.mock.On("FindTransactionByID", ctx, id)}
+}
+
+func (_c *MockPaymentWebhookStore_FindTransactionByID_Call) Run(run func(ctx context.Context, id uint64)) *MockPaymentWebhookStore_FindTransactionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockPaymentWebhookStore_FindTransactionByID_Call) Return(_a0 *domain.Transaction, _a1 error) *MockPaymentWebhookStore_FindTransactionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentWebhookStore_FindTransactionByID_Call) RunAndReturn(run func(context.Context, uint64) (*domain.Transaction, error)) *MockPaymentWebhookStore_FindTransactionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncreaseAccountBalance provides a mock function with given fields: ctx, userID, currency, amount
+func (_m *MockPaymentWebhookStore) IncreaseAccountBalance(ctx context.Context, userID uint64, currency domain.Currency, amount decimal.Decimal) error {
+	ret := _m.Called(ctx, userID, currency, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncreaseAccountBalance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.Currency, decimal.Decimal) error); ok {
+		r0 = rf(ctx, userID, currency, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPaymentWebhookStore_IncreaseAccountBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncreaseAccountBalance'
+type MockPaymentWebhookStore_IncreaseAccountBalance_Call struct {
+	*mock.Call
+}
+
+// IncreaseAccountBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - currency domain.Currency
+//   - amount decimal.Decimal
+func (_e *MockPaymentWebhookStore_Expecter) IncreaseAccountBalance(ctx interface{}, userID interface{}, currency interface{}, amount interface{}) *MockPaymentWebhookStore_IncreaseAccountBalance_Call {
+	return &MockPaymentWebhookStore_IncreaseAccountBalance_Call{Call: _e.mock.On("IncreaseAccountBalance", ctx, userID, currency, amount)}
+}
+
+func (_c *MockPaymentWebhookStore_IncreaseAccountBalance_Call) Run(run func(ctx context.Context, userID uint64, currency domain.Currency, amount decimal.Decimal)) *MockPaymentWebhookStore_IncreaseAccountBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(domain.Currency), args[3].(decimal.Decimal))
+	})
+	return _c
+}
+
+func (_c *MockPaymentWebhookStore_IncreaseAccountBalance_Call) Return(_a0 error) *MockPaymentWebhookStore_IncreaseAccountBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentWebhookStore_IncreaseAccountBalance_Call) RunAndReturn(run func(context.Context, uint64, domain.Currency, decimal.Decimal) error) *MockPaymentWebhookStore_IncreaseAccountBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTransactionStatus provides a mock function with given fields: ctx, id, from, to
+func (_m *MockPaymentWebhookStore) UpdateTransactionStatus(ctx context.Context, id uint64, from domain.TransactionStatus, to domain.TransactionStatus) error {
+	ret := _m.Called(ctx, id, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTransactionStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.TransactionStatus, domain.TransactionStatus) error); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPaymentWebhookStore_UpdateTransactionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransactionStatus'
+type MockPaymentWebhookStore_UpdateTransactionStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateTransactionStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - from domain.TransactionStatus
+//   - to domain.TransactionStatus
+func (_e *MockPaymentWebhookStore_Expecter) UpdateTransactionStatus(ctx interface{}, id interface{}, from interface{}, to interface{}) *MockPaymentWebhookStore_UpdateTransactionStatus_Call {
+	return &MockPaymentWebhookStore_UpdateTransactionStatus_Call{Call: _e.mock.On("UpdateTransactionStatus", ctx, id, from, to)}
+}
+
+func (_c *MockPaymentWebhookStore_UpdateTransactionStatus_Call) Run(run func(ctx context.Context, id uint64, from domain.TransactionStatus, to domain.TransactionStatus)) *MockPaymentWebhookStore_UpdateTransactionStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(domain.TransactionStatus), args[3].(domain.TransactionStatus))
+	})
+	return _c
+}
+
+func (_c *MockPaymentWebhookStore_UpdateTransactionStatus_Call) Return(_a0 error) *MockPaymentWebhookStore_UpdateTransactionStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentWebhookStore_UpdateTransactionStatus_Call) RunAndReturn(run func(context.Context, uint64, domain.TransactionStatus, domain.TransactionStatus) error) *MockPaymentWebhookStore_UpdateTransactionStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPaymentWebhookStore creates a new instance of MockPaymentWebhookStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPaymentWebhookStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPaymentWebhookStore {
+	mock := &MockPaymentWebhookStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
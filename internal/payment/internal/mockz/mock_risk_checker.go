@@ -0,0 +1,84 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRiskChecker is an autogenerated mock type for the RiskChecker type
+type MockRiskChecker struct {
+	mock.Mock
+}
+
+type MockRiskChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRiskChecker) EXPECT() *MockRiskChecker_Expecter {
+	return &MockRiskChecker_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx, in
+func (_m *MockRiskChecker) Check(ctx context.Context, in domain.RiskInput) error {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RiskInput) error); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRiskChecker_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockRiskChecker_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in domain.RiskInput
+func (_e *MockRiskChecker_Expecter) Check(ctx interface{}, in interface{}) *MockRiskChecker_Check_Call {
+	return &MockRiskChecker_Check_Call{Call: _e.mock.On("Check", ctx, in)}
+}
+
+func (_c *MockRiskChecker_Check_Call) Run(run func(ctx context.Context, in domain.RiskInput)) *MockRiskChecker_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RiskInput))
+	})
+	return _c
+}
+
+func (_c *MockRiskChecker_Check_Call) Return(_a0 error) *MockRiskChecker_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRiskChecker_Check_Call) RunAndReturn(run func(context.Context, domain.RiskInput) error) *MockRiskChecker_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRiskChecker creates a new instance of MockRiskChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRiskChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRiskChecker {
+	mock := &MockRiskChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,136 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRiskRule is an autogenerated mock type for the RiskRule type
+type MockRiskRule struct {
+	mock.Mock
+}
+
+type MockRiskRule_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRiskRule) EXPECT() *MockRiskRule_Expecter {
+	return &MockRiskRule_Expecter{mock: &_m.Mock}
+}
+
+// Evaluate provides a mock function with given fields: in
+func (_m *MockRiskRule) Evaluate(in domain.RiskInput) (string, bool) {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Evaluate")
+	}
+
+	var r0 string
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(domain.RiskInput) (string, bool)); ok {
+		return rf(in)
+	}
+	if rf, ok := ret.Get(0).(func(domain.RiskInput) string); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.RiskInput) bool); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockRiskRule_Evaluate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Evaluate'
+type MockRiskRule_Evaluate_Call struct {
+	*mock.Call
+}
+
+// Evaluate is a helper method to define mock.On call
+//   - in domain.RiskInput
+func (_e *MockRiskRule_Expecter) Evaluate(in interface{}) *MockRiskRule_Evaluate_Call {
+	return &MockRiskRule_Evaluate_Call{Call: _e.mock.On("Evaluate", in)}
+}
+
+func (_c *MockRiskRule_Evaluate_Call) Run(run func(in domain.RiskInput)) *MockRiskRule_Evaluate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.RiskInput))
+	})
+	return _c
+}
+
+func (_c *MockRiskRule_Evaluate_Call) Return(_a0 string, _a1 bool) *MockRiskRule_Evaluate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRiskRule_Evaluate_Call) RunAndReturn(run func(domain.RiskInput) (string, bool)) *MockRiskRule_Evaluate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function with no fields
+func (_m *MockRiskRule) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockRiskRule_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockRiskRule_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockRiskRule_Expecter) Name() *MockRiskRule_Name_Call {
+	return &MockRiskRule_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockRiskRule_Name_Call) Run(run func()) *MockRiskRule_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRiskRule_Name_Call) Return(_a0 string) *MockRiskRule_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRiskRule_Name_Call) RunAndReturn(run func() string) *MockRiskRule_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRiskRule creates a new instance of MockRiskRule. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRiskRule(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRiskRule {
+	mock := &MockRiskRule{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,148 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	decimal "github.com/shopspring/decimal"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockRiskStore is an autogenerated mock type for the RiskStore type
+type MockRiskStore struct {
+	mock.Mock
+}
+
+type MockRiskStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRiskStore) EXPECT() *MockRiskStore_Expecter {
+	return &MockRiskStore_Expecter{mock: &_m.Mock}
+}
+
+// SaveRiskDecision provides a mock function with given fields: ctx, d
+func (_m *MockRiskStore) SaveRiskDecision(ctx context.Context, d domain.RiskDecision) error {
+	ret := _m.Called(ctx, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRiskDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RiskDecision) error); ok {
+		r0 = rf(ctx, d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRiskStore_SaveRiskDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRiskDecision'
+type MockRiskStore_SaveRiskDecision_Call struct {
+	*mock.Call
+}
+
+// SaveRiskDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - d domain.RiskDecision
+func (_e *MockRiskStore_Expecter) SaveRiskDecision(ctx interface{}, d interface{}) *MockRiskStore_SaveRiskDecision_Call {
+	return &MockRiskStore_SaveRiskDecision_Call{Call: _e.mock.On("SaveRiskDecision", ctx, d)}
+}
+
+func (_c *MockRiskStore_SaveRiskDecision_Call) Run(run func(ctx context.Context, d domain.RiskDecision)) *MockRiskStore_SaveRiskDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RiskDecision))
+	})
+	return _c
+}
+
+func (_c *MockRiskStore_SaveRiskDecision_Call) Return(_a0 error) *MockRiskStore_SaveRiskDecision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRiskStore_SaveRiskDecision_Call) RunAndReturn(run func(context.Context, domain.RiskDecision) error) *MockRiskStore_SaveRiskDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumDebitAmount provides a mock function with given fields: ctx, userID, op, currency, since
+func (_m *MockRiskStore) SumDebitAmount(ctx context.Context, userID uint64, op domain.RiskOperation, currency domain.Currency, since time.Time) (decimal.Decimal, error) {
+	ret := _m.Called(ctx, userID, op, currency, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumDebitAmount")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.RiskOperation, domain.Currency, time.Time) (decimal.Decimal, error)); ok {
+		return rf(ctx, userID, op, currency, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, domain.RiskOperation, domain.Currency, time.Time) decimal.Decimal); ok {
+		r0 = rf(ctx, userID, op, currency, since)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, domain.RiskOperation, domain.Currency, time.Time) error); ok {
+		r1 = rf(ctx, userID, op, currency, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRiskStore_SumDebitAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumDebitAmount'
+type MockRiskStore_SumDebitAmount_Call struct {
+	*mock.Call
+}
+
+// SumDebitAmount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - op domain.RiskOperation
+//   - currency domain.Currency
+//   - since time.Time
+func (_e *MockRiskStore_Expecter) SumDebitAmount(ctx interface{}, userID interface{}, op interface{}, currency interface{}, since interface{}) *MockRiskStore_SumDebitAmount_Call {
+	return &MockRiskStore_SumDebitAmount_Call{Call: _e.mock.On("SumDebitAmount", ctx, userID, op, currency, since)}
+}
+
+func (_c *MockRiskStore_SumDebitAmount_Call) Run(run func(ctx context.Context, userID uint64, op domain.RiskOperation, currency domain.Currency, since time.Time)) *MockRiskStore_SumDebitAmount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(domain.RiskOperation), args[3].(domain.Currency), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRiskStore_SumDebitAmount_Call) Return(_a0 decimal.Decimal, _a1 error) *MockRiskStore_SumDebitAmount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRiskStore_SumDebitAmount_Call) RunAndReturn(run func(context.Context, uint64, domain.RiskOperation, domain.Currency, time.Time) (decimal.Decimal, error)) *MockRiskStore_SumDebitAmount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRiskStore creates a new instance of MockRiskStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRiskStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRiskStore {
+	mock := &MockRiskStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
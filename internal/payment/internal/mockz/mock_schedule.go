@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSchedule is an autogenerated mock type for the Schedule type
+type MockSchedule struct {
+	mock.Mock
+}
+
+type MockSchedule_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchedule) EXPECT() *MockSchedule_Expecter {
+	return &MockSchedule_Expecter{mock: &_m.Mock}
+}
+
+// Next provides a mock function with given fields: t
+func (_m *MockSchedule) Next(t time.Time) time.Time {
+	ret := _m.Called(t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Next")
+	}
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(time.Time) time.Time); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
+
+// MockSchedule_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
+type MockSchedule_Next_Call struct {
+	*mock.Call
+}
+
+// Next is a helper method to define mock.On call
+//   - t time.Time
+func (_e *MockSchedule_Expecter) Next(t interface{}) *MockSchedule_Next_Call {
+	return &MockSchedule_Next_Call{Call: _e.mock.On("Next", t)}
+}
+
+func (_c *MockSchedule_Next_Call) Run(run func(t time.Time)) *MockSchedule_Next_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSchedule_Next_Call) Return(_a0 time.Time) *MockSchedule_Next_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSchedule_Next_Call) RunAndReturn(run func(time.Time) time.Time) *MockSchedule_Next_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchedule creates a new instance of MockSchedule. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchedule(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchedule {
+	mock := &MockSchedule{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
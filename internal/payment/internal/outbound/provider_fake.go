@@ -0,0 +1,46 @@
+package outbound
+
+import (
+	"encoding/json"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// FakeProvider stands in for a payment gateway during local development and
+// tests. It builds settlement webhooks signed exactly like a real provider
+// would, so the webhook receiver can be exercised end to end.
+type FakeProvider struct {
+	name   string
+	secret string
+}
+
+func NewFakeProvider(name, secret string) *FakeProvider {
+	return &FakeProvider{name: name, secret: secret}
+}
+
+// Name returns the provider name used in the webhook path.
+func (fp *FakeProvider) Name() string {
+	return fp.name
+}
+
+// Secret returns the shared secret the provider signs webhooks with.
+func (fp *FakeProvider) Secret() string {
+	return fp.secret
+}
+
+// Event encodes a settlement event and returns the payload with its signature.
+func (fp *FakeProvider) Event(refID string, status domain.TransactionStatus, amount decimal.Decimal,
+	currency domain.Currency,
+) ([]byte, string) {
+	//nolint:errchkjson // WebhookEvent always encodes
+	payload, _ := json.Marshal(domain.WebhookEvent{
+		ReferenceID: refID,
+		Status:      enum.New(status).String(),
+		Amount:      amount,
+		Currency:    currency.String(),
+	})
+
+	return payload, domain.SignWebhook(fp.secret, payload)
+}
@@ -0,0 +1,20 @@
+package outbound
+
+import (
+	"testing"
+
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeProvider_Event(t *testing.T) {
+	fp := NewFakeProvider("fakepay", "secret")
+	assert.Equal(t, "fakepay", fp.Name())
+	assert.Equal(t, "secret", fp.Secret())
+
+	payload, sig := fp.Event("ref", domain.TransactionStatusSuccess, decimal.NewFromInt(100), domain.CurrencyIDR)
+	assert.JSONEq(t, `{"reference_id":"ref","status":"SUCCESS","amount":"100","currency":"IDR"}`, string(payload))
+	assert.True(t, domain.VerifyWebhook("secret", payload, sig))
+	assert.False(t, domain.VerifyWebhook("other", payload, sig))
+}
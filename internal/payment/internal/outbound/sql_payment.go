@@ -4,123 +4,431 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
-	"github.com/doug-martin/goqu/v9"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"github.com/shopspring/decimal"
 )
 
 type SQLPayment struct {
-	db        *sql.DB
-	qu        goqu.DialectWrapper
-	telemetry *telemetry.Telemetry
+	db        *sqlkit.DB
+	telemetry *lib.Telemetry
 }
 
-func NewSQLPayment(db *sql.DB, qu goqu.DialectWrapper, tel *telemetry.Telemetry) *SQLPayment {
+func NewSQLPayment(db *sqlkit.DB, tel *lib.Telemetry) *SQLPayment {
 	return &SQLPayment{
 		db:        db,
-		qu:        qu,
 		telemetry: tel,
 	}
 }
 
-func (st *SQLPayment) FindAccountByUserID(ctx context.Context, userID uint64) (*domain.Account, error) {
-	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.FindAccountByUserID")
+func (st *SQLPayment) FindAccount(ctx context.Context, userID uint64, currency domain.Currency) (
+	*domain.Account, error,
+) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.FindAccount")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return st.qu.Select("id", "user_id", "balance").
-			From("accounts").
-			Where(goqu.Ex{"user_id": userID}).
-			Prepared(true).
-			ToSQL()
+	return sqlkit.One[domain.Account](ctx, st.db, sqlkit.Ex{"user_id": userID, "currency": currency})
+}
+
+// LockAccount finds the account of the user in currency and locks it until the
+// transaction of ctx ends, so the debits of that account are checked one by one.
+func (st *SQLPayment) LockAccount(ctx context.Context, userID uint64, currency domain.Currency) (
+	*domain.Account, error,
+) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.LockAccount")
+	defer span.End()
+
+	query := `SELECT id, user_id, currency, balance FROM accounts WHERE user_id = ? AND currency = ? FOR UPDATE;`
+
+	var acc domain.Account
+	err := st.db.Scan(ctx, &acc, query, userID, currency)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil //nolint:nilnil // just a nil result
 	}
 
-	return dbops.SQLGet[domain.Account](ctx, st.db, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &acc, nil
 }
 
-func (st *SQLPayment) FindTopupByReferenceID(ctx context.Context, refID string) (*domain.Topup, error) {
-	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.FindTopupByReferenceID")
+func (st *SQLPayment) SaveAccount(ctx context.Context, acc domain.Account) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.SaveAccount")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return st.qu.Select("id", "transaction_id", "reference_id", "amount").
-			From("topups").
-			Where(goqu.Ex{"reference_id": refID}).
-			Prepared(true).
-			ToSQL()
+	query := `INSERT INTO accounts(id, user_id, currency, balance) VALUES(?, ?, ?, ?);`
+	args := []any{acc.ID, acc.UserID, acc.Currency, acc.Balanace}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
 	}
 
-	return dbops.SQLGet[domain.Topup](ctx, st.db, query)
+	if result.RowsAffected == 0 {
+		return domain.ErrAccountNoRowsAffected
+	}
+
+	return nil
+}
+
+func (st *SQLPayment) IncreaseAccountBalance(ctx context.Context, userID uint64, currency domain.Currency,
+	amount decimal.Decimal,
+) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.IncreaseAccountBalance")
+	defer span.End()
+
+	query := `UPDATE accounts SET balance = balance + ? WHERE user_id = ? AND currency = ?;`
+	args := []any{amount, userID, currency}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrAccountNoRowsAffected
+	}
+
+	return nil
+}
+
+// CreditAccount adds the balance of acc to the account of the user in its
+// currency, opening it with acc when it does not exist yet. The account is
+// upserted at once, so two credits of a new account can not both insert it.
+func (st *SQLPayment) CreditAccount(ctx context.Context, acc domain.Account) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.CreditAccount")
+	defer span.End()
+
+	query := `INSERT INTO accounts(id, user_id, currency, balance) VALUES(?, ?, ?, ?) ` +
+		`ON DUPLICATE KEY UPDATE balance = balance + VALUES(balance);`
+	if st.db.Driver() == sqlkit.PostgresDriver {
+		query = `INSERT INTO accounts(id, user_id, currency, balance) VALUES($1, $2, $3, $4) ` +
+			`ON CONFLICT (user_id, currency) DO UPDATE SET balance = accounts.balance + EXCLUDED.balance;`
+	}
+	args := []any{acc.ID, acc.UserID, acc.Currency, acc.Balanace}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrAccountNoRowsAffected
+	}
+
+	return nil
+}
+
+// DecreaseAccountBalance debits the account only when the balance covers amount,
+// so the balance can never go negative even under concurrent debits.
+func (st *SQLPayment) DecreaseAccountBalance(ctx context.Context, userID uint64, currency domain.Currency,
+	amount decimal.Decimal,
+) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.DecreaseAccountBalance")
+	defer span.End()
+
+	query := `UPDATE accounts SET balance = balance - ? WHERE user_id = ? AND currency = ? AND balance >= ?;`
+	args := []any{amount, userID, currency, amount}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrAccountNoRowsAffected
+	}
+
+	return nil
+}
+
+// UserExists reports whether there is a user with the given id.
+func (st *SQLPayment) UserExists(ctx context.Context, userID uint64) (bool, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.UserExists")
+	defer span.End()
+
+	var count uint64
+	if err := st.db.Scan(ctx, &count, `SELECT COUNT(*) FROM users WHERE id = ?;`, userID); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (st *SQLPayment) FindTopupByReferenceID(ctx context.Context, refID string) (*domain.Topup, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.FindTopupByReferenceID")
+	defer span.End()
+
+	return sqlkit.One[domain.Topup](ctx, st.db, sqlkit.Ex{"reference_id": refID})
 }
 
 func (st *SQLPayment) SaveTopup(ctx context.Context, t domain.Topup) error {
 	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.SaveTopup")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return st.qu.Insert("topups").
-			Cols("id", "transaction_id", "reference_id", "amount").
-			Vals([]any{t.ID, t.TransactionID, t.ReferenceID, t.Amount}).
-			Prepared(true).
-			ToSQL()
+	query := `INSERT INTO topups(id, transaction_id, reference_id, amount) VALUES(?, ?, ?, ?);`
+	args := []any{t.ID, t.TransactionID, t.ReferenceID, t.Amount}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
 	}
 
-	err := dbops.Exec(ctx, st.db, query, true)
-	if errors.Is(err, dbops.ErrZeroRowsAffected) {
-		return domain.ErrAccountNoRowsAffected
+	if result.RowsAffected == 0 {
+		return domain.ErrTopupNoRowsAffected
 	}
 
-	return err
+	return nil
+}
+
+func (st *SQLPayment) FindTransactionByID(ctx context.Context, id uint64) (*domain.Transaction, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.FindTransactionByID")
+	defer span.End()
+
+	return sqlkit.One[domain.Transaction](ctx, st.db, sqlkit.Ex{"id": id})
 }
 
 func (st *SQLPayment) SaveTransaction(ctx context.Context, t domain.Transaction) error {
 	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.SaveTransaction")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return st.qu.Insert("transactions").
-			Cols("id", "user_id", "amount", "type", "status", "remark", "created_at").
-			Vals([]any{t.ID, t.UserID, t.Amount, t.Type, t.Status, t.Remark, t.CreateAt}).
-			Prepared(true).
-			ToSQL()
+	query := `INSERT INTO transactions(id, user_id, amount, currency, type, status, remark, created_at)
+	VALUES(?, ?, ?, ?, ?, ?, ?, ?);`
+	args := []any{t.ID, t.UserID, t.Amount, t.Currency, t.Type, t.Status, t.Remark, t.CreateAt}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrTransactionNoRowsAffected
+	}
+
+	return nil
+}
+
+// UpdateTransactionStatus moves a transaction to status `to` only when it is
+// still in status `from`, so concurrent settlements cannot both succeed.
+func (st *SQLPayment) UpdateTransactionStatus(ctx context.Context, id uint64,
+	from, to domain.TransactionStatus,
+) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.UpdateTransactionStatus")
+	defer span.End()
+
+	query := `UPDATE transactions SET status = ? WHERE id = ? AND status = ?;`
+	args := []any{enum.New(to), id, enum.New(from)}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
 	}
 
-	err := dbops.Exec(ctx, st.db, query, true)
-	if errors.Is(err, dbops.ErrZeroRowsAffected) {
+	if result.RowsAffected == 0 {
 		return domain.ErrTransactionNoRowsAffected
 	}
 
-	return err
+	return nil
 }
 
-func (st *SQLPayment) UpdateAccount(ctx context.Context, data map[string]any) error {
-	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.UpdateAccount")
+func (st *SQLPayment) FindExchangeRate(ctx context.Context, base, quote domain.Currency) (
+	*domain.ExchangeRate, error,
+) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.FindExchangeRate")
 	defer span.End()
 
-	id, hasID := data["id"].(uint64)
-	balance, hasBalance := data["balance"].(decimal.Decimal)
-	sets := map[string]any{}
+	return sqlkit.One[domain.ExchangeRate](ctx, st.db, sqlkit.Ex{"base_currency": base, "quote_currency": quote})
+}
+
+func (st *SQLPayment) SaveTransfer(ctx context.Context, t domain.Transfer) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.SaveTransfer")
+	defer span.End()
+
+	query := `INSERT INTO transfers(id, transaction_id, sender_id, recipient_id, amount, currency,
+	target_amount, target_currency, rate) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?);`
+	args := []any{
+		t.ID, t.TransactionID, t.SenderID, t.RecipientID, t.Amount, t.Currency,
+		t.TargetAmount, t.TargetCurrency, t.Rate,
+	}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
+	}
 
-	query := func() (string, []any, error) {
-		q := st.qu.Update("accounts")
+	if result.RowsAffected == 0 {
+		return domain.ErrTransferNoRowsAffected
+	}
 
-		if hasBalance {
-			sets["balance"] = balance
-		}
+	return nil
+}
 
-		if len(sets) > 0 {
-			q = q.Set(sets)
-		}
+// SumDebitAmount returns the total of the user's debits of the operation op in
+// currency created at or after since. A debit belongs to the operation whose table,
+// topups or transfers, records its transaction. Failed transactions are not counted,
+// pending topups are, so topups waiting for their confirmation can not go past a
+// limit together.
+func (st *SQLPayment) SumDebitAmount(ctx context.Context, userID uint64, op domain.RiskOperation,
+	currency domain.Currency, since time.Time,
+) (decimal.Decimal, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.SumDebitAmount")
+	defer span.End()
+
+	var table string
+	switch op {
+	case domain.RiskOperationTopup:
+		table = "topups"
+	case domain.RiskOperationTransfer:
+		table = "transfers"
+	default:
+		return decimal.Zero, domain.ErrRiskOperationNotSupported
+	}
+
+	query := `SELECT COALESCE(SUM(t.amount), 0) AS total FROM transactions t
+	JOIN ` + table + ` o ON o.transaction_id = t.id
+	WHERE t.user_id = ? AND t.currency = ? AND t.type = ? AND t.status <> ? AND t.created_at >= ?;`
+	args := []any{
+		userID, currency, enum.New(domain.TransactionTypeDebit), enum.New(domain.TransactionStatusFailed), since,
+	}
 
-		if hasID {
-			q = q.Where(goqu.Ex{"id": id})
-		}
+	var out struct {
+		Total decimal.Decimal `db:"total"`
+	}
+	if err := st.db.Scan(ctx, &out, query, args...); err != nil {
+		return decimal.Zero, err
+	}
+
+	return out.Total, nil
+}
+
+func (st *SQLPayment) SaveRiskDecision(ctx context.Context, d domain.RiskDecision) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.SaveRiskDecision")
+	defer span.End()
+
+	query := `INSERT INTO risk_decisions(id, user_id, operation, amount, currency, allowed, rule, reason, created_at)
+	VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?);`
+	args := []any{d.ID, d.UserID, d.Operation, d.Amount, d.Currency, d.Allowed, d.Rule, d.Reason, d.CreatedAt}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrRiskDecisionNoRowsAffected
+	}
+
+	return nil
+}
+
+func (st *SQLPayment) SaveScheduledPayment(ctx context.Context, sp domain.ScheduledPayment) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.SaveScheduledPayment")
+	defer span.End()
+
+	query := `INSERT INTO scheduled_payments(id, user_id, recipient_id, amount, currency, target_currency,
+	schedule, next_run_at, active, attempts, created_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+	args := []any{
+		sp.ID, sp.UserID, sp.RecipientID, sp.Amount, sp.Currency, sp.TargetCurrency,
+		sp.Schedule, sp.NextRunAt, sp.Active, sp.Attempts, sp.CreatedAt,
+	}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrScheduledPaymentNoRowsAffected
+	}
+
+	return nil
+}
+
+// FindDueScheduledPayments returns active schedules whose next run is due and
+// that are not leased by a runner, oldest first.
+func (st *SQLPayment) FindDueScheduledPayments(ctx context.Context, now time.Time, limit int) (
+	[]domain.ScheduledPayment, error,
+) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.FindDueScheduledPayments")
+	defer span.End()
+
+	query := `SELECT id, user_id, recipient_id, amount, currency, target_currency, schedule, next_run_at,
+	active, attempts, lease_owner, lease_until, created_at FROM scheduled_payments
+	WHERE active = ? AND next_run_at <= ? AND (lease_until IS NULL OR lease_until < ?)
+	ORDER BY next_run_at LIMIT ?;`
+
+	var out []domain.ScheduledPayment
+	if err := st.db.Scan(ctx, &out, query, true, now, now, limit); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// AcquireScheduledPayment leases a due schedule to owner until the given time.
+// The update only matches when no other runner holds a live lease, so at most
+// one caller gets true for the same run.
+func (st *SQLPayment) AcquireScheduledPayment(ctx context.Context, id uint64, owner string, now,
+	until time.Time,
+) (bool, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.AcquireScheduledPayment")
+	defer span.End()
+
+	query := `UPDATE scheduled_payments SET lease_owner = ?, lease_until = ?
+	WHERE id = ? AND active = ? AND next_run_at <= ? AND (lease_until IS NULL OR lease_until < ?);`
+	args := []any{owner, until, id, true, now, now}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseScheduledPayment stores the state after a run and drops the lease.
+// It fails with ErrScheduledPaymentNoRowsAffected when owner lost the lease.
+func (st *SQLPayment) ReleaseScheduledPayment(ctx context.Context, sp domain.ScheduledPayment, owner string) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.ReleaseScheduledPayment")
+	defer span.End()
+
+	query := `UPDATE scheduled_payments SET next_run_at = ?, active = ?, attempts = ?, lease_owner = '',
+	lease_until = NULL WHERE id = ? AND lease_owner = ?;`
+	args := []any{sp.NextRunAt, sp.Active, sp.Attempts, sp.ID, owner}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrScheduledPaymentNoRowsAffected
+	}
+
+	return nil
+}
+
+func (st *SQLPayment) SaveScheduledPaymentRun(ctx context.Context, run domain.ScheduledPaymentRun) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "payment.outbound.SQLPayment.SaveScheduledPaymentRun")
+	defer span.End()
+
+	query := `INSERT INTO scheduled_payment_runs(id, schedule_id, attempt, status, transfer_id, error, run_at)
+	VALUES(?, ?, ?, ?, ?, ?, ?);`
+	args := []any{run.ID, run.ScheduleID, run.Attempt, run.Status, run.TransferID, run.Error, run.RunAt}
+
+	result, err := sqlkit.Exec(ctx, st.db, query, args...)
+	if err != nil {
+		return err
+	}
 
-		return q.Prepared(true).ToSQL()
+	if result.RowsAffected == 0 {
+		return domain.ErrScheduledPaymentRunNoRowsAffected
 	}
 
-	return dbops.Exec(ctx, st.db, query)
+	return nil
 }
@@ -2,13 +2,14 @@ package outbound
 
 import (
 	"context"
-	"database/sql"
+	"database/sql/driver"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
-	"github.com/doug-martin/goqu/v9"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"github.com/shopspring/decimal"
@@ -17,9 +18,8 @@ import (
 
 func TestNewSQLPayment(t *testing.T) {
 	type args struct {
-		db  *sql.DB
-		qu  goqu.DialectWrapper
-		tel *telemetry.Telemetry
+		db  *sqlkit.DB
+		tel *lib.Telemetry
 	}
 	tests := []struct {
 		name string
@@ -27,47 +27,34 @@ func TestNewSQLPayment(t *testing.T) {
 		want *SQLPayment
 	}{
 		{
-			name: "SuccessMySQL",
-			args: args{
-				db:  &sql.DB{},
-				qu:  goqu.Dialect(dbops.MySQLDriver),
-				tel: telemetry.NewTelemetry(),
-			},
-			want: &SQLPayment{
-				db:        &sql.DB{},
-				qu:        goqu.Dialect(dbops.MySQLDriver),
-				telemetry: telemetry.NewTelemetry(),
-			},
-		},
-		{
-			name: "SuccessPostgres",
+			name: "Success",
 			args: args{
-				db:  &sql.DB{},
-				qu:  goqu.Dialect(dbops.MySQLDriver),
-				tel: telemetry.NewTelemetry(),
+				db:  &sqlkit.DB{},
+				tel: lib.NewTelemetry(),
 			},
 			want: &SQLPayment{
-				db:        &sql.DB{},
-				qu:        goqu.Dialect(dbops.MySQLDriver),
-				telemetry: telemetry.NewTelemetry(),
+				db:        &sqlkit.DB{},
+				telemetry: lib.NewTelemetry(),
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := NewSQLPayment(tt.args.db, tt.args.qu, tt.args.tel)
+			got := NewSQLPayment(tt.args.db, tt.args.tel)
 			assert.Equal(t, tt.want.db, got.db)
-			assert.Equal(t, tt.want.qu, got.qu)
 			assert.Equal(t, tt.want.telemetry, got.telemetry)
 		})
 	}
 }
 
-func TestSQLPayment_FindAccountByUserID(t *testing.T) {
+func TestSQLPayment_FindAccount(t *testing.T) {
+	tel := lib.NewTelemetry()
+
 	type args struct {
-		ctx    context.Context
-		userID uint64
+		ctx      context.Context
+		userID   uint64
+		currency domain.Currency
 	}
 	tests := []struct {
 		name    string
@@ -77,98 +64,53 @@ func TestSQLPayment_FindAccountByUserID(t *testing.T) {
 		mockFn  func(a args) (*SQLPayment, func() error)
 	}{
 		{
-			name: "ErrorWhenQuery",
-			args: args{
-				ctx:    context.Background(),
-				userID: 19,
-			},
+			name:    "ErrorWhenQuery",
+			args:    args{ctx: context.Background(), userID: 19, currency: domain.CurrencyUSD},
 			want:    nil,
 			wantErr: assert.AnError,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Select("id", "user_id", "balance").
-					From("accounts").
-					Where(goqu.Ex{"user_id": a.userID}).
-					Prepared(true).
-					ToSQL()
-
-				mock.ExpectQuery(query).
-					WithArgs(dbops.AnyToValue(args)...).
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "accounts" WHERE (("currency" = 'USD') AND ("user_id" = 19)) LIMIT 1`)).
 					WillReturnError(assert.AnError)
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name: "NotFound",
-			args: args{
-				ctx:    context.Background(),
-				userID: 19,
-			},
+			name:    "NotFound",
+			args:    args{ctx: context.Background(), userID: 19, currency: domain.CurrencyUSD},
 			want:    nil,
 			wantErr: nil,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Select("id", "user_id", "balance").
-					From("accounts").
-					Where(goqu.Ex{"user_id": a.userID}).
-					Prepared(true).
-					ToSQL()
-
-				mock.ExpectQuery(query).
-					WithArgs(dbops.AnyToValue(args)...).
-					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "accounts" WHERE (("currency" = 'USD') AND ("user_id" = 19)) LIMIT 1`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "balance"}))
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
 			name: "Success",
-			args: args{
-				ctx:    context.Background(),
-				userID: 19,
-			},
+			args: args{ctx: context.Background(), userID: 19, currency: domain.CurrencyUSD},
 			want: &domain.Account{
-				ID:       20,
+				ID:       1,
 				UserID:   19,
-				Balanace: decimal.NewFromFloat32(100.17),
+				Currency: domain.CurrencyUSD,
+				Balanace: decimal.NewFromFloat(10.5),
 			},
 			wantErr: nil,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Select("id", "user_id", "balance").
-					From("accounts").
-					Where(goqu.Ex{"user_id": a.userID}).
-					Prepared(true).
-					ToSQL()
-
-				row := sqlmock.
-					NewRows([]string{"id", "user_id", "balance"}).
-					AddRow(20, 19, 100.17)
+				row := sqlmock.NewRows([]string{"id", "user_id", "currency", "balance"}).
+					AddRow(1, 19, "USD", "10.5")
 
-				mock.ExpectQuery(query).
-					WithArgs(dbops.AnyToValue(args)...).
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "accounts" WHERE (("currency" = 'USD') AND ("user_id" = 19)) LIMIT 1`)).
 					WillReturnRows(row)
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -178,139 +120,91 @@ func TestSQLPayment_FindAccountByUserID(t *testing.T) {
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
 
-			got, err := s.FindAccountByUserID(tt.args.ctx, tt.args.userID)
+			got, err := s.FindAccount(tt.args.ctx, tt.args.userID, tt.args.currency)
 			assert.Equal(t, tt.wantErr, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestSQLPayment_FindTopupByReferenceID(t *testing.T) {
-	type args struct {
-		ctx   context.Context
-		refID string
-	}
+func TestSQLPayment_LockAccount(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`SELECT id, user_id, currency, balance FROM accounts WHERE user_id = ? ` +
+		`AND currency = ? FOR UPDATE;`)
+	columns := []string{"id", "user_id", "currency", "balance"}
+
 	tests := []struct {
 		name    string
-		args    args
-		want    *domain.Topup
+		want    *domain.Account
 		wantErr error
-		mockFn  func(a args) (*SQLPayment, func() error)
+		mockFn  func() (*SQLPayment, func() error)
 	}{
 		{
-			name: "ErrorWhenQuery",
-			args: args{
-				ctx:   context.Background(),
-				refID: "uuid",
-			},
+			name:    "ErrorWhenQuery",
 			want:    nil,
 			wantErr: assert.AnError,
-			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Select("id", "transaction_id", "reference_id", "amount").
-					From("topups").
-					Where(goqu.Ex{"reference_id": a.refID}).
-					Prepared(true).
-					ToSQL()
-
-				mock.ExpectQuery(query).
-					WithArgs(dbops.AnyToValue(args)...).
-					WillReturnError(assert.AnError)
+				mock.ExpectQuery(query).WithArgs(uint64(19), domain.CurrencyUSD).WillReturnError(assert.AnError)
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name: "NotFound",
-			args: args{
-				ctx:   context.Background(),
-				refID: "uuid",
-			},
+			name:    "NotFound",
 			want:    nil,
 			wantErr: nil,
-			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Select("id", "transaction_id", "reference_id", "amount").
-					From("topups").
-					Where(goqu.Ex{"reference_id": a.refID}).
-					Prepared(true).
-					ToSQL()
+				mock.ExpectQuery(query).WithArgs(uint64(19), domain.CurrencyUSD).WillReturnRows(sqlmock.NewRows(columns))
 
-				mock.ExpectQuery(query).
-					WithArgs(dbops.AnyToValue(args)...).
-					WillReturnError(sql.ErrNoRows)
-
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
 			name: "Success",
-			args: args{
-				ctx:   context.Background(),
-				refID: "uuid",
-			},
-			want: &domain.Topup{
-				ID:            11,
-				TransactionID: 12,
-				ReferenceID:   "uuid",
-				Amount:        decimal.NewFromFloat32(234.457),
+			want: &domain.Account{
+				ID:       1,
+				UserID:   19,
+				Currency: domain.CurrencyUSD,
+				Balanace: decimal.NewFromFloat(10.5),
 			},
 			wantErr: nil,
-			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Select("id", "transaction_id", "reference_id", "amount").
-					From("topups").
-					Where(goqu.Ex{"reference_id": a.refID}).
-					Prepared(true).
-					ToSQL()
-
-				row := sqlmock.
-					NewRows([]string{"id", "transaction_id", "reference_id", "amount"}).
-					AddRow(11, 12, "uuid", 234.457)
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
 				mock.ExpectQuery(query).
-					WithArgs(dbops.AnyToValue(args)...).
-					WillReturnRows(row)
+					WithArgs(uint64(19), domain.CurrencyUSD).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 19, "USD", "10.5"))
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			s, dbMockCloser := tt.mockFn(tt.args)
+			s, dbMockCloser := tt.mockFn()
 			defer dbMockCloser()
 
-			got, err := s.FindTopupByReferenceID(tt.args.ctx, tt.args.refID)
+			got, err := s.LockAccount(context.Background(), 19, domain.CurrencyUSD)
 			assert.Equal(t, tt.wantErr, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestSQLPayment_SaveTopup(t *testing.T) {
+func TestSQLPayment_IncreaseAccountBalance(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`UPDATE accounts SET balance = balance + ? WHERE user_id = ? AND currency = ?;`)
+
 	type args struct {
-		ctx context.Context
-		t   domain.Topup
+		ctx      context.Context
+		userID   uint64
+		currency domain.Currency
+		amount   decimal.Decimal
 	}
 	tests := []struct {
 		name    string
@@ -320,101 +214,47 @@ func TestSQLPayment_SaveTopup(t *testing.T) {
 	}{
 		{
 			name: "ErrorWhenExec",
-			args: args{
-				ctx: context.Background(),
-				t: domain.Topup{
-					ID:            10,
-					TransactionID: 20,
-					ReferenceID:   "uuid",
-					Amount:        decimal.NewFromInt(100),
-				},
-			},
+			args: args{ctx: context.Background(), userID: 19, currency: domain.CurrencyUSD,
+				amount: decimal.NewFromInt(10)},
 			wantErr: assert.AnError,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Insert("topups").
-					Cols("id", "transaction_id", "reference_id", "amount").
-					Vals([]any{a.t.ID, a.t.TransactionID, a.t.ReferenceID, a.t.Amount}).
-					Prepared(true).
-					ToSQL()
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
 				mock.ExpectExec(query).
-					WithArgs(dbops.AnyToValue(args)...).
+					WithArgs(a.amount, a.userID, a.currency).
 					WillReturnError(assert.AnError)
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
 			name: "ErrorNoRowsAffected",
-			args: args{
-				ctx: context.Background(),
-				t: domain.Topup{
-					ID:            10,
-					TransactionID: 20,
-					ReferenceID:   "uuid",
-					Amount:        decimal.NewFromInt(100),
-				},
-			},
+			args: args{ctx: context.Background(), userID: 19, currency: domain.CurrencyUSD,
+				amount: decimal.NewFromInt(10)},
 			wantErr: domain.ErrAccountNoRowsAffected,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Insert("topups").
-					Cols("id", "transaction_id", "reference_id", "amount").
-					Vals([]any{a.t.ID, a.t.TransactionID, a.t.ReferenceID, a.t.Amount}).
-					Prepared(true).
-					ToSQL()
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
 				mock.ExpectExec(query).
-					WithArgs(dbops.AnyToValue(args)...).
+					WithArgs(a.amount, a.userID, a.currency).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
 			name: "Success",
-			args: args{
-				ctx: context.Background(),
-				t: domain.Topup{
-					ID:            10,
-					TransactionID: 20,
-					ReferenceID:   "uuid",
-					Amount:        decimal.NewFromInt(100),
-				},
-			},
+			args: args{ctx: context.Background(), userID: 19, currency: domain.CurrencyUSD,
+				amount: decimal.NewFromInt(10)},
 			wantErr: nil,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Insert("topups").
-					Cols("id", "transaction_id", "reference_id", "amount").
-					Vals([]any{a.t.ID, a.t.TransactionID, a.t.ReferenceID, a.t.Amount}).
-					Prepared(true).
-					ToSQL()
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
 				mock.ExpectExec(query).
-					WithArgs(dbops.AnyToValue(args)...).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+					WithArgs(a.amount, a.userID, a.currency).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -424,16 +264,87 @@ func TestSQLPayment_SaveTopup(t *testing.T) {
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
 
-			err := s.SaveTopup(tt.args.ctx, tt.args.t)
+			err := s.IncreaseAccountBalance(tt.args.ctx, tt.args.userID, tt.args.currency, tt.args.amount)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
 }
 
-func TestSQLPayment_SaveTransaction(t *testing.T) {
+func TestSQLPayment_CreditAccount(t *testing.T) {
+	tel := lib.NewTelemetry()
+	acc := domain.Account{ID: 30, UserID: 19, Currency: domain.CurrencyIDR, Balanace: decimal.NewFromInt(10)}
+	queryMySQL := regexp.QuoteMeta(`INSERT INTO accounts(id, user_id, currency, balance) VALUES(?, ?, ?, ?) ` +
+		`ON DUPLICATE KEY UPDATE balance = balance + VALUES(balance);`)
+	queryPostgres := regexp.QuoteMeta(`INSERT INTO accounts(id, user_id, currency, balance) ` +
+		`VALUES($1, $2, $3, $4) ON CONFLICT (user_id, currency) ` +
+		`DO UPDATE SET balance = accounts.balance + EXCLUDED.balance;`)
+
+	tests := []struct {
+		name    string
+		driver  string
+		query   string
+		result  driver.Result
+		err     error
+		wantErr error
+	}{
+		{
+			name:    "ErrorWhenExec",
+			driver:  "mysql",
+			query:   queryMySQL,
+			err:     assert.AnError,
+			wantErr: assert.AnError,
+		},
+		{
+			name:    "ErrorNoRowsAffected",
+			driver:  "mysql",
+			query:   queryMySQL,
+			result:  sqlmock.NewResult(0, 0),
+			wantErr: domain.ErrAccountNoRowsAffected,
+		},
+		{
+			name:    "SuccessMySQL",
+			driver:  "mysql",
+			query:   queryMySQL,
+			result:  sqlmock.NewResult(0, 2),
+			wantErr: nil,
+		},
+		{
+			name:    "SuccessPostgres",
+			driver:  sqlkit.PostgresDriver,
+			query:   queryPostgres,
+			result:  sqlmock.NewResult(0, 1),
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+			defer db.Close()
+
+			exp := mock.ExpectExec(tt.query).WithArgs(acc.ID, acc.UserID, acc.Currency, acc.Balanace)
+			if tt.err != nil {
+				exp.WillReturnError(tt.err)
+			} else {
+				exp.WillReturnResult(tt.result)
+			}
+
+			s := &SQLPayment{db: sqlkit.New(tt.driver, db, tel.Logger()), telemetry: tel}
+			err := s.CreditAccount(context.Background(), acc)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_DecreaseAccountBalance(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`UPDATE accounts SET balance = balance - ? WHERE user_id = ? AND currency = ? AND balance >= ?;`)
+
 	type args struct {
-		ctx context.Context
-		t   domain.Transaction
+		ctx      context.Context
+		userID   uint64
+		currency domain.Currency
+		amount   decimal.Decimal
 	}
 	tests := []struct {
 		name    string
@@ -443,134 +354,47 @@ func TestSQLPayment_SaveTransaction(t *testing.T) {
 	}{
 		{
 			name: "ErrorWhenExec",
-			args: args{
-				ctx: context.Background(),
-				t: domain.Transaction{
-					ID:       10,
-					UserID:   20,
-					Amount:   decimal.NewFromInt(100),
-					Type:     domain.TransactionTypeDebit,
-					Status:   domain.TransactionStatusPending,
-					Remark:   "remark",
-					CreateAt: time.Time{},
-				},
-			},
+			args: args{ctx: context.Background(), userID: 19, currency: domain.CurrencyUSD,
+				amount: decimal.NewFromInt(10)},
 			wantErr: assert.AnError,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Insert("transactions").
-					Cols("id", "user_id", "amount", "type", "status", "remark", "created_at").
-					Vals([]any{
-						a.t.ID,
-						a.t.UserID,
-						a.t.Amount,
-						a.t.Type,
-						a.t.Status,
-						a.t.Remark,
-						a.t.CreateAt,
-					}).
-					Prepared(true).
-					ToSQL()
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
 				mock.ExpectExec(query).
-					WithArgs(dbops.AnyToValue(args)...).
+					WithArgs(a.amount, a.userID, a.currency, a.amount).
 					WillReturnError(assert.AnError)
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
 			name: "ErrorNoRowsAffected",
-			args: args{
-				ctx: context.Background(),
-				t: domain.Transaction{
-					ID:       10,
-					UserID:   20,
-					Amount:   decimal.NewFromInt(100),
-					Type:     domain.TransactionTypeDebit,
-					Status:   domain.TransactionStatusPending,
-					Remark:   "remark",
-					CreateAt: time.Time{},
-				},
-			},
-			wantErr: domain.ErrTransactionNoRowsAffected,
+			args: args{ctx: context.Background(), userID: 19, currency: domain.CurrencyUSD,
+				amount: decimal.NewFromInt(10)},
+			wantErr: domain.ErrAccountNoRowsAffected,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Insert("transactions").
-					Cols("id", "user_id", "amount", "type", "status", "remark", "created_at").
-					Vals([]any{
-						a.t.ID,
-						a.t.UserID,
-						a.t.Amount,
-						a.t.Type,
-						a.t.Status,
-						a.t.Remark,
-						a.t.CreateAt,
-					}).
-					Prepared(true).
-					ToSQL()
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
 				mock.ExpectExec(query).
-					WithArgs(dbops.AnyToValue(args)...).
+					WithArgs(a.amount, a.userID, a.currency, a.amount).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
 			name: "Success",
-			args: args{
-				ctx: context.Background(),
-				t: domain.Transaction{
-					ID:       10,
-					UserID:   20,
-					Amount:   decimal.NewFromInt(100),
-					Type:     domain.TransactionTypeDebit,
-					Status:   domain.TransactionStatusPending,
-					Remark:   "remark",
-					CreateAt: time.Time{},
-				},
-			},
+			args: args{ctx: context.Background(), userID: 19, currency: domain.CurrencyUSD,
+				amount: decimal.NewFromInt(10)},
 			wantErr: nil,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Insert("transactions").
-					Cols("id", "user_id", "amount", "type", "status", "remark", "created_at").
-					Vals([]any{
-						a.t.ID,
-						a.t.UserID,
-						a.t.Amount,
-						a.t.Type,
-						a.t.Status,
-						a.t.Remark,
-						a.t.CreateAt,
-					}).
-					Prepared(true).
-					ToSQL()
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
 				mock.ExpectExec(query).
-					WithArgs(dbops.AnyToValue(args)...).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+					WithArgs(a.amount, a.userID, a.currency, a.amount).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -580,87 +404,255 @@ func TestSQLPayment_SaveTransaction(t *testing.T) {
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
 
-			err := s.SaveTransaction(tt.args.ctx, tt.args.t)
+			err := s.DecreaseAccountBalance(tt.args.ctx, tt.args.userID, tt.args.currency, tt.args.amount)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_UserExists(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE id = ?;`)
+
+	tests := []struct {
+		name    string
+		want    bool
+		wantErr error
+		mockFn  func() (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenQuery",
+			want:    false,
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WithArgs(uint64(12)).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "NotFound",
+			want:    false,
+			wantErr: nil,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).
+					WithArgs(uint64(12)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			want:    true,
+			wantErr: nil,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).
+					WithArgs(uint64(12)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			got, err := s.UserExists(context.Background(), 12)
 			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestSQLPayment_UpdateAccount(t *testing.T) {
+func TestSQLPayment_FindTopupByReferenceID(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`FROM "topups" WHERE ("reference_id" = 'ref') LIMIT 1`)
+
 	type args struct {
-		ctx  context.Context
-		data map[string]any
+		ctx   context.Context
+		refID string
 	}
 	tests := []struct {
 		name    string
 		args    args
+		want    *domain.Topup
 		wantErr error
 		mockFn  func(a args) (*SQLPayment, func() error)
 	}{
 		{
-			name: "ErrorWhenExec",
-			args: args{
-				ctx: context.Background(),
-				data: map[string]any{
-					"id":      uint64(11),
-					"balance": decimal.NewFromFloat(100),
-				},
-			},
+			name:    "ErrorWhenQuery",
+			args:    args{ctx: context.Background(), refID: "ref"},
+			want:    nil,
 			wantErr: assert.AnError,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			args:    args{ctx: context.Background(), refID: "ref"},
+			want:    &domain.Topup{ID: 1, TransactionID: 2, ReferenceID: "ref", Amount: decimal.NewFromInt(100)},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				row := sqlmock.NewRows([]string{"id", "transaction_id", "reference_id", "amount"}).
+					AddRow(1, 2, "ref", "100")
+
+				mock.ExpectQuery(query).WillReturnRows(row)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			got, err := s.FindTopupByReferenceID(tt.args.ctx, tt.args.refID)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLPayment_SaveTopup(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`INSERT INTO topups(id, transaction_id, reference_id, amount) VALUES(?, ?, ?, ?);`)
 
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Update("accounts").
-					Set(map[string]any{
-						"balance": decimal.NewFromFloat(100),
-					}).
-					Where(goqu.Ex{"id": uint64(11)}).
-					Prepared(true).
-					ToSQL()
+	type args struct {
+		ctx context.Context
+		t   domain.Topup
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), t: domain.Topup{ID: 1}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
 				mock.ExpectExec(query).
-					WithArgs(dbops.AnyToValue(args)...).
+					WithArgs(a.t.ID, a.t.TransactionID, a.t.ReferenceID, a.t.Amount).
 					WillReturnError(assert.AnError)
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name: "Success",
-			args: args{
-				ctx: context.Background(),
-				data: map[string]any{
-					"id":      uint64(11),
-					"balance": decimal.NewFromFloat(100),
-				},
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), t: domain.Topup{ID: 1}},
+			wantErr: domain.ErrTopupNoRowsAffected,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs(a.t.ID, a.t.TransactionID, a.t.ReferenceID, a.t.Amount).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
+		},
+		{
+			name:    "Success",
+			args:    args{ctx: context.Background(), t: domain.Topup{ID: 1}},
 			wantErr: nil,
 			mockFn: func(a args) (*SQLPayment, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.MySQLDriver).
-					Update("accounts").
-					Set(map[string]any{
-						"balance": decimal.NewFromFloat(100),
-					}).
-					Where(goqu.Ex{"id": uint64(11)}).
-					Prepared(true).
-					ToSQL()
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
 				mock.ExpectExec(query).
-					WithArgs(dbops.AnyToValue(args)...).
+					WithArgs(a.t.ID, a.t.TransactionID, a.t.ReferenceID, a.t.Amount).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
-				return &SQLPayment{
-					db:        db,
-					qu:        goqu.Dialect(dbops.MySQLDriver),
-					telemetry: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.SaveTopup(tt.args.ctx, tt.args.t)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_FindTransactionByID(t *testing.T) {
+	tel := lib.NewTelemetry()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := regexp.QuoteMeta(`FROM "transactions" WHERE ("id" = 2) LIMIT 1`)
+
+	type args struct {
+		ctx context.Context
+		id  uint64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.Transaction
+		wantErr error
+		mockFn  func(a args) (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenQuery",
+			args:    args{ctx: context.Background(), id: 2},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			args: args{ctx: context.Background(), id: 2},
+			want: &domain.Transaction{
+				ID:       2,
+				UserID:   19,
+				Amount:   decimal.NewFromInt(100),
+				Type:     enum.New(domain.TransactionTypeCredit),
+				Status:   enum.New(domain.TransactionStatusPending),
+				Remark:   "topup",
+				CreateAt: now,
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				row := sqlmock.
+					NewRows([]string{"id", "user_id", "amount", "type", "status", "remark", "created_at"}).
+					AddRow(2, 19, "100", "CREDIT", "PENDING", "topup", now)
+
+				mock.ExpectQuery(query).WillReturnRows(row)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -670,7 +662,827 @@ func TestSQLPayment_UpdateAccount(t *testing.T) {
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
 
-			err := s.UpdateAccount(tt.args.ctx, tt.args.data)
+			got, err := s.FindTransactionByID(tt.args.ctx, tt.args.id)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLPayment_SaveAccount(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`INSERT INTO accounts(id, user_id, currency, balance) VALUES(?, ?, ?, ?);`)
+
+	type args struct {
+		ctx context.Context
+		t   domain.Account
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), t: domain.Account{ID: 1}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), t: domain.Account{ID: 1}},
+			wantErr: domain.ErrAccountNoRowsAffected,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			args:    args{ctx: context.Background(), t: domain.Account{ID: 1}},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.SaveAccount(tt.args.ctx, tt.args.t)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_SaveTransaction(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`INSERT INTO transactions(id, user_id, amount, currency, type, status, remark, created_at)`)
+
+	type args struct {
+		ctx context.Context
+		t   domain.Transaction
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), t: domain.Transaction{ID: 1}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), t: domain.Transaction{ID: 1}},
+			wantErr: domain.ErrTransactionNoRowsAffected,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			args:    args{ctx: context.Background(), t: domain.Transaction{ID: 1}},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.SaveTransaction(tt.args.ctx, tt.args.t)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_UpdateTransactionStatus(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`UPDATE transactions SET status = ? WHERE id = ? AND status = ?;`)
+
+	type args struct {
+		ctx  context.Context
+		id   uint64
+		from domain.TransactionStatus
+		to   domain.TransactionStatus
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLPayment, func() error)
+	}{
+		{
+			name: "ErrorWhenExec",
+			args: args{
+				ctx:  context.Background(),
+				id:   2,
+				from: domain.TransactionStatusPending,
+				to:   domain.TransactionStatusSuccess,
+			},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs("SUCCESS", a.id, "PENDING").
+					WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "ErrorAlreadyMoved",
+			args: args{
+				ctx:  context.Background(),
+				id:   2,
+				from: domain.TransactionStatusPending,
+				to:   domain.TransactionStatusSuccess,
+			},
+			wantErr: domain.ErrTransactionNoRowsAffected,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs("SUCCESS", a.id, "PENDING").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx:  context.Background(),
+				id:   2,
+				from: domain.TransactionStatusPending,
+				to:   domain.TransactionStatusSuccess,
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs("SUCCESS", a.id, "PENDING").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.UpdateTransactionStatus(tt.args.ctx, tt.args.id, tt.args.from, tt.args.to)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_SaveTransfer(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`INSERT INTO transfers(id, transaction_id, sender_id, recipient_id, amount, currency,`)
+
+	type args struct {
+		ctx context.Context
+		t   domain.Transfer
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), t: domain.Transfer{ID: 1}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), t: domain.Transfer{ID: 1}},
+			wantErr: domain.ErrTransferNoRowsAffected,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			args:    args{ctx: context.Background(), t: domain.Transfer{ID: 1}},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.SaveTransfer(tt.args.ctx, tt.args.t)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_FindExchangeRate(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(
+		`FROM "exchange_rates" WHERE (("base_currency" = 'USD') AND ("quote_currency" = 'IDR')) LIMIT 1`)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		ctx   context.Context
+		base  domain.Currency
+		quote domain.Currency
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.ExchangeRate
+		wantErr error
+		mockFn  func(a args) (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenQuery",
+			args:    args{ctx: context.Background(), base: domain.CurrencyUSD, quote: domain.CurrencyIDR},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "NotFound",
+			args:    args{ctx: context.Background(), base: domain.CurrencyUSD, quote: domain.CurrencyIDR},
+			want:    nil,
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"base_currency"}))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			args: args{ctx: context.Background(), base: domain.CurrencyUSD, quote: domain.CurrencyIDR},
+			want: &domain.ExchangeRate{
+				Base:      domain.CurrencyUSD,
+				Quote:     domain.CurrencyIDR,
+				Rate:      decimal.RequireFromString("16266.75"),
+				UpdatedAt: now,
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				row := sqlmock.NewRows([]string{"base_currency", "quote_currency", "rate", "updated_at"}).
+					AddRow("USD", "IDR", "16266.75", now)
+
+				mock.ExpectQuery(query).WillReturnRows(row)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			got, err := s.FindExchangeRate(tt.args.ctx, tt.args.base, tt.args.quote)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLPayment_SumDebitAmount(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := func(table string) string {
+		return regexp.QuoteMeta(`SELECT COALESCE(SUM(t.amount), 0) AS total FROM transactions t
+	JOIN ` + table + ` o ON o.transaction_id = t.id
+	WHERE t.user_id = ? AND t.currency = ? AND t.type = ? AND t.status <> ? AND t.created_at >= ?;`)
+	}
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		op      domain.RiskOperation
+		want    decimal.Decimal
+		wantErr error
+		mockFn  func() (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorOperationNotSupported",
+			op:      domain.RiskOperation("BILL"),
+			want:    decimal.Zero,
+			wantErr: domain.ErrRiskOperationNotSupported,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, _, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorWhenQuery",
+			op:      domain.RiskOperationTransfer,
+			want:    decimal.Zero,
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query("transfers")).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			// the pending topups of the user are counted, its transfers are not
+			name:    "SuccessTopup",
+			op:      domain.RiskOperationTopup,
+			want:    decimal.RequireFromString("500"),
+			wantErr: nil,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query("topups")).
+					WithArgs(uint64(11), "USD", "DEBIT", "FAILED", since).
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow("500"))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			// the transfers of the user are counted, its topups are not
+			name:    "SuccessTransfer",
+			op:      domain.RiskOperationTransfer,
+			want:    decimal.RequireFromString("150.25"),
+			wantErr: nil,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query("transfers")).
+					WithArgs(uint64(11), "USD", "DEBIT", "FAILED", since).
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow("150.25"))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			got, err := s.SumDebitAmount(context.Background(), 11, tt.op, domain.CurrencyUSD, since)
+			assert.Equal(t, tt.wantErr, err)
+			assert.True(t, tt.want.Equal(got))
+		})
+	}
+}
+
+func TestSQLPayment_SaveRiskDecision(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`INSERT INTO risk_decisions(id, user_id, operation, amount, currency, allowed, rule, reason, created_at)`)
+
+	type args struct {
+		ctx context.Context
+		t   domain.RiskDecision
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), t: domain.RiskDecision{ID: 1}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), t: domain.RiskDecision{ID: 1}},
+			wantErr: domain.ErrRiskDecisionNoRowsAffected,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			args:    args{ctx: context.Background(), t: domain.RiskDecision{ID: 1}},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.SaveRiskDecision(tt.args.ctx, tt.args.t)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_SaveScheduledPayment(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`INSERT INTO scheduled_payments(id, user_id, recipient_id, amount, currency, target_currency,`)
+
+	type args struct {
+		ctx context.Context
+		sp  domain.ScheduledPayment
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), sp: domain.ScheduledPayment{ID: 1}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), sp: domain.ScheduledPayment{ID: 1}},
+			wantErr: domain.ErrScheduledPaymentNoRowsAffected,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			args:    args{ctx: context.Background(), sp: domain.ScheduledPayment{ID: 1}},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.SaveScheduledPayment(tt.args.ctx, tt.args.sp)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_FindDueScheduledPayments(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`SELECT id, user_id, recipient_id, amount, currency, target_currency, schedule, next_run_at,`)
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	columns := []string{
+		"id", "user_id", "recipient_id", "amount", "currency", "target_currency", "schedule", "next_run_at",
+		"active", "attempts", "lease_owner", "lease_until", "created_at",
+	}
+
+	tests := []struct {
+		name    string
+		want    []domain.ScheduledPayment
+		wantErr error
+		mockFn  func() (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenQuery",
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			want: []domain.ScheduledPayment{{
+				ID:             1,
+				UserID:         11,
+				RecipientID:    12,
+				Amount:         decimal.NewFromInt(10),
+				Currency:       domain.CurrencyUSD,
+				TargetCurrency: domain.CurrencyUSD,
+				Schedule:       "@daily",
+				NextRunAt:      now,
+				Active:         true,
+				CreatedAt:      now,
+			}},
+			wantErr: nil,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).
+					WithArgs(true, now, now, 10).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(1, 11, 12, "10", "USD", "USD", "@daily", now, true, 0, "", nil, now))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			got, err := s.FindDueScheduledPayments(context.Background(), now, 10)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, len(tt.want), len(got))
+			for i := range tt.want {
+				assert.True(t, tt.want[i].Amount.Equal(got[i].Amount))
+				got[i].Amount = tt.want[i].Amount
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLPayment_AcquireScheduledPayment(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`UPDATE scheduled_payments SET lease_owner = ?, lease_until = ?`)
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	until := now.Add(time.Minute)
+
+	tests := []struct {
+		name    string
+		want    bool
+		wantErr error
+		mockFn  func() (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			want:    false,
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "LeasedByOther",
+			want:    false,
+			wantErr: nil,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			want:    true,
+			wantErr: nil,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs("runner-1", until, uint64(1), true, now, now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			got, err := s.AcquireScheduledPayment(context.Background(), 1, "runner-1", now, until)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLPayment_ReleaseScheduledPayment(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`UPDATE scheduled_payments SET next_run_at = ?, active = ?, attempts = ?, lease_owner = ''`)
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	sp := domain.ScheduledPayment{ID: 1, NextRunAt: now, Active: true, Attempts: 2}
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mockFn  func() (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorLeaseLost",
+			wantErr: domain.ErrScheduledPaymentNoRowsAffected,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			wantErr: nil,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs(now, true, 2, uint64(1), "runner-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			err := s.ReleaseScheduledPayment(context.Background(), sp, "runner-1")
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLPayment_SaveScheduledPaymentRun(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`INSERT INTO scheduled_payment_runs(id, schedule_id, attempt, status, transfer_id, error, run_at)`)
+	run := domain.ScheduledPaymentRun{ID: 1, ScheduleID: 2, Status: enum.New(domain.ScheduledRunStatusSuccess)}
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mockFn  func() (*SQLPayment, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnError(assert.AnError)
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorNoRowsAffected",
+			wantErr: domain.ErrScheduledPaymentRunNoRowsAffected,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			wantErr: nil,
+			mockFn: func() (*SQLPayment, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs(uint64(1), uint64(2), 0, "SUCCESS", uint64(0), "", time.Time{}).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				return &SQLPayment{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			err := s.SaveScheduledPaymentRun(context.Background(), run)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
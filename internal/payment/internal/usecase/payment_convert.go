@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+)
+
+type PaymentConvertStore interface {
+	FindExchangeRate(ctx context.Context, base, quote domain.Currency) (*domain.ExchangeRate, error)
+}
+
+type PaymentConvert struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	store     PaymentConvertStore
+}
+
+func NewPaymentConvert(dep Dependency, s PaymentConvertStore) *PaymentConvert {
+	return &PaymentConvert{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		store:     s,
+	}
+}
+
+func (pc *PaymentConvert) Call(ctx context.Context, in domain.PaymentConvertInput) (
+	*domain.PaymentConvertOutput, error,
+) {
+	ctx, span := pc.telemetry.Tracer().Start(ctx, "payment.usecase.PaymentConvert")
+	defer span.End()
+
+	if err := pc.validator.Validate(in); err != nil {
+		pc.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	from, err := domain.ParseCurrency(in.From)
+	if err != nil {
+		pc.telemetry.Logger().Warn(ctx, "currency not supported", logger.KeyVal("currency", in.From))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	to, err := domain.ParseCurrency(in.To)
+	if err != nil {
+		pc.telemetry.Logger().Warn(ctx, "currency not supported", logger.KeyVal("currency", in.To))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	if err := from.ValidateAmount(in.Amount); err != nil {
+		pc.telemetry.Logger().Warn(ctx, "invalid convert amount", logger.KeyVal("currency", from))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	rate, err := lookupRate(ctx, pc.store, from, to)
+	if err != nil {
+		pc.telemetry.Logger().Error(ctx, "failed to find exchange rate", err,
+			logger.KeyVal("from", from), logger.KeyVal("to", to))
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if rate == nil {
+		pc.telemetry.Logger().Warn(ctx, "exchange rate is not found", logger.KeyVal("from", from),
+			logger.KeyVal("to", to))
+
+		return nil, goerror.NewBusiness("exchange rate not found", goerror.CodeNotFound)
+	}
+
+	return &domain.PaymentConvertOutput{
+		From:      from,
+		To:        to,
+		Amount:    in.Amount,
+		Converted: rate.Convert(in.Amount),
+		Rate:      rate.Rate,
+	}, nil
+}
+
+// lookupRate returns the rate for converting base into quote. Only one
+// direction of a pair has to be stored; the other one is derived from it.
+// It returns nil when neither direction is known.
+func lookupRate(ctx context.Context, s PaymentConvertStore, base, quote domain.Currency) (
+	*domain.ExchangeRate, error,
+) {
+	if base == quote {
+		rate := domain.SameCurrencyRate(base)
+
+		return &rate, nil
+	}
+
+	rate, err := s.FindExchangeRate(ctx, base, quote)
+	if err != nil || rate != nil {
+		return rate, err
+	}
+
+	rate, err = s.FindExchangeRate(ctx, quote, base)
+	if err != nil || rate == nil {
+		return nil, err
+	}
+
+	inv := rate.Inverse()
+
+	return &inv, nil
+}
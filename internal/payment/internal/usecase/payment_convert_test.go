@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/mockz"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaymentConvert(t *testing.T) {
+	tests := []struct {
+		name string
+		dep  Dependency
+		s    PaymentConvertStore
+		want *PaymentConvert
+	}{
+		{
+			name: "Success",
+			dep:  Dependency{},
+			s:    nil,
+			want: &PaymentConvert{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewPaymentConvert(tt.dep, tt.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPaymentConvert_Call(t *testing.T) {
+	usdIDR := &domain.ExchangeRate{
+		Base:  domain.CurrencyUSD,
+		Quote: domain.CurrencyIDR,
+		Rate:  decimal.RequireFromString("16266.75"),
+	}
+
+	type args struct {
+		ctx context.Context
+		in  domain.PaymentConvertInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.PaymentConvertOutput
+		wantErr error
+		mockFn  func(a args) *PaymentConvert
+	}{
+		{
+			name:    "ErrorValidationInput",
+			args:    args{ctx: context.Background(), in: domain.PaymentConvertInput{}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *PaymentConvert {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(assert.AnError)
+
+				return &PaymentConvert{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorFromNotSupported",
+			args: args{ctx: context.Background(), in: domain.PaymentConvertInput{
+				From: "XXX", To: "IDR", Amount: decimal.NewFromInt(10),
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrCurrencyNotSupported),
+			mockFn: func(a args) *PaymentConvert {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentConvert{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorToNotSupported",
+			args: args{ctx: context.Background(), in: domain.PaymentConvertInput{
+				From: "USD", To: "XXX", Amount: decimal.NewFromInt(10),
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrCurrencyNotSupported),
+			mockFn: func(a args) *PaymentConvert {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentConvert{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorAmountNotPositive",
+			args: args{ctx: context.Background(), in: domain.PaymentConvertInput{
+				From: "USD", To: "IDR", Amount: decimal.NewFromInt(-1),
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrAmountNotPositive),
+			mockFn: func(a args) *PaymentConvert {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentConvert{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFindExchangeRate",
+			args: args{ctx: context.Background(), in: domain.PaymentConvertInput{
+				From: "USD", To: "IDR", Amount: decimal.NewFromInt(10),
+			}},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *PaymentConvert {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentConvertStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentConvert")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(nil, assert.AnError)
+
+				return &PaymentConvert{
+					telemetry: tel,
+					validator: validatorMock,
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "ErrorExchangeRateNotFound",
+			args: args{ctx: context.Background(), in: domain.PaymentConvertInput{
+				From: "USD", To: "IDR", Amount: decimal.NewFromInt(10),
+			}},
+			want:    nil,
+			wantErr: goerror.NewBusiness("exchange rate not found", goerror.CodeNotFound),
+			mockFn: func(a args) *PaymentConvert {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentConvertStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentConvert")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(nil, nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyIDR, domain.CurrencyUSD).
+					Return(nil, nil)
+
+				return &PaymentConvert{
+					telemetry: tel,
+					validator: validatorMock,
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "SuccessSameCurrency",
+			args: args{ctx: context.Background(), in: domain.PaymentConvertInput{
+				From: "usd", To: "USD", Amount: decimal.NewFromInt(10),
+			}},
+			want: &domain.PaymentConvertOutput{
+				From:      domain.CurrencyUSD,
+				To:        domain.CurrencyUSD,
+				Amount:    decimal.NewFromInt(10),
+				Converted: decimal.NewFromInt(10),
+				Rate:      decimal.NewFromInt(1),
+			},
+			wantErr: nil,
+			mockFn: func(a args) *PaymentConvert {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentConvert{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "SuccessDirectRate",
+			args: args{ctx: context.Background(), in: domain.PaymentConvertInput{
+				From: "USD", To: "IDR", Amount: decimal.NewFromInt(10),
+			}},
+			want: &domain.PaymentConvertOutput{
+				From:      domain.CurrencyUSD,
+				To:        domain.CurrencyIDR,
+				Amount:    decimal.NewFromInt(10),
+				Converted: decimal.RequireFromString("162667.5"),
+				Rate:      usdIDR.Rate,
+			},
+			wantErr: nil,
+			mockFn: func(a args) *PaymentConvert {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentConvertStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentConvert")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(usdIDR, nil)
+
+				return &PaymentConvert{
+					telemetry: tel,
+					validator: validatorMock,
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "SuccessInverseRate",
+			args: args{ctx: context.Background(), in: domain.PaymentConvertInput{
+				From: "IDR", To: "USD", Amount: decimal.NewFromInt(162667),
+			}},
+			want: &domain.PaymentConvertOutput{
+				From:      domain.CurrencyIDR,
+				To:        domain.CurrencyUSD,
+				Amount:    decimal.NewFromInt(162667),
+				Converted: decimal.RequireFromString("10"),
+				Rate:      decimal.RequireFromString("0.0000614751"),
+			},
+			wantErr: nil,
+			mockFn: func(a args) *PaymentConvert {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentConvertStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentConvert")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyIDR, domain.CurrencyUSD).
+					Return(nil, nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(usdIDR, nil)
+
+				return &PaymentConvert{
+					telemetry: tel,
+					validator: validatorMock,
+					store:     storeMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+
+				return
+			}
+			assert.Equal(t, tt.want.From, got.From)
+			assert.Equal(t, tt.want.To, got.To)
+			assert.True(t, tt.want.Amount.Equal(got.Amount))
+			assert.True(t, tt.want.Converted.Equal(got.Converted), got.Converted.String())
+			assert.True(t, tt.want.Rate.Equal(got.Rate), got.Rate.String())
+		})
+	}
+}
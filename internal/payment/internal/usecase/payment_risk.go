@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/config"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/shandysiswandi/goreng/uid"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/shopspring/decimal"
+)
+
+// RiskChecker decides whether a money movement may go ahead. A debit is checked
+// in its transaction, once the account is locked, so the totals it reads can not
+// change before the debit is written.
+type RiskChecker interface {
+	Check(ctx context.Context, in domain.RiskInput) error
+}
+
+type RiskStore interface {
+	SumDebitAmount(ctx context.Context, userID uint64, op domain.RiskOperation, currency domain.Currency,
+		since time.Time) (decimal.Decimal, error)
+	SaveRiskDecision(ctx context.Context, d domain.RiskDecision) error
+}
+
+// RiskEngine evaluates the configured risk rules and records every decision.
+//
+// Rules are read from config once at start up:
+//
+//	payment.risk.blocked.users: "1,2"        # comma separated user ids
+//	payment.risk.limit.<currency>.min: 10000 # per transaction minimum
+//	payment.risk.limit.<currency>.max: 1e8   # per transaction maximum
+//	payment.risk.limit.<currency>.daily      # total per calendar day
+//	payment.risk.limit.<currency>.monthly    # total per calendar month
+//
+// A limit that is not set does not apply to that currency. The totals are kept
+// per operation, so topups and transfers each have their own daily and monthly limit.
+type RiskEngine struct {
+	telemetry *lib.Telemetry
+	uidnumber uid.NumberID
+	clock     clock.Clocker
+	rules     []domain.RiskRule
+	store     RiskStore
+}
+
+func NewRiskEngine(dep Dependency, s RiskStore) *RiskEngine {
+	return &RiskEngine{
+		telemetry: dep.Telemetry,
+		uidnumber: dep.UIDNumber,
+		clock:     dep.Clock,
+		rules:     riskRulesFromConfig(dep.Config),
+		store:     s,
+	}
+}
+
+func riskRulesFromConfig(cfg config.Config) []domain.RiskRule {
+	if cfg == nil {
+		return nil
+	}
+
+	blocked := map[uint64]struct{}{}
+	for _, v := range strings.Split(cfg.GetString("payment.risk.blocked.users"), ",") {
+		if id, err := strconv.ParseUint(strings.TrimSpace(v), 10, 64); err == nil {
+			blocked[id] = struct{}{}
+		}
+	}
+
+	limits := func(kind string) domain.RiskLimits {
+		out := domain.RiskLimits{}
+		for _, c := range domain.Currencies() {
+			key := "payment.risk.limit." + strings.ToLower(c.String()) + "." + kind
+			if v, err := decimal.NewFromString(cfg.GetString(key)); err == nil {
+				out[c] = v
+			}
+		}
+
+		return out
+	}
+
+	return []domain.RiskRule{
+		domain.BlockedUserRule{UserIDs: blocked},
+		domain.MinAmountRule{Limits: limits("min")},
+		domain.MaxAmountRule{Limits: limits("max")},
+		domain.DailyLimitRule{Limits: limits("daily")},
+		domain.MonthlyLimitRule{Limits: limits("monthly")},
+	}
+}
+
+func (re *RiskEngine) Check(ctx context.Context, in domain.RiskInput) error {
+	ctx, span := re.telemetry.Tracer().Start(ctx, "payment.usecase.RiskEngine.Check")
+	defer span.End()
+
+	now := re.clock.Now()
+	y, m, d := now.Date()
+	startOfDay := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	startOfMonth := time.Date(y, m, 1, 0, 0, 0, 0, now.Location())
+
+	var err error
+	in.DailyTotal, err = re.store.SumDebitAmount(ctx, in.UserID, in.Operation, in.Currency, startOfDay)
+	if err != nil {
+		re.telemetry.Logger().Error(ctx, "failed to sum daily amount", err, logger.KeyVal("user_id", in.UserID))
+
+		return goerror.NewServerInternal(err)
+	}
+
+	in.MonthlyTotal, err = re.store.SumDebitAmount(ctx, in.UserID, in.Operation, in.Currency, startOfMonth)
+	if err != nil {
+		re.telemetry.Logger().Error(ctx, "failed to sum monthly amount", err, logger.KeyVal("user_id", in.UserID))
+
+		return goerror.NewServerInternal(err)
+	}
+
+	decision := domain.EvaluateRisk(re.rules, in)
+	decision.ID = re.uidnumber.Generate()
+	decision.CreatedAt = now
+
+	// a rejection fails the transaction of the payment, its decision is kept
+	if err := re.store.SaveRiskDecision(sqlkit.WithoutTx(ctx), decision); err != nil {
+		re.telemetry.Logger().Error(ctx, "failed to save risk decision", err,
+			logger.KeyVal("risk_decision", decision))
+
+		return goerror.NewServerInternal(err)
+	}
+
+	if !decision.Allowed {
+		re.telemetry.Logger().Warn(ctx, "payment rejected by risk rule", logger.KeyVal("rule", decision.Rule),
+			logger.KeyVal("user_id", in.UserID))
+
+		return goerror.NewBusiness(decision.Reason, goerror.CodeForbidden)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/mockz"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewRiskEngine(t *testing.T) {
+	configMock := mocker.NewMockConfig(t)
+	configMock.EXPECT().GetString("payment.risk.blocked.users").Return("7, x,9")
+	configMock.EXPECT().GetString("payment.risk.limit.usd.max").Return("1000")
+	configMock.EXPECT().GetString("payment.risk.limit.idr.daily").Return("5000000")
+	configMock.EXPECT().GetString(mock.Anything).Return("")
+
+	got := NewRiskEngine(Dependency{Config: configMock}, nil)
+
+	want := []domain.RiskRule{
+		domain.BlockedUserRule{UserIDs: map[uint64]struct{}{7: {}, 9: {}}},
+		domain.MinAmountRule{Limits: domain.RiskLimits{}},
+		domain.MaxAmountRule{Limits: domain.RiskLimits{domain.CurrencyUSD: decimal.NewFromInt(1000)}},
+		domain.DailyLimitRule{Limits: domain.RiskLimits{domain.CurrencyIDR: decimal.NewFromInt(5000000)}},
+		domain.MonthlyLimitRule{Limits: domain.RiskLimits{}},
+	}
+	assert.Equal(t, want, got.rules)
+	assert.Empty(t, NewRiskEngine(Dependency{}, nil).rules)
+}
+
+func TestRiskEngine_Check(t *testing.T) {
+	now := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	startOfDay := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	startOfMonth := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	rules := []domain.RiskRule{
+		domain.DailyLimitRule{Limits: domain.RiskLimits{domain.CurrencyUSD: decimal.NewFromInt(100)}},
+	}
+	input := domain.RiskInput{
+		UserID:    11,
+		Operation: domain.RiskOperationTransfer,
+		Amount:    decimal.NewFromInt(40),
+		Currency:  domain.CurrencyUSD,
+	}
+
+	tests := []struct {
+		name    string
+		in      domain.RiskInput
+		wantErr error
+		mockFn  func(ctx context.Context) *RiskEngine
+	}{
+		{
+			name:    "ErrorSumDaily",
+			in:      input,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(ctx context.Context) *RiskEngine {
+				tel := lib.NewTelemetry()
+				clk := mocker.NewMockClocker(t)
+				storeMock := mockz.NewMockRiskStore(t)
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.usecase.RiskEngine.Check")
+				defer span.End()
+
+				clk.EXPECT().Now().Return(now)
+				storeMock.EXPECT().
+					SumDebitAmount(ctx, uint64(11), domain.RiskOperationTransfer, domain.CurrencyUSD, startOfDay).
+					Return(decimal.Zero, assert.AnError)
+
+				return &RiskEngine{telemetry: tel, clock: clk, rules: rules, store: storeMock}
+			},
+		},
+		{
+			name:    "ErrorSumMonthly",
+			in:      input,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(ctx context.Context) *RiskEngine {
+				tel := lib.NewTelemetry()
+				clk := mocker.NewMockClocker(t)
+				storeMock := mockz.NewMockRiskStore(t)
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.usecase.RiskEngine.Check")
+				defer span.End()
+
+				clk.EXPECT().Now().Return(now)
+				storeMock.EXPECT().
+					SumDebitAmount(ctx, uint64(11), domain.RiskOperationTransfer, domain.CurrencyUSD, startOfDay).
+					Return(decimal.Zero, nil)
+				storeMock.EXPECT().
+					SumDebitAmount(ctx, uint64(11), domain.RiskOperationTransfer, domain.CurrencyUSD, startOfMonth).
+					Return(decimal.Zero, assert.AnError)
+
+				return &RiskEngine{telemetry: tel, clock: clk, rules: rules, store: storeMock}
+			},
+		},
+		{
+			name:    "ErrorSaveRiskDecision",
+			in:      input,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(ctx context.Context) *RiskEngine {
+				tel := lib.NewTelemetry()
+				clk := mocker.NewMockClocker(t)
+				muid := mocker.NewMockNumberID(t)
+				storeMock := mockz.NewMockRiskStore(t)
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.usecase.RiskEngine.Check")
+				defer span.End()
+
+				clk.EXPECT().Now().Return(now)
+				muid.EXPECT().Generate().Return(5)
+				storeMock.EXPECT().
+					SumDebitAmount(ctx, uint64(11), domain.RiskOperationTransfer, domain.CurrencyUSD, startOfDay).
+					Return(decimal.Zero, nil)
+				storeMock.EXPECT().
+					SumDebitAmount(ctx, uint64(11), domain.RiskOperationTransfer, domain.CurrencyUSD, startOfMonth).
+					Return(decimal.Zero, nil)
+				storeMock.EXPECT().
+					SaveRiskDecision(ctx, mock.Anything).
+					Return(assert.AnError)
+
+				return &RiskEngine{telemetry: tel, clock: clk, uidnumber: muid, rules: rules, store: storeMock}
+			},
+		},
+		{
+			name:    "Rejected",
+			in:      input,
+			wantErr: goerror.NewBusiness("daily limit of 100 USD exceeded", goerror.CodeForbidden),
+			mockFn: func(ctx context.Context) *RiskEngine {
+				tel := lib.NewTelemetry()
+				clk := mocker.NewMockClocker(t)
+				muid := mocker.NewMockNumberID(t)
+				storeMock := mockz.NewMockRiskStore(t)
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.usecase.RiskEngine.Check")
+				defer span.End()
+
+				clk.EXPECT().Now().Return(now)
+				muid.EXPECT().Generate().Return(5)
+				storeMock.EXPECT().
+					SumDebitAmount(ctx, uint64(11), domain.RiskOperationTransfer, domain.CurrencyUSD, startOfDay).
+					Return(decimal.NewFromInt(70), nil)
+				storeMock.EXPECT().
+					SumDebitAmount(ctx, uint64(11), domain.RiskOperationTransfer, domain.CurrencyUSD, startOfMonth).
+					Return(decimal.NewFromInt(70), nil)
+				storeMock.EXPECT().
+					SaveRiskDecision(ctx, domain.RiskDecision{
+						ID:        5,
+						UserID:    11,
+						Operation: domain.RiskOperationTransfer,
+						Amount:    decimal.NewFromInt(40),
+						Currency:  domain.CurrencyUSD,
+						Allowed:   false,
+						Rule:      "daily_limit",
+						Reason:    "daily limit of 100 USD exceeded",
+						CreatedAt: now,
+					}).
+					Return(nil)
+
+				return &RiskEngine{telemetry: tel, clock: clk, uidnumber: muid, rules: rules, store: storeMock}
+			},
+		},
+		{
+			name:    "Allowed",
+			in:      input,
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *RiskEngine {
+				tel := lib.NewTelemetry()
+				clk := mocker.NewMockClocker(t)
+				muid := mocker.NewMockNumberID(t)
+				storeMock := mockz.NewMockRiskStore(t)
+
+				ctx, span := tel.Tracer().Start(ctx, "payment.usecase.RiskEngine.Check")
+				defer span.End()
+
+				clk.EXPECT().Now().Return(now)
+				muid.EXPECT().Generate().Return(5)
+				storeMock.EXPECT().
+					SumDebitAmount(ctx, uint64(11), domain.RiskOperationTransfer, domain.CurrencyUSD, startOfDay).
+					Return(decimal.NewFromInt(60), nil)
+				storeMock.EXPECT().
+					SumDebitAmount(ctx, uint64(11), domain.RiskOperationTransfer, domain.CurrencyUSD, startOfMonth).
+					Return(decimal.NewFromInt(60), nil)
+				storeMock.EXPECT().
+					SaveRiskDecision(ctx, domain.RiskDecision{
+						ID:        5,
+						UserID:    11,
+						Operation: domain.RiskOperationTransfer,
+						Amount:    decimal.NewFromInt(40),
+						Currency:  domain.CurrencyUSD,
+						Allowed:   true,
+						CreatedAt: now,
+					}).
+					Return(nil)
+
+				return &RiskEngine{telemetry: tel, clock: clk, uidnumber: muid, rules: rules, store: storeMock}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			re := tt.mockFn(ctx)
+			err := re.Check(ctx, tt.in)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
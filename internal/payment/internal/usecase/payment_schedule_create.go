@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/shandysiswandi/goreng/uid"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+)
+
+type PaymentScheduleCreateStore interface {
+	SaveScheduledPayment(ctx context.Context, sp domain.ScheduledPayment) error
+}
+
+type PaymentScheduleCreate struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	uidnumber uid.NumberID
+	clock     clock.Clocker
+	store     PaymentScheduleCreateStore
+}
+
+func NewPaymentScheduleCreate(dep Dependency, s PaymentScheduleCreateStore) *PaymentScheduleCreate {
+	return &PaymentScheduleCreate{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		uidnumber: dep.UIDNumber,
+		clock:     dep.Clock,
+		store:     s,
+	}
+}
+
+func (psc *PaymentScheduleCreate) Call(ctx context.Context, in domain.PaymentScheduleCreateInput) (
+	*domain.PaymentScheduleCreateOutput, error,
+) {
+	ctx, span := psc.telemetry.Tracer().Start(ctx, "payment.usecase.PaymentScheduleCreate")
+	defer span.End()
+
+	if err := psc.validator.Validate(in); err != nil {
+		psc.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	if in.TargetCurrency == "" {
+		in.TargetCurrency = in.Currency
+	}
+
+	currency, err := domain.ParseCurrency(in.Currency)
+	if err != nil {
+		psc.telemetry.Logger().Warn(ctx, "currency not supported", logger.KeyVal("currency", in.Currency))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	target, err := domain.ParseCurrency(in.TargetCurrency)
+	if err != nil {
+		psc.telemetry.Logger().Warn(ctx, "currency not supported", logger.KeyVal("currency", in.TargetCurrency))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	if err := currency.ValidateAmount(in.Amount); err != nil {
+		psc.telemetry.Logger().Warn(ctx, "invalid scheduled amount", logger.KeyVal("currency", currency))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	sched, err := domain.ParseSchedule(in.Schedule)
+	if err != nil {
+		psc.telemetry.Logger().Warn(ctx, "invalid schedule", logger.KeyVal("schedule", in.Schedule))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	clm := lib.GetJWTClaim(ctx)
+	if clm.AuthID == in.RecipientID {
+		psc.telemetry.Logger().Warn(ctx, "schedule transfer to self", logger.KeyVal("user_id", clm.AuthID))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", domain.ErrTransferToSelf)
+	}
+
+	// The first run happens at StartAt when given, otherwise at the first
+	// occurrence of the schedule. A one-off schedule needs a start in the future.
+	now := psc.clock.Now()
+	next := in.StartAt
+	if next.IsZero() {
+		next = sched.Next(now)
+	}
+
+	if !next.After(now) {
+		psc.telemetry.Logger().Warn(ctx, "schedule has no future run", logger.KeyVal("schedule", in.Schedule))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", domain.ErrScheduleInvalid)
+	}
+
+	sp := domain.ScheduledPayment{
+		ID:             psc.uidnumber.Generate(),
+		UserID:         clm.AuthID,
+		RecipientID:    in.RecipientID,
+		Amount:         in.Amount,
+		Currency:       currency,
+		TargetCurrency: target,
+		Schedule:       in.Schedule,
+		NextRunAt:      next,
+		Active:         true,
+		CreatedAt:      now,
+	}
+	if err := psc.store.SaveScheduledPayment(ctx, sp); err != nil {
+		psc.telemetry.Logger().Error(ctx, "failed to save scheduled payment", err,
+			logger.KeyVal("scheduled_payment", sp))
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	return &domain.PaymentScheduleCreateOutput{
+		ID:        sp.ID,
+		Schedule:  sp.Schedule,
+		NextRunAt: sp.NextRunAt,
+	}, nil
+}
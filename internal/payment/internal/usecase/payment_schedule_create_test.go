@@ -0,0 +1,313 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/mockz"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaymentScheduleCreate(t *testing.T) {
+	tests := []struct {
+		name string
+		dep  Dependency
+		s    PaymentScheduleCreateStore
+		want *PaymentScheduleCreate
+	}{
+		{
+			name: "Success",
+			dep:  Dependency{},
+			s:    nil,
+			want: &PaymentScheduleCreate{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewPaymentScheduleCreate(tt.dep, tt.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPaymentScheduleCreate_Call(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	ctxClaim := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+
+	type args struct {
+		ctx context.Context
+		in  domain.PaymentScheduleCreateInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.PaymentScheduleCreateOutput
+		wantErr error
+		mockFn  func(a args) *PaymentScheduleCreate
+	}{
+		{
+			name:    "ErrorValidationInput",
+			args:    args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *PaymentScheduleCreate {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(assert.AnError)
+
+				return &PaymentScheduleCreate{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorCurrencyNotSupported",
+			args: args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{
+				RecipientID: 12, Amount: decimal.NewFromInt(10), Currency: "XXX", Schedule: "@daily",
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrCurrencyNotSupported),
+			mockFn: func(a args) *PaymentScheduleCreate {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentScheduleCreate{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorTargetCurrencyNotSupported",
+			args: args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{
+				RecipientID: 12, Amount: decimal.NewFromInt(10), Currency: "USD", TargetCurrency: "XXX",
+				Schedule: "@daily",
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrCurrencyNotSupported),
+			mockFn: func(a args) *PaymentScheduleCreate {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentScheduleCreate{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorAmountNotPositive",
+			args: args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{
+				RecipientID: 12, Amount: decimal.NewFromInt(-1), Currency: "USD", Schedule: "@daily",
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrAmountNotPositive),
+			mockFn: func(a args) *PaymentScheduleCreate {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentScheduleCreate{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorInvalidSchedule",
+			args: args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{
+				RecipientID: 12, Amount: decimal.NewFromInt(10), Currency: "USD", Schedule: "sometimes",
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrScheduleInvalid),
+			mockFn: func(a args) *PaymentScheduleCreate {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentScheduleCreate{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorTransferToSelf",
+			args: args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{
+				RecipientID: 11, Amount: decimal.NewFromInt(10), Currency: "USD", Schedule: "@daily",
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrTransferToSelf),
+			mockFn: func(a args) *PaymentScheduleCreate {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentScheduleCreate{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorOnceWithoutStart",
+			args: args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{
+				RecipientID: 12, Amount: decimal.NewFromInt(10), Currency: "USD", Schedule: "@once",
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrScheduleInvalid),
+			mockFn: func(a args) *PaymentScheduleCreate {
+				validatorMock := mocker.NewMockValidator(t)
+				clockMock := mocker.NewMockClocker(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				clockMock.EXPECT().Now().Return(now)
+
+				return &PaymentScheduleCreate{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+					clock:     clockMock,
+				}
+			},
+		},
+		{
+			name: "ErrorStartInPast",
+			args: args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{
+				RecipientID: 12, Amount: decimal.NewFromInt(10), Currency: "USD", Schedule: "@once",
+				StartAt: now.Add(-time.Hour),
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrScheduleInvalid),
+			mockFn: func(a args) *PaymentScheduleCreate {
+				validatorMock := mocker.NewMockValidator(t)
+				clockMock := mocker.NewMockClocker(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				clockMock.EXPECT().Now().Return(now)
+
+				return &PaymentScheduleCreate{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+					clock:     clockMock,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreSaveScheduledPayment",
+			args: args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{
+				RecipientID: 12, Amount: decimal.NewFromInt(10), Currency: "USD", Schedule: "@daily",
+			}},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *PaymentScheduleCreate {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				clockMock := mocker.NewMockClocker(t)
+				idnumMock := mocker.NewMockNumberID(t)
+				storeMock := mockz.NewMockPaymentScheduleCreateStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentScheduleCreate")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				clockMock.EXPECT().Now().Return(now)
+
+				idnumMock.EXPECT().Generate().Return(uint64(77))
+
+				sp := domain.ScheduledPayment{
+					ID:             77,
+					UserID:         11,
+					RecipientID:    12,
+					Amount:         a.in.Amount,
+					Currency:       domain.CurrencyUSD,
+					TargetCurrency: domain.CurrencyUSD,
+					Schedule:       "@daily",
+					NextRunAt:      time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC),
+					Active:         true,
+					CreatedAt:      now,
+				}
+				storeMock.EXPECT().SaveScheduledPayment(ctx, sp).Return(assert.AnError)
+
+				return &PaymentScheduleCreate{
+					telemetry: tel,
+					validator: validatorMock,
+					uidnumber: idnumMock,
+					clock:     clockMock,
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{ctx: ctxClaim, in: domain.PaymentScheduleCreateInput{
+				RecipientID: 12, Amount: decimal.NewFromInt(10), Currency: "USD", TargetCurrency: "IDR",
+				Schedule: "0 9 1 * *", StartAt: now.Add(time.Hour),
+			}},
+			want: &domain.PaymentScheduleCreateOutput{
+				ID:        77,
+				Schedule:  "0 9 1 * *",
+				NextRunAt: now.Add(time.Hour),
+			},
+			wantErr: nil,
+			mockFn: func(a args) *PaymentScheduleCreate {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				clockMock := mocker.NewMockClocker(t)
+				idnumMock := mocker.NewMockNumberID(t)
+				storeMock := mockz.NewMockPaymentScheduleCreateStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentScheduleCreate")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				clockMock.EXPECT().Now().Return(now)
+
+				idnumMock.EXPECT().Generate().Return(uint64(77))
+
+				sp := domain.ScheduledPayment{
+					ID:             77,
+					UserID:         11,
+					RecipientID:    12,
+					Amount:         a.in.Amount,
+					Currency:       domain.CurrencyUSD,
+					TargetCurrency: domain.CurrencyIDR,
+					Schedule:       "0 9 1 * *",
+					NextRunAt:      now.Add(time.Hour),
+					Active:         true,
+					CreatedAt:      now,
+				}
+				storeMock.EXPECT().SaveScheduledPayment(ctx, sp).Return(nil)
+
+				return &PaymentScheduleCreate{
+					telemetry: tel,
+					validator: validatorMock,
+					uidnumber: idnumMock,
+					clock:     clockMock,
+					store:     storeMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.mockFn(tt.args).Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
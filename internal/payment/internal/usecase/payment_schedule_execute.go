@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/config"
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/shandysiswandi/goreng/uid"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+)
+
+const (
+	defaultScheduleBatch       = 10
+	defaultScheduleLease       = 5 * time.Minute
+	defaultScheduleMaxAttempts = 3
+	defaultScheduleRetryDelay  = time.Minute
+
+	// maxRunErrorLength is the size of the error column of a run, in characters.
+	maxRunErrorLength = 255
+)
+
+type PaymentScheduleExecuteStore interface {
+	FindDueScheduledPayments(ctx context.Context, now time.Time, limit int) ([]domain.ScheduledPayment, error)
+	AcquireScheduledPayment(ctx context.Context, id uint64, owner string, now, until time.Time) (bool, error)
+	ReleaseScheduledPayment(ctx context.Context, sp domain.ScheduledPayment, owner string) error
+	SaveScheduledPaymentRun(ctx context.Context, run domain.ScheduledPaymentRun) error
+}
+
+// PaymentScheduleExecute runs the scheduled payments that are due.
+//
+// Every schedule is leased before it runs, so when several instances execute
+// at the same time each due schedule is picked by exactly one of them. A run
+// that fails with a transient (server) error is retried with exponential
+// backoff up to `payment.schedule.max.attempts` times; any other failure is
+// recorded and the schedule moves on to its next occurrence. The transfer of a
+// run is committed only along with the run and the release of the lease.
+type PaymentScheduleExecute struct {
+	telemetry   *lib.Telemetry
+	validator   validation.Validator
+	uidnumber   uid.NumberID
+	clock       clock.Clocker
+	trx         sqlkit.Tx
+	store       PaymentScheduleExecuteStore
+	transferUC  domain.PaymentTransfer
+	batch       int
+	lease       time.Duration
+	maxAttempts int
+	retryDelay  time.Duration
+}
+
+func NewPaymentScheduleExecute(dep Dependency, s PaymentScheduleExecuteStore, transferUC domain.PaymentTransfer,
+) *PaymentScheduleExecute {
+	psx := &PaymentScheduleExecute{
+		telemetry:   dep.Telemetry,
+		validator:   dep.Validator,
+		uidnumber:   dep.UIDNumber,
+		clock:       dep.Clock,
+		trx:         dep.Transaction,
+		store:       s,
+		transferUC:  transferUC,
+		batch:       defaultScheduleBatch,
+		lease:       defaultScheduleLease,
+		maxAttempts: defaultScheduleMaxAttempts,
+		retryDelay:  defaultScheduleRetryDelay,
+	}
+	psx.configure(dep.Config)
+
+	return psx
+}
+
+func (psx *PaymentScheduleExecute) configure(cfg config.Config) {
+	if cfg == nil {
+		return
+	}
+
+	if v := cfg.GetInt("payment.schedule.batch"); v > 0 {
+		psx.batch = int(v)
+	}
+
+	if v := cfg.GetInt("payment.schedule.lease"); v > 0 {
+		psx.lease = time.Duration(v) * time.Second
+	}
+
+	if v := cfg.GetInt("payment.schedule.max.attempts"); v > 0 {
+		psx.maxAttempts = int(v)
+	}
+
+	if v := cfg.GetInt("payment.schedule.retry.delay"); v > 0 {
+		psx.retryDelay = time.Duration(v) * time.Second
+	}
+}
+
+func (psx *PaymentScheduleExecute) Call(ctx context.Context, in domain.PaymentScheduleExecuteInput) (
+	*domain.PaymentScheduleExecuteOutput, error,
+) {
+	ctx, span := psx.telemetry.Tracer().Start(ctx, "payment.usecase.PaymentScheduleExecute")
+	defer span.End()
+
+	if err := psx.validator.Validate(in); err != nil {
+		psx.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	now := psx.clock.Now()
+	due, err := psx.store.FindDueScheduledPayments(ctx, now, psx.batch)
+	if err != nil {
+		psx.telemetry.Logger().Error(ctx, "failed to find due scheduled payments", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	out := &domain.PaymentScheduleExecuteOutput{}
+	for _, sp := range due {
+		switch psx.execute(ctx, sp, in.Owner, now) {
+		case domain.ScheduledRunStatusSuccess:
+			out.Succeeded++
+		case domain.ScheduledRunStatusRetry:
+			out.Retried++
+		case domain.ScheduledRunStatusFailed:
+			out.Failed++
+		case domain.ScheduledRunStatusUnknown:
+			// not leased by this instance or not recorded
+		}
+	}
+
+	return out, nil
+}
+
+func (psx *PaymentScheduleExecute) execute(ctx context.Context, sp domain.ScheduledPayment, owner string,
+	now time.Time,
+) domain.ScheduledRunStatus {
+	acquired, err := psx.store.AcquireScheduledPayment(ctx, sp.ID, owner, now, now.Add(psx.lease))
+	if err != nil {
+		psx.telemetry.Logger().Error(ctx, "failed to lease scheduled payment", err, logger.KeyVal("id", sp.ID))
+
+		return domain.ScheduledRunStatusUnknown
+	}
+
+	if !acquired {
+		return domain.ScheduledRunStatusUnknown
+	}
+
+	run := domain.ScheduledPaymentRun{
+		ID:         psx.uidnumber.Generate(),
+		ScheduleID: sp.ID,
+		Attempt:    sp.Attempts + 1,
+		RunAt:      now,
+	}
+
+	// the transfer, its run and the next occurrence are committed together, a
+	// lease lost meanwhile rolls the transfer back so no other runner pays twice
+	err = psx.trx.Transaction(ctx, func(ctx context.Context) error {
+		psx.run(ctx, &sp, &run, now)
+
+		if err := psx.store.SaveScheduledPaymentRun(ctx, run); err != nil {
+			psx.telemetry.Logger().Error(ctx, "failed to save scheduled payment run", err,
+				logger.KeyVal("scheduled_payment_run", run))
+
+			return err
+		}
+
+		if err := psx.store.ReleaseScheduledPayment(ctx, sp, owner); err != nil {
+			psx.telemetry.Logger().Error(ctx, "failed to release scheduled payment", err, logger.KeyVal("id", sp.ID))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.ScheduledRunStatusUnknown
+	}
+
+	return run.Status.Enum()
+}
+
+// run makes the transfer of sp and sets the outcome on run and the next
+// occurrence on sp.
+func (psx *PaymentScheduleExecute) run(ctx context.Context, sp *domain.ScheduledPayment,
+	run *domain.ScheduledPaymentRun, now time.Time,
+) {
+	var err error
+	sched, errSched := domain.ParseSchedule(sp.Schedule)
+	if errSched != nil {
+		// a stored schedule that no longer parses can never run again
+		psx.telemetry.Logger().Warn(ctx, "scheduled payment has invalid schedule", logger.KeyVal("id", sp.ID))
+		err = errSched
+	} else {
+		err = psx.transfer(ctx, *sp, run)
+	}
+
+	switch {
+	case err == nil:
+		run.Status = enum.New(domain.ScheduledRunStatusSuccess)
+		sp.Attempts = 0
+		sp.NextRunAt = sched.Next(now)
+	case errSched == nil && isTransient(err) && run.Attempt < psx.maxAttempts:
+		run.Status = enum.New(domain.ScheduledRunStatusRetry)
+		run.Error = runError(err)
+		sp.Attempts = run.Attempt
+		sp.NextRunAt = now.Add(psx.retryDelay << (run.Attempt - 1))
+	default:
+		run.Status = enum.New(domain.ScheduledRunStatusFailed)
+		run.Error = runError(err)
+		sp.Attempts = 0
+		if errSched == nil {
+			sp.NextRunAt = sched.Next(now)
+		} else {
+			sp.NextRunAt = time.Time{}
+		}
+	}
+
+	sp.Active = !sp.NextRunAt.IsZero()
+	if !sp.Active {
+		sp.NextRunAt = now
+	}
+}
+
+// transfer executes the payment as the owner of the schedule.
+func (psx *PaymentScheduleExecute) transfer(ctx context.Context, sp domain.ScheduledPayment,
+	run *domain.ScheduledPaymentRun,
+) error {
+	ctx = lib.SetJWTClaim(ctx, lib.NewJWTClaim(sp.UserID, "", time.Time{}, nil))
+
+	out, err := psx.transferUC.Call(ctx, domain.PaymentTransferInput{
+		RecipientID:    sp.RecipientID,
+		Amount:         sp.Amount,
+		Currency:       sp.Currency.String(),
+		TargetCurrency: sp.TargetCurrency.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	run.TransferID = out.ID
+
+	return nil
+}
+
+// runError is the message of err cut to fit the error column of a run,
+// without splitting a character.
+func runError(err error) string {
+	msg := []rune(err.Error())
+	if len(msg) > maxRunErrorLength {
+		msg = msg[:maxRunErrorLength]
+	}
+
+	return string(msg)
+}
+
+// isTransient reports whether err may go away when the same request is retried.
+// Business and validation errors are final, anything else is worth another try.
+func isTransient(err error) bool {
+	var gerr *goerror.GoError
+	if !errors.As(err, &gerr) {
+		return true
+	}
+
+	return gerr.Type() == goerror.TypeServer
+}
@@ -0,0 +1,413 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/mockz"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewPaymentScheduleExecute(t *testing.T) {
+	tests := []struct {
+		name       string
+		dep        func() Dependency
+		s          PaymentScheduleExecuteStore
+		transferUC domain.PaymentTransfer
+		want       *PaymentScheduleExecute
+	}{
+		{
+			name: "SuccessDefault",
+			dep:  func() Dependency { return Dependency{} },
+			want: &PaymentScheduleExecute{
+				batch:       defaultScheduleBatch,
+				lease:       defaultScheduleLease,
+				maxAttempts: defaultScheduleMaxAttempts,
+				retryDelay:  defaultScheduleRetryDelay,
+			},
+		},
+		{
+			name: "SuccessFromConfig",
+			dep: func() Dependency {
+				configMock := mocker.NewMockConfig(t)
+
+				configMock.EXPECT().GetInt("payment.schedule.batch").Return(50)
+				configMock.EXPECT().GetInt("payment.schedule.lease").Return(120)
+				configMock.EXPECT().GetInt("payment.schedule.max.attempts").Return(5)
+				configMock.EXPECT().GetInt("payment.schedule.retry.delay").Return(30)
+
+				return Dependency{Config: configMock}
+			},
+			want: &PaymentScheduleExecute{
+				batch:       50,
+				lease:       2 * time.Minute,
+				maxAttempts: 5,
+				retryDelay:  30 * time.Second,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewPaymentScheduleExecute(tt.dep(), tt.s, tt.transferUC)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPaymentScheduleExecute_Call(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	nextDay := time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC)
+	owner := "host:1"
+	in := domain.PaymentScheduleExecuteInput{Owner: owner}
+	daily := domain.ScheduledPayment{
+		ID:             7,
+		UserID:         11,
+		RecipientID:    12,
+		Amount:         decimal.NewFromInt(10),
+		Currency:       domain.CurrencyUSD,
+		TargetCurrency: domain.CurrencyUSD,
+		Schedule:       "@daily",
+		NextRunAt:      now,
+		Active:         true,
+	}
+	transferIn := domain.PaymentTransferInput{
+		RecipientID:    12,
+		Amount:         decimal.NewFromInt(10),
+		Currency:       "USD",
+		TargetCurrency: "USD",
+	}
+
+	type mocks struct {
+		tel        *lib.Telemetry
+		ctx        context.Context
+		store      *mockz.MockPaymentScheduleExecuteStore
+		transferUC *mockz.MockPaymentTransfer
+	}
+	// setup builds the use case and expects a lease attempt on every due schedule.
+	setup := func(t *testing.T, due []domain.ScheduledPayment) (*PaymentScheduleExecute, mocks) {
+		tel := lib.NewTelemetry()
+		validatorMock := mocker.NewMockValidator(t)
+		clockMock := mocker.NewMockClocker(t)
+		idnumMock := mocker.NewMockNumberID(t)
+		storeMock := mockz.NewMockPaymentScheduleExecuteStore(t)
+		transferMock := mockz.NewMockPaymentTransfer(t)
+
+		ctx, span := tel.Tracer().Start(context.Background(), "payment.usecase.PaymentScheduleExecute")
+		defer span.End()
+
+		validatorMock.EXPECT().Validate(in).Return(nil)
+		clockMock.EXPECT().Now().Return(now)
+		idnumMock.EXPECT().Generate().Return(uint64(99)).Maybe()
+		storeMock.EXPECT().FindDueScheduledPayments(ctx, now, defaultScheduleBatch).Return(due, nil)
+
+		return &PaymentScheduleExecute{
+			telemetry:   tel,
+			validator:   validatorMock,
+			uidnumber:   idnumMock,
+			clock:       clockMock,
+			trx:         sqlkit.NewNoopDB(),
+			store:       storeMock,
+			transferUC:  transferMock,
+			batch:       defaultScheduleBatch,
+			lease:       defaultScheduleLease,
+			maxAttempts: defaultScheduleMaxAttempts,
+			retryDelay:  defaultScheduleRetryDelay,
+		}, mocks{tel: tel, ctx: ctx, store: storeMock, transferUC: transferMock}
+	}
+	acquire := func(m mocks, sp domain.ScheduledPayment, ok bool, err error) {
+		m.store.EXPECT().
+			AcquireScheduledPayment(m.ctx, sp.ID, owner, now, now.Add(defaultScheduleLease)).
+			Return(ok, err)
+	}
+
+	tests := []struct {
+		name    string
+		want    *domain.PaymentScheduleExecuteOutput
+		wantErr error
+		mockFn  func(t *testing.T) *PaymentScheduleExecute
+	}{
+		{
+			name:    "ErrorValidationInput",
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(in).Return(assert.AnError)
+
+				return &PaymentScheduleExecute{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorStoreFindDueScheduledPayments",
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				clockMock := mocker.NewMockClocker(t)
+				storeMock := mockz.NewMockPaymentScheduleExecuteStore(t)
+
+				ctx, span := tel.Tracer().Start(context.Background(), "payment.usecase.PaymentScheduleExecute")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(in).Return(nil)
+				clockMock.EXPECT().Now().Return(now)
+				storeMock.EXPECT().
+					FindDueScheduledPayments(ctx, now, defaultScheduleBatch).
+					Return(nil, assert.AnError)
+
+				return &PaymentScheduleExecute{
+					telemetry: tel,
+					validator: validatorMock,
+					clock:     clockMock,
+					store:     storeMock,
+					batch:     defaultScheduleBatch,
+				}
+			},
+		},
+		{
+			name:    "SkipWhenLeaseFailedOrTaken",
+			want:    &domain.PaymentScheduleExecuteOutput{},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				other := daily
+				other.ID = 8
+				uc, m := setup(t, []domain.ScheduledPayment{daily, other})
+
+				acquire(m, daily, false, assert.AnError)
+				acquire(m, other, false, nil)
+
+				return uc
+			},
+		},
+		{
+			name:    "SuccessRecurring",
+			want:    &domain.PaymentScheduleExecuteOutput{Succeeded: 1},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				sp := daily
+				sp.Attempts = 1
+				uc, m := setup(t, []domain.ScheduledPayment{sp})
+
+				acquire(m, sp, true, nil)
+				asOwner := mock.MatchedBy(func(ctx context.Context) bool {
+					return lib.GetJWTClaim(ctx).AuthID == sp.UserID
+				})
+				m.transferUC.EXPECT().
+					Call(asOwner, transferIn).
+					Return(&domain.PaymentTransferOutput{ID: 55}, nil)
+				m.store.EXPECT().SaveScheduledPaymentRun(m.ctx, domain.ScheduledPaymentRun{
+					ID: 99, ScheduleID: 7, Attempt: 2, Status: enum.New(domain.ScheduledRunStatusSuccess),
+					TransferID: 55, RunAt: now,
+				}).Return(nil)
+
+				released := daily
+				released.NextRunAt = nextDay
+				m.store.EXPECT().ReleaseScheduledPayment(m.ctx, released, owner).Return(nil)
+
+				return uc
+			},
+		},
+		{
+			name:    "SuccessOnceDeactivates",
+			want:    &domain.PaymentScheduleExecuteOutput{Succeeded: 1},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				sp := daily
+				sp.Schedule = "@once"
+				uc, m := setup(t, []domain.ScheduledPayment{sp})
+
+				acquire(m, sp, true, nil)
+				m.transferUC.EXPECT().
+					Call(mock.Anything, transferIn).
+					Return(&domain.PaymentTransferOutput{ID: 55}, nil)
+				m.store.EXPECT().SaveScheduledPaymentRun(m.ctx, mock.Anything).Return(nil)
+
+				released := sp
+				released.Active = false
+				m.store.EXPECT().ReleaseScheduledPayment(m.ctx, released, owner).Return(nil)
+
+				return uc
+			},
+		},
+		{
+			name:    "RetryOnTransientError",
+			want:    &domain.PaymentScheduleExecuteOutput{Retried: 1},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				sp := daily
+				sp.Attempts = 1
+				uc, m := setup(t, []domain.ScheduledPayment{sp})
+
+				acquire(m, sp, true, nil)
+				m.transferUC.EXPECT().
+					Call(mock.Anything, transferIn).
+					Return(nil, goerror.NewServerInternal(assert.AnError))
+				m.store.EXPECT().SaveScheduledPaymentRun(m.ctx, domain.ScheduledPaymentRun{
+					ID: 99, ScheduleID: 7, Attempt: 2, Status: enum.New(domain.ScheduledRunStatusRetry),
+					Error: goerror.NewServerInternal(assert.AnError).Error(), RunAt: now,
+				}).Return(nil)
+
+				released := sp
+				released.Attempts = 2
+				released.NextRunAt = now.Add(2 * defaultScheduleRetryDelay)
+				m.store.EXPECT().ReleaseScheduledPayment(m.ctx, released, owner).Return(nil)
+
+				return uc
+			},
+		},
+		{
+			name:    "FailedAfterMaxAttempts",
+			want:    &domain.PaymentScheduleExecuteOutput{Failed: 1},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				sp := daily
+				sp.Attempts = 2
+				uc, m := setup(t, []domain.ScheduledPayment{sp})
+
+				acquire(m, sp, true, nil)
+				m.transferUC.EXPECT().
+					Call(mock.Anything, transferIn).
+					Return(nil, assert.AnError)
+				m.store.EXPECT().SaveScheduledPaymentRun(m.ctx, domain.ScheduledPaymentRun{
+					ID: 99, ScheduleID: 7, Attempt: 3, Status: enum.New(domain.ScheduledRunStatusFailed),
+					Error: assert.AnError.Error(), RunAt: now,
+				}).Return(nil)
+
+				released := daily
+				released.NextRunAt = nextDay
+				m.store.EXPECT().ReleaseScheduledPayment(m.ctx, released, owner).Return(nil)
+
+				return uc
+			},
+		},
+		{
+			name:    "FailedOnBusinessError",
+			want:    &domain.PaymentScheduleExecuteOutput{Failed: 1},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				uc, m := setup(t, []domain.ScheduledPayment{daily})
+				errBiz := goerror.NewBusiness("insufficient balance", goerror.CodeConflict)
+
+				acquire(m, daily, true, nil)
+				m.transferUC.EXPECT().
+					Call(mock.Anything, transferIn).
+					Return(nil, errBiz)
+				m.store.EXPECT().SaveScheduledPaymentRun(m.ctx, domain.ScheduledPaymentRun{
+					ID: 99, ScheduleID: 7, Attempt: 1, Status: enum.New(domain.ScheduledRunStatusFailed),
+					Error: errBiz.Error(), RunAt: now,
+				}).Return(nil)
+
+				released := daily
+				released.NextRunAt = nextDay
+				m.store.EXPECT().ReleaseScheduledPayment(m.ctx, released, owner).Return(nil)
+
+				return uc
+			},
+		},
+		{
+			name:    "FailedWithLongErrorTruncated",
+			want:    &domain.PaymentScheduleExecuteOutput{Failed: 1},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				uc, m := setup(t, []domain.ScheduledPayment{daily})
+				errBiz := goerror.NewBusiness(strings.Repeat("é", 300), goerror.CodeConflict)
+
+				acquire(m, daily, true, nil)
+				m.transferUC.EXPECT().
+					Call(mock.Anything, transferIn).
+					Return(nil, errBiz)
+				m.store.EXPECT().SaveScheduledPaymentRun(m.ctx, domain.ScheduledPaymentRun{
+					ID: 99, ScheduleID: 7, Attempt: 1, Status: enum.New(domain.ScheduledRunStatusFailed),
+					Error: strings.Repeat("é", 255), RunAt: now,
+				}).Return(nil)
+
+				released := daily
+				released.NextRunAt = nextDay
+				m.store.EXPECT().ReleaseScheduledPayment(m.ctx, released, owner).Return(nil)
+
+				return uc
+			},
+		},
+		{
+			name:    "FailedOnInvalidScheduleDeactivates",
+			want:    &domain.PaymentScheduleExecuteOutput{Failed: 1},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				sp := daily
+				sp.Schedule = "sometimes"
+				uc, m := setup(t, []domain.ScheduledPayment{sp})
+
+				acquire(m, sp, true, nil)
+				m.store.EXPECT().SaveScheduledPaymentRun(m.ctx, domain.ScheduledPaymentRun{
+					ID: 99, ScheduleID: 7, Attempt: 1, Status: enum.New(domain.ScheduledRunStatusFailed),
+					Error: domain.ErrScheduleInvalid.Error(), RunAt: now,
+				}).Return(nil)
+
+				released := sp
+				released.Active = false
+				m.store.EXPECT().ReleaseScheduledPayment(m.ctx, released, owner).Return(nil)
+
+				return uc
+			},
+		},
+		{
+			name:    "SkipWhenSaveRunFailed",
+			want:    &domain.PaymentScheduleExecuteOutput{},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				uc, m := setup(t, []domain.ScheduledPayment{daily})
+
+				acquire(m, daily, true, nil)
+				m.transferUC.EXPECT().
+					Call(mock.Anything, transferIn).
+					Return(&domain.PaymentTransferOutput{ID: 55}, nil)
+				m.store.EXPECT().SaveScheduledPaymentRun(m.ctx, mock.Anything).Return(assert.AnError)
+
+				return uc
+			},
+		},
+		{
+			name:    "SkipWhenReleaseFailed",
+			want:    &domain.PaymentScheduleExecuteOutput{},
+			wantErr: nil,
+			mockFn: func(t *testing.T) *PaymentScheduleExecute {
+				uc, m := setup(t, []domain.ScheduledPayment{daily})
+
+				acquire(m, daily, true, nil)
+				m.transferUC.EXPECT().
+					Call(mock.Anything, transferIn).
+					Return(&domain.PaymentTransferOutput{ID: 55}, nil)
+				m.store.EXPECT().SaveScheduledPaymentRun(m.ctx, mock.Anything).Return(nil)
+				m.store.EXPECT().
+					ReleaseScheduledPayment(m.ctx, mock.Anything, owner).
+					Return(domain.ErrScheduledPaymentNoRowsAffected)
+
+				return uc
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.mockFn(t).Call(context.Background(), in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,34 +4,36 @@ import (
 	"context"
 
 	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/enum"
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/shopspring/decimal"
 )
 
 type PaymentTopupStore interface {
-	FindAccountByUserID(ctx context.Context, userID uint64) (*domain.Account, error)
+	LockAccount(ctx context.Context, userID uint64, currency domain.Currency) (*domain.Account, error)
+	SaveAccount(ctx context.Context, acc domain.Account) error
 	FindTopupByReferenceID(ctx context.Context, refID string) (*domain.Topup, error)
 	SaveTopup(ctx context.Context, topup domain.Topup) error
 	SaveTransaction(ctx context.Context, topup domain.Transaction) error
-	UpdateAccount(ctx context.Context, data map[string]any) error
 }
 
 type PaymentTopup struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 	validator validation.Validator
 	uidnumber uid.NumberID
 	clock     clock.Clocker
-	trx       dbops.Tx
+	trx       sqlkit.Tx
 	store     PaymentTopupStore
+	risk      RiskChecker
 }
 
-func NewPaymentTopup(dep Dependency, s PaymentTopupStore) *PaymentTopup {
+func NewPaymentTopup(dep Dependency, s PaymentTopupStore, r RiskChecker) *PaymentTopup {
 	return &PaymentTopup{
 		telemetry: dep.Telemetry,
 		uidnumber: dep.UIDNumber,
@@ -39,6 +41,7 @@ func NewPaymentTopup(dep Dependency, s PaymentTopupStore) *PaymentTopup {
 		clock:     dep.Clock,
 		trx:       dep.Transaction,
 		store:     s,
+		risk:      r,
 	}
 }
 
@@ -54,6 +57,19 @@ func (pt *PaymentTopup) Call(ctx context.Context, in domain.PaymentTopupInput) (
 		return nil, goerror.NewInvalidInput("Invalid request payload", err)
 	}
 
+	currency, err := domain.ParseCurrency(in.Currency)
+	if err != nil {
+		pt.telemetry.Logger().Warn(ctx, "currency not supported", logger.KeyVal("currency", in.Currency))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	if err := currency.ValidateAmount(in.Amount); err != nil {
+		pt.telemetry.Logger().Warn(ctx, "invalid topup amount", logger.KeyVal("currency", currency))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
 	top, err := pt.store.FindTopupByReferenceID(ctx, in.ReferenceID)
 	if err != nil {
 		pt.telemetry.Logger().Error(ctx, "failed to get topup by ref_id", err,
@@ -69,41 +85,69 @@ func (pt *PaymentTopup) Call(ctx context.Context, in domain.PaymentTopupInput) (
 		return nil, goerror.NewBusiness("duplicate request topup", goerror.CodeConflict)
 	}
 
+	// The balance is not credited here; it only moves once the provider
+	// confirms the topup through the payment webhook.
 	clm := lib.GetJWTClaim(ctx)
-	acc, err := pt.store.FindAccountByUserID(ctx, clm.AuthID)
-	if err != nil {
-		pt.telemetry.Logger().Error(ctx, "failed to find account", err, logger.KeyVal("user_id", clm.AuthID))
-
-		return nil, goerror.NewServerInternal(err)
-	}
-
-	if acc == nil {
-		pt.telemetry.Logger().Warn(ctx, "account is not found", logger.KeyVal("user_id", clm.AuthID))
-
-		return nil, goerror.NewBusiness("account not found", goerror.CodeNotFound)
-	}
-
-	if err := pt.doTransaction(ctx, in, acc, clm.AuthID); err != nil {
+	if err := pt.doTransaction(ctx, in, clm.AuthID, currency); err != nil {
 		return nil, err
 	}
 
 	return &domain.PaymentTopupOutput{
 		ReferenceID: in.ReferenceID,
 		Amount:      in.Amount,
-		Balance:     acc.Balanace.Add(in.Amount),
+		Currency:    currency,
+		Status:      domain.TransactionStatusPending,
 	}, nil
 }
 
-func (pt *PaymentTopup) doTransaction(ctx context.Context, in domain.PaymentTopupInput,
-	acc *domain.Account, userID uint64,
+// doTransaction records the pending topup. The user's account is locked first and
+// the risk rules are checked under that lock, so concurrent topups can not go past
+// a limit together. The first topup in a currency also opens the user's sub-account
+// for it, so the webhook has a balance to credit; its insert holds the lock instead.
+func (pt *PaymentTopup) doTransaction(ctx context.Context, in domain.PaymentTopupInput, userID uint64,
+	currency domain.Currency,
 ) error {
 	return pt.trx.Transaction(ctx, func(cc context.Context) error {
+		acc, err := pt.store.LockAccount(cc, userID, currency)
+		if err != nil {
+			pt.telemetry.Logger().Error(ctx, "failed to lock account", err, logger.KeyVal("user_id", userID),
+				logger.KeyVal("currency", currency))
+
+			return goerror.NewServerInternal(err)
+		}
+
+		if acc == nil {
+			opened := domain.Account{
+				ID:       pt.uidnumber.Generate(),
+				UserID:   userID,
+				Currency: currency,
+				Balanace: decimal.Zero,
+			}
+			if err := pt.store.SaveAccount(cc, opened); err != nil {
+				pt.telemetry.Logger().Error(ctx, "failed to open account", err,
+					logger.KeyVal("user_id", userID), logger.KeyVal("currency", currency))
+
+				return goerror.NewServerInternal(err)
+			}
+		}
+
+		riskIn := domain.RiskInput{
+			UserID:    userID,
+			Operation: domain.RiskOperationTopup,
+			Amount:    in.Amount,
+			Currency:  currency,
+		}
+		if err := pt.risk.Check(cc, riskIn); err != nil {
+			return err
+		}
+
 		trx := domain.Transaction{
 			ID:       pt.uidnumber.Generate(),
 			UserID:   userID,
 			Amount:   in.Amount,
-			Type:     domain.TransactionTypeDebit,
-			Status:   domain.TransactionStatusPending,
+			Currency: currency,
+			Type:     enum.New(domain.TransactionTypeDebit),
+			Status:   enum.New(domain.TransactionStatusPending),
 			Remark:   "top up balance",
 			CreateAt: pt.clock.Now(),
 		}
@@ -127,17 +171,6 @@ func (pt *PaymentTopup) doTransaction(ctx context.Context, in domain.PaymentTopu
 			return goerror.NewServerInternal(err)
 		}
 
-		accUpdateData := map[string]any{
-			"id":      acc.ID,
-			"balance": acc.Balanace.Add(in.Amount),
-		}
-		if err := pt.store.UpdateAccount(cc, accUpdateData); err != nil {
-			pt.telemetry.Logger().Error(ctx, "failed to update account", err,
-				logger.KeyVal("account_update_data", accUpdateData))
-
-			return goerror.NewServerInternal(err)
-		}
-
 		return nil
 	})
 }
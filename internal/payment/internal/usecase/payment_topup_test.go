@@ -5,11 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shandysiswandi/goreng/enum"
 	"github.com/shandysiswandi/goreng/goerror"
 	mclk "github.com/shandysiswandi/goreng/mocker"
 	mu "github.com/shandysiswandi/goreng/mocker"
 	mv "github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/mockz"
@@ -35,7 +35,7 @@ func TestNewPaymentTopup(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := NewPaymentTopup(tt.dep, tt.s)
+			got := NewPaymentTopup(tt.dep, tt.s, nil)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -63,12 +63,13 @@ func TestPaymentTopup_Call(t *testing.T) {
 				in: domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "IDR",
 				},
 			},
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *PaymentTopup {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mv.NewMockValidator(t)
 
 				_, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTopup")
@@ -85,6 +86,56 @@ func TestPaymentTopup_Call(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "ErrorCurrencyNotSupported",
+			args: args{
+				ctx: context.Background(),
+				in: domain.PaymentTopupInput{
+					ReferenceID: "uuid",
+					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "XXX",
+				},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrCurrencyNotSupported),
+			mockFn: func(a args) *PaymentTopup {
+				validatorMock := mv.NewMockValidator(t)
+
+				validatorMock.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &PaymentTopup{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorAmountPrecision",
+			args: args{
+				ctx: context.Background(),
+				in: domain.PaymentTopupInput{
+					ReferenceID: "uuid",
+					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "JPY",
+				},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrAmountPrecision),
+			mockFn: func(a args) *PaymentTopup {
+				validatorMock := mv.NewMockValidator(t)
+
+				validatorMock.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &PaymentTopup{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
 		{
 			name: "ErrorStoreFindTopupByReferenceID",
 			args: args{
@@ -92,12 +143,13 @@ func TestPaymentTopup_Call(t *testing.T) {
 				in: domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "IDR",
 				},
 			},
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *PaymentTopup {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mv.NewMockValidator(t)
 				storeMock := mockz.NewMockPaymentTopupStore(t)
 
@@ -126,12 +178,13 @@ func TestPaymentTopup_Call(t *testing.T) {
 				in: domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "IDR",
 				},
 			},
 			want:    nil,
 			wantErr: goerror.NewBusiness("duplicate request topup", goerror.CodeConflict),
 			mockFn: func(a args) *PaymentTopup {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mv.NewMockValidator(t)
 				storeMock := mockz.NewMockPaymentTopupStore(t)
 
@@ -160,18 +213,19 @@ func TestPaymentTopup_Call(t *testing.T) {
 			},
 		},
 		{
-			name: "ErrorStoreFindAccountByUserID",
+			name: "ErrorStoreLockAccount",
 			args: args{
 				ctx: ctxJWT,
 				in: domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "IDR",
 				},
 			},
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *PaymentTopup {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mv.NewMockValidator(t)
 				storeMock := mockz.NewMockPaymentTopupStore(t)
 
@@ -187,31 +241,34 @@ func TestPaymentTopup_Call(t *testing.T) {
 					Return(nil, nil)
 
 				storeMock.EXPECT().
-					FindAccountByUserID(ctx, uint64(11)).
+					LockAccount(ctx, uint64(11), domain.CurrencyIDR).
 					Return(nil, assert.AnError)
 
 				return &PaymentTopup{
 					telemetry: tel,
 					validator: validatorMock,
 					store:     storeMock,
+					trx:       sqlkit.NewNoopDB(),
 				}
 			},
 		},
 		{
-			name: "ErrorStoreFindAccountByUserIDNotFound",
+			name: "ErrorRiskRejected",
 			args: args{
 				ctx: ctxJWT,
 				in: domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "IDR",
 				},
 			},
 			want:    nil,
-			wantErr: goerror.NewBusiness("account not found", goerror.CodeNotFound),
+			wantErr: goerror.NewBusiness("daily limit of 100 IDR exceeded", goerror.CodeForbidden),
 			mockFn: func(a args) *PaymentTopup {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mv.NewMockValidator(t)
 				storeMock := mockz.NewMockPaymentTopupStore(t)
+				riskMock := mockz.NewMockRiskChecker(t)
 
 				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTopup")
 				defer span.End()
@@ -225,33 +282,43 @@ func TestPaymentTopup_Call(t *testing.T) {
 					Return(nil, nil)
 
 				storeMock.EXPECT().
-					FindAccountByUserID(ctx, uint64(11)).
-					Return(nil, nil)
+					LockAccount(ctx, uint64(11), domain.CurrencyIDR).
+					Return(&domain.Account{ID: 22, UserID: 11, Currency: domain.CurrencyIDR}, nil)
+
+				riskMock.EXPECT().
+					Check(ctx, domain.RiskInput{
+						UserID:    11,
+						Operation: domain.RiskOperationTopup,
+						Amount:    a.in.Amount,
+						Currency:  domain.CurrencyIDR,
+					}).
+					Return(goerror.NewBusiness("daily limit of 100 IDR exceeded", goerror.CodeForbidden))
 
 				return &PaymentTopup{
 					telemetry: tel,
 					validator: validatorMock,
 					store:     storeMock,
+					trx:       sqlkit.NewNoopDB(),
+					risk:      riskMock,
 				}
 			},
 		},
 		{
-			name: "ErrorTransactionStoreSaveTransaction",
+			name: "ErrorTransactionStoreSaveAccount",
 			args: args{
 				ctx: ctxJWT,
 				in: domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "IDR",
 				},
 			},
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *PaymentTopup {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mv.NewMockValidator(t)
 				muid := mu.NewMockNumberID(t)
-				clk := mclk.NewMockClocker(t)
-				trxMock := dbops.NewTransaction(dbops.NewNoopDB())
 				storeMock := mockz.NewMockPaymentTopupStore(t)
 
 				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTopup")
@@ -265,65 +332,51 @@ func TestPaymentTopup_Call(t *testing.T) {
 					FindTopupByReferenceID(ctx, a.in.ReferenceID).
 					Return(nil, nil)
 
-				account := &domain.Account{
-					ID:       22,
-					UserID:   11,
-					Balanace: decimal.NewFromInt(1000),
-				}
 				storeMock.EXPECT().
-					FindAccountByUserID(ctx, uint64(11)).
-					Return(account, nil)
-
-				ctx = dbops.SetContextNoopTx(ctx)
+					LockAccount(ctx, uint64(11), domain.CurrencyIDR).
+					Return(nil, nil)
 
 				muid.EXPECT().
 					Generate().
-					Return(16)
+					Return(22)
 
-				clk.EXPECT().
-					Now().
-					Return(time.Time{})
-
-				dataTrx := domain.Transaction{
-					ID:       16,
+				account := domain.Account{
+					ID:       22,
 					UserID:   11,
-					Amount:   decimal.NewFromFloat(123.45),
-					Type:     domain.TransactionTypeDebit,
-					Status:   domain.TransactionStatusPending,
-					Remark:   "top up balance",
-					CreateAt: time.Time{},
+					Currency: domain.CurrencyIDR,
+					Balanace: decimal.Zero,
 				}
 				storeMock.EXPECT().
-					SaveTransaction(ctx, dataTrx).
+					SaveAccount(ctx, account).
 					Return(assert.AnError)
 
 				return &PaymentTopup{
 					telemetry: tel,
 					validator: validatorMock,
 					store:     storeMock,
-					trx:       trxMock,
+					trx:       sqlkit.NewNoopDB(),
 					uidnumber: muid,
-					clock:     clk,
 				}
 			},
 		},
 		{
-			name: "ErrorTransactionStoreSaveTopup",
+			name: "ErrorTransactionStoreSaveTransaction",
 			args: args{
 				ctx: ctxJWT,
 				in: domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "IDR",
 				},
 			},
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *PaymentTopup {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mv.NewMockValidator(t)
 				muid := mu.NewMockNumberID(t)
 				clk := mclk.NewMockClocker(t)
-				trxMock := dbops.NewTransaction(dbops.NewNoopDB())
+				trxMock := sqlkit.NewNoopDB()
 				storeMock := mockz.NewMockPaymentTopupStore(t)
 
 				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTopup")
@@ -340,18 +393,26 @@ func TestPaymentTopup_Call(t *testing.T) {
 				account := &domain.Account{
 					ID:       22,
 					UserID:   11,
+					Currency: domain.CurrencyIDR,
 					Balanace: decimal.NewFromInt(1000),
 				}
 				storeMock.EXPECT().
-					FindAccountByUserID(ctx, uint64(11)).
+					LockAccount(ctx, uint64(11), domain.CurrencyIDR).
 					Return(account, nil)
 
-				ctx = dbops.SetContextNoopTx(ctx)
+				riskMock := mockz.NewMockRiskChecker(t)
+				riskMock.EXPECT().
+					Check(ctx, domain.RiskInput{
+						UserID:    11,
+						Operation: domain.RiskOperationTopup,
+						Amount:    a.in.Amount,
+						Currency:  domain.CurrencyIDR,
+					}).
+					Return(nil)
 
 				muid.EXPECT().
 					Generate().
-					Return(16).
-					Once()
+					Return(16)
 
 				clk.EXPECT().
 					Now().
@@ -360,32 +421,19 @@ func TestPaymentTopup_Call(t *testing.T) {
 				dataTrx := domain.Transaction{
 					ID:       16,
 					UserID:   11,
-					Amount:   a.in.Amount,
-					Type:     domain.TransactionTypeDebit,
-					Status:   domain.TransactionStatusPending,
+					Amount:   decimal.NewFromFloat(123.45),
+					Currency: domain.CurrencyIDR,
+					Type:     enum.New(domain.TransactionTypeDebit),
+					Status:   enum.New(domain.TransactionStatusPending),
 					Remark:   "top up balance",
 					CreateAt: time.Time{},
 				}
 				storeMock.EXPECT().
 					SaveTransaction(ctx, dataTrx).
-					Return(nil)
-
-				muid.EXPECT().
-					Generate().
-					Return(19).
-					Once()
-
-				dataTopup := domain.Topup{
-					ID:            19,
-					TransactionID: dataTrx.ID,
-					ReferenceID:   a.in.ReferenceID,
-					Amount:        a.in.Amount,
-				}
-				storeMock.EXPECT().
-					SaveTopup(ctx, dataTopup).
 					Return(assert.AnError)
 
 				return &PaymentTopup{
+					risk:      riskMock,
 					telemetry: tel,
 					validator: validatorMock,
 					store:     storeMock,
@@ -396,22 +444,23 @@ func TestPaymentTopup_Call(t *testing.T) {
 			},
 		},
 		{
-			name: "ErrorTransactionStoreUpdateAccount",
+			name: "ErrorTransactionStoreSaveTopup",
 			args: args{
 				ctx: ctxJWT,
 				in: domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "IDR",
 				},
 			},
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *PaymentTopup {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mv.NewMockValidator(t)
 				muid := mu.NewMockNumberID(t)
 				clk := mclk.NewMockClocker(t)
-				trxMock := dbops.NewTransaction(dbops.NewNoopDB())
+				trxMock := sqlkit.NewNoopDB()
 				storeMock := mockz.NewMockPaymentTopupStore(t)
 
 				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTopup")
@@ -428,13 +477,22 @@ func TestPaymentTopup_Call(t *testing.T) {
 				account := &domain.Account{
 					ID:       22,
 					UserID:   11,
+					Currency: domain.CurrencyIDR,
 					Balanace: decimal.NewFromInt(1000),
 				}
 				storeMock.EXPECT().
-					FindAccountByUserID(ctx, uint64(11)).
+					LockAccount(ctx, uint64(11), domain.CurrencyIDR).
 					Return(account, nil)
 
-				ctx = dbops.SetContextNoopTx(ctx)
+				riskMock := mockz.NewMockRiskChecker(t)
+				riskMock.EXPECT().
+					Check(ctx, domain.RiskInput{
+						UserID:    11,
+						Operation: domain.RiskOperationTopup,
+						Amount:    a.in.Amount,
+						Currency:  domain.CurrencyIDR,
+					}).
+					Return(nil)
 
 				muid.EXPECT().
 					Generate().
@@ -449,19 +507,20 @@ func TestPaymentTopup_Call(t *testing.T) {
 					ID:       16,
 					UserID:   11,
 					Amount:   a.in.Amount,
-					Type:     domain.TransactionTypeDebit,
-					Status:   domain.TransactionStatusPending,
+					Currency: domain.CurrencyIDR,
+					Type:     enum.New(domain.TransactionTypeDebit),
+					Status:   enum.New(domain.TransactionStatusPending),
 					Remark:   "top up balance",
 					CreateAt: time.Time{},
 				}
 				storeMock.EXPECT().
 					SaveTransaction(ctx, dataTrx).
-					Return(nil).
-					Once()
+					Return(nil)
 
 				muid.EXPECT().
 					Generate().
-					Return(19)
+					Return(19).
+					Once()
 
 				dataTopup := domain.Topup{
 					ID:            19,
@@ -471,17 +530,10 @@ func TestPaymentTopup_Call(t *testing.T) {
 				}
 				storeMock.EXPECT().
 					SaveTopup(ctx, dataTopup).
-					Return(nil)
-
-				dataUpdateAccount := map[string]any{
-					"id":      account.ID,
-					"balance": account.Balanace.Add(a.in.Amount),
-				}
-				storeMock.EXPECT().
-					UpdateAccount(ctx, dataUpdateAccount).
 					Return(assert.AnError)
 
 				return &PaymentTopup{
+					risk:      riskMock,
 					telemetry: tel,
 					validator: validatorMock,
 					store:     storeMock,
@@ -498,20 +550,22 @@ func TestPaymentTopup_Call(t *testing.T) {
 				in: domain.PaymentTopupInput{
 					ReferenceID: "uuid",
 					Amount:      decimal.NewFromFloat(123.45),
+					Currency:    "IDR",
 				},
 			},
 			want: &domain.PaymentTopupOutput{
 				ReferenceID: "uuid",
 				Amount:      decimal.NewFromFloat(123.45),
-				Balance:     decimal.NewFromFloat(123.45).Add(decimal.NewFromInt(1000)),
+				Currency:    domain.CurrencyIDR,
+				Status:      domain.TransactionStatusPending,
 			},
 			wantErr: nil,
 			mockFn: func(a args) *PaymentTopup {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mv.NewMockValidator(t)
 				muid := mu.NewMockNumberID(t)
 				clk := mclk.NewMockClocker(t)
-				trxMock := dbops.NewTransaction(dbops.NewNoopDB())
+				trxMock := sqlkit.NewNoopDB()
 				storeMock := mockz.NewMockPaymentTopupStore(t)
 
 				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTopup")
@@ -528,13 +582,22 @@ func TestPaymentTopup_Call(t *testing.T) {
 				account := &domain.Account{
 					ID:       22,
 					UserID:   11,
+					Currency: domain.CurrencyIDR,
 					Balanace: decimal.NewFromInt(1000),
 				}
 				storeMock.EXPECT().
-					FindAccountByUserID(ctx, uint64(11)).
+					LockAccount(ctx, uint64(11), domain.CurrencyIDR).
 					Return(account, nil)
 
-				ctx = dbops.SetContextNoopTx(ctx)
+				riskMock := mockz.NewMockRiskChecker(t)
+				riskMock.EXPECT().
+					Check(ctx, domain.RiskInput{
+						UserID:    11,
+						Operation: domain.RiskOperationTopup,
+						Amount:    a.in.Amount,
+						Currency:  domain.CurrencyIDR,
+					}).
+					Return(nil)
 
 				muid.EXPECT().
 					Generate().
@@ -549,8 +612,9 @@ func TestPaymentTopup_Call(t *testing.T) {
 					ID:       16,
 					UserID:   11,
 					Amount:   a.in.Amount,
-					Type:     domain.TransactionTypeDebit,
-					Status:   domain.TransactionStatusPending,
+					Currency: domain.CurrencyIDR,
+					Type:     enum.New(domain.TransactionTypeDebit),
+					Status:   enum.New(domain.TransactionStatusPending),
 					Remark:   "top up balance",
 					CreateAt: time.Time{},
 				}
@@ -573,15 +637,8 @@ func TestPaymentTopup_Call(t *testing.T) {
 					SaveTopup(ctx, dataTopup).
 					Return(nil)
 
-				dataUpdateAccount := map[string]any{
-					"id":      account.ID,
-					"balance": account.Balanace.Add(a.in.Amount),
-				}
-				storeMock.EXPECT().
-					UpdateAccount(ctx, dataUpdateAccount).
-					Return(nil)
-
 				return &PaymentTopup{
+					risk:      riskMock,
 					telemetry: tel,
 					validator: validatorMock,
 					store:     storeMock,
@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/shandysiswandi/goreng/uid"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/shopspring/decimal"
+)
+
+type PaymentTransferStore interface {
+	FindExchangeRate(ctx context.Context, base, quote domain.Currency) (*domain.ExchangeRate, error)
+	UserExists(ctx context.Context, userID uint64) (bool, error)
+	FindAccount(ctx context.Context, userID uint64, currency domain.Currency) (*domain.Account, error)
+	LockAccount(ctx context.Context, userID uint64, currency domain.Currency) (*domain.Account, error)
+	CreditAccount(ctx context.Context, acc domain.Account) error
+	DecreaseAccountBalance(ctx context.Context, userID uint64, currency domain.Currency, amount decimal.Decimal) error
+	SaveTransaction(ctx context.Context, trx domain.Transaction) error
+	SaveTransfer(ctx context.Context, transfer domain.Transfer) error
+}
+
+type PaymentTransfer struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	uidnumber uid.NumberID
+	clock     clock.Clocker
+	trx       sqlkit.Tx
+	store     PaymentTransferStore
+	risk      RiskChecker
+}
+
+func NewPaymentTransfer(dep Dependency, s PaymentTransferStore, r RiskChecker) *PaymentTransfer {
+	return &PaymentTransfer{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		uidnumber: dep.UIDNumber,
+		clock:     dep.Clock,
+		trx:       dep.Transaction,
+		store:     s,
+		risk:      r,
+	}
+}
+
+func (pt *PaymentTransfer) Call(ctx context.Context, in domain.PaymentTransferInput) (
+	*domain.PaymentTransferOutput, error,
+) {
+	ctx, span := pt.telemetry.Tracer().Start(ctx, "payment.usecase.PaymentTransfer")
+	defer span.End()
+
+	if err := pt.validator.Validate(in); err != nil {
+		pt.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	if in.TargetCurrency == "" {
+		in.TargetCurrency = in.Currency
+	}
+
+	currency, err := domain.ParseCurrency(in.Currency)
+	if err != nil {
+		pt.telemetry.Logger().Warn(ctx, "currency not supported", logger.KeyVal("currency", in.Currency))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	target, err := domain.ParseCurrency(in.TargetCurrency)
+	if err != nil {
+		pt.telemetry.Logger().Warn(ctx, "currency not supported", logger.KeyVal("currency", in.TargetCurrency))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	if err := currency.ValidateAmount(in.Amount); err != nil {
+		pt.telemetry.Logger().Warn(ctx, "invalid transfer amount", logger.KeyVal("currency", currency))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	clm := lib.GetJWTClaim(ctx)
+	if clm.AuthID == in.RecipientID {
+		pt.telemetry.Logger().Warn(ctx, "transfer to self", logger.KeyVal("user_id", clm.AuthID))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", domain.ErrTransferToSelf)
+	}
+
+	exists, err := pt.store.UserExists(ctx, in.RecipientID)
+	if err != nil {
+		pt.telemetry.Logger().Error(ctx, "failed to find recipient", err,
+			logger.KeyVal("user_id", in.RecipientID))
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if !exists {
+		pt.telemetry.Logger().Warn(ctx, "recipient is not found", logger.KeyVal("user_id", in.RecipientID))
+
+		return nil, goerror.NewBusiness("recipient not found", goerror.CodeNotFound)
+	}
+
+	acc, err := pt.store.FindAccount(ctx, clm.AuthID, currency)
+	if err != nil {
+		pt.telemetry.Logger().Error(ctx, "failed to find account", err, logger.KeyVal("user_id", clm.AuthID),
+			logger.KeyVal("currency", currency))
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if acc == nil {
+		pt.telemetry.Logger().Warn(ctx, "account is not found", logger.KeyVal("user_id", clm.AuthID),
+			logger.KeyVal("currency", currency))
+
+		return nil, goerror.NewBusiness("account not found", goerror.CodeNotFound)
+	}
+
+	if acc.Balanace.LessThan(in.Amount) {
+		pt.telemetry.Logger().Warn(ctx, "insufficient balance", logger.KeyVal("user_id", clm.AuthID))
+
+		return nil, goerror.NewBusiness("insufficient balance", goerror.CodeConflict)
+	}
+
+	rate, err := lookupRate(ctx, pt.store, currency, target)
+	if err != nil {
+		pt.telemetry.Logger().Error(ctx, "failed to find exchange rate", err,
+			logger.KeyVal("from", currency), logger.KeyVal("to", target))
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if rate == nil {
+		pt.telemetry.Logger().Warn(ctx, "exchange rate is not found", logger.KeyVal("from", currency),
+			logger.KeyVal("to", target))
+
+		return nil, goerror.NewBusiness("exchange rate not found", goerror.CodeNotFound)
+	}
+
+	transfer := domain.Transfer{
+		SenderID:       clm.AuthID,
+		RecipientID:    in.RecipientID,
+		Amount:         in.Amount,
+		Currency:       currency,
+		TargetAmount:   rate.Convert(in.Amount),
+		TargetCurrency: target,
+		Rate:           rate.Rate,
+	}
+
+	if !transfer.TargetAmount.IsPositive() {
+		pt.telemetry.Logger().Warn(ctx, "converted amount rounds to zero", logger.KeyVal("currency", target))
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", domain.ErrAmountNotPositive)
+	}
+
+	if err := pt.doTransaction(ctx, &transfer); err != nil {
+		return nil, err
+	}
+
+	return &domain.PaymentTransferOutput{
+		ID:             transfer.ID,
+		Amount:         transfer.Amount,
+		Currency:       transfer.Currency,
+		TargetAmount:   transfer.TargetAmount,
+		TargetCurrency: transfer.TargetCurrency,
+		Rate:           transfer.Rate,
+	}, nil
+}
+
+// doTransaction debits the sender, credits the recipient and records both legs
+// together with the applied rate. The sender's account is locked first and the
+// risk rules are checked under that lock, so concurrent transfers can not go past
+// a limit together. The recipient's sub-account in the target currency is opened
+// when it does not exist yet.
+func (pt *PaymentTransfer) doTransaction(ctx context.Context, transfer *domain.Transfer) error {
+	return pt.trx.Transaction(ctx, func(cc context.Context) error {
+		acc, err := pt.store.LockAccount(cc, transfer.SenderID, transfer.Currency)
+		if err != nil {
+			pt.telemetry.Logger().Error(ctx, "failed to lock account", err,
+				logger.KeyVal("user_id", transfer.SenderID))
+
+			return goerror.NewServerInternal(err)
+		}
+
+		if acc == nil {
+			pt.telemetry.Logger().Warn(ctx, "account is not found", logger.KeyVal("user_id", transfer.SenderID),
+				logger.KeyVal("currency", transfer.Currency))
+
+			return goerror.NewBusiness("account not found", goerror.CodeNotFound)
+		}
+
+		riskIn := domain.RiskInput{
+			UserID:    transfer.SenderID,
+			Operation: domain.RiskOperationTransfer,
+			Amount:    transfer.Amount,
+			Currency:  transfer.Currency,
+		}
+		if err := pt.risk.Check(cc, riskIn); err != nil {
+			return err
+		}
+
+		err = pt.store.DecreaseAccountBalance(cc, transfer.SenderID, transfer.Currency, transfer.Amount)
+		if errors.Is(err, domain.ErrAccountNoRowsAffected) {
+			pt.telemetry.Logger().Warn(ctx, "insufficient balance", logger.KeyVal("user_id", transfer.SenderID))
+
+			return goerror.NewBusiness("insufficient balance", goerror.CodeConflict)
+		}
+
+		if err != nil {
+			pt.telemetry.Logger().Error(ctx, "failed to debit account", err,
+				logger.KeyVal("user_id", transfer.SenderID))
+
+			return goerror.NewServerInternal(err)
+		}
+
+		if err := pt.creditRecipient(cc, transfer); err != nil {
+			pt.telemetry.Logger().Error(ctx, "failed to credit account", err,
+				logger.KeyVal("user_id", transfer.RecipientID))
+
+			return goerror.NewServerInternal(err)
+		}
+
+		now := pt.clock.Now()
+		debit := domain.Transaction{
+			ID:       pt.uidnumber.Generate(),
+			UserID:   transfer.SenderID,
+			Amount:   transfer.Amount,
+			Currency: transfer.Currency,
+			Type:     enum.New(domain.TransactionTypeDebit),
+			Status:   enum.New(domain.TransactionStatusSuccess),
+			Remark:   "transfer to user " + strconv.FormatUint(transfer.RecipientID, 10),
+			CreateAt: now,
+		}
+		credit := domain.Transaction{
+			ID:       pt.uidnumber.Generate(),
+			UserID:   transfer.RecipientID,
+			Amount:   transfer.TargetAmount,
+			Currency: transfer.TargetCurrency,
+			Type:     enum.New(domain.TransactionTypeCredit),
+			Status:   enum.New(domain.TransactionStatusSuccess),
+			Remark:   "transfer from user " + strconv.FormatUint(transfer.SenderID, 10),
+			CreateAt: now,
+		}
+		for _, trx := range []domain.Transaction{debit, credit} {
+			if err := pt.store.SaveTransaction(cc, trx); err != nil {
+				pt.telemetry.Logger().Error(ctx, "failed to save transaction", err,
+					logger.KeyVal("transaction_data", trx))
+
+				return goerror.NewServerInternal(err)
+			}
+		}
+
+		transfer.ID = pt.uidnumber.Generate()
+		transfer.TransactionID = debit.ID
+		if err := pt.store.SaveTransfer(cc, *transfer); err != nil {
+			pt.telemetry.Logger().Error(ctx, "failed to save transfer", err,
+				logger.KeyVal("transfer_data", transfer))
+
+			return goerror.NewServerInternal(err)
+		}
+
+		return nil
+	})
+}
+
+// creditRecipient credits the recipient's sub-account in the target currency,
+// opening it when it does not exist yet.
+func (pt *PaymentTransfer) creditRecipient(ctx context.Context, transfer *domain.Transfer) error {
+	return pt.store.CreditAccount(ctx, domain.Account{
+		ID:       pt.uidnumber.Generate(),
+		UserID:   transfer.RecipientID,
+		Currency: transfer.TargetCurrency,
+		Balanace: transfer.TargetAmount,
+	})
+}
@@ -0,0 +1,526 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/mockz"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaymentTransfer(t *testing.T) {
+	tests := []struct {
+		name string
+		dep  Dependency
+		s    PaymentTransferStore
+		want *PaymentTransfer
+	}{
+		{
+			name: "Success",
+			dep:  Dependency{},
+			s:    nil,
+			want: &PaymentTransfer{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewPaymentTransfer(tt.dep, tt.s, nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPaymentTransfer_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	amount := decimal.NewFromInt(10)
+	sender := &domain.Account{ID: 1, UserID: 11, Currency: domain.CurrencyUSD, Balanace: decimal.NewFromInt(100)}
+	usdIDR := &domain.ExchangeRate{
+		Base:  domain.CurrencyUSD,
+		Quote: domain.CurrencyIDR,
+		Rate:  decimal.RequireFromString("16266.75"),
+	}
+	input := domain.PaymentTransferInput{RecipientID: 12, Amount: amount, Currency: "USD", TargetCurrency: "IDR"}
+
+	type args struct {
+		ctx context.Context
+		in  domain.PaymentTransferInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.PaymentTransferOutput
+		wantErr error
+		mockFn  func(a args) *PaymentTransfer
+	}{
+		{
+			name:    "ErrorValidationInput",
+			args:    args{ctx: ctxJWT, in: domain.PaymentTransferInput{}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *PaymentTransfer {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(assert.AnError)
+
+				return &PaymentTransfer{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorTargetCurrencyNotSupported",
+			args: args{ctx: ctxJWT, in: domain.PaymentTransferInput{
+				RecipientID: 12, Amount: amount, Currency: "USD", TargetCurrency: "XXX",
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrCurrencyNotSupported),
+			mockFn: func(a args) *PaymentTransfer {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentTransfer{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name: "ErrorTransferToSelf",
+			args: args{ctx: ctxJWT, in: domain.PaymentTransferInput{
+				RecipientID: 11, Amount: amount, Currency: "USD",
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", domain.ErrTransferToSelf),
+			mockFn: func(a args) *PaymentTransfer {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+
+				return &PaymentTransfer{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorFindRecipient",
+			args:    args{ctx: ctxJWT, in: input},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(false, assert.AnError)
+
+				return &PaymentTransfer{
+					telemetry: tel,
+					validator: validatorMock,
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorRecipientNotFound",
+			args:    args{ctx: ctxJWT, in: input},
+			want:    nil,
+			wantErr: goerror.NewBusiness("recipient not found", goerror.CodeNotFound),
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(false, nil)
+
+				return &PaymentTransfer{
+					telemetry: tel,
+					validator: validatorMock,
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorAccountNotFound",
+			args:    args{ctx: ctxJWT, in: input},
+			want:    nil,
+			wantErr: goerror.NewBusiness("account not found", goerror.CodeNotFound),
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(true, nil)
+				storeMock.EXPECT().FindAccount(ctx, uint64(11), domain.CurrencyUSD).Return(nil, nil)
+
+				return &PaymentTransfer{
+					telemetry: tel,
+					validator: validatorMock,
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "ErrorInsufficientBalance",
+			args: args{ctx: ctxJWT, in: domain.PaymentTransferInput{
+				RecipientID: 12, Amount: decimal.NewFromInt(101), Currency: "USD",
+			}},
+			want:    nil,
+			wantErr: goerror.NewBusiness("insufficient balance", goerror.CodeConflict),
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(true, nil)
+				storeMock.EXPECT().FindAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+
+				return &PaymentTransfer{
+					telemetry: tel,
+					validator: validatorMock,
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorExchangeRateNotFound",
+			args:    args{ctx: ctxJWT, in: input},
+			want:    nil,
+			wantErr: goerror.NewBusiness("exchange rate not found", goerror.CodeNotFound),
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(true, nil)
+				storeMock.EXPECT().FindAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(nil, nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyIDR, domain.CurrencyUSD).
+					Return(nil, nil)
+
+				return &PaymentTransfer{
+					telemetry: tel,
+					validator: validatorMock,
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorLockAccount",
+			args:    args{ctx: ctxJWT, in: input},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(true, nil)
+				storeMock.EXPECT().FindAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(usdIDR, nil)
+				storeMock.EXPECT().LockAccount(ctx, uint64(11), domain.CurrencyUSD).Return(nil, assert.AnError)
+
+				return &PaymentTransfer{
+					telemetry: tel,
+					validator: validatorMock,
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorRiskRejected",
+			args:    args{ctx: ctxJWT, in: input},
+			want:    nil,
+			wantErr: goerror.NewBusiness("user is blocked from making payments", goerror.CodeForbidden),
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+				riskMock := mockz.NewMockRiskChecker(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(true, nil)
+				storeMock.EXPECT().FindAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(usdIDR, nil)
+				storeMock.EXPECT().LockAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+				riskMock.EXPECT().
+					Check(ctx, domain.RiskInput{
+						UserID:    11,
+						Operation: domain.RiskOperationTransfer,
+						Amount:    amount,
+						Currency:  domain.CurrencyUSD,
+					}).
+					Return(goerror.NewBusiness("user is blocked from making payments", goerror.CodeForbidden))
+
+				return &PaymentTransfer{
+					telemetry: tel,
+					validator: validatorMock,
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+					risk:      riskMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorTransactionDebitedConcurrently",
+			args:    args{ctx: ctxJWT, in: input},
+			want:    nil,
+			wantErr: goerror.NewBusiness("insufficient balance", goerror.CodeConflict),
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(true, nil)
+				storeMock.EXPECT().FindAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(usdIDR, nil)
+
+				storeMock.EXPECT().LockAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+
+				riskMock := mockz.NewMockRiskChecker(t)
+				riskMock.EXPECT().
+					Check(ctx, domain.RiskInput{
+						UserID:    11,
+						Operation: domain.RiskOperationTransfer,
+						Amount:    amount,
+						Currency:  domain.CurrencyUSD,
+					}).
+					Return(nil)
+				storeMock.EXPECT().
+					DecreaseAccountBalance(ctx, uint64(11), domain.CurrencyUSD, amount).
+					Return(domain.ErrAccountNoRowsAffected)
+
+				return &PaymentTransfer{
+					risk:      riskMock,
+					telemetry: tel,
+					validator: validatorMock,
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorTransactionCreditRecipient",
+			args:    args{ctx: ctxJWT, in: input},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				muid := mocker.NewMockNumberID(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(true, nil)
+				storeMock.EXPECT().FindAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(usdIDR, nil)
+
+				storeMock.EXPECT().LockAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+
+				riskMock := mockz.NewMockRiskChecker(t)
+				riskMock.EXPECT().
+					Check(ctx, domain.RiskInput{
+						UserID:    11,
+						Operation: domain.RiskOperationTransfer,
+						Amount:    amount,
+						Currency:  domain.CurrencyUSD,
+					}).
+					Return(nil)
+				storeMock.EXPECT().
+					DecreaseAccountBalance(ctx, uint64(11), domain.CurrencyUSD, amount).
+					Return(nil)
+				muid.EXPECT().Generate().Return(30)
+				storeMock.EXPECT().
+					CreditAccount(ctx, domain.Account{
+						ID:       30,
+						UserID:   12,
+						Currency: domain.CurrencyIDR,
+						Balanace: usdIDR.Convert(amount),
+					}).
+					Return(assert.AnError)
+
+				return &PaymentTransfer{
+					risk:      riskMock,
+					telemetry: tel,
+					validator: validatorMock,
+					uidnumber: muid,
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{ctx: ctxJWT, in: input},
+			want: &domain.PaymentTransferOutput{
+				ID:             33,
+				Amount:         amount,
+				Currency:       domain.CurrencyUSD,
+				TargetAmount:   usdIDR.Convert(amount),
+				TargetCurrency: domain.CurrencyIDR,
+				Rate:           usdIDR.Rate,
+			},
+			wantErr: nil,
+			mockFn: func(a args) *PaymentTransfer {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				muid := mocker.NewMockNumberID(t)
+				clk := mocker.NewMockClocker(t)
+				storeMock := mockz.NewMockPaymentTransferStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentTransfer")
+				defer span.End()
+
+				target := usdIDR.Convert(amount)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				storeMock.EXPECT().UserExists(ctx, uint64(12)).Return(true, nil)
+				storeMock.EXPECT().FindAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+				storeMock.EXPECT().
+					FindExchangeRate(ctx, domain.CurrencyUSD, domain.CurrencyIDR).
+					Return(usdIDR, nil)
+
+				storeMock.EXPECT().LockAccount(ctx, uint64(11), domain.CurrencyUSD).Return(sender, nil)
+
+				riskMock := mockz.NewMockRiskChecker(t)
+				riskMock.EXPECT().
+					Check(ctx, domain.RiskInput{
+						UserID:    11,
+						Operation: domain.RiskOperationTransfer,
+						Amount:    amount,
+						Currency:  domain.CurrencyUSD,
+					}).
+					Return(nil)
+				storeMock.EXPECT().
+					DecreaseAccountBalance(ctx, uint64(11), domain.CurrencyUSD, amount).
+					Return(nil)
+				muid.EXPECT().Generate().Return(30).Once()
+				storeMock.EXPECT().
+					CreditAccount(ctx, domain.Account{
+						ID:       30,
+						UserID:   12,
+						Currency: domain.CurrencyIDR,
+						Balanace: target,
+					}).
+					Return(nil)
+
+				clk.EXPECT().Now().Return(time.Time{})
+				muid.EXPECT().Generate().Return(31).Once()
+				muid.EXPECT().Generate().Return(32).Once()
+				storeMock.EXPECT().
+					SaveTransaction(ctx, domain.Transaction{
+						ID:       31,
+						UserID:   11,
+						Amount:   amount,
+						Currency: domain.CurrencyUSD,
+						Type:     enum.New(domain.TransactionTypeDebit),
+						Status:   enum.New(domain.TransactionStatusSuccess),
+						Remark:   "transfer to user 12",
+					}).
+					Return(nil)
+				storeMock.EXPECT().
+					SaveTransaction(ctx, domain.Transaction{
+						ID:       32,
+						UserID:   12,
+						Amount:   target,
+						Currency: domain.CurrencyIDR,
+						Type:     enum.New(domain.TransactionTypeCredit),
+						Status:   enum.New(domain.TransactionStatusSuccess),
+						Remark:   "transfer from user 11",
+					}).
+					Return(nil)
+
+				muid.EXPECT().Generate().Return(33).Once()
+				storeMock.EXPECT().
+					SaveTransfer(ctx, domain.Transfer{
+						ID:             33,
+						TransactionID:  31,
+						SenderID:       11,
+						RecipientID:    12,
+						Amount:         amount,
+						Currency:       domain.CurrencyUSD,
+						TargetAmount:   target,
+						TargetCurrency: domain.CurrencyIDR,
+						Rate:           usdIDR.Rate,
+					}).
+					Return(nil)
+
+				return &PaymentTransfer{
+					risk:      riskMock,
+					telemetry: tel,
+					validator: validatorMock,
+					uidnumber: muid,
+					clock:     clk,
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
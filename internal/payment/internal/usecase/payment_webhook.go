@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/shandysiswandi/goreng/codec"
+	"github.com/shandysiswandi/goreng/config"
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/shopspring/decimal"
+)
+
+type PaymentWebhookStore interface {
+	FindTopupByReferenceID(ctx context.Context, refID string) (*domain.Topup, error)
+	FindTransactionByID(ctx context.Context, id uint64) (*domain.Transaction, error)
+	UpdateTransactionStatus(ctx context.Context, id uint64, from, to domain.TransactionStatus) error
+	IncreaseAccountBalance(ctx context.Context, userID uint64, currency domain.Currency, amount decimal.Decimal) error
+}
+
+type PaymentWebhook struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	config    config.Config
+	cjson     codec.Codec
+	trx       sqlkit.Tx
+	store     PaymentWebhookStore
+}
+
+func NewPaymentWebhook(dep Dependency, s PaymentWebhookStore) *PaymentWebhook {
+	return &PaymentWebhook{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		config:    dep.Config,
+		cjson:     dep.CodecJSON,
+		trx:       dep.Transaction,
+		store:     s,
+	}
+}
+
+func (pw *PaymentWebhook) Call(ctx context.Context, in domain.PaymentWebhookInput) (
+	*domain.PaymentWebhookOutput, error,
+) {
+	ctx, span := pw.telemetry.Tracer().Start(ctx, "payment.usecase.PaymentWebhook")
+	defer span.End()
+
+	if err := pw.validator.Validate(in); err != nil {
+		pw.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	secret := pw.config.GetString("payment.webhook." + in.Provider + ".secret")
+	if secret == "" {
+		pw.telemetry.Logger().Warn(ctx, "unknown payment provider", logger.KeyVal("provider", in.Provider))
+
+		return nil, goerror.NewBusiness("payment provider not found", goerror.CodeNotFound)
+	}
+
+	if !domain.VerifyWebhook(secret, in.Payload, in.Signature) {
+		pw.telemetry.Logger().Warn(ctx, "invalid webhook signature", logger.KeyVal("provider", in.Provider))
+
+		return nil, goerror.NewBusiness("invalid webhook signature", goerror.CodeUnauthorized)
+	}
+
+	var event domain.WebhookEvent
+	if err := pw.cjson.Decode(in.Payload, &event); err != nil {
+		pw.telemetry.Logger().Warn(ctx, "failed to decode webhook payload", logger.KeyVal("provider", in.Provider))
+
+		return nil, goerror.NewInvalidFormat("Webhook payload malformed")
+	}
+
+	status := enum.Parse[domain.TransactionStatus](event.Status)
+	if status != domain.TransactionStatusSuccess && status != domain.TransactionStatusFailed {
+		pw.telemetry.Logger().Warn(ctx, "unsupported webhook status", logger.KeyVal("status", event.Status))
+
+		return nil, goerror.NewInvalidFormat("Webhook payload malformed")
+	}
+
+	top, err := pw.store.FindTopupByReferenceID(ctx, event.ReferenceID)
+	if err != nil {
+		pw.telemetry.Logger().Error(ctx, "failed to get topup by ref_id", err,
+			logger.KeyVal("reference_id", event.ReferenceID))
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if top == nil {
+		pw.telemetry.Logger().Warn(ctx, "topup is not found", logger.KeyVal("reference_id", event.ReferenceID))
+
+		return nil, goerror.NewBusiness("topup not found", goerror.CodeNotFound)
+	}
+
+	if !top.Amount.Equal(event.Amount) {
+		pw.telemetry.Logger().Warn(ctx, "webhook amount does not match topup",
+			logger.KeyVal("reference_id", event.ReferenceID))
+
+		return nil, goerror.NewBusiness("webhook amount does not match topup", goerror.CodeConflict)
+	}
+
+	settled, err := pw.doTransaction(ctx, top, status, event.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PaymentWebhookOutput{
+		ReferenceID: event.ReferenceID,
+		Status:      settled,
+	}, nil
+}
+
+// doTransaction moves the topup transaction out of PENDING and credits the
+// account when the provider reports SUCCESS. A transaction that is already
+// settled is left untouched and its current status is returned, which makes
+// retried webhooks for the same reference_id harmless. The event currency must
+// match the currency the topup was requested in.
+func (pw *PaymentWebhook) doTransaction(ctx context.Context, top *domain.Topup,
+	status domain.TransactionStatus, currency string,
+) (domain.TransactionStatus, error) {
+	settled := status
+
+	err := pw.trx.Transaction(ctx, func(cc context.Context) error {
+		trx, err := pw.store.FindTransactionByID(cc, top.TransactionID)
+		if err != nil {
+			pw.telemetry.Logger().Error(ctx, "failed to find transaction", err,
+				logger.KeyVal("transaction_id", top.TransactionID))
+
+			return goerror.NewServerInternal(err)
+		}
+
+		if trx == nil {
+			pw.telemetry.Logger().Warn(ctx, "transaction of topup is missing",
+				logger.KeyVal("transaction_id", top.TransactionID))
+
+			return goerror.NewServerInternal(domain.ErrTransactionNoRowsAffected)
+		}
+
+		if trx.Currency.String() != strings.ToUpper(currency) {
+			pw.telemetry.Logger().Warn(ctx, "webhook currency does not match topup",
+				logger.KeyVal("reference_id", top.ReferenceID))
+
+			return goerror.NewBusiness("webhook currency does not match topup", goerror.CodeConflict)
+		}
+
+		if trx.Status.Enum() != domain.TransactionStatusPending {
+			pw.telemetry.Logger().Info(ctx, "topup already settled",
+				logger.KeyVal("reference_id", top.ReferenceID))
+			settled = trx.Status.Enum()
+
+			return nil
+		}
+
+		err = pw.store.UpdateTransactionStatus(cc, trx.ID, domain.TransactionStatusPending, status)
+		if errors.Is(err, domain.ErrTransactionNoRowsAffected) {
+			pw.telemetry.Logger().Warn(ctx, "topup is settled concurrently",
+				logger.KeyVal("reference_id", top.ReferenceID))
+
+			return goerror.NewBusiness("topup is being settled", goerror.CodeConflict)
+		}
+
+		if err != nil {
+			pw.telemetry.Logger().Error(ctx, "failed to update transaction status", err,
+				logger.KeyVal("transaction_id", trx.ID))
+
+			return goerror.NewServerInternal(err)
+		}
+
+		if status != domain.TransactionStatusSuccess {
+			return nil
+		}
+
+		if err := pw.store.IncreaseAccountBalance(cc, trx.UserID, trx.Currency, top.Amount); err != nil {
+			pw.telemetry.Logger().Error(ctx, "failed to credit account", err,
+				logger.KeyVal("user_id", trx.UserID))
+
+			return goerror.NewServerInternal(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.TransactionStatusUnknown, err
+	}
+
+	return settled, nil
+}
@@ -0,0 +1,544 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shandysiswandi/goreng/codec"
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/mockz"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/outbound"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaymentWebhook(t *testing.T) {
+	tests := []struct {
+		name string
+		dep  Dependency
+		s    PaymentWebhookStore
+		want *PaymentWebhook
+	}{
+		{
+			name: "Success",
+			dep:  Dependency{},
+			s:    nil,
+			want: &PaymentWebhook{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewPaymentWebhook(tt.dep, tt.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPaymentWebhook_Call(t *testing.T) {
+	provider := outbound.NewFakeProvider("fakepay", "secret")
+	secretKey := "payment.webhook.fakepay.secret"
+	amount := decimal.NewFromInt(1000)
+
+	input := func(status domain.TransactionStatus, amt decimal.Decimal) domain.PaymentWebhookInput {
+		payload, sig := provider.Event("uuid", status, amt, domain.CurrencyIDR)
+
+		return domain.PaymentWebhookInput{
+			Provider:  provider.Name(),
+			Signature: sig,
+			Payload:   payload,
+		}
+	}
+
+	topup := &domain.Topup{ID: 19, TransactionID: 16, ReferenceID: "uuid", Amount: amount}
+	pending := &domain.Transaction{
+		ID:       16,
+		UserID:   11,
+		Amount:   amount,
+		Currency: domain.CurrencyIDR,
+		Status:   enum.New(domain.TransactionStatusPending),
+	}
+
+	type args struct {
+		ctx context.Context
+		in  domain.PaymentWebhookInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.PaymentWebhookOutput
+		wantErr error
+		mockFn  func(a args) *PaymentWebhook
+	}{
+		{
+			name:    "ErrorValidationInput",
+			args:    args{ctx: context.Background(), in: domain.PaymentWebhookInput{}},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *PaymentWebhook {
+				validatorMock := mocker.NewMockValidator(t)
+
+				validatorMock.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &PaymentWebhook{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorUnknownProvider",
+			args:    args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, amount)},
+			want:    nil,
+			wantErr: goerror.NewBusiness("payment provider not found", goerror.CodeNotFound),
+			mockFn: func(a args) *PaymentWebhook {
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("")
+
+				return &PaymentWebhook{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+					config:    configMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorInvalidSignature",
+			args:    args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, amount)},
+			want:    nil,
+			wantErr: goerror.NewBusiness("invalid webhook signature", goerror.CodeUnauthorized),
+			mockFn: func(a args) *PaymentWebhook {
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("not-the-secret")
+
+				return &PaymentWebhook{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+					config:    configMock,
+				}
+			},
+		},
+		{
+			name: "ErrorMalformedPayload",
+			args: args{ctx: context.Background(), in: domain.PaymentWebhookInput{
+				Provider:  "fakepay",
+				Signature: domain.SignWebhook("secret", []byte("{")),
+				Payload:   []byte("{"),
+			}},
+			want:    nil,
+			wantErr: goerror.NewInvalidFormat("Webhook payload malformed"),
+			mockFn: func(a args) *PaymentWebhook {
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+
+				return &PaymentWebhook{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+				}
+			},
+		},
+		{
+			name:    "ErrorUnsupportedStatus",
+			args:    args{ctx: context.Background(), in: input(domain.TransactionStatusPending, amount)},
+			want:    nil,
+			wantErr: goerror.NewInvalidFormat("Webhook payload malformed"),
+			mockFn: func(a args) *PaymentWebhook {
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+
+				return &PaymentWebhook{
+					telemetry: lib.NewTelemetry(),
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+				}
+			},
+		},
+		{
+			name:    "ErrorStoreFindTopupByReferenceID",
+			args:    args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, amount)},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(nil, assert.AnError)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorTopupNotFound",
+			args:    args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, amount)},
+			want:    nil,
+			wantErr: goerror.NewBusiness("topup not found", goerror.CodeNotFound),
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(nil, nil)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorAmountMismatch",
+			args:    args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, decimal.NewFromInt(1))},
+			want:    nil,
+			wantErr: goerror.NewBusiness("webhook amount does not match topup", goerror.CodeConflict),
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(topup, nil)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorStoreFindTransactionByID",
+			args:    args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, amount)},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(topup, nil)
+				storeMock.EXPECT().
+					FindTransactionByID(ctx, topup.TransactionID).
+					Return(nil, assert.AnError)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "SuccessAlreadySettled",
+			args: args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, amount)},
+			want: &domain.PaymentWebhookOutput{
+				ReferenceID: "uuid",
+				Status:      domain.TransactionStatusFailed,
+			},
+			wantErr: nil,
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(topup, nil)
+
+				settled := *pending
+				settled.Status = enum.New(domain.TransactionStatusFailed)
+				storeMock.EXPECT().
+					FindTransactionByID(ctx, topup.TransactionID).
+					Return(&settled, nil)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "ErrorCurrencyMismatch",
+			args: args{ctx: context.Background(), in: func() domain.PaymentWebhookInput {
+				payload, sig := provider.Event("uuid", domain.TransactionStatusSuccess, amount, domain.CurrencyUSD)
+
+				return domain.PaymentWebhookInput{Provider: provider.Name(), Signature: sig, Payload: payload}
+			}()},
+			want:    nil,
+			wantErr: goerror.NewBusiness("webhook currency does not match topup", goerror.CodeConflict),
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(topup, nil)
+				storeMock.EXPECT().
+					FindTransactionByID(ctx, topup.TransactionID).
+					Return(pending, nil)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorSettledConcurrently",
+			args:    args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, amount)},
+			want:    nil,
+			wantErr: goerror.NewBusiness("topup is being settled", goerror.CodeConflict),
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(topup, nil)
+				storeMock.EXPECT().
+					FindTransactionByID(ctx, topup.TransactionID).
+					Return(pending, nil)
+				storeMock.EXPECT().
+					UpdateTransactionStatus(ctx, pending.ID, domain.TransactionStatusPending,
+						domain.TransactionStatusSuccess).
+					Return(domain.ErrTransactionNoRowsAffected)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name:    "ErrorStoreIncreaseAccountBalance",
+			args:    args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, amount)},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(topup, nil)
+				storeMock.EXPECT().
+					FindTransactionByID(ctx, topup.TransactionID).
+					Return(pending, nil)
+				storeMock.EXPECT().
+					UpdateTransactionStatus(ctx, pending.ID, domain.TransactionStatusPending,
+						domain.TransactionStatusSuccess).
+					Return(nil)
+				storeMock.EXPECT().
+					IncreaseAccountBalance(ctx, pending.UserID, pending.Currency, topup.Amount).
+					Return(assert.AnError)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "SuccessFailed",
+			args: args{ctx: context.Background(), in: input(domain.TransactionStatusFailed, amount)},
+			want: &domain.PaymentWebhookOutput{
+				ReferenceID: "uuid",
+				Status:      domain.TransactionStatusFailed,
+			},
+			wantErr: nil,
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(topup, nil)
+				storeMock.EXPECT().
+					FindTransactionByID(ctx, topup.TransactionID).
+					Return(pending, nil)
+				storeMock.EXPECT().
+					UpdateTransactionStatus(ctx, pending.ID, domain.TransactionStatusPending,
+						domain.TransactionStatusFailed).
+					Return(nil)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{ctx: context.Background(), in: input(domain.TransactionStatusSuccess, amount)},
+			want: &domain.PaymentWebhookOutput{
+				ReferenceID: "uuid",
+				Status:      domain.TransactionStatusSuccess,
+			},
+			wantErr: nil,
+			mockFn: func(a args) *PaymentWebhook {
+				tel := lib.NewTelemetry()
+				validatorMock := mocker.NewMockValidator(t)
+				configMock := mocker.NewMockConfig(t)
+				storeMock := mockz.NewMockPaymentWebhookStore(t)
+
+				ctx, span := tel.Tracer().Start(a.ctx, "payment.usecase.PaymentWebhook")
+				defer span.End()
+
+				validatorMock.EXPECT().Validate(a.in).Return(nil)
+				configMock.EXPECT().GetString(secretKey).Return("secret")
+				storeMock.EXPECT().
+					FindTopupByReferenceID(ctx, "uuid").
+					Return(topup, nil)
+				storeMock.EXPECT().
+					FindTransactionByID(ctx, topup.TransactionID).
+					Return(pending, nil)
+				storeMock.EXPECT().
+					UpdateTransactionStatus(ctx, pending.ID, domain.TransactionStatusPending,
+						domain.TransactionStatusSuccess).
+					Return(nil)
+				storeMock.EXPECT().
+					IncreaseAccountBalance(ctx, pending.UserID, pending.Currency, topup.Amount).
+					Return(nil)
+
+				return &PaymentWebhook{
+					telemetry: tel,
+					validator: validatorMock,
+					config:    configMock,
+					cjson:     codec.NewJSONCodec(),
+					trx:       sqlkit.NewNoopDB(),
+					store:     storeMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
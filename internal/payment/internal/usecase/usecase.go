@@ -6,9 +6,9 @@ import (
 	"github.com/shandysiswandi/goreng/config"
 	"github.com/shandysiswandi/goreng/goroutine"
 	"github.com/shandysiswandi/goreng/messaging"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 )
 
@@ -18,8 +18,8 @@ type Dependency struct {
 	UIDNumber   uid.NumberID
 	CodecJSON   codec.Codec
 	Validator   validation.Validator
-	Transaction dbops.Tx
-	Telemetry   *telemetry.Telemetry
+	Transaction sqlkit.Tx
+	Telemetry   *lib.Telemetry
 	Goroutine   *goroutine.Manager
 	Clock       clock.Clocker
 }
@@ -1,58 +1,82 @@
 package payment
 
 import (
-	"database/sql"
-	"hash"
-
-	"github.com/doug-martin/goqu/v9"
 	"github.com/shandysiswandi/goreng/clock"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/codec"
+	"github.com/shandysiswandi/goreng/config"
+	"github.com/shandysiswandi/goreng/hash"
+	"github.com/shandysiswandi/goreng/task"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/inbound"
+	"github.com/shandysiswandi/gostarter/internal/payment/internal/job"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/outbound"
 	"github.com/shandysiswandi/gostarter/internal/payment/internal/usecase"
-	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 )
 
-type Expose struct{}
+type Expose struct {
+	Tasks []task.Runner
+}
 
 type Dependency struct {
-	Database     *sql.DB
-	Transaction  dbops.Tx
-	QueryBuilder goqu.DialectWrapper
-	Telemetry    *telemetry.Telemetry
-	Router       *framework.Router
-	Validator    validation.Validator
-	UIDNumber    uid.NumberID
-	Hash         hash.Hash
-	SecHash      hash.Hash
-	Clock        clock.Clocker
+	SQLKitDB  *sqlkit.DB
+	Config    config.Config
+	CodecJSON codec.Codec
+	Telemetry *lib.Telemetry
+	Router    *framework.Router
+	Validator validation.Validator
+	UIDNumber uid.NumberID
+	Hash      hash.Hash
+	SecHash   hash.Hash
+	Clock     clock.Clocker
 }
 
 func New(dep Dependency) (*Expose, error) {
 	// This block initializes outbound services: Database, HTTP client, gRPC client, Redis, etc.
-	sqlPayment := outbound.NewSQLPayment(dep.Database, dep.QueryBuilder, dep.Telemetry)
+	sqlPayment := outbound.NewSQLPayment(dep.SQLKitDB, dep.Telemetry)
 
 	// This block initializes core business logic or use cases to handle user interaction
 	ucDep := usecase.Dependency{
+		Config:      dep.Config,
+		CodecJSON:   dep.CodecJSON,
 		UIDNumber:   dep.UIDNumber,
 		Validator:   dep.Validator,
-		Transaction: dep.Transaction,
+		Transaction: dep.SQLKitDB.Tx(),
 		Telemetry:   dep.Telemetry,
 		Clock:       dep.Clock,
 	}
-	paymentTopupUC := usecase.NewPaymentTopup(ucDep, sqlPayment)
+
+	riskEngine := usecase.NewRiskEngine(ucDep, sqlPayment)
+
+	paymentTopupUC := usecase.NewPaymentTopup(ucDep, sqlPayment, riskEngine)
+	paymentWebhookUC := usecase.NewPaymentWebhook(ucDep, sqlPayment)
+	paymentConvertUC := usecase.NewPaymentConvert(ucDep, sqlPayment)
+	paymentTransferUC := usecase.NewPaymentTransfer(ucDep, sqlPayment, riskEngine)
+	paymentScheduleCreateUC := usecase.NewPaymentScheduleCreate(ucDep, sqlPayment)
+	paymentScheduleExecuteUC := usecase.NewPaymentScheduleExecute(ucDep, sqlPayment, paymentTransferUC)
 
 	// This block initializes REST, SSE, gRPC, and graphQL API endpoints to handle core user workflows:
 	inbound := inbound.Inbound{
 		Router:    dep.Router,
 		Telemetry: dep.Telemetry,
 		//
-		PaymentTopupUC: paymentTopupUC,
+		PaymentTopupUC:    paymentTopupUC,
+		PaymentWebhookUC:  paymentWebhookUC,
+		PaymentConvertUC:  paymentConvertUC,
+		PaymentTransferUC: paymentTransferUC,
+		PaymentScheduleUC: paymentScheduleCreateUC,
 	}
 	inbound.RegisterPaymentServiceServer()
 
-	return &Expose{}, nil
+	// This block initializes runner job to handle background workflows:
+	jobs := job.New(job.Dependency{
+		Config:                dep.Config,
+		Telemetry:             dep.Telemetry,
+		DomainScheduleExecute: paymentScheduleExecuteUC,
+	})
+
+	return &Expose{Tasks: jobs}, nil
 }
@@ -3,10 +3,11 @@ package payment
 import (
 	"testing"
 
-	"github.com/doug-martin/goqu/v9"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNew(t *testing.T) {
@@ -18,17 +19,21 @@ func TestNew(t *testing.T) {
 		{
 			name: "Success",
 			dep: func() Dependency {
+				mc := mocker.NewMockConfig(t)
+				mc.EXPECT().GetString(mock.Anything).Return("")
+				mc.EXPECT().GetInt(mock.Anything).Return(0)
+				mc.EXPECT().GetBool("feature.flag.payment.schedule").Return(true).Once()
+
 				return Dependency{
-					Database:     nil,
-					Transaction:  nil,
-					QueryBuilder: goqu.DialectWrapper{},
-					Telemetry:    telemetry.NewTelemetry(),
-					Router:       framework.NewRouter(),
-					Validator:    nil,
-					UIDNumber:    nil,
-					Hash:         nil,
-					SecHash:      nil,
-					Clock:        nil,
+					Config:    mc,
+					CodecJSON: nil,
+					Telemetry: lib.NewTelemetry(),
+					Router:    framework.NewRouter(),
+					Validator: nil,
+					UIDNumber: nil,
+					Hash:      nil,
+					SecHash:   nil,
+					Clock:     nil,
 				}
 			},
 			wantErr: nil,
@@ -7,9 +7,9 @@ import (
 var ErrPermissionNotCreated = errors.New("permission not created")
 
 type Permission struct {
-	ID          uint64
-	Name        string
-	Description string
+	ID          uint64 `db:"id"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
 }
 
 func (p *Permission) ScanColumn() []any {
@@ -5,9 +5,9 @@ import "errors"
 var ErrRoleNotCreated = errors.New("role not created")
 
 type Role struct {
-	ID          uint64
-	Name        string
-	Description string
+	ID          uint64 `db:"id"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
 }
 
 func (r *Role) ScanColumn() []any {
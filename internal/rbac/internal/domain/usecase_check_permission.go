@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+type CheckPermission interface {
+	Call(ctx context.Context, in CheckPermissionInput) (*CheckPermissionOutput, error)
+}
+
+type CheckPermissionInput struct {
+	Permission string
+}
+
+type CheckPermissionOutput struct {
+	Allowed bool
+}
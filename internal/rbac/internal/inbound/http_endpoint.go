@@ -1,22 +1,13 @@
 package inbound
 
 import (
-	"encoding/json"
-	"strconv"
-
-	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 )
 
-var (
-	errInvalidBody       = goerror.NewInvalidFormat("Request payload malformed")
-	errFailedParseToUint = goerror.NewInvalidFormat("failed parse id to uint")
-)
-
 type httpEndpoint struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 
 	createRoleUC domain.CreateRole
 	findRoleUC   domain.FindRole
@@ -33,9 +24,9 @@ func (h *httpEndpoint) CreateRole(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "rbac.inbound.httpEndpoint.CreateRole")
 	defer span.End()
 
-	var req CreateRoleRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[CreateRoleRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.createRoleUC.Call(ctx, domain.CreateRoleInput{
@@ -53,12 +44,12 @@ func (h *httpEndpoint) FindRole(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "rbac.inbound.httpEndpoint.FindRole")
 	defer span.End()
 
-	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	req, err := framework.Bind[FindRoleRequest](c)
 	if err != nil {
-		return nil, errFailedParseToUint
+		return nil, err
 	}
 
-	resp, err := h.findRoleUC.Call(ctx, domain.FindRoleInput{ID: id})
+	resp, err := h.findRoleUC.Call(ctx, domain.FindRoleInput{ID: req.ID})
 	if err != nil {
 		return nil, err
 	}
@@ -74,10 +65,15 @@ func (h *httpEndpoint) FetchRole(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "rbac.inbound.httpEndpoint.FetchRole")
 	defer span.End()
 
+	req, err := framework.Bind[FetchRoleRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := h.fetchRoleUC.Call(ctx, domain.FetchRoleInput{
-		Cursor: c.Query("cursor"),
-		Limit:  c.Query("limit"),
-		Name:   c.Query("name"),
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+		Name:   req.Name,
 	})
 	if err != nil {
 		return nil, err
@@ -105,18 +101,13 @@ func (h *httpEndpoint) UpdateRole(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "rbac.inbound.httpEndpoint.UpdateRole")
 	defer span.End()
 
-	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	req, err := framework.Bind[UpdateRoleRequest](c)
 	if err != nil {
-		return nil, errFailedParseToUint
-	}
-
-	var req UpdateRoleRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+		return nil, err
 	}
 
 	resp, err := h.updateRoleUC.Call(ctx, domain.UpdateRoleInput{
-		ID:          id,
+		ID:          req.ID,
 		Name:        req.Name,
 		Description: req.Description,
 	})
@@ -135,12 +126,12 @@ func (h *httpEndpoint) FindPermission(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "rbac.inbound.httpEndpoint.FindPermission")
 	defer span.End()
 
-	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	req, err := framework.Bind[FindPermissionRequest](c)
 	if err != nil {
-		return nil, errFailedParseToUint
+		return nil, err
 	}
 
-	resp, err := h.findPermissionUC.Call(ctx, domain.FindPermissionInput{ID: id})
+	resp, err := h.findPermissionUC.Call(ctx, domain.FindPermissionInput{ID: req.ID})
 	if err != nil {
 		return nil, err
 	}
@@ -156,10 +147,15 @@ func (h *httpEndpoint) FetchPermission(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "rbac.inbound.httpEndpoint.FetchPermission")
 	defer span.End()
 
+	req, err := framework.Bind[FetchPermissionRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := h.fetchPermissionUC.Call(ctx, domain.FetchPermissionInput{
-		Cursor: c.Query("cursor"),
-		Limit:  c.Query("limit"),
-		Name:   c.Query("name"),
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+		Name:   req.Name,
 	})
 	if err != nil {
 		return nil, err
@@ -187,9 +183,9 @@ func (h *httpEndpoint) CreatePermission(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "rbac.inbound.httpEndpoint.CreatePermission")
 	defer span.End()
 
-	var req CreatePermissionRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[CreatePermissionRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.createPermissionUC.Call(ctx, domain.CreatePermissionInput{
@@ -207,18 +203,13 @@ func (h *httpEndpoint) UpdatePermission(c framework.Context) (any, error) {
 	ctx, span := h.telemetry.Tracer().Start(c.Context(), "rbac.inbound.httpEndpoint.UpdatePermission")
 	defer span.End()
 
-	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	req, err := framework.Bind[UpdatePermissionRequest](c)
 	if err != nil {
-		return nil, errFailedParseToUint
-	}
-
-	var req UpdateRoleRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+		return nil, err
 	}
 
 	resp, err := h.updatePermissionUC.Call(ctx, domain.UpdatePermissionInput{
-		ID:          id,
+		ID:          req.ID,
 		Name:        req.Name,
 		Description: req.Description,
 	})
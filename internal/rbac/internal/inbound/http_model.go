@@ -28,8 +28,15 @@ type (
 	}
 )
 
+type (
+	FindRoleRequest struct {
+		ID uint64 `param:"id"`
+	}
+)
+
 type (
 	UpdateRoleRequest struct {
+		ID          uint64 `param:"id"`
 		Name        string `json:"name"`
 		Description string `json:"description"`
 	}
@@ -37,10 +44,9 @@ type (
 
 type (
 	FetchRoleRequest struct {
-		ID          string `json:"id"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Status      string `json:"status"`
+		Cursor string `query:"cursor"`
+		Limit  string `query:"limit"`
+		Name   string `query:"name"`
 	}
 
 	FetchRoleResponse struct {
@@ -60,8 +66,15 @@ type (
 	}
 )
 
+type (
+	FindPermissionRequest struct {
+		ID uint64 `param:"id"`
+	}
+)
+
 type (
 	UpdatePermissionRequest struct {
+		ID          uint64 `param:"id"`
 		Name        string `json:"name"`
 		Description string `json:"description"`
 	}
@@ -69,10 +82,9 @@ type (
 
 type (
 	FetchPermissionRequest struct {
-		ID          string `json:"id"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Status      string `json:"status"`
+		Cursor string `query:"cursor"`
+		Limit  string `query:"limit"`
+		Name   string `query:"name"`
 	}
 
 	FetchPermissionResponse struct {
@@ -3,14 +3,14 @@ package inbound
 import (
 	"net/http"
 
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 )
 
 type Inbound struct {
 	Router    *framework.Router
-	Telemetry *telemetry.Telemetry
+	Telemetry *lib.Telemetry
 	//
 	CreateRole domain.CreateRole
 	FindRole   domain.FindRole
@@ -38,13 +38,45 @@ func (in Inbound) RegisterRBACServiceServer() {
 		updatePermissionUC: in.UpdatePermission,
 	}
 
-	in.Router.Endpoint(http.MethodPost, "/rbac/roles", he.CreateRole)
-	in.Router.Endpoint(http.MethodGet, "/rbac/roles", he.FetchRole)
-	in.Router.Endpoint(http.MethodGet, "/rbac/roles/:id", he.FindRole)
-	in.Router.Endpoint(http.MethodPut, "/rbac/roles/:id", he.UpdateRole)
+	roles := in.Router.Group("/rbac/roles")
+	roles.Permission("rbac.role.write").Doc(framework.RouteDoc{
+		Summary:  "Create a role",
+		Request:  CreateRoleRequest{},
+		Response: CreateRoleResponse{},
+	}).Endpoint(http.MethodPost, "", he.CreateRole)
+	roles.Permission("rbac.role.read").Doc(framework.RouteDoc{
+		Summary:  "List the roles",
+		Request:  FetchRoleRequest{},
+		Response: FetchRoleResponse{},
+	}).Endpoint(http.MethodGet, "", he.FetchRole)
+	roles.Permission("rbac.role.read").Doc(framework.RouteDoc{
+		Summary:  "Get a role",
+		Response: Role{},
+	}).Endpoint(http.MethodGet, "/:id", he.FindRole, framework.ETag())
+	roles.Permission("rbac.role.write").Doc(framework.RouteDoc{
+		Summary:  "Update a role",
+		Request:  UpdateRoleRequest{},
+		Response: Role{},
+	}).Endpoint(http.MethodPut, "/:id", he.UpdateRole)
 	//
-	in.Router.Endpoint(http.MethodPost, "/rbac/permissions", he.CreatePermission)
-	in.Router.Endpoint(http.MethodGet, "/rbac/permissions", he.FetchPermission)
-	in.Router.Endpoint(http.MethodGet, "/rbac/permissions/:id", he.FindPermission)
-	in.Router.Endpoint(http.MethodPut, "/rbac/permissions/:id", he.UpdatePermission)
+	permissions := in.Router.Group("/rbac/permissions")
+	permissions.Permission("rbac.permission.write").Doc(framework.RouteDoc{
+		Summary:  "Create a permission",
+		Request:  CreatePermissionRequest{},
+		Response: CreatePermissionResponse{},
+	}).Endpoint(http.MethodPost, "", he.CreatePermission)
+	permissions.Permission("rbac.permission.read").Doc(framework.RouteDoc{
+		Summary:  "List the permissions",
+		Request:  FetchPermissionRequest{},
+		Response: FetchPermissionResponse{},
+	}).Endpoint(http.MethodGet, "", he.FetchPermission)
+	permissions.Permission("rbac.permission.read").Doc(framework.RouteDoc{
+		Summary:  "Get a permission",
+		Response: Permission{},
+	}).Endpoint(http.MethodGet, "/:id", he.FindPermission, framework.ETag())
+	permissions.Permission("rbac.permission.write").Doc(framework.RouteDoc{
+		Summary:  "Update a permission",
+		Request:  UpdatePermissionRequest{},
+		Response: Permission{},
+	}).Endpoint(http.MethodPut, "/:id", he.UpdatePermission)
 }
@@ -0,0 +1,42 @@
+package inbound
+
+import (
+	"testing"
+
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/pkg/framework"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInbound_RegisterRBACServiceServer(t *testing.T) {
+	tests := []struct {
+		name string
+		in   func() Inbound
+	}{
+		{
+			name: "Success",
+			in: func() Inbound {
+				return Inbound{
+					Router:           framework.NewRouter(),
+					Telemetry:        lib.NewTelemetry(),
+					CreateRole:       nil,
+					FindRole:         nil,
+					FetchRole:        nil,
+					UpdateRole:       nil,
+					CreatePermission: nil,
+					FindPermission:   nil,
+					FetchPermission:  nil,
+					UpdatePermission: nil,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			in := tt.in()
+			in.RegisterRBACServiceServer()
+			assert.Empty(t, in.Router.Undocumented())
+		})
+	}
+}
@@ -6,21 +6,23 @@ import (
 	"errors"
 
 	"github.com/doug-martin/goqu/v9"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 )
 
 type SQLRBAC struct {
-	db        *sql.DB
+	db        *sqlkit.DB
 	qu        goqu.DialectWrapper
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 }
 
-func NewSQLRBAC(db *sql.DB, qu goqu.DialectWrapper, tel *telemetry.Telemetry) *SQLRBAC {
+// NewSQLRBAC builds its queries with the dialect of db, so the placeholders
+// suit MySQL as well as PostgreSQL.
+func NewSQLRBAC(db *sqlkit.DB, tel *lib.Telemetry) *SQLRBAC {
 	return &SQLRBAC{
 		db:        db,
-		qu:        qu,
+		qu:        goqu.Dialect(db.Driver()),
 		telemetry: tel,
 	}
 }
@@ -29,196 +31,222 @@ func (sr *SQLRBAC) SaveRole(ctx context.Context, r domain.Role) error {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.SaveRole")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return sr.qu.Insert("roles").
-			Cols("id", "name", "description").
-			Vals([]any{r.ID, r.Name, r.Description}).
-			Prepared(true).
-			ToSQL()
+	query, args, err := sr.qu.Insert("roles").
+		Cols("id", "name", "description").
+		Vals([]any{r.ID, r.Name, r.Description}).
+		Prepared(true).
+		ToSQL()
+	if err != nil {
+		return err
 	}
 
-	err := dbops.Exec(ctx, sr.db, query, true)
-	if errors.Is(err, dbops.ErrZeroRowsAffected) {
+	result, err := sqlkit.Exec(ctx, sr.db, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
 		return domain.ErrRoleNotCreated
 	}
 
-	return err
+	return nil
 }
 
 func (sr *SQLRBAC) EditRole(ctx context.Context, r domain.Role) error {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.EditRole")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return sr.qu.Update("roles").
-			Set(map[string]any{
-				"name":        r.Name,
-				"description": r.Description,
-			}).
-			Where(goqu.Ex{"id": r.ID}).
-			Prepared(true).
-			ToSQL()
+	query, args, err := sr.qu.Update("roles").
+		Set(map[string]any{
+			"name":        r.Name,
+			"description": r.Description,
+		}).
+		Where(goqu.Ex{"id": r.ID}).
+		Prepared(true).
+		ToSQL()
+	if err != nil {
+		return err
 	}
 
-	return dbops.Exec(ctx, sr.db, query)
+	_, err = sqlkit.Exec(ctx, sr.db, query, args...)
+
+	return err
 }
 
 func (sr *SQLRBAC) FindRole(ctx context.Context, id uint64) (*domain.Role, error) {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.FindRole")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return sr.qu.Select("id", "name", "description").
-			From("roles").
-			Where(goqu.Ex{"id": id}).
-			Prepared(true).
-			ToSQL()
-	}
-
-	return dbops.SQLGet[domain.Role](ctx, sr.db, query)
+	return one[domain.Role](ctx, sr.db, sr.qu.Select("id", "name", "description").
+		From("roles").
+		Where(goqu.Ex{"id": id}))
 }
 
 func (sr *SQLRBAC) FindRoleByName(ctx context.Context, name string) (*domain.Role, error) {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.FindRoleByName")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return sr.qu.Select("id", "name", "description").
-			From("roles").
-			Where(goqu.Ex{"name": name}).
-			Prepared(true).
-			ToSQL()
-	}
-
-	return dbops.SQLGet[domain.Role](ctx, sr.db, query)
+	return one[domain.Role](ctx, sr.db, sr.qu.Select("id", "name", "description").
+		From("roles").
+		Where(goqu.Ex{"name": name}))
 }
 
-//nolint:dupl // #11 this is not duplicate with #12
 func (sr *SQLRBAC) FetchRole(ctx context.Context, filter map[string]any) ([]domain.Role, error) {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.FetchRole")
 	defer span.End()
 
-	cursor, hasCursor := filter["cursor"].(uint64)
-	limit, hasLimit := filter["limit"].(int)
-	name, hasName := filter["name"].(string)
-
-	query := func() (string, []any, error) {
-		q := sr.qu.Select("id", "name", "description").
-			From("roles")
-
-		if hasCursor && cursor > 0 {
-			q = q.Where(goqu.Ex{"id": goqu.Op{"gt": cursor}})
-		}
-
-		if hasName {
-			q = q.Where(goqu.Ex{"name": goqu.Op{"like": "%" + name + "%"}})
-		}
-
-		if hasLimit {
-			q = q.Limit(uint(limit + 1))
-		}
-
-		return q.Prepared(true).ToSQL()
-	}
-
-	return dbops.SQLGets[domain.Role](ctx, sr.db, query)
+	return many[domain.Role](ctx, sr.db, sr.fetch("roles", filter))
 }
 
 func (sr *SQLRBAC) SavePermission(ctx context.Context, p domain.Permission) error {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.SavePermission")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return sr.qu.Insert("permissions").
-			Cols("id", "name", "description").
-			Vals([]any{p.ID, p.Name, p.Description}).
-			Prepared(true).
-			ToSQL()
+	query, args, err := sr.qu.Insert("permissions").
+		Cols("id", "name", "description").
+		Vals([]any{p.ID, p.Name, p.Description}).
+		Prepared(true).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+
+	result, err := sqlkit.Exec(ctx, sr.db, query, args...)
+	if err != nil {
+		return err
 	}
 
-	err := dbops.Exec(ctx, sr.db, query, true)
-	if errors.Is(err, dbops.ErrZeroRowsAffected) {
+	if result.RowsAffected == 0 {
 		return domain.ErrPermissionNotCreated
 	}
 
-	return err
+	return nil
 }
 
 func (sr *SQLRBAC) EditPermission(ctx context.Context, p domain.Permission) error {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.EditPermission")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return sr.qu.Update("permissions").
-			Set(map[string]any{
-				"name":        p.Name,
-				"description": p.Description,
-			}).
-			Where(goqu.Ex{"id": p.ID}).
-			Prepared(true).
-			ToSQL()
+	query, args, err := sr.qu.Update("permissions").
+		Set(map[string]any{
+			"name":        p.Name,
+			"description": p.Description,
+		}).
+		Where(goqu.Ex{"id": p.ID}).
+		Prepared(true).
+		ToSQL()
+	if err != nil {
+		return err
 	}
 
-	return dbops.Exec(ctx, sr.db, query)
+	_, err = sqlkit.Exec(ctx, sr.db, query, args...)
+
+	return err
 }
 
 func (sr *SQLRBAC) FindPermission(ctx context.Context, id uint64) (*domain.Permission, error) {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.FindPermission")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return sr.qu.Select("id", "name", "description").
-			From("permissions").
-			Where(goqu.Ex{"id": id}).
-			Prepared(true).
-			ToSQL()
-	}
-
-	return dbops.SQLGet[domain.Permission](ctx, sr.db, query)
+	return one[domain.Permission](ctx, sr.db, sr.qu.Select("id", "name", "description").
+		From("permissions").
+		Where(goqu.Ex{"id": id}))
 }
 
 func (sr *SQLRBAC) FindPermissionByName(ctx context.Context, name string) (*domain.Permission, error) {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.FindPermissionByName")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return sr.qu.Select("id", "name", "description").
-			From("permissions").
-			Where(goqu.Ex{"name": name}).
-			Prepared(true).
-			ToSQL()
-	}
-
-	return dbops.SQLGet[domain.Permission](ctx, sr.db, query)
+	return one[domain.Permission](ctx, sr.db, sr.qu.Select("id", "name", "description").
+		From("permissions").
+		Where(goqu.Ex{"name": name}))
 }
 
-//nolint:dupl // #12 this is not duplicate with #11
 func (sr *SQLRBAC) FetchPermission(ctx context.Context, filter map[string]any) ([]domain.Permission, error) {
 	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.FetchPermission")
 	defer span.End()
 
+	return many[domain.Permission](ctx, sr.db, sr.fetch("permissions", filter))
+}
+
+// HasPermission tells whether the user has the permission through one of its roles.
+func (sr *SQLRBAC) HasPermission(ctx context.Context, userID uint64, permission string) (bool, error) {
+	ctx, span := sr.telemetry.Tracer().Start(ctx, "rbac.outbound.SQLRBAC.HasPermission")
+	defer span.End()
+
+	query, args, err := sr.qu.From(goqu.T("user_roles").As("ur")).
+		Select(goqu.COUNT("*")).
+		Join(goqu.T("role_permissions").As("rp"), goqu.On(goqu.I("rp.role_id").Eq(goqu.I("ur.role_id")))).
+		Join(goqu.T("permissions").As("p"), goqu.On(goqu.I("p.id").Eq(goqu.I("rp.permission_id")))).
+		Where(goqu.I("ur.user_id").Eq(userID), goqu.I("p.name").Eq(permission)).
+		Prepared(true).
+		ToSQL()
+	if err != nil {
+		return false, err
+	}
+
+	var count uint64
+	if err := sr.db.Scan(ctx, &count, query, args...); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// fetch selects a page of roles or permissions, those after the cursor whose
+// name contains the filter. One more row than the limit tells a next page.
+func (sr *SQLRBAC) fetch(table string, filter map[string]any) *goqu.SelectDataset {
 	cursor, hasCursor := filter["cursor"].(uint64)
 	limit, hasLimit := filter["limit"].(int)
 	name, hasName := filter["name"].(string)
 
-	query := func() (string, []any, error) {
-		q := sr.qu.Select("id", "name", "description").
-			From("permissions")
+	q := sr.qu.Select("id", "name", "description").From(table)
+
+	if hasCursor && cursor > 0 {
+		q = q.Where(goqu.Ex{"id": goqu.Op{"gt": cursor}})
+	}
+
+	if hasName {
+		q = q.Where(goqu.Ex{"name": goqu.Op{"like": "%" + name + "%"}})
+	}
 
-		if hasCursor && cursor > 0 {
-			q = q.Where(goqu.Ex{"id": goqu.Op{"gt": cursor}})
-		}
+	if hasLimit {
+		q = q.Limit(uint(limit + 1))
+	}
 
-		if hasName {
-			q = q.Where(goqu.Ex{"name": goqu.Op{"like": "%" + name + "%"}})
-		}
+	return q
+}
 
-		if hasLimit {
-			q = q.Limit(uint(limit + 1))
-		}
+// one returns the first row of q, nil when there is none.
+func one[M any](ctx context.Context, db *sqlkit.DB, q *goqu.SelectDataset) (*M, error) {
+	query, args, err := q.Limit(1).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var m M
+	err = db.Scan(ctx, &m, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func many[M any](ctx context.Context, db *sqlkit.DB, q *goqu.SelectDataset) ([]M, error) {
+	query, args, err := q.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
 
-		return q.Prepared(true).ToSQL()
+	var ms []M
+	if err := db.Scan(ctx, &ms, query, args...); err != nil {
+		return nil, err
 	}
 
-	return dbops.SQLGets[domain.Permission](ctx, sr.db, query)
+	return ms, nil
 }
@@ -0,0 +1,91 @@
+package outbound
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLRBAC_HasPermission(t *testing.T) {
+	queryMySQL := regexp.QuoteMeta("SELECT COUNT(*) FROM `user_roles` AS `ur` " +
+		"INNER JOIN `role_permissions` AS `rp` ON (`rp`.`role_id` = `ur`.`role_id`) " +
+		"INNER JOIN `permissions` AS `p` ON (`p`.`id` = `rp`.`permission_id`) " +
+		"WHERE ((`ur`.`user_id` = ?) AND (`p`.`name` = ?))")
+	queryPostgres := regexp.QuoteMeta(`SELECT COUNT(*) FROM "user_roles" AS "ur" ` +
+		`INNER JOIN "role_permissions" AS "rp" ON ("rp"."role_id" = "ur"."role_id") ` +
+		`INNER JOIN "permissions" AS "p" ON ("p"."id" = "rp"."permission_id") ` +
+		`WHERE (("ur"."user_id" = $1) AND ("p"."name" = $2))`)
+
+	tests := []struct {
+		name    string
+		driver  string
+		want    bool
+		wantErr error
+		mockFn  func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:    "ErrorWhenScan",
+			driver:  sqlkit.MySQLDriver,
+			want:    false,
+			wantErr: assert.AnError,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(queryMySQL).WithArgs(7, "rbac.role.read").WillReturnError(assert.AnError)
+			},
+		},
+		{
+			name:    "SuccessWithoutRole",
+			driver:  sqlkit.MySQLDriver,
+			want:    false,
+			wantErr: nil,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(queryMySQL).WithArgs(7, "rbac.role.read").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+		},
+		{
+			name:    "SuccessMySQL",
+			driver:  sqlkit.MySQLDriver,
+			want:    true,
+			wantErr: nil,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(queryMySQL).WithArgs(7, "rbac.role.read").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+		},
+		{
+			name:    "SuccessPostgres",
+			driver:  sqlkit.PostgresDriver,
+			want:    true,
+			wantErr: nil,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(queryPostgres).WithArgs(7, "rbac.role.read").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+			tt.mockFn(mock)
+
+			tel := lib.NewTelemetry()
+			sr := NewSQLRBAC(sqlkit.New(tt.driver, db, tel.Logger()), tel)
+			got, err := sr.HasPermission(context.Background(), 7, "rbac.role.read")
+			assert.True(t, errors.Is(err, tt.wantErr))
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
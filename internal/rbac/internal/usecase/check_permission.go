@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/shandysiswandi/goreng/config"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/rbac/internal/domain"
+)
+
+type CheckPermissionStore interface {
+	HasPermission(ctx context.Context, userID uint64, permission string) (bool, error)
+}
+
+// CheckPermission tells whether the caller has a permission, through one of its
+// roles. Users listed in `rbac.admin.users` have every permission, so the first
+// roles can be granted before anybody holds one.
+type CheckPermission struct {
+	tele   *lib.Telemetry
+	admins map[uint64]struct{}
+	store  CheckPermissionStore
+}
+
+func NewCheckPermission(dep Dependency, s CheckPermissionStore) *CheckPermission {
+	return &CheckPermission{
+		tele:   dep.Telemetry,
+		admins: admins(dep.Config),
+		store:  s,
+	}
+}
+
+func (cp *CheckPermission) Call(ctx context.Context, in domain.CheckPermissionInput) (
+	*domain.CheckPermissionOutput, error,
+) {
+	ctx, span := cp.tele.Tracer().Start(ctx, "rbac.usecase.CheckPermission")
+	defer span.End()
+
+	clm := lib.GetJWTClaim(ctx)
+	if clm == nil {
+		return &domain.CheckPermissionOutput{Allowed: false}, nil
+	}
+
+	if _, ok := cp.admins[clm.AuthID]; ok {
+		return &domain.CheckPermissionOutput{Allowed: true}, nil
+	}
+
+	allowed, err := cp.store.HasPermission(ctx, clm.AuthID, in.Permission)
+	if err != nil {
+		cp.tele.Logger().Error(ctx, "failed to check permission", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	return &domain.CheckPermissionOutput{Allowed: allowed}, nil
+}
+
+func admins(cfg config.Config) map[uint64]struct{} {
+	ids := make(map[uint64]struct{})
+	if cfg == nil {
+		return ids
+	}
+
+	for _, v := range strings.Split(cfg.GetString("rbac.admin.users"), ",") {
+		if id, err := strconv.ParseUint(strings.TrimSpace(v), 10, 64); err == nil {
+			ids[id] = struct{}{}
+		}
+	}
+
+	return ids
+}
@@ -5,9 +5,9 @@ import (
 	"context"
 
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/domain"
 )
 
@@ -17,7 +17,7 @@ type CreatePermissionStore interface {
 }
 
 type CreatePermission struct {
-	tele      *telemetry.Telemetry
+	tele      *lib.Telemetry
 	validator validation.Validator
 	uidnumber uid.NumberID
 	store     CreatePermissionStore
@@ -8,7 +8,7 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/pagination"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/domain"
 )
 
@@ -17,7 +17,7 @@ type FetchRoleStore interface {
 }
 
 type FetchRole struct {
-	tele  *telemetry.Telemetry
+	tele  *lib.Telemetry
 	store FetchRoleStore
 }
 
@@ -5,7 +5,7 @@ import (
 	"context"
 
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/domain"
 )
 
@@ -14,7 +14,7 @@ type FindRoleStore interface {
 }
 
 type FindRole struct {
-	tele  *telemetry.Telemetry
+	tele  *lib.Telemetry
 	store FindRoleStore
 }
 
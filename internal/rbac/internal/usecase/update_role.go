@@ -5,8 +5,8 @@ import (
 	"context"
 
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/domain"
 )
 
@@ -16,7 +16,7 @@ type UpdateRoleStore interface {
 }
 
 type UpdateRole struct {
-	tele      *telemetry.Telemetry
+	tele      *lib.Telemetry
 	validator validation.Validator
 	store     UpdateRoleStore
 }
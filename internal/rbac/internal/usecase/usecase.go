@@ -1,15 +1,17 @@
 package usecase
 
 import (
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/config"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 )
 
 type Dependency struct {
-	Telemetry   *telemetry.Telemetry
+	Config      config.Config
+	Telemetry   *lib.Telemetry
 	Validator   validation.Validator
 	UIDNumber   uid.NumberID
-	Transaction dbops.Tx
+	Transaction sqlkit.Tx
 }
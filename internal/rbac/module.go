@@ -1,42 +1,46 @@
 package rbac
 
 import (
-	"database/sql"
+	"context"
 
-	"github.com/doug-martin/goqu/v9"
 	"github.com/shandysiswandi/goreng/clock"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/config"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/rbac/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/inbound"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/outbound"
 	"github.com/shandysiswandi/gostarter/internal/rbac/internal/usecase"
-	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 )
 
-type Expose struct{}
+type Expose struct {
+	// Allowed tells whether the caller has a permission, it is meant for framework.Authorize.
+	Allowed func(ctx context.Context, permission string) (bool, error)
+}
 
 type Dependency struct {
-	Database     *sql.DB
-	Transaction  dbops.Tx
-	QueryBuilder goqu.DialectWrapper
-	Telemetry    *telemetry.Telemetry
-	Router       *framework.Router
-	Validator    validation.Validator
-	UIDNumber    uid.NumberID
-	Clock        clock.Clocker
+	SQLKitDB  *sqlkit.DB
+	Config    config.Config
+	Telemetry *lib.Telemetry
+	Router    *framework.Router
+	Validator validation.Validator
+	UIDNumber uid.NumberID
+	Clock     clock.Clocker
 }
 
 func New(dep Dependency) (*Expose, error) {
 	// This block initializes outbound services: Database, HTTP client, gRPC client, Redis, etc.
-	sqlRBAC := outbound.NewSQLRBAC(dep.Database, dep.QueryBuilder, dep.Telemetry)
+	sqlRBAC := outbound.NewSQLRBAC(dep.SQLKitDB, dep.Telemetry)
 
 	// This block initializes core business logic or use cases to handle user interaction
 	ucDep := usecase.Dependency{
+		Config:      dep.Config,
 		UIDNumber:   dep.UIDNumber,
 		Validator:   dep.Validator,
-		Transaction: dep.Transaction,
+		Transaction: dep.SQLKitDB.Tx(),
 		Telemetry:   dep.Telemetry,
 	}
 
@@ -49,6 +53,8 @@ func New(dep Dependency) (*Expose, error) {
 	fip := usecase.NewFindPermission(ucDep, sqlRBAC)
 	fep := usecase.NewFetchPermission(ucDep, sqlRBAC)
 	rf := usecase.NewUpdatePermission(ucDep, sqlRBAC)
+	//
+	chp := usecase.NewCheckPermission(ucDep, sqlRBAC)
 
 	// This block initializes REST, SSE, gRPC, and graphQL API endpoints to handle core user workflows:
 	inbound := inbound.Inbound{
@@ -67,5 +73,16 @@ func New(dep Dependency) (*Expose, error) {
 	}
 	inbound.RegisterRBACServiceServer()
 
-	return &Expose{}, nil
+	return &Expose{Allowed: allowed(chp)}, nil
+}
+
+func allowed(uc domain.CheckPermission) func(ctx context.Context, permission string) (bool, error) {
+	return func(ctx context.Context, permission string) (bool, error) {
+		out, err := uc.Call(ctx, domain.CheckPermissionInput{Permission: permission})
+		if err != nil {
+			return false, err
+		}
+
+		return out.Allowed, nil
+	}
 }
@@ -0,0 +1,102 @@
+package rbac
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/pkg/framework"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_AuthorizeRoles(t *testing.T) {
+	tests := []struct {
+		name     string
+		admins   string
+		authID   uint64
+		wantCode int
+		mockFn   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:     "ForbiddenWithoutPermission",
+			authID:   7,
+			wantCode: http.StatusForbidden,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM `user_roles`").
+					WithArgs(7, "rbac.role.read").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+		},
+		{
+			name:     "SuccessWithRole",
+			authID:   7,
+			wantCode: http.StatusOK,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM `user_roles`").
+					WithArgs(7, "rbac.role.read").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery("SELECT `id`, `name`, `description` FROM `roles`").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description"}).
+						AddRow(1, "admin", "holds every permission"))
+			},
+		},
+		{
+			name:     "SuccessWithAdminConfig",
+			admins:   "3, 7",
+			authID:   7,
+			wantCode: http.StatusOK,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT `id`, `name`, `description` FROM `roles`").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description"}))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+			tt.mockFn(mock)
+
+			mc := mocker.NewMockConfig(t)
+			mc.EXPECT().GetString("rbac.admin.users").Return(tt.admins)
+
+			// the routes are registered by New, so the middlewares reach the
+			// permission check of the module through a variable.
+			var allowed func(ctx context.Context, permission string) (bool, error)
+			router := framework.NewRouter()
+			router.Use(func(h http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					ctx := lib.SetJWTClaim(r.Context(), &lib.JWTClaim{AuthID: tt.authID})
+					h.ServeHTTP(w, r.WithContext(ctx))
+				})
+			}, framework.Authorize(func(ctx context.Context, permission string) (bool, error) {
+				return allowed(ctx, permission)
+			}))
+
+			tel := lib.NewTelemetry()
+			exp, err := New(Dependency{
+				SQLKitDB:  sqlkit.New(sqlkit.MySQLDriver, db, tel.Logger()),
+				Config:    mc,
+				Telemetry: tel,
+				Router:    router,
+			})
+			assert.NoError(t, err)
+			allowed = exp.Allowed
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/rbac/roles", nil))
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"time"
 
 	"github.com/shandysiswandi/goreng/enum"
 )
@@ -14,13 +15,58 @@ var (
 )
 
 type Todo struct {
-	ID          uint64
-	UserID      uint64
-	Title       string
-	Description string
-	Status      enum.Enum[TodoStatus]
+	ID          uint64                  `db:"id"`
+	UserID      uint64                  `db:"user_id"`
+	Title       string                  `db:"title"`
+	Description string                  `db:"description"`
+	Status      enum.Enum[TodoStatus]   `db:"status"`
+	Priority    enum.Enum[TodoPriority] `db:"priority"`
+	DueAt       *time.Time              `db:"due_at"`
+	CompletedAt *time.Time              `db:"completed_at"`
+	DeletedAt   *time.Time              `db:"deleted_at"`
+	CreatedAt   time.Time               `db:"created_at"`
+	// Tags are kept in the tags table and loaded apart from the todo row.
+	Tags []string `db:"-"`
+	// Collaborators are the users the todo is shared with, loaded like the tags.
+	Collaborators []TodoCollaborator `db:"-"`
+}
+
+func (Todo) Table() string {
+	return "todos"
 }
 
 func (t *Todo) ScanColumn() []any {
-	return []any{&t.ID, &t.UserID, &t.Title, &t.Description, &t.Status}
+	return []any{
+		&t.ID, &t.UserID, &t.Title, &t.Description, &t.Status,
+		&t.Priority, &t.DueAt, &t.CompletedAt, &t.DeletedAt, &t.CreatedAt,
+	}
+}
+
+// SetStatus changes the status and keeps CompletedAt in line with it. The todo
+// is completed the first time it is done, and is not anymore once it leaves done.
+func (t *Todo) SetStatus(sts enum.Enum[TodoStatus], now time.Time) {
+	t.Status = sts
+
+	switch {
+	case sts.Enum() != TodoStatusDone:
+		t.CompletedAt = nil
+	case t.CompletedAt == nil:
+		t.CompletedAt = &now
+	}
+}
+
+// CanEdit reports whether the user may change the todo, which is the owner and
+// the collaborators who accepted an invitation as editor.
+func (t Todo) CanEdit(userID uint64) bool {
+	if t.UserID == userID {
+		return true
+	}
+
+	for _, c := range t.Collaborators {
+		if c.UserID == userID && c.AcceptedAt != nil && c.Role.Enum() == TodoRoleEditor {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// TodoAttachment is the metadata of a file attached to a todo, the content
+// itself lives in the blob store under StorageKey.
+type TodoAttachment struct {
+	ID          uint64    `db:"id"`
+	TodoID      uint64    `db:"todo_id"`
+	Filename    string    `db:"filename"`
+	ContentType string    `db:"content_type"`
+	Size        int64     `db:"size"`
+	StorageKey  string    `db:"storage_key"`
+	CreatedBy   uint64    `db:"created_by"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func (TodoAttachment) Table() string {
+	return "todo_attachments"
+}
+
+func (a *TodoAttachment) ScanColumn() []any {
+	return []any{
+		&a.ID, &a.TodoID, &a.Filename, &a.ContentType, &a.Size, &a.StorageKey, &a.CreatedBy, &a.CreatedAt,
+	}
+}
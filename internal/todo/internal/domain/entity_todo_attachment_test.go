@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTodoAttachment_ScanColumn(t *testing.T) {
+	a := &TodoAttachment{}
+	tests := []struct {
+		name string
+		ta   *TodoAttachment
+		want []any
+	}{
+		{
+			name: "Success",
+			ta:   a,
+			want: []any{
+				&a.ID, &a.TodoID, &a.Filename, &a.ContentType, &a.Size, &a.StorageKey, &a.CreatedBy, &a.CreatedAt,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.ta.ScanColumn())
+		})
+	}
+}
+
+func TestTodoAttachment_Table(t *testing.T) {
+	tests := []struct {
+		name string
+		ta   TodoAttachment
+		want string
+	}{
+		{
+			name: "Success",
+			ta:   TodoAttachment{},
+			want: "todo_attachments",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.ta.Table())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+)
+
+var ErrCollaboratorNotFound = errors.New("collaborator not found")
+
+type TodoRole int
+
+const (
+	TodoRoleUnknown TodoRole = iota
+	TodoRoleViewer
+	TodoRoleEditor
+)
+
+func (tr TodoRole) Values() map[enum.Enumerate]string {
+	return map[enum.Enumerate]string{
+		TodoRoleUnknown: "UNKNOWN",
+		TodoRoleViewer:  "VIEWER",
+		TodoRoleEditor:  "EDITOR",
+	}
+}
+
+// TodoCollaborator is a user the todo is shared with. The invitation is made to
+// an email, UserID is only set once the user with that email accepted it.
+type TodoCollaborator struct {
+	TodoID     uint64              `db:"todo_id"`
+	Email      string              `db:"email"`
+	UserID     uint64              `db:"user_id"`
+	Role       enum.Enum[TodoRole] `db:"role"`
+	InvitedBy  uint64              `db:"invited_by"`
+	InvitedAt  time.Time           `db:"invited_at"`
+	AcceptedAt *time.Time          `db:"accepted_at"`
+}
+
+func (TodoCollaborator) Table() string {
+	return "todo_collaborators"
+}
+
+func (c *TodoCollaborator) ScanColumn() []any {
+	return []any{&c.TodoID, &c.Email, &c.UserID, &c.Role, &c.InvitedBy, &c.InvitedAt, &c.AcceptedAt}
+}
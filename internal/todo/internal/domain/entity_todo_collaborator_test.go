@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTodoRole_Values(t *testing.T) {
+	tests := []struct {
+		name string
+		want map[enum.Enumerate]string
+	}{
+		{
+			name: "Success",
+			want: map[enum.Enumerate]string{
+				TodoRoleUnknown: "UNKNOWN",
+				TodoRoleViewer:  "VIEWER",
+				TodoRoleEditor:  "EDITOR",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, TodoRole(0).Values())
+		})
+	}
+}
+
+func TestTodoCollaborator_ScanColumn(t *testing.T) {
+	c := &TodoCollaborator{}
+	tests := []struct {
+		name string
+		tr   *TodoCollaborator
+		want []any
+	}{
+		{
+			name: "Success",
+			tr:   c,
+			want: []any{&c.TodoID, &c.Email, &c.UserID, &c.Role, &c.InvitedBy, &c.InvitedAt, &c.AcceptedAt},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.tr.ScanColumn())
+		})
+	}
+}
+
+func TestTodoCollaborator_Table(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   TodoCollaborator
+		want string
+	}{
+		{
+			name: "Success",
+			tr:   TodoCollaborator{},
+			want: "todo_collaborators",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.tr.Table())
+		})
+	}
+}
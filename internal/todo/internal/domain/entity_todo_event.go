@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/shandysiswandi/goreng/enum"
+)
+
+type TodoEventType int
+
+const (
+	TodoEventTypeUnknown TodoEventType = iota
+	TodoEventTypeCreated
+	TodoEventTypeUpdated
+	TodoEventTypeStatusUpdated
+	TodoEventTypeDeleted
+)
+
+func (tet TodoEventType) Values() map[enum.Enumerate]string {
+	return map[enum.Enumerate]string{
+		TodoEventTypeUnknown:       "UNKNOWN",
+		TodoEventTypeCreated:       "TODO_CREATED",
+		TodoEventTypeUpdated:       "TODO_UPDATED",
+		TodoEventTypeStatusUpdated: "TODO_STATUS_UPDATED",
+		TodoEventTypeDeleted:       "TODO_DELETED",
+	}
+}
+
+// TodoEvent is a change made to a todo. The ID is given by the event bus on
+// publish and keeps increasing, so clients can resume after the last one seen.
+type TodoEvent struct {
+	ID   uint64
+	Type enum.Enum[TodoEventType]
+	Todo Todo
+	// Members are the users the event is streamed to, the owner of the todo and
+	// the collaborators who accepted it when the change was made.
+	Members []uint64
+}
+
+// NewTodoEvent returns the event of a change made to the todo, for its members.
+func NewTodoEvent(typ TodoEventType, todo Todo) TodoEvent {
+	members := []uint64{todo.UserID}
+	for _, c := range todo.Collaborators {
+		if c.UserID > 0 && c.AcceptedAt != nil {
+			members = append(members, c.UserID)
+		}
+	}
+
+	return TodoEvent{Type: enum.New(typ), Todo: todo, Members: members}
+}
+
+// IsFor reports whether the event is streamed to the user.
+func (ev TodoEvent) IsFor(userID uint64) bool {
+	return slices.Contains(ev.Members, userID)
+}
+
+// TodoEventPublisher is used by the use cases to announce todo changes.
+type TodoEventPublisher interface {
+	Publish(ctx context.Context, ev TodoEvent)
+}
+
+// TodoEventSubscriber streams the changes of the todos one user owns or shares
+// until ctx is done.
+// Events after lastID still kept by the bus are returned first for replay. The
+// channel is closed when ctx is done or when the subscriber can not keep up.
+type TodoEventSubscriber interface {
+	Subscribe(ctx context.Context, member, lastID uint64) ([]TodoEvent, <-chan TodoEvent)
+}
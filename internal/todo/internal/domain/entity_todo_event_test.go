@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTodoEventType_Values(t *testing.T) {
+	tests := []struct {
+		name string
+		want map[enum.Enumerate]string
+	}{
+		{
+			name: "Success",
+			want: map[enum.Enumerate]string{
+				TodoEventTypeUnknown:       "UNKNOWN",
+				TodoEventTypeCreated:       "TODO_CREATED",
+				TodoEventTypeUpdated:       "TODO_UPDATED",
+				TodoEventTypeStatusUpdated: "TODO_STATUS_UPDATED",
+				TodoEventTypeDeleted:       "TODO_DELETED",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, TodoEventType(0).Values())
+		})
+	}
+}
+
+func TestNewTodoEvent(t *testing.T) {
+	accepted := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		todo        Todo
+		wantMembers []uint64
+	}{
+		{
+			name:        "OwnerOnly",
+			todo:        Todo{ID: 1, UserID: 11},
+			wantMembers: []uint64{11},
+		},
+		{
+			name: "WithAcceptedCollaborators",
+			todo: Todo{ID: 1, UserID: 11, Collaborators: []TodoCollaborator{
+				{Email: "editor@example.com", UserID: 12, AcceptedAt: &accepted},
+				{Email: "invited@example.com"},
+				{Email: "viewer@example.com", UserID: 13, AcceptedAt: &accepted},
+			}},
+			wantMembers: []uint64{11, 12, 13},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewTodoEvent(TodoEventTypeUpdated, tt.todo)
+			assert.Equal(t, TodoEventTypeUpdated, got.Type.Enum())
+			assert.Equal(t, tt.todo, got.Todo)
+			assert.Equal(t, tt.wantMembers, got.Members)
+		})
+	}
+}
+
+func TestTodoEvent_IsFor(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID uint64
+		want   bool
+	}{
+		{name: "Owner", userID: 11, want: true},
+		{name: "Collaborator", userID: 12, want: true},
+		{name: "Other", userID: 13, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ev := TodoEvent{Members: []uint64{11, 12}}
+			assert.Equal(t, tt.want, ev.IsFor(tt.userID))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"github.com/shandysiswandi/goreng/enum"
+)
+
+type TodoPriority int
+
+const (
+	TodoPriorityUnknown TodoPriority = iota
+	TodoPriorityLow
+	TodoPriorityMedium
+	TodoPriorityHigh
+)
+
+func (tp TodoPriority) Values() map[enum.Enumerate]string {
+	return map[enum.Enumerate]string{
+		TodoPriorityUnknown: "UNKNOWN",
+		TodoPriorityLow:     "LOW",
+		TodoPriorityMedium:  "MEDIUM",
+		TodoPriorityHigh:    "HIGH",
+	}
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTodoPriority_Values(t *testing.T) {
+	tests := []struct {
+		name string
+		want map[enum.Enumerate]string
+	}{
+		{
+			name: "Success",
+			want: map[enum.Enumerate]string{
+				TodoPriorityUnknown: "UNKNOWN",
+				TodoPriorityLow:     "LOW",
+				TodoPriorityMedium:  "MEDIUM",
+				TodoPriorityHigh:    "HIGH",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, TodoPriority(0).Values())
+		})
+	}
+}
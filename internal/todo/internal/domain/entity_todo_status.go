@@ -23,3 +23,30 @@ func (ts TodoStatus) Values() map[enum.Enumerate]string {
 		TodoStatusDone:       "DONE",
 	}
 }
+
+// todoStatusTransitions lists the statuses a todo may move to from each status.
+// A done todo can be reopened and a dropped one is picked up again from
+// INITIATE, otherwise a todo only goes back to INITIATE to be repaired.
+var todoStatusTransitions = map[TodoStatus][]TodoStatus{
+	TodoStatusInitiate:   {TodoStatusInProgress, TodoStatusDrop},
+	TodoStatusInProgress: {TodoStatusDone, TodoStatusDrop},
+	TodoStatusDone:       {TodoStatusInProgress},
+	TodoStatusDrop:       {TodoStatusInitiate},
+	TodoStatusUnknown:    {TodoStatusInitiate}, // only to repair rows with a bad status
+}
+
+// CanTransitionTo reports whether a todo in ts may move to next. Staying in the
+// same status is not a transition and is always allowed.
+func (ts TodoStatus) CanTransitionTo(next TodoStatus) bool {
+	if ts == next {
+		return true
+	}
+
+	for _, allowed := range todoStatusTransitions[ts] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+)
+
+// TodoStatusChange is one transition of a todo status, kept in the history.
+type TodoStatusChange struct {
+	ID        uint64                `db:"id"`
+	TodoID    uint64                `db:"todo_id"`
+	From      enum.Enum[TodoStatus] `db:"from_status"`
+	To        enum.Enum[TodoStatus] `db:"to_status"`
+	ChangedBy uint64                `db:"changed_by"`
+	ChangedAt time.Time             `db:"changed_at"`
+}
+
+func (TodoStatusChange) Table() string {
+	return "todo_status_history"
+}
+
+func (c *TodoStatusChange) ScanColumn() []any {
+	return []any{&c.ID, &c.TodoID, &c.From, &c.To, &c.ChangedBy, &c.ChangedAt}
+}
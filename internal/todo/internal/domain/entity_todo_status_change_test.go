@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTodoStatusChange_ScanColumn(t *testing.T) {
+	c := &TodoStatusChange{}
+	tests := []struct {
+		name string
+		tr   *TodoStatusChange
+		want []any
+	}{
+		{
+			name: "Success",
+			tr:   c,
+			want: []any{&c.ID, &c.TodoID, &c.From, &c.To, &c.ChangedBy, &c.ChangedAt},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.tr.ScanColumn())
+		})
+	}
+}
+
+func TestTodoStatusChange_Table(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   TodoStatusChange
+		want string
+	}{
+		{
+			name: "Success",
+			tr:   TodoStatusChange{},
+			want: "todo_status_history",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.tr.Table())
+		})
+	}
+}
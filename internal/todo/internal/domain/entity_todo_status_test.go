@@ -30,3 +30,31 @@ func TestTodoStatus_Values(t *testing.T) {
 		})
 	}
 }
+
+func TestTodoStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from TodoStatus
+		to   TodoStatus
+		want bool
+	}{
+		{name: "SameStatus", from: TodoStatusDone, to: TodoStatusDone, want: true},
+		{name: "InitiateToInProgress", from: TodoStatusInitiate, to: TodoStatusInProgress, want: true},
+		{name: "InitiateToDrop", from: TodoStatusInitiate, to: TodoStatusDrop, want: true},
+		{name: "InitiateToDone", from: TodoStatusInitiate, to: TodoStatusDone, want: false},
+		{name: "InProgressToDone", from: TodoStatusInProgress, to: TodoStatusDone, want: true},
+		{name: "InProgressToDrop", from: TodoStatusInProgress, to: TodoStatusDrop, want: true},
+		{name: "InProgressToInitiate", from: TodoStatusInProgress, to: TodoStatusInitiate, want: false},
+		{name: "DoneToInProgress", from: TodoStatusDone, to: TodoStatusInProgress, want: true},
+		{name: "DoneToInitiate", from: TodoStatusDone, to: TodoStatusInitiate, want: false},
+		{name: "DropToInitiate", from: TodoStatusDrop, to: TodoStatusInitiate, want: true},
+		{name: "DropToDone", from: TodoStatusDrop, to: TodoStatusDone, want: false},
+		{name: "ToUnknown", from: TodoStatusInitiate, to: TodoStatusUnknown, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
@@ -2,7 +2,9 @@ package domain
 
 import (
 	"testing"
+	"time"
 
+	"github.com/shandysiswandi/goreng/enum"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,6 +24,11 @@ func TestTodo_ScanColumn(t *testing.T) {
 				&td.Title,
 				&td.Description,
 				&td.Status,
+				&td.Priority,
+				&td.DueAt,
+				&td.CompletedAt,
+				&td.DeletedAt,
+				&td.CreatedAt,
 			},
 		},
 	}
@@ -32,3 +39,92 @@ func TestTodo_ScanColumn(t *testing.T) {
 		})
 	}
 }
+
+func TestTodo_Table(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Todo
+		want string
+	}{
+		{
+			name: "Success",
+			tr:   Todo{},
+			want: "todos",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.tr.Table())
+		})
+	}
+}
+
+func TestTodo_SetStatus(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	before := now.Add(-time.Hour)
+
+	tests := []struct {
+		name string
+		tr   *Todo
+		sts  TodoStatus
+		want *time.Time
+	}{
+		{
+			name: "BecomeDone",
+			tr:   &Todo{Status: enum.New(TodoStatusInProgress)},
+			sts:  TodoStatusDone,
+			want: &now,
+		},
+		{
+			name: "StayDone",
+			tr:   &Todo{Status: enum.New(TodoStatusDone), CompletedAt: &before},
+			sts:  TodoStatusDone,
+			want: &before,
+		},
+		{
+			name: "LeaveDone",
+			tr:   &Todo{Status: enum.New(TodoStatusDone), CompletedAt: &before},
+			sts:  TodoStatusInProgress,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.tr.SetStatus(enum.New(tt.sts), now)
+			assert.Equal(t, tt.sts, tt.tr.Status.Enum())
+			assert.Equal(t, tt.want, tt.tr.CompletedAt)
+		})
+	}
+}
+
+func TestTodo_CanEdit(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	todo := Todo{
+		UserID: 11,
+		Collaborators: []TodoCollaborator{
+			{UserID: 12, Role: enum.New(TodoRoleEditor), AcceptedAt: &now},
+			{UserID: 13, Role: enum.New(TodoRoleViewer), AcceptedAt: &now},
+			{Email: "pending@example.com", Role: enum.New(TodoRoleEditor)},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		userID uint64
+		want   bool
+	}{
+		{name: "Owner", userID: 11, want: true},
+		{name: "Editor", userID: 12, want: true},
+		{name: "Viewer", userID: 13, want: false},
+		{name: "PendingInvitation", userID: 0, want: false},
+		{name: "Stranger", userID: 14, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, todo.CanEdit(tt.userID))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+type Accept interface {
+	Call(ctx context.Context, in AcceptInput) (*AcceptOutput, error)
+}
+
+type AcceptInput struct {
+	ID uint64 `validate:"required,gt=0"`
+}
+
+type AcceptOutput struct {
+	ID uint64
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"io"
+)
+
+type Attach interface {
+	Call(ctx context.Context, in AttachInput) (*AttachOutput, error)
+}
+
+type AttachInput struct {
+	ID       uint64    `validate:"required,gt=0"`
+	Filename string    `validate:"required,max=255"`
+	Size     int64     `validate:"required,gt=0"`
+	Body     io.Reader `validate:"required"`
+}
+
+type AttachOutput struct {
+	Attachment TodoAttachment
+}
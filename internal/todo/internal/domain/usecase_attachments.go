@@ -0,0 +1,16 @@
+package domain
+
+import "context"
+
+type Attachments interface {
+	Call(ctx context.Context, in AttachmentsInput) (*AttachmentsOutput, error)
+}
+
+type AttachmentsInput struct {
+	ID uint64 `validate:"required,gt=0"`
+}
+
+type AttachmentsOutput struct {
+	ID          uint64
+	Attachments []TodoAttachment // oldest first
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "context"
+
+type Batch interface {
+	Call(ctx context.Context, in BatchInput) (*BatchOutput, error)
+}
+
+// BatchInput groups many operations in one transaction. They run in order: the
+// creates, then the status updates, then the deletes.
+type BatchInput struct {
+	// Atomic applies all the operations or none of them. Otherwise every
+	// operation stands alone and its failure is reported in the results.
+	Atomic       bool
+	Create       []CreateInput
+	UpdateStatus []UpdateStatusInput
+	Delete       []DeleteInput
+}
+
+// BatchOutput has a result for each operation, in the order of the input.
+type BatchOutput struct {
+	Create       []BatchResult
+	UpdateStatus []BatchResult
+	Delete       []BatchResult
+}
+
+// BatchResult is the outcome of one operation. Err is nil when it succeeded.
+type BatchResult struct {
+	ID  uint64
+	Err error
+}
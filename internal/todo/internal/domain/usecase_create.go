@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Create interface {
 	Call(ctx context.Context, in CreateInput) (*CreateOutput, error)
@@ -9,6 +12,9 @@ type Create interface {
 type CreateInput struct {
 	Title       string `validate:"required,min=5"`
 	Description string `validate:"required,min=15"`
+	Priority    string `validate:"omitempty,oneof=LOW MEDIUM HIGH"` // defaults to MEDIUM
+	DueAt       *time.Time
+	Tags        []string `validate:"max=10,dive,required,max=50"`
 }
 
 type CreateOutput struct {
@@ -0,0 +1,17 @@
+package domain
+
+import "context"
+
+type Detach interface {
+	Call(ctx context.Context, in DetachInput) (*DetachOutput, error)
+}
+
+type DetachInput struct {
+	ID           uint64 `validate:"required,gt=0"`
+	AttachmentID uint64 `validate:"required,gt=0"`
+}
+
+type DetachOutput struct {
+	ID           uint64
+	AttachmentID uint64
+}
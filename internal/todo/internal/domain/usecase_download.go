@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Download interface {
+	Call(ctx context.Context, in DownloadInput) (*DownloadOutput, error)
+}
+
+type DownloadInput struct {
+	ID           uint64 `validate:"required,gt=0"`
+	AttachmentID uint64 `validate:"required,gt=0"`
+}
+
+type DownloadOutput struct {
+	URL       string
+	ExpiresAt time.Time
+}
@@ -12,6 +12,17 @@ type FetchInput struct {
 	Cursor string
 	Limit  string
 	Status string
+	// UserID lists the todos of another user, only allowed for admins.
+	UserID string
+	// Query is a full-text search over the title and description.
+	Query    string   `validate:"max=100"`
+	Tags     []string `validate:"max=10,dive,required,max=50"` // todos having all of them
+	Priority string   `validate:"omitempty,oneof=LOW MEDIUM HIGH"`
+	DueFrom  string   `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	DueTo    string   `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	// Sort is one of id, created_at, due_at or priority, prefixed with - for
+	// descending order. It defaults to id.
+	Sort string `validate:"omitempty,oneof=id -id created_at -created_at due_at -due_at priority -priority"`
 }
 
 type FetchOutput struct {
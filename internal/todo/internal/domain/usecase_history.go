@@ -0,0 +1,16 @@
+package domain
+
+import "context"
+
+type History interface {
+	Call(ctx context.Context, in HistoryInput) (*HistoryOutput, error)
+}
+
+type HistoryInput struct {
+	ID uint64 `validate:"required,gt=0"`
+}
+
+type HistoryOutput struct {
+	ID      uint64
+	Changes []TodoStatusChange // oldest first
+}
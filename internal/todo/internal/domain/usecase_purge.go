@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+type Purge interface {
+	Call(ctx context.Context, in PurgeInput) (*PurgeOutput, error)
+}
+
+type PurgeInput struct {
+	ID uint64 `validate:"required,gt=0"`
+}
+
+type PurgeOutput struct {
+	ID uint64
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+type Restore interface {
+	Call(ctx context.Context, in RestoreInput) (*RestoreOutput, error)
+}
+
+type RestoreInput struct {
+	ID uint64 `validate:"required,gt=0"`
+}
+
+type RestoreOutput struct {
+	ID uint64
+}
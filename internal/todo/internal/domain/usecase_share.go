@@ -0,0 +1,19 @@
+package domain
+
+import "context"
+
+type Share interface {
+	Call(ctx context.Context, in ShareInput) (*ShareOutput, error)
+}
+
+type ShareInput struct {
+	ID    uint64 `validate:"required,gt=0"`
+	Email string `validate:"required,email"`
+	Role  string `validate:"required,oneof=VIEWER EDITOR"`
+}
+
+type ShareOutput struct {
+	ID    uint64
+	Email string
+	Role  string
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "context"
+
+type Unshare interface {
+	Call(ctx context.Context, in UnshareInput) (*UnshareOutput, error)
+}
+
+type UnshareInput struct {
+	ID    uint64 `validate:"required,gt=0"`
+	Email string `validate:"required,email"`
+}
+
+type UnshareOutput struct {
+	ID    uint64
+	Email string
+}
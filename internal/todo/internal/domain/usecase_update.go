@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 type Update interface {
@@ -12,5 +13,8 @@ type UpdateInput struct {
 	ID          uint64 `validate:"required,gt=0"`
 	Title       string `validate:"required,min=5"`
 	Description string `validate:"required,min=15"`
-	Status      string `validate:"required"`
+	Status      string `validate:"required,oneof=INITIATE IN_PROGRESS DROP DONE"`
+	Priority    string `validate:"omitempty,oneof=LOW MEDIUM HIGH"` // defaults to MEDIUM
+	DueAt       *time.Time
+	Tags        []string `validate:"max=10,dive,required,max=50"`
 }
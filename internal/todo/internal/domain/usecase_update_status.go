@@ -12,7 +12,7 @@ type UpdateStatus interface {
 
 type UpdateStatusInput struct {
 	ID     uint64 `validate:"required,gt=0"`
-	Status string `validate:"required"`
+	Status string `validate:"required,oneof=INITIATE IN_PROGRESS DROP DONE"`
 }
 
 type UpdateStatusOutput struct {
@@ -3,9 +3,10 @@ package inbound
 import (
 	"context"
 	"strconv"
+	"time"
 
-	"github.com/shandysiswandi/goreng/telemetry"
 	ql "github.com/shandysiswandi/gostarter/api/gen-gql/todo"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
@@ -25,10 +26,81 @@ func getStatusString(status *ql.Status) string {
 	return ""
 }
 
+func getPriorityString(priority *ql.Priority) string {
+	if priority != nil && priority.IsValid() {
+		return priority.String()
+	}
+
+	return ""
+}
+
+func getTimeString(t *time.Time) string {
+	if t != nil {
+		return t.Format(time.RFC3339Nano)
+	}
+
+	return ""
+}
+
+func newQLTodo(t domain.Todo) *ql.Todo {
+	tags := t.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return &ql.Todo{
+		ID:          strconv.FormatUint(t.ID, 10),
+		UserID:      strconv.FormatUint(t.UserID, 10),
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      ql.Status(t.Status.String()),
+		Priority:    ql.Priority(t.Priority.String()),
+		DueAt:       t.DueAt,
+		CompletedAt: t.CompletedAt,
+		Tags:        tags,
+		// the users the todo is shared with
+		Collaborators: newQLCollaborators(t.Collaborators),
+	}
+}
+
+func newQLCollaborators(cs []domain.TodoCollaborator) []ql.Collaborator {
+	out := make([]ql.Collaborator, 0, len(cs))
+	for _, c := range cs {
+		item := ql.Collaborator{
+			Email:      c.Email,
+			Role:       ql.Role(c.Role.String()),
+			InvitedAt:  c.InvitedAt,
+			AcceptedAt: c.AcceptedAt,
+		}
+		if c.UserID > 0 {
+			item.UserID = strconv.FormatUint(c.UserID, 10)
+		}
+
+		out = append(out, item)
+	}
+
+	return out
+}
+
+func newQLBatchResults(results []domain.BatchResult) []ql.BatchResult {
+	out := make([]ql.BatchResult, 0, len(results))
+	for _, res := range results {
+		item := ql.BatchResult{ID: strconv.FormatUint(res.ID, 10)}
+		if res.Err != nil {
+			msg := res.Err.Error()
+			item.Error = &msg
+		}
+
+		out = append(out, item)
+	}
+
+	return out
+}
+
 type gqlEndpoint struct {
 	ql.Resolver
 
-	tel *telemetry.Telemetry
+	tel *lib.Telemetry
 
 	findUC         domain.Find
 	fetchUC        domain.Fetch
@@ -36,12 +108,21 @@ type gqlEndpoint struct {
 	deleteUC       domain.Delete
 	updateUC       domain.Update
 	updateStatusUC domain.UpdateStatus
+	restoreUC      domain.Restore
+	purgeUC        domain.Purge
+	batchUC        domain.Batch
+	shareUC        domain.Share
+	unshareUC      domain.Unshare
+	acceptUC       domain.Accept
+	events         domain.TodoEventSubscriber
 }
 
 func (l *gqlEndpoint) Mutation() ql.MutationResolver { return l }
 
 func (l *gqlEndpoint) Query() ql.QueryResolver { return l }
 
+func (l *gqlEndpoint) Subscription() ql.SubscriptionResolver { return l }
+
 func (l *gqlEndpoint) Fetch(ctx context.Context, in *ql.FetchInput) (*ql.FetchOutput, error) {
 	ctx, span := l.tel.Tracer().Start(ctx, "todo.inbound.gqlEndpoint.Fetch")
 	defer span.End()
@@ -49,9 +130,16 @@ func (l *gqlEndpoint) Fetch(ctx context.Context, in *ql.FetchInput) (*ql.FetchOu
 	input := domain.FetchInput{}
 	if in != nil {
 		input = domain.FetchInput{
-			Cursor: getString(in.Cursor),
-			Limit:  getString(in.Limit),
-			Status: getStatusString(in.Status),
+			Cursor:   getString(in.Cursor),
+			Limit:    getString(in.Limit),
+			Status:   getStatusString(in.Status),
+			UserID:   getString(in.UserID),
+			Query:    getString(in.Q),
+			Tags:     in.Tags,
+			Priority: getPriorityString(in.Priority),
+			DueFrom:  getTimeString(in.DueFrom),
+			DueTo:    getTimeString(in.DueTo),
+			Sort:     getString(in.Sort),
 		}
 	}
 
@@ -62,13 +150,7 @@ func (l *gqlEndpoint) Fetch(ctx context.Context, in *ql.FetchInput) (*ql.FetchOu
 
 	todos := make([]ql.Todo, 0)
 	for _, todo := range resp.Todos {
-		todos = append(todos, ql.Todo{
-			ID:          strconv.FormatUint(todo.ID, 10),
-			UserID:      strconv.FormatUint(todo.UserID, 10),
-			Title:       todo.Title,
-			Description: todo.Description,
-			Status:      ql.Status(todo.Status.String()),
-		})
+		todos = append(todos, *newQLTodo(todo))
 	}
 
 	return &ql.FetchOutput{
@@ -94,20 +176,20 @@ func (l *gqlEndpoint) Find(ctx context.Context, id string) (*ql.Todo, error) {
 		return nil, err
 	}
 
-	return &ql.Todo{
-		ID:          strconv.FormatUint(resp.ID, 10),
-		UserID:      strconv.FormatUint(resp.UserID, 10),
-		Title:       resp.Title,
-		Description: resp.Description,
-		Status:      ql.Status(resp.Status.String()),
-	}, nil
+	return newQLTodo(*resp), nil
 }
 
 func (l *gqlEndpoint) Create(ctx context.Context, in ql.CreateInput) (string, error) {
 	ctx, span := l.tel.Tracer().Start(ctx, "todo.inbound.gqlEndpoint.Create")
 	defer span.End()
 
-	resp, err := l.createUC.Call(ctx, domain.CreateInput{Title: in.Title, Description: in.Description})
+	resp, err := l.createUC.Call(ctx, domain.CreateInput{
+		Title:       in.Title,
+		Description: in.Description,
+		Priority:    getPriorityString(in.Priority),
+		DueAt:       in.DueAt,
+		Tags:        in.Tags,
+	})
 	if err != nil {
 		return "", err
 	}
@@ -168,16 +250,188 @@ func (l *gqlEndpoint) Update(ctx context.Context, in ql.UpdateInput) (*ql.Todo,
 		Title:       in.Title,
 		Description: in.Description,
 		Status:      in.Status.String(),
+		Priority:    getPriorityString(in.Priority),
+		DueAt:       in.DueAt,
+		Tags:        in.Tags,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &ql.Todo{
-		ID:          strconv.FormatUint(resp.ID, 10),
-		UserID:      strconv.FormatUint(resp.UserID, 10),
-		Title:       resp.Title,
-		Description: resp.Description,
-		Status:      ql.Status(resp.Status.String()),
+	return newQLTodo(*resp), nil
+}
+
+func (l *gqlEndpoint) Restore(ctx context.Context, id string) (string, error) {
+	ctx, span := l.tel.Tracer().Start(ctx, "todo.inbound.gqlEndpoint.Restore")
+	defer span.End()
+
+	idu64, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return "", errFailedParseToUint
+	}
+
+	resp, err := l.restoreUC.Call(ctx, domain.RestoreInput{ID: idu64})
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(resp.ID, 10), nil
+}
+
+func (l *gqlEndpoint) Purge(ctx context.Context, id string) (string, error) {
+	ctx, span := l.tel.Tracer().Start(ctx, "todo.inbound.gqlEndpoint.Purge")
+	defer span.End()
+
+	idu64, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return "", errFailedParseToUint
+	}
+
+	resp, err := l.purgeUC.Call(ctx, domain.PurgeInput{ID: idu64})
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(resp.ID, 10), nil
+}
+
+func (l *gqlEndpoint) Batch(ctx context.Context, in ql.BatchInput) (*ql.BatchOutput, error) {
+	ctx, span := l.tel.Tracer().Start(ctx, "todo.inbound.gqlEndpoint.Batch")
+	defer span.End()
+
+	input := domain.BatchInput{Atomic: in.Atomic != nil && *in.Atomic}
+	for _, item := range in.Create {
+		input.Create = append(input.Create, domain.CreateInput{
+			Title:       item.Title,
+			Description: item.Description,
+			Priority:    getPriorityString(item.Priority),
+			DueAt:       item.DueAt,
+			Tags:        item.Tags,
+		})
+	}
+
+	for _, item := range in.UpdateStatus {
+		idu64, err := strconv.ParseUint(item.ID, 10, 64)
+		if err != nil {
+			return nil, errFailedParseToUint
+		}
+
+		input.UpdateStatus = append(input.UpdateStatus, domain.UpdateStatusInput{
+			ID:     idu64,
+			Status: item.Status.String(),
+		})
+	}
+
+	for _, id := range in.Delete {
+		idu64, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, errFailedParseToUint
+		}
+
+		input.Delete = append(input.Delete, domain.DeleteInput{ID: idu64})
+	}
+
+	resp, err := l.batchUC.Call(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ql.BatchOutput{
+		Create:       newQLBatchResults(resp.Create),
+		UpdateStatus: newQLBatchResults(resp.UpdateStatus),
+		Delete:       newQLBatchResults(resp.Delete),
 	}, nil
 }
+
+func (l *gqlEndpoint) Share(ctx context.Context, in ql.ShareInput) (*ql.ShareOutput, error) {
+	ctx, span := l.tel.Tracer().Start(ctx, "todo.inbound.gqlEndpoint.Share")
+	defer span.End()
+
+	idu64, err := strconv.ParseUint(in.ID, 10, 64)
+	if err != nil {
+		return nil, errFailedParseToUint
+	}
+
+	resp, err := l.shareUC.Call(ctx, domain.ShareInput{ID: idu64, Email: in.Email, Role: in.Role.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ql.ShareOutput{
+		ID:    strconv.FormatUint(resp.ID, 10),
+		Email: resp.Email,
+		Role:  ql.Role(resp.Role),
+	}, nil
+}
+
+func (l *gqlEndpoint) Unshare(ctx context.Context, in ql.UnshareInput) (string, error) {
+	ctx, span := l.tel.Tracer().Start(ctx, "todo.inbound.gqlEndpoint.Unshare")
+	defer span.End()
+
+	idu64, err := strconv.ParseUint(in.ID, 10, 64)
+	if err != nil {
+		return "", errFailedParseToUint
+	}
+
+	resp, err := l.unshareUC.Call(ctx, domain.UnshareInput{ID: idu64, Email: in.Email})
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(resp.ID, 10), nil
+}
+
+func (l *gqlEndpoint) Accept(ctx context.Context, id string) (string, error) {
+	ctx, span := l.tel.Tracer().Start(ctx, "todo.inbound.gqlEndpoint.Accept")
+	defer span.End()
+
+	idu64, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return "", errFailedParseToUint
+	}
+
+	resp, err := l.acceptUC.Call(ctx, domain.AcceptInput{ID: idu64})
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(resp.ID, 10), nil
+}
+
+// TodoChanged streams the todo changes of the caller until the subscription
+// ends. The caller comes from the connection init payload of the websocket.
+func (l *gqlEndpoint) TodoChanged(ctx context.Context, status *ql.Status) (<-chan *ql.TodoEvent, error) {
+	ctx, span := l.tel.Tracer().Start(ctx, "todo.inbound.gqlEndpoint.TodoChanged")
+	defer span.End()
+
+	clm := lib.GetJWTClaim(ctx)
+	if clm == nil {
+		return nil, errUnauthenticated
+	}
+
+	filter := getStatusString(status)
+	_, events := l.events.Subscribe(ctx, clm.AuthID, 0)
+	out := make(chan *ql.TodoEvent)
+
+	go func() {
+		defer close(out)
+
+		for ev := range events {
+			if filter != "" && ev.Todo.Status.String() != filter {
+				continue
+			}
+
+			select {
+			case out <- &ql.TodoEvent{
+				ID:   strconv.FormatUint(ev.ID, 10),
+				Type: ql.TodoEventType(ev.Type.String()),
+				Todo: newQLTodo(ev.Todo),
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
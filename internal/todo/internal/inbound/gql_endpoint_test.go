@@ -2,14 +2,17 @@ package inbound
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/shandysiswandi/goreng/enum"
-	"github.com/shandysiswandi/goreng/telemetry"
 	ql "github.com/shandysiswandi/gostarter/api/gen-gql/todo"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test_gqlEndpoint_Mutation(t *testing.T) {
@@ -52,9 +55,31 @@ func Test_gqlEndpoint_Query(t *testing.T) {
 	}
 }
 
+func Test_gqlEndpoint_Subscription(t *testing.T) {
+	tests := []struct {
+		name string
+		e    *gqlEndpoint
+		want ql.SubscriptionResolver
+	}{
+		{
+			name: "Success",
+			e:    &gqlEndpoint{},
+			want: &gqlEndpoint{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.e.Subscription())
+		})
+	}
+}
+
 func Test_gqlEndpoint_Fetch(t *testing.T) {
 	cursor := "Mg"
 	limit := "1"
+	query := "milk"
+	sort := "-created_at"
 
 	type args struct {
 		ctx context.Context
@@ -81,7 +106,7 @@ func Test_gqlEndpoint_Fetch(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *gqlEndpoint {
 				fetchMock := mockz.NewMockFetch(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Fetch")
 				defer span.End()
@@ -109,15 +134,21 @@ func Test_gqlEndpoint_Fetch(t *testing.T) {
 					Cursor: &cursor,
 					Limit:  &limit,
 					Status: &ql.AllStatus[1],
+					Q:      &query,
+					Tags:   []string{"work"},
+					Sort:   &sort,
 				},
 			},
 			want: &ql.FetchOutput{
 				Todos: []ql.Todo{{
-					ID:          "10",
-					UserID:      "11",
-					Title:       "title",
-					Description: "description",
-					Status:      ql.StatusDone,
+					ID:            "10",
+					UserID:        "11",
+					Title:         "title",
+					Description:   "description",
+					Status:        ql.StatusDone,
+					Priority:      ql.PriorityMedium,
+					Tags:          []string{},
+					Collaborators: []ql.Collaborator{},
 				}},
 				Pagination: &ql.Pagination{
 					NextCursor: "NTY",
@@ -127,7 +158,7 @@ func Test_gqlEndpoint_Fetch(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *gqlEndpoint {
 				fetchMock := mockz.NewMockFetch(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Fetch")
 				defer span.End()
@@ -136,6 +167,9 @@ func Test_gqlEndpoint_Fetch(t *testing.T) {
 					Cursor: "Mg",
 					Limit:  "1",
 					Status: a.in.Status.String(),
+					Query:  query,
+					Tags:   []string{"work"},
+					Sort:   sort,
 				}
 				out := &domain.FetchOutput{
 					Todos: []domain.Todo{{
@@ -144,6 +178,7 @@ func Test_gqlEndpoint_Fetch(t *testing.T) {
 						Title:       "title",
 						Description: "description",
 						Status:      enum.New(domain.TodoStatusDone),
+						Priority:    enum.New(domain.TodoPriorityMedium),
 					}},
 					NextCursor: "NTY",
 					HasMore:    true,
@@ -190,7 +225,7 @@ func Test_gqlEndpoint_Find(t *testing.T) {
 			want:    nil,
 			wantErr: errFailedParseToUint,
 			mockFn: func(a args) *gqlEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Find")
 				defer span.End()
@@ -210,7 +245,7 @@ func Test_gqlEndpoint_Find(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *gqlEndpoint {
 				findMock := mockz.NewMockFind(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Find")
 				defer span.End()
@@ -233,16 +268,19 @@ func Test_gqlEndpoint_Find(t *testing.T) {
 				id:  "10",
 			},
 			want: &ql.Todo{
-				ID:          "10",
-				UserID:      "11",
-				Title:       "title",
-				Description: "description",
-				Status:      ql.StatusDrop,
+				ID:            "10",
+				UserID:        "11",
+				Title:         "title",
+				Description:   "description",
+				Status:        ql.StatusDrop,
+				Priority:      ql.PriorityMedium,
+				Tags:          []string{},
+				Collaborators: []ql.Collaborator{},
 			},
 			wantErr: nil,
 			mockFn: func(a args) *gqlEndpoint {
 				findMock := mockz.NewMockFind(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Find")
 				defer span.End()
@@ -254,6 +292,7 @@ func Test_gqlEndpoint_Find(t *testing.T) {
 					Title:       "title",
 					Description: "description",
 					Status:      enum.New(domain.TodoStatusDrop),
+					Priority:    enum.New(domain.TodoPriorityMedium),
 				}
 				findMock.EXPECT().
 					Call(ctx, in).
@@ -298,7 +337,7 @@ func Test_gqlEndpoint_Create(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *gqlEndpoint {
 				createMock := mockz.NewMockCreate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Create")
 				defer span.End()
@@ -327,7 +366,7 @@ func Test_gqlEndpoint_Create(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *gqlEndpoint {
 				createMock := mockz.NewMockCreate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Create")
 				defer span.End()
@@ -379,7 +418,7 @@ func Test_gqlEndpoint_Delete(t *testing.T) {
 			want:    "",
 			wantErr: errFailedParseToUint,
 			mockFn: func(a args) *gqlEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Delete")
 				defer span.End()
@@ -399,7 +438,7 @@ func Test_gqlEndpoint_Delete(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *gqlEndpoint {
 				deleteMock := mockz.NewMockDelete(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Delete")
 				defer span.End()
@@ -425,7 +464,7 @@ func Test_gqlEndpoint_Delete(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *gqlEndpoint {
 				deleteMock := mockz.NewMockDelete(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Delete")
 				defer span.End()
@@ -474,7 +513,7 @@ func Test_gqlEndpoint_UpdateStatus(t *testing.T) {
 			want:    nil,
 			wantErr: errFailedParseToUint,
 			mockFn: func(a args) *gqlEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.UpdateStatus")
 				defer span.End()
@@ -494,7 +533,7 @@ func Test_gqlEndpoint_UpdateStatus(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *gqlEndpoint {
 				updateStateMock := mockz.NewMockUpdateStatus(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.UpdateStatus")
 				defer span.End()
@@ -523,7 +562,7 @@ func Test_gqlEndpoint_UpdateStatus(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *gqlEndpoint {
 				updateStateMock := mockz.NewMockUpdateStatus(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.UpdateStatus")
 				defer span.End()
@@ -583,7 +622,7 @@ func Test_gqlEndpoint_Update(t *testing.T) {
 			want:    nil,
 			wantErr: errFailedParseToUint,
 			mockFn: func(a args) *gqlEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Update")
 				defer span.End()
@@ -608,7 +647,7 @@ func Test_gqlEndpoint_Update(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *gqlEndpoint {
 				updateMock := mockz.NewMockUpdate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Update")
 				defer span.End()
@@ -641,16 +680,19 @@ func Test_gqlEndpoint_Update(t *testing.T) {
 				},
 			},
 			want: &ql.Todo{
-				ID:          "10",
-				UserID:      "11",
-				Title:       "title",
-				Description: "description",
-				Status:      ql.StatusInProgress,
+				ID:            "10",
+				UserID:        "11",
+				Title:         "title",
+				Description:   "description",
+				Status:        ql.StatusInProgress,
+				Priority:      ql.PriorityMedium,
+				Tags:          []string{},
+				Collaborators: []ql.Collaborator{},
 			},
 			wantErr: nil,
 			mockFn: func(a args) *gqlEndpoint {
 				updateMock := mockz.NewMockUpdate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Update")
 				defer span.End()
@@ -667,6 +709,7 @@ func Test_gqlEndpoint_Update(t *testing.T) {
 					Title:       "title",
 					Description: "description",
 					Status:      enum.New(domain.TodoStatusInProgress),
+					Priority:    enum.New(domain.TodoPriorityMedium),
 				}
 				updateMock.EXPECT().
 					Call(ctx, in).
@@ -688,3 +731,509 @@ func Test_gqlEndpoint_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_gqlEndpoint_Batch(t *testing.T) {
+	atomic := true
+	notFound := "todo not found"
+
+	type args struct {
+		ctx context.Context
+		in  ql.BatchInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *ql.BatchOutput
+		wantErr error
+		mockFn  func(a args) *gqlEndpoint
+	}{
+		{
+			name: "ErrorParseUpdateStatusID",
+			args: args{
+				ctx: context.Background(),
+				in:  ql.BatchInput{UpdateStatus: []ql.UpdateStatusInput{{ID: "n/a", Status: ql.StatusDone}}},
+			},
+			want:    nil,
+			wantErr: errFailedParseToUint,
+			mockFn: func(a args) *gqlEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Batch")
+				defer span.End()
+
+				return &gqlEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorParseDeleteID",
+			args: args{
+				ctx: context.Background(),
+				in:  ql.BatchInput{Delete: []string{"n/a"}},
+			},
+			want:    nil,
+			wantErr: errFailedParseToUint,
+			mockFn: func(a args) *gqlEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Batch")
+				defer span.End()
+
+				return &gqlEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			args: args{
+				ctx: context.Background(),
+				in:  ql.BatchInput{Atomic: &atomic, Delete: []string{"3"}},
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) *gqlEndpoint {
+				batchMock := mockz.NewMockBatch(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Batch")
+				defer span.End()
+
+				in := domain.BatchInput{Atomic: true, Delete: []domain.DeleteInput{{ID: 3}}}
+				batchMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &gqlEndpoint{
+					tel:     tel,
+					batchUC: batchMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(),
+				in: ql.BatchInput{
+					Create:       []ql.CreateInput{{Title: "title", Description: "description"}},
+					UpdateStatus: []ql.UpdateStatusInput{{ID: "2", Status: ql.StatusDone}},
+					Delete:       []string{"3"},
+				},
+			},
+			want: &ql.BatchOutput{
+				Create:       []ql.BatchResult{{ID: "1"}},
+				UpdateStatus: []ql.BatchResult{{ID: "2", Error: &notFound}},
+				Delete:       []ql.BatchResult{{ID: "3"}},
+			},
+			wantErr: nil,
+			mockFn: func(a args) *gqlEndpoint {
+				batchMock := mockz.NewMockBatch(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Batch")
+				defer span.End()
+
+				in := domain.BatchInput{
+					Create:       []domain.CreateInput{{Title: "title", Description: "description"}},
+					UpdateStatus: []domain.UpdateStatusInput{{ID: 2, Status: "DONE"}},
+					Delete:       []domain.DeleteInput{{ID: 3}},
+				}
+				out := &domain.BatchOutput{
+					Create:       []domain.BatchResult{{ID: 1}},
+					UpdateStatus: []domain.BatchResult{{ID: 2, Err: errors.New(notFound)}},
+					Delete:       []domain.BatchResult{{ID: 3}},
+				}
+				batchMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &gqlEndpoint{
+					tel:     tel,
+					batchUC: batchMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := tt.mockFn(tt.args)
+			got, err := l.Batch(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_gqlEndpoint_Share(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  ql.ShareInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *ql.ShareOutput
+		wantErr error
+		mockFn  func(a args) *gqlEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			args: args{
+				ctx: context.Background(),
+				in:  ql.ShareInput{ID: "a", Email: "bob@mail.com", Role: ql.RoleViewer},
+			},
+			want:    nil,
+			wantErr: errFailedParseToUint,
+			mockFn: func(a args) *gqlEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Share")
+				defer span.End()
+
+				return &gqlEndpoint{
+					tel: tel,
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			args: args{
+				ctx: context.Background(),
+				in:  ql.ShareInput{ID: "10", Email: "bob@mail.com", Role: ql.RoleViewer},
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) *gqlEndpoint {
+				shareMock := mockz.NewMockShare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Share")
+				defer span.End()
+
+				in := domain.ShareInput{ID: 10, Email: "bob@mail.com", Role: "VIEWER"}
+				shareMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &gqlEndpoint{
+					tel:     tel,
+					shareUC: shareMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(),
+				in:  ql.ShareInput{ID: "10", Email: "bob@mail.com", Role: ql.RoleEditor},
+			},
+			want:    &ql.ShareOutput{ID: "10", Email: "bob@mail.com", Role: ql.RoleEditor},
+			wantErr: nil,
+			mockFn: func(a args) *gqlEndpoint {
+				shareMock := mockz.NewMockShare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Share")
+				defer span.End()
+
+				in := domain.ShareInput{ID: 10, Email: "bob@mail.com", Role: "EDITOR"}
+				out := &domain.ShareOutput{ID: 10, Email: "bob@mail.com", Role: "EDITOR"}
+				shareMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &gqlEndpoint{
+					tel:     tel,
+					shareUC: shareMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.mockFn(tt.args).Share(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_gqlEndpoint_Unshare(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  ql.UnshareInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+		mockFn  func(a args) *gqlEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			args: args{
+				ctx: context.Background(),
+				in:  ql.UnshareInput{ID: "a", Email: "bob@mail.com"},
+			},
+			want:    "",
+			wantErr: errFailedParseToUint,
+			mockFn: func(a args) *gqlEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Unshare")
+				defer span.End()
+
+				return &gqlEndpoint{
+					tel: tel,
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			args: args{
+				ctx: context.Background(),
+				in:  ql.UnshareInput{ID: "10", Email: "bob@mail.com"},
+			},
+			want:    "",
+			wantErr: assert.AnError,
+			mockFn: func(a args) *gqlEndpoint {
+				unshareMock := mockz.NewMockUnshare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Unshare")
+				defer span.End()
+
+				in := domain.UnshareInput{ID: 10, Email: "bob@mail.com"}
+				unshareMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &gqlEndpoint{
+					tel:       tel,
+					unshareUC: unshareMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(),
+				in:  ql.UnshareInput{ID: "10", Email: "bob@mail.com"},
+			},
+			want:    "10",
+			wantErr: nil,
+			mockFn: func(a args) *gqlEndpoint {
+				unshareMock := mockz.NewMockUnshare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Unshare")
+				defer span.End()
+
+				in := domain.UnshareInput{ID: 10, Email: "bob@mail.com"}
+				out := &domain.UnshareOutput{ID: 10, Email: "bob@mail.com"}
+				unshareMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &gqlEndpoint{
+					tel:       tel,
+					unshareUC: unshareMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.mockFn(tt.args).Unshare(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_gqlEndpoint_Accept(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+		mockFn  func(a args) *gqlEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			args: args{
+				ctx: context.Background(),
+				id:  "a",
+			},
+			want:    "",
+			wantErr: errFailedParseToUint,
+			mockFn: func(a args) *gqlEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Accept")
+				defer span.End()
+
+				return &gqlEndpoint{
+					tel: tel,
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			args: args{
+				ctx: context.Background(),
+				id:  "10",
+			},
+			want:    "",
+			wantErr: assert.AnError,
+			mockFn: func(a args) *gqlEndpoint {
+				acceptMock := mockz.NewMockAccept(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Accept")
+				defer span.End()
+
+				acceptMock.EXPECT().
+					Call(ctx, domain.AcceptInput{ID: 10}).
+					Return(nil, assert.AnError)
+
+				return &gqlEndpoint{
+					tel:      tel,
+					acceptUC: acceptMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(),
+				id:  "10",
+			},
+			want:    "10",
+			wantErr: nil,
+			mockFn: func(a args) *gqlEndpoint {
+				acceptMock := mockz.NewMockAccept(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.gqlEndpoint.Accept")
+				defer span.End()
+
+				acceptMock.EXPECT().
+					Call(ctx, domain.AcceptInput{ID: 10}).
+					Return(&domain.AcceptOutput{ID: 10}, nil)
+
+				return &gqlEndpoint{
+					tel:      tel,
+					acceptUC: acceptMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.mockFn(tt.args).Accept(tt.args.ctx, tt.args.id)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_gqlEndpoint_TodoChanged(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	done := ql.StatusDone
+
+	type args struct {
+		ctx    context.Context
+		status *ql.Status
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*ql.TodoEvent
+		wantErr error
+		mockFn  func(a args) *gqlEndpoint
+	}{
+		{
+			name:    "ErrorWithoutClaim",
+			args:    args{ctx: context.Background()},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *gqlEndpoint {
+				return &gqlEndpoint{tel: lib.NewTelemetry()}
+			},
+		},
+		{
+			name: "SuccessFilterByStatus",
+			args: args{ctx: ctxJWT, status: &done},
+			want: []*ql.TodoEvent{{
+				ID:   "8",
+				Type: ql.TodoEventTypeTodoStatusUpdated,
+				Todo: &ql.Todo{
+					ID:            "2",
+					UserID:        "11",
+					Title:         "title",
+					Description:   "description",
+					Status:        ql.StatusDone,
+					Priority:      ql.PriorityMedium,
+					Tags:          []string{},
+					Collaborators: []ql.Collaborator{},
+				},
+			}},
+			wantErr: nil,
+			mockFn: func(a args) *gqlEndpoint {
+				subMock := mockz.NewMockTodoEventSubscriber(t)
+
+				events := make(chan domain.TodoEvent, 2)
+				events <- domain.TodoEvent{
+					ID:   7,
+					Type: enum.New(domain.TodoEventTypeCreated),
+					Todo: domain.Todo{ID: 1, UserID: 11, Status: enum.New(domain.TodoStatusInitiate)},
+				}
+				events <- domain.TodoEvent{
+					ID:   8,
+					Type: enum.New(domain.TodoEventTypeStatusUpdated),
+					Todo: domain.Todo{
+						ID:          2,
+						UserID:      11,
+						Title:       "title",
+						Description: "description",
+						Status:      enum.New(domain.TodoStatusDone),
+						Priority:    enum.New(domain.TodoPriorityMedium),
+					},
+				}
+				close(events)
+
+				subMock.EXPECT().
+					Subscribe(mock.Anything, uint64(11), uint64(0)).
+					Return(nil, events)
+
+				return &gqlEndpoint{tel: lib.NewTelemetry(), events: subMock}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e := tt.mockFn(tt.args)
+			got, err := e.TodoChanged(tt.args.ctx, tt.args.status)
+			assert.Equal(t, tt.wantErr, err)
+			if err != nil {
+				assert.Nil(t, got)
+
+				return
+			}
+
+			var events []*ql.TodoEvent
+			for ev := range got {
+				events = append(events, ev)
+			}
+			assert.Equal(t, tt.want, events)
+		})
+	}
+}
@@ -3,16 +3,102 @@ package inbound
 import (
 	"context"
 	"strings"
+	"time"
 
-	"github.com/shandysiswandi/goreng/telemetry"
 	pb "github.com/shandysiswandi/gostarter/api/gen-proto/todo"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func toPBTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func fromPBTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
+
+// pbTimeString formats the timestamp as RFC 3339 for the inputs taking a time
+// as text, nil is left empty.
+func pbTimeString(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	return ts.AsTime().Format(time.RFC3339Nano)
+}
+
+// priorityName maps the proto priority to the domain one, unspecified is left
+// empty for the use case to apply its default.
+func priorityName(p pb.Priority) string {
+	if p == pb.Priority_PRIORITY_UNSPECIFIED {
+		return ""
+	}
+
+	return strings.TrimPrefix(p.String(), "PRIORITY_")
+}
+
+func newPBBatchResults(results []domain.BatchResult) []*pb.BatchResult {
+	out := make([]*pb.BatchResult, 0, len(results))
+	for _, res := range results {
+		item := &pb.BatchResult{Id: res.ID}
+		if res.Err != nil {
+			item.Error = res.Err.Error()
+		}
+
+		out = append(out, item)
+	}
+
+	return out
+}
+
+func newPBTodo(t domain.Todo) *pb.Todo {
+	return &pb.Todo{
+		Id:          t.ID,
+		UserId:      t.UserID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      pb.Status(t.Status.Enum()),
+		Priority:    pb.Priority(t.Priority.Enum()),
+		DueAt:       toPBTime(t.DueAt),
+		CompletedAt: toPBTime(t.CompletedAt),
+		Tags:        t.Tags,
+		// the users the todo is shared with
+		Collaborators: newPBCollaborators(t.Collaborators),
+	}
+}
+
+func newPBCollaborators(cs []domain.TodoCollaborator) []*pb.Collaborator {
+	var out []*pb.Collaborator
+	for _, c := range cs {
+		out = append(out, &pb.Collaborator{
+			Email:      c.Email,
+			UserId:     c.UserID,
+			Role:       pb.Role(c.Role.Enum()),
+			InvitedAt:  timestamppb.New(c.InvitedAt),
+			AcceptedAt: toPBTime(c.AcceptedAt),
+		})
+	}
+
+	return out
+}
+
 type grpcEndpoint struct {
 	pb.UnimplementedTodoServiceServer
 
-	tel *telemetry.Telemetry
+	tel *lib.Telemetry
 
 	findUC         domain.Find
 	fetchUC        domain.Fetch
@@ -20,6 +106,13 @@ type grpcEndpoint struct {
 	deleteUC       domain.Delete
 	updateUC       domain.Update
 	updateStatusUC domain.UpdateStatus
+	restoreUC      domain.Restore
+	purgeUC        domain.Purge
+	batchUC        domain.Batch
+	shareUC        domain.Share
+	unshareUC      domain.Unshare
+	acceptUC       domain.Accept
+	events         domain.TodoEventSubscriber
 }
 
 func (g *grpcEndpoint) Create(ctx context.Context, req *pb.CreateRequest) (*pb.CreateResponse, error) {
@@ -29,6 +122,9 @@ func (g *grpcEndpoint) Create(ctx context.Context, req *pb.CreateRequest) (*pb.C
 	resp, err := g.createUC.Call(ctx, domain.CreateInput{
 		Title:       req.GetTitle(),
 		Description: req.GetDescription(),
+		Priority:    priorityName(req.GetPriority()),
+		DueAt:       fromPBTime(req.GetDueAt()),
+		Tags:        req.GetTags(),
 	})
 	if err != nil {
 		return nil, err
@@ -64,6 +160,12 @@ func (g *grpcEndpoint) Find(ctx context.Context, req *pb.FindRequest) (*pb.FindR
 		Title:       resp.Title,
 		Description: resp.Description,
 		Status:      pb.Status(resp.Status.Enum()),
+		Priority:    pb.Priority(resp.Priority.Enum()),
+		DueAt:       toPBTime(resp.DueAt),
+		CompletedAt: toPBTime(resp.CompletedAt),
+		Tags:        resp.Tags,
+		// the users the todo is shared with
+		Collaborators: newPBCollaborators(resp.Collaborators),
 	}, nil
 }
 
@@ -72,9 +174,16 @@ func (g *grpcEndpoint) Fetch(ctx context.Context, req *pb.FetchRequest) (*pb.Fet
 	defer span.End()
 
 	resp, err := g.fetchUC.Call(ctx, domain.FetchInput{
-		Cursor: req.GetCursor(),
-		Limit:  req.GetLimit(),
-		Status: req.GetStatus().String(),
+		Cursor:   req.GetCursor(),
+		Limit:    req.GetLimit(),
+		Status:   req.GetStatus().String(),
+		UserID:   req.GetUserId(),
+		Query:    req.GetQ(),
+		Tags:     req.GetTags(),
+		Priority: priorityName(req.GetPriority()),
+		DueFrom:  pbTimeString(req.GetDueFrom()),
+		DueTo:    pbTimeString(req.GetDueTo()),
+		Sort:     req.GetSort(),
 	})
 	if err != nil {
 		return nil, err
@@ -82,13 +191,7 @@ func (g *grpcEndpoint) Fetch(ctx context.Context, req *pb.FetchRequest) (*pb.Fet
 
 	todos := make([]*pb.Todo, 0)
 	for _, todo := range resp.Todos {
-		todos = append(todos, &pb.Todo{
-			Id:          todo.ID,
-			UserId:      todo.UserID,
-			Title:       todo.Title,
-			Description: todo.Description,
-			Status:      pb.Status(todo.Status.Enum()),
-		})
+		todos = append(todos, newPBTodo(todo))
 	}
 
 	return &pb.FetchResponse{
@@ -129,6 +232,9 @@ func (g *grpcEndpoint) Update(ctx context.Context, req *pb.UpdateRequest) (*pb.U
 		Title:       req.GetTitle(),
 		Description: req.GetDescription(),
 		Status:      strings.TrimPrefix(req.GetStatus().String(), "STATUS_"),
+		Priority:    priorityName(req.GetPriority()),
+		DueAt:       fromPBTime(req.GetDueAt()),
+		Tags:        req.GetTags(),
 	})
 	if err != nil {
 		return nil, err
@@ -140,5 +246,164 @@ func (g *grpcEndpoint) Update(ctx context.Context, req *pb.UpdateRequest) (*pb.U
 		Title:       resp.Title,
 		Description: resp.Description,
 		Status:      pb.Status(resp.Status.Enum()),
+		Priority:    pb.Priority(resp.Priority.Enum()),
+		DueAt:       toPBTime(resp.DueAt),
+		CompletedAt: toPBTime(resp.CompletedAt),
+		Tags:        resp.Tags,
+		// the users the todo is shared with
+		Collaborators: newPBCollaborators(resp.Collaborators),
+	}, nil
+}
+
+func (g *grpcEndpoint) Restore(ctx context.Context, req *pb.RestoreRequest) (*pb.RestoreResponse, error) {
+	ctx, span := g.tel.Tracer().Start(ctx, "todo.inbound.grpcEndpoint.Restore")
+	defer span.End()
+
+	resp, err := g.restoreUC.Call(ctx, domain.RestoreInput{ID: req.GetId()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreResponse{Id: resp.ID}, nil
+}
+
+func (g *grpcEndpoint) Purge(ctx context.Context, req *pb.PurgeRequest) (*pb.PurgeResponse, error) {
+	ctx, span := g.tel.Tracer().Start(ctx, "todo.inbound.grpcEndpoint.Purge")
+	defer span.End()
+
+	resp, err := g.purgeUC.Call(ctx, domain.PurgeInput{ID: req.GetId()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PurgeResponse{Id: resp.ID}, nil
+}
+
+func (g *grpcEndpoint) Batch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchResponse, error) {
+	ctx, span := g.tel.Tracer().Start(ctx, "todo.inbound.grpcEndpoint.Batch")
+	defer span.End()
+
+	in := domain.BatchInput{Atomic: req.GetAtomic()}
+	for _, item := range req.GetCreate() {
+		in.Create = append(in.Create, domain.CreateInput{
+			Title:       item.GetTitle(),
+			Description: item.GetDescription(),
+			Priority:    priorityName(item.GetPriority()),
+			DueAt:       fromPBTime(item.GetDueAt()),
+			Tags:        item.GetTags(),
+		})
+	}
+
+	for _, item := range req.GetUpdateStatus() {
+		in.UpdateStatus = append(in.UpdateStatus, domain.UpdateStatusInput{
+			ID:     item.GetId(),
+			Status: strings.TrimPrefix(item.GetStatus().String(), "STATUS_"),
+		})
+	}
+
+	for _, item := range req.GetDelete() {
+		in.Delete = append(in.Delete, domain.DeleteInput{ID: item.GetId()})
+	}
+
+	resp, err := g.batchUC.Call(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BatchResponse{
+		Create:       newPBBatchResults(resp.Create),
+		UpdateStatus: newPBBatchResults(resp.UpdateStatus),
+		Delete:       newPBBatchResults(resp.Delete),
+	}, nil
+}
+
+// Watch streams the todo changes of the caller. A client that reconnects sends
+// the last id it got to replay what it missed while it was away.
+func (g *grpcEndpoint) Share(ctx context.Context, req *pb.ShareRequest) (*pb.ShareResponse, error) {
+	ctx, span := g.tel.Tracer().Start(ctx, "todo.inbound.grpcEndpoint.Share")
+	defer span.End()
+
+	resp, err := g.shareUC.Call(ctx, domain.ShareInput{
+		ID:    req.GetId(),
+		Email: req.GetEmail(),
+		Role:  strings.TrimPrefix(req.GetRole().String(), "ROLE_"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ShareResponse{
+		Id:    resp.ID,
+		Email: resp.Email,
+		Role:  pb.Role(pb.Role_value["ROLE_"+resp.Role]),
 	}, nil
 }
+
+func (g *grpcEndpoint) Unshare(ctx context.Context, req *pb.UnshareRequest) (*pb.UnshareResponse, error) {
+	ctx, span := g.tel.Tracer().Start(ctx, "todo.inbound.grpcEndpoint.Unshare")
+	defer span.End()
+
+	resp, err := g.unshareUC.Call(ctx, domain.UnshareInput{ID: req.GetId(), Email: req.GetEmail()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnshareResponse{Id: resp.ID, Email: resp.Email}, nil
+}
+
+func (g *grpcEndpoint) Accept(ctx context.Context, req *pb.AcceptRequest) (*pb.AcceptResponse, error) {
+	ctx, span := g.tel.Tracer().Start(ctx, "todo.inbound.grpcEndpoint.Accept")
+	defer span.End()
+
+	resp, err := g.acceptUC.Call(ctx, domain.AcceptInput{ID: req.GetId()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AcceptResponse{Id: resp.ID}, nil
+}
+
+func (g *grpcEndpoint) Watch(req *pb.WatchRequest, stream grpc.ServerStreamingServer[pb.TodoEvent]) error {
+	ctx, span := g.tel.Tracer().Start(stream.Context(), "todo.inbound.grpcEndpoint.Watch")
+	defer span.End()
+
+	clm := lib.GetJWTClaim(ctx)
+	if clm == nil {
+		return errUnauthenticated
+	}
+
+	send := func(ev domain.TodoEvent) error {
+		sts := pb.Status(ev.Todo.Status.Enum())
+		if req.GetStatus() != pb.Status_STATUS_UNSPECIFIED && req.GetStatus() != sts {
+			return nil
+		}
+
+		return stream.Send(&pb.TodoEvent{
+			Id:   ev.ID,
+			Type: pb.EventType(ev.Type.Enum()),
+			Todo: newPBTodo(ev.Todo),
+		})
+	}
+
+	replay, events := g.events.Subscribe(ctx, clm.AuthID, req.GetLastEventId())
+	for _, ev := range replay {
+		if err := send(ev); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-events:
+			if !ok {
+				return errWatchLagging
+			}
+
+			if err := send(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
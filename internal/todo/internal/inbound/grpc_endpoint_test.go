@@ -2,14 +2,19 @@ package inbound
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/shandysiswandi/goreng/enum"
-	"github.com/shandysiswandi/goreng/telemetry"
 	pb "github.com/shandysiswandi/gostarter/api/gen-proto/todo"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_grpcEndpoint_Create(t *testing.T) {
@@ -37,7 +42,7 @@ func Test_grpcEndpoint_Create(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *grpcEndpoint {
 				createMock := mockz.NewMockCreate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Create")
 				defer span.End()
@@ -69,7 +74,7 @@ func Test_grpcEndpoint_Create(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *grpcEndpoint {
 				createMock := mockz.NewMockCreate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Create")
 				defer span.End()
@@ -123,7 +128,7 @@ func Test_grpcEndpoint_Delete(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *grpcEndpoint {
 				deleteMock := mockz.NewMockDelete(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Delete")
 				defer span.End()
@@ -149,7 +154,7 @@ func Test_grpcEndpoint_Delete(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *grpcEndpoint {
 				deleteMock := mockz.NewMockDelete(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Delete")
 				defer span.End()
@@ -200,7 +205,7 @@ func Test_grpcEndpoint_Find(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *grpcEndpoint {
 				findMock := mockz.NewMockFind(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Find")
 				defer span.End()
@@ -232,7 +237,7 @@ func Test_grpcEndpoint_Find(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *grpcEndpoint {
 				findMock := mockz.NewMockFind(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Find")
 				defer span.End()
@@ -293,7 +298,7 @@ func Test_grpcEndpoint_Fetch(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *grpcEndpoint {
 				fetchMock := mockz.NewMockFetch(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Fetch")
 				defer span.End()
@@ -318,9 +323,14 @@ func Test_grpcEndpoint_Fetch(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				req: &pb.FetchRequest{
-					Cursor: "Mg",
-					Limit:  "1",
-					Status: pb.Status_STATUS_DONE,
+					Cursor:   "Mg",
+					Limit:    "1",
+					Status:   pb.Status_STATUS_DONE,
+					Q:        "milk",
+					Tags:     []string{"home"},
+					Priority: pb.Priority_PRIORITY_HIGH,
+					DueFrom:  timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+					Sort:     "-priority",
 				},
 			},
 			want: &pb.FetchResponse{
@@ -339,15 +349,20 @@ func Test_grpcEndpoint_Fetch(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *grpcEndpoint {
 				fetchMock := mockz.NewMockFetch(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Fetch")
 				defer span.End()
 
 				in := domain.FetchInput{
-					Cursor: "Mg",
-					Limit:  "1",
-					Status: a.req.Status.String(),
+					Cursor:   "Mg",
+					Limit:    "1",
+					Status:   a.req.Status.String(),
+					Query:    "milk",
+					Tags:     []string{"home"},
+					Priority: "HIGH",
+					DueFrom:  "2025-01-01T00:00:00Z",
+					Sort:     "-priority",
 				}
 				out := &domain.FetchOutput{
 					Todos: []domain.Todo{{
@@ -407,7 +422,7 @@ func Test_grpcEndpoint_UpdateStatus(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *grpcEndpoint {
 				updateStatusMock := mockz.NewMockUpdateStatus(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.UpdateStatus")
 				defer span.End()
@@ -442,7 +457,7 @@ func Test_grpcEndpoint_UpdateStatus(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *grpcEndpoint {
 				updateStatusMock := mockz.NewMockUpdateStatus(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.UpdateStatus")
 				defer span.End()
@@ -504,7 +519,7 @@ func Test_grpcEndpoint_Update(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(a args) *grpcEndpoint {
 				updateMock := mockz.NewMockUpdate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Update")
 				defer span.End()
@@ -546,7 +561,7 @@ func Test_grpcEndpoint_Update(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(a args) *grpcEndpoint {
 				updateMock := mockz.NewMockUpdate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Update")
 				defer span.End()
@@ -585,3 +600,442 @@ func Test_grpcEndpoint_Update(t *testing.T) {
 		})
 	}
 }
+
+type watchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	err  error
+	sent []*pb.TodoEvent
+}
+
+func (w *watchStream) Context() context.Context { return w.ctx }
+
+func (w *watchStream) Send(ev *pb.TodoEvent) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.sent = append(w.sent, ev)
+
+	return nil
+}
+
+func Test_grpcEndpoint_Batch(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.BatchRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *pb.BatchResponse
+		wantErr error
+		mockFn  func(a args) *grpcEndpoint
+	}{
+		{
+			name: "ErrorCallUC",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.BatchRequest{Atomic: true, Delete: []*pb.DeleteRequest{{Id: 3}}},
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) *grpcEndpoint {
+				batchMock := mockz.NewMockBatch(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Batch")
+				defer span.End()
+
+				in := domain.BatchInput{Atomic: true, Delete: []domain.DeleteInput{{ID: 3}}}
+				batchMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &grpcEndpoint{
+					tel:     tel,
+					batchUC: batchMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.BatchRequest{
+					Create: []*pb.CreateRequest{{
+						Title: "title", Description: "description", Priority: pb.Priority_PRIORITY_LOW,
+					}},
+					UpdateStatus: []*pb.UpdateStatusRequest{{Id: 2, Status: pb.Status_STATUS_DONE}},
+					Delete:       []*pb.DeleteRequest{{Id: 3}},
+				},
+			},
+			want: &pb.BatchResponse{
+				Create:       []*pb.BatchResult{{Id: 1}},
+				UpdateStatus: []*pb.BatchResult{{Id: 2, Error: "todo not found"}},
+				Delete:       []*pb.BatchResult{{Id: 3}},
+			},
+			wantErr: nil,
+			mockFn: func(a args) *grpcEndpoint {
+				batchMock := mockz.NewMockBatch(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Batch")
+				defer span.End()
+
+				in := domain.BatchInput{
+					Create:       []domain.CreateInput{{Title: "title", Description: "description", Priority: "LOW"}},
+					UpdateStatus: []domain.UpdateStatusInput{{ID: 2, Status: "DONE"}},
+					Delete:       []domain.DeleteInput{{ID: 3}},
+				}
+				out := &domain.BatchOutput{
+					Create:       []domain.BatchResult{{ID: 1}},
+					UpdateStatus: []domain.BatchResult{{ID: 2, Err: errors.New("todo not found")}},
+					Delete:       []domain.BatchResult{{ID: 3}},
+				}
+				batchMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &grpcEndpoint{
+					tel:     tel,
+					batchUC: batchMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.mockFn(tt.args)
+			got, err := g.Batch(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_grpcEndpoint_Share(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.ShareRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *pb.ShareResponse
+		wantErr error
+		mockFn  func(a args) *grpcEndpoint
+	}{
+		{
+			name: "ErrorCallUC",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ShareRequest{Id: 10, Email: "bob@mail.com", Role: pb.Role_ROLE_VIEWER},
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) *grpcEndpoint {
+				shareMock := mockz.NewMockShare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Share")
+				defer span.End()
+
+				in := domain.ShareInput{ID: 10, Email: "bob@mail.com", Role: "VIEWER"}
+				shareMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &grpcEndpoint{
+					tel:     tel,
+					shareUC: shareMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ShareRequest{Id: 10, Email: "bob@mail.com", Role: pb.Role_ROLE_EDITOR},
+			},
+			want:    &pb.ShareResponse{Id: 10, Email: "bob@mail.com", Role: pb.Role_ROLE_EDITOR},
+			wantErr: nil,
+			mockFn: func(a args) *grpcEndpoint {
+				shareMock := mockz.NewMockShare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Share")
+				defer span.End()
+
+				in := domain.ShareInput{ID: 10, Email: "bob@mail.com", Role: "EDITOR"}
+				out := &domain.ShareOutput{ID: 10, Email: "bob@mail.com", Role: "EDITOR"}
+				shareMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &grpcEndpoint{
+					tel:     tel,
+					shareUC: shareMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.mockFn(tt.args)
+			got, err := g.Share(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_grpcEndpoint_Unshare(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.UnshareRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *pb.UnshareResponse
+		wantErr error
+		mockFn  func(a args) *grpcEndpoint
+	}{
+		{
+			name: "ErrorCallUC",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.UnshareRequest{Id: 10, Email: "bob@mail.com"},
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) *grpcEndpoint {
+				unshareMock := mockz.NewMockUnshare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Unshare")
+				defer span.End()
+
+				in := domain.UnshareInput{ID: 10, Email: "bob@mail.com"}
+				unshareMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &grpcEndpoint{
+					tel:       tel,
+					unshareUC: unshareMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.UnshareRequest{Id: 10, Email: "bob@mail.com"},
+			},
+			want:    &pb.UnshareResponse{Id: 10, Email: "bob@mail.com"},
+			wantErr: nil,
+			mockFn: func(a args) *grpcEndpoint {
+				unshareMock := mockz.NewMockUnshare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Unshare")
+				defer span.End()
+
+				in := domain.UnshareInput{ID: 10, Email: "bob@mail.com"}
+				out := &domain.UnshareOutput{ID: 10, Email: "bob@mail.com"}
+				unshareMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &grpcEndpoint{
+					tel:       tel,
+					unshareUC: unshareMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.mockFn(tt.args)
+			got, err := g.Unshare(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_grpcEndpoint_Accept(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.AcceptRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *pb.AcceptResponse
+		wantErr error
+		mockFn  func(a args) *grpcEndpoint
+	}{
+		{
+			name: "ErrorCallUC",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.AcceptRequest{Id: 10},
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) *grpcEndpoint {
+				acceptMock := mockz.NewMockAccept(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Accept")
+				defer span.End()
+
+				acceptMock.EXPECT().
+					Call(ctx, domain.AcceptInput{ID: 10}).
+					Return(nil, assert.AnError)
+
+				return &grpcEndpoint{
+					tel:      tel,
+					acceptUC: acceptMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.AcceptRequest{Id: 10},
+			},
+			want:    &pb.AcceptResponse{Id: 10},
+			wantErr: nil,
+			mockFn: func(a args) *grpcEndpoint {
+				acceptMock := mockz.NewMockAccept(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.inbound.grpcEndpoint.Accept")
+				defer span.End()
+
+				acceptMock.EXPECT().
+					Call(ctx, domain.AcceptInput{ID: 10}).
+					Return(&domain.AcceptOutput{ID: 10}, nil)
+
+				return &grpcEndpoint{
+					tel:      tel,
+					acceptUC: acceptMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := tt.mockFn(tt.args)
+			got, err := g.Accept(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_grpcEndpoint_Watch(t *testing.T) {
+	claim := lib.NewJWTClaim(11, "email", time.Time{}, nil)
+	initiate := domain.Todo{ID: 5, UserID: 11, Title: "title", Status: enum.New(domain.TodoStatusInitiate)}
+	done := domain.Todo{ID: 6, UserID: 11, Title: "title", Status: enum.New(domain.TodoStatusDone)}
+
+	tests := []struct {
+		name     string
+		req      *pb.WatchRequest
+		wantErr  error
+		wantSent []*pb.TodoEvent
+		mockFn   func() (*grpcEndpoint, *watchStream)
+	}{
+		{
+			name:    "ErrorWithoutClaim",
+			req:     &pb.WatchRequest{},
+			wantErr: errUnauthenticated,
+			mockFn: func() (*grpcEndpoint, *watchStream) {
+				return &grpcEndpoint{tel: lib.NewTelemetry()}, &watchStream{ctx: context.Background()}
+			},
+		},
+		{
+			name:    "ErrorSendReplay",
+			req:     &pb.WatchRequest{LastEventId: 4},
+			wantErr: assert.AnError,
+			mockFn: func() (*grpcEndpoint, *watchStream) {
+				subMock := mockz.NewMockTodoEventSubscriber(t)
+				replay := []domain.TodoEvent{{ID: 5, Type: enum.New(domain.TodoEventTypeCreated), Todo: initiate}}
+				subMock.EXPECT().
+					Subscribe(mock.Anything, uint64(11), uint64(4)).
+					Return(replay, make(chan domain.TodoEvent))
+
+				ss := &watchStream{ctx: lib.SetJWTClaim(context.Background(), claim), err: assert.AnError}
+
+				return &grpcEndpoint{tel: lib.NewTelemetry(), events: subMock}, ss
+			},
+		},
+		{
+			name:    "ErrorDropped",
+			req:     &pb.WatchRequest{LastEventId: 4, Status: pb.Status_STATUS_DONE},
+			wantErr: errWatchLagging,
+			wantSent: []*pb.TodoEvent{{
+				Id:   7,
+				Type: pb.EventType_EVENT_TYPE_STATUS_UPDATED,
+				Todo: &pb.Todo{Id: 6, UserId: 11, Title: "title", Status: pb.Status_STATUS_DONE},
+			}},
+			mockFn: func() (*grpcEndpoint, *watchStream) {
+				subMock := mockz.NewMockTodoEventSubscriber(t)
+				replay := []domain.TodoEvent{{ID: 5, Type: enum.New(domain.TodoEventTypeCreated), Todo: initiate}}
+				events := make(chan domain.TodoEvent, 1)
+				events <- domain.TodoEvent{ID: 7, Type: enum.New(domain.TodoEventTypeStatusUpdated), Todo: done}
+				close(events)
+
+				subMock.EXPECT().
+					Subscribe(mock.Anything, uint64(11), uint64(4)).
+					Return(replay, events)
+
+				ss := &watchStream{ctx: lib.SetJWTClaim(context.Background(), claim)}
+
+				return &grpcEndpoint{tel: lib.NewTelemetry(), events: subMock}, ss
+			},
+		},
+		{
+			name:    "SuccessUntilDone",
+			req:     &pb.WatchRequest{},
+			wantErr: nil,
+			wantSent: []*pb.TodoEvent{{
+				Id:   5,
+				Type: pb.EventType_EVENT_TYPE_CREATED,
+				Todo: &pb.Todo{Id: 5, UserId: 11, Title: "title", Status: pb.Status_STATUS_INITIATE},
+			}},
+			mockFn: func() (*grpcEndpoint, *watchStream) {
+				ctx, cancel := context.WithTimeout(lib.SetJWTClaim(context.Background(), claim), 50*time.Millisecond)
+				t.Cleanup(cancel)
+
+				subMock := mockz.NewMockTodoEventSubscriber(t)
+				events := make(chan domain.TodoEvent, 1)
+				events <- domain.TodoEvent{ID: 5, Type: enum.New(domain.TodoEventTypeCreated), Todo: initiate}
+
+				subMock.EXPECT().
+					Subscribe(mock.Anything, uint64(11), uint64(0)).
+					Return(nil, events)
+
+				return &grpcEndpoint{tel: lib.NewTelemetry(), events: subMock}, &watchStream{ctx: ctx}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g, ss := tt.mockFn()
+			err := g.Watch(tt.req, ss)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantSent, ss.sent)
+		})
+	}
+}
@@ -1,16 +1,19 @@
 package inbound
 
 import (
-	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"strconv"
 
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 )
 
 type httpEndpoint struct {
-	tel *telemetry.Telemetry
+	tel *lib.Telemetry
 
 	createUC       domain.Create
 	deleteUC       domain.Delete
@@ -18,20 +21,37 @@ type httpEndpoint struct {
 	fetchUC        domain.Fetch
 	updateStatusUC domain.UpdateStatus
 	updateUC       domain.Update
+	restoreUC      domain.Restore
+	purgeUC        domain.Purge
+	historyUC      domain.History
+	batchUC        domain.Batch
+	shareUC        domain.Share
+	unshareUC      domain.Unshare
+	acceptUC       domain.Accept
+	attachUC       domain.Attach
+	attachmentsUC  domain.Attachments
+	downloadUC     domain.Download
+	detachUC       domain.Detach
+
+	// uploadLimit is the largest attachment accepted, in bytes.
+	uploadLimit int64
 }
 
 func (h *httpEndpoint) Create(c framework.Context) (any, error) {
 	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Create")
 	defer span.End()
 
-	var req CreateRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+	req, err := framework.Bind[CreateRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.createUC.Call(ctx, domain.CreateInput{
 		Title:       req.Title,
 		Description: req.Description,
+		Priority:    req.Priority,
+		DueAt:       req.DueAt,
+		Tags:        req.Tags,
 	})
 	if err != nil {
 		return nil, err
@@ -44,12 +64,12 @@ func (h *httpEndpoint) Delete(c framework.Context) (any, error) {
 	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Delete")
 	defer span.End()
 
-	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	req, err := framework.Bind[DeleteRequest](c)
 	if err != nil {
-		return nil, errFailedParseToUint
+		return nil, err
 	}
 
-	resp, err := h.deleteUC.Call(ctx, domain.DeleteInput{ID: id})
+	resp, err := h.deleteUC.Call(ctx, domain.DeleteInput{ID: req.ID})
 	if err != nil {
 		return nil, err
 	}
@@ -61,33 +81,39 @@ func (h *httpEndpoint) Find(c framework.Context) (any, error) {
 	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Find")
 	defer span.End()
 
-	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	req, err := framework.Bind[FindRequest](c)
 	if err != nil {
-		return nil, errFailedParseToUint
+		return nil, err
 	}
 
-	resp, err := h.findUC.Call(ctx, domain.FindInput{ID: id})
+	resp, err := h.findUC.Call(ctx, domain.FindInput{ID: req.ID})
 	if err != nil {
 		return nil, err
 	}
 
-	return FindResponse{
-		ID:          resp.ID,
-		UserID:      resp.UserID,
-		Title:       resp.Title,
-		Description: resp.Description,
-		Status:      resp.Status.String(),
-	}, nil
+	return FindResponse(newTodo(*resp)), nil
 }
 
 func (h *httpEndpoint) Fetch(c framework.Context) (any, error) {
 	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Fetch")
 	defer span.End()
 
+	req, err := framework.Bind[FetchRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := h.fetchUC.Call(ctx, domain.FetchInput{
-		Cursor: c.Query("cursor"),
-		Limit:  c.Query("limit"),
-		Status: c.Query("status"),
+		Cursor:   req.Cursor,
+		Limit:    req.Limit,
+		Status:   req.Status,
+		UserID:   req.UserID,
+		Query:    req.Query,
+		Tags:     req.Tags,
+		Priority: req.Priority,
+		DueFrom:  req.DueFrom,
+		DueTo:    req.DueTo,
+		Sort:     req.Sort,
 	})
 	if err != nil {
 		return nil, err
@@ -95,13 +121,7 @@ func (h *httpEndpoint) Fetch(c framework.Context) (any, error) {
 
 	todos := make([]Todo, 0)
 	for _, todo := range resp.Todos {
-		todos = append(todos, Todo{
-			ID:          todo.ID,
-			UserID:      todo.UserID,
-			Title:       todo.Title,
-			Description: todo.Description,
-			Status:      todo.Status.String(),
-		})
+		todos = append(todos, newTodo(todo))
 	}
 
 	return FetchResponse{
@@ -117,18 +137,13 @@ func (h *httpEndpoint) UpdateStatus(c framework.Context) (any, error) {
 	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.UpdateStatus")
 	defer span.End()
 
-	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	req, err := framework.Bind[UpdateStatusRequest](c)
 	if err != nil {
-		return nil, errFailedParseToUint
-	}
-
-	var req UpdateStatusRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+		return nil, err
 	}
 
 	resp, err := h.updateStatusUC.Call(ctx, domain.UpdateStatusInput{
-		ID:     id,
+		ID:     req.ID,
 		Status: req.Status,
 	})
 	if err != nil {
@@ -136,7 +151,7 @@ func (h *httpEndpoint) UpdateStatus(c framework.Context) (any, error) {
 	}
 
 	return UpdateStatusResponse{
-		ID:     id,
+		ID:     req.ID,
 		Status: resp.Status.String(),
 	}, nil
 }
@@ -145,31 +160,273 @@ func (h *httpEndpoint) Update(c framework.Context) (any, error) {
 	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Update")
 	defer span.End()
 
-	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	req, err := framework.Bind[UpdateRequest](c)
 	if err != nil {
-		return nil, errFailedParseToUint
-	}
-
-	var req UpdateRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, errInvalidBody
+		return nil, err
 	}
 
 	resp, err := h.updateUC.Call(ctx, domain.UpdateInput{
-		ID:          id,
+		ID:          req.ID,
 		Title:       req.Title,
 		Description: req.Description,
 		Status:      req.Status,
+		Priority:    req.Priority,
+		DueAt:       req.DueAt,
+		Tags:        req.Tags,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return UpdateResponse{
-		ID:          id,
-		UserID:      resp.UserID,
-		Title:       resp.Title,
-		Description: resp.Description,
-		Status:      resp.Status.String(),
+	return UpdateResponse(newTodo(*resp)), nil
+}
+
+func (h *httpEndpoint) Restore(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Restore")
+	defer span.End()
+
+	req, err := framework.Bind[RestoreRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.restoreUC.Call(ctx, domain.RestoreInput{ID: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return RestoreResponse{ID: resp.ID}, nil
+}
+
+func (h *httpEndpoint) Purge(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Purge")
+	defer span.End()
+
+	req, err := framework.Bind[PurgeRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.purgeUC.Call(ctx, domain.PurgeInput{ID: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return PurgeResponse{ID: resp.ID}, nil
+}
+
+func (h *httpEndpoint) History(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.History")
+	defer span.End()
+
+	req, err := framework.Bind[HistoryRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.historyUC.Call(ctx, domain.HistoryInput{ID: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]StatusChange, 0, len(resp.Changes))
+	for _, change := range resp.Changes {
+		changes = append(changes, StatusChange{
+			From:      change.From.String(),
+			To:        change.To.String(),
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.ChangedAt,
+		})
+	}
+
+	return HistoryResponse{ID: resp.ID, Changes: changes}, nil
+}
+
+func (h *httpEndpoint) Batch(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Batch")
+	defer span.End()
+
+	req, err := framework.Bind[BatchRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	in := domain.BatchInput{Atomic: req.Atomic}
+	for _, item := range req.Create {
+		in.Create = append(in.Create, domain.CreateInput{
+			Title:       item.Title,
+			Description: item.Description,
+			Priority:    item.Priority,
+			DueAt:       item.DueAt,
+			Tags:        item.Tags,
+		})
+	}
+
+	for _, item := range req.UpdateStatus {
+		in.UpdateStatus = append(in.UpdateStatus, domain.UpdateStatusInput{ID: item.ID, Status: item.Status})
+	}
+
+	for _, item := range req.Delete {
+		in.Delete = append(in.Delete, domain.DeleteInput{ID: item.ID})
+	}
+
+	resp, err := h.batchUC.Call(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return BatchResponse{
+		Create:       newBatchResults(resp.Create),
+		UpdateStatus: newBatchResults(resp.UpdateStatus),
+		Delete:       newBatchResults(resp.Delete),
 	}, nil
 }
+
+func (h *httpEndpoint) Share(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Share")
+	defer span.End()
+
+	req, err := framework.Bind[ShareRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.shareUC.Call(ctx, domain.ShareInput{
+		ID:    req.ID,
+		Email: req.Email,
+		Role:  req.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ShareResponse{ID: resp.ID, Email: resp.Email, Role: resp.Role}, nil
+}
+
+func (h *httpEndpoint) Unshare(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Unshare")
+	defer span.End()
+
+	req, err := framework.Bind[UnshareRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.unshareUC.Call(ctx, domain.UnshareInput{ID: req.ID, Email: req.Email})
+	if err != nil {
+		return nil, err
+	}
+
+	return UnshareResponse{ID: resp.ID, Email: resp.Email}, nil
+}
+
+func (h *httpEndpoint) Accept(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Accept")
+	defer span.End()
+
+	req, err := framework.Bind[AcceptRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.acceptUC.Call(ctx, domain.AcceptInput{ID: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return AcceptResponse{ID: resp.ID}, nil
+}
+
+func (h *httpEndpoint) Attach(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Attach")
+	defer span.End()
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return nil, errFailedParseToUint
+	}
+
+	// the body is cut off a little after the limit, room for the multipart framing
+	r := c.Request()
+	r.Body = http.MaxBytesReader(nil, r.Body, h.uploadLimit+multipartOverhead)
+	if err := r.ParseMultipartForm(multipartMemory); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			msg := fmt.Sprintf("attachment is larger than %d MB", h.uploadLimit>>20)
+
+			return nil, goerror.NewBusiness(msg, goerror.CodeInvalidInput)
+		}
+
+		return nil, errInvalidBody
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		return nil, errMissingFile
+	}
+	defer file.Close()
+
+	resp, err := h.attachUC.Call(ctx, domain.AttachInput{
+		ID:       id,
+		Filename: header.Filename,
+		Size:     header.Size,
+		Body:     file,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return AttachResponse{Attachment: newAttachment(resp.Attachment)}, nil
+}
+
+func (h *httpEndpoint) Attachments(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Attachments")
+	defer span.End()
+
+	req, err := framework.Bind[AttachmentsRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.attachmentsUC.Call(ctx, domain.AttachmentsInput{ID: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return AttachmentsResponse{ID: resp.ID, Attachments: newAttachments(resp.Attachments)}, nil
+}
+
+func (h *httpEndpoint) Download(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Download")
+	defer span.End()
+
+	req, err := framework.Bind[DownloadRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.downloadUC.Call(ctx, domain.DownloadInput{ID: req.ID, AttachmentID: req.AttachmentID})
+	if err != nil {
+		return nil, err
+	}
+
+	return DownloadResponse{URL: resp.URL, ExpiresAt: resp.ExpiresAt}, nil
+}
+
+func (h *httpEndpoint) Detach(c framework.Context) (any, error) {
+	ctx, span := h.tel.Tracer().Start(c.Context(), "todo.inbound.httpEndpoint.Detach")
+	defer span.End()
+
+	req, err := framework.Bind[DetachRequest](c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.detachUC.Call(ctx, domain.DetachInput{ID: req.ID, AttachmentID: req.AttachmentID})
+	if err != nil {
+		return nil, err
+	}
+
+	return DetachResponse{ID: resp.ID, AttachmentID: resp.AttachmentID}, nil
+}
@@ -3,15 +3,29 @@ package inbound
 import (
 	"bytes"
 	"context"
+	"errors"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/shandysiswandi/goreng/enum"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	errBindID = goerror.NewInvalidInput("Invalid request payload",
+		framework.FieldErrors{"id": "must be a positive integer"})
+	errBindAttachmentID = goerror.NewInvalidInput("Invalid request payload",
+		framework.FieldErrors{"attachment_id": "must be a positive integer"})
 )
 
 func Test_httpEndpoint_Create(t *testing.T) {
@@ -33,7 +47,7 @@ func Test_httpEndpoint_Create(t *testing.T) {
 			want:    nil,
 			wantErr: errInvalidBody,
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Create")
 				defer span.End()
@@ -55,7 +69,7 @@ func Test_httpEndpoint_Create(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				createMock := mockz.NewMockCreate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Create")
 				defer span.End()
@@ -86,7 +100,7 @@ func Test_httpEndpoint_Create(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				createMock := mockz.NewMockCreate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Create")
 				defer span.End()
@@ -136,9 +150,9 @@ func Test_httpEndpoint_Delete(t *testing.T) {
 				return c.Build()
 			},
 			want:    nil,
-			wantErr: errFailedParseToUint,
+			wantErr: errBindID,
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Delete")
 				defer span.End()
@@ -161,7 +175,7 @@ func Test_httpEndpoint_Delete(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				deleteMock := mockz.NewMockDelete(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Delete")
 				defer span.End()
@@ -189,7 +203,7 @@ func Test_httpEndpoint_Delete(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				deleteMock := mockz.NewMockDelete(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Delete")
 				defer span.End()
@@ -219,6 +233,206 @@ func Test_httpEndpoint_Delete(t *testing.T) {
 	}
 }
 
+func Test_httpEndpoint_Restore(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/restore", nil)
+				c.SetParam("id", "n/a")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Restore")
+				defer span.End()
+
+				return &httpEndpoint{
+					tel:    tel,
+					findUC: nil,
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/restore", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				restoreMock := mockz.NewMockRestore(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Restore")
+				defer span.End()
+
+				in := domain.RestoreInput{ID: 1}
+				restoreMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:       tel,
+					restoreUC: restoreMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/restore", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    RestoreResponse{ID: 1},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				restoreMock := mockz.NewMockRestore(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Restore")
+				defer span.End()
+
+				in := domain.RestoreInput{ID: 1}
+				out := &domain.RestoreOutput{ID: 1}
+				restoreMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:       tel,
+					restoreUC: restoreMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Restore(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_Purge(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/purge", nil)
+				c.SetParam("id", "n/a")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Purge")
+				defer span.End()
+
+				return &httpEndpoint{
+					tel:    tel,
+					findUC: nil,
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/purge", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				purgeMock := mockz.NewMockPurge(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Purge")
+				defer span.End()
+
+				in := domain.PurgeInput{ID: 1}
+				purgeMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:     tel,
+					purgeUC: purgeMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/purge", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    PurgeResponse{ID: 1},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				purgeMock := mockz.NewMockPurge(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Purge")
+				defer span.End()
+
+				in := domain.PurgeInput{ID: 1}
+				out := &domain.PurgeOutput{ID: 1}
+				purgeMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:     tel,
+					purgeUC: purgeMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Purge(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_httpEndpoint_Find(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -236,9 +450,9 @@ func Test_httpEndpoint_Find(t *testing.T) {
 				return c.Build()
 			},
 			want:    nil,
-			wantErr: errFailedParseToUint,
+			wantErr: errBindID,
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Find")
 				defer span.End()
@@ -260,7 +474,7 @@ func Test_httpEndpoint_Find(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				findMock := mockz.NewMockFind(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Find")
 				defer span.End()
@@ -285,16 +499,19 @@ func Test_httpEndpoint_Find(t *testing.T) {
 				return c.Build()
 			},
 			want: FindResponse{
-				ID:          11,
-				UserID:      89,
-				Title:       "title",
-				Description: "description",
-				Status:      enum.New(domain.TodoStatusDone).String(),
+				ID:            11,
+				UserID:        89,
+				Title:         "title",
+				Description:   "description",
+				Status:        enum.New(domain.TodoStatusDone).String(),
+				Priority:      "MEDIUM",
+				Tags:          []string{},
+				Collaborators: []Collaborator{},
 			},
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				findMock := mockz.NewMockFind(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Find")
 				defer span.End()
@@ -306,6 +523,7 @@ func Test_httpEndpoint_Find(t *testing.T) {
 					Title:       "title",
 					Description: "description",
 					Status:      enum.New(domain.TodoStatusDone),
+					Priority:    enum.New(domain.TodoPriorityMedium),
 				}
 				findMock.EXPECT().
 					Call(ctx, in).
@@ -352,7 +570,7 @@ func Test_httpEndpoint_Fetch(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				fetchMock := mockz.NewMockFetch(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Fetch")
 				defer span.End()
@@ -379,15 +597,25 @@ func Test_httpEndpoint_Fetch(t *testing.T) {
 				c.SetQuery("limit", "1")
 				c.SetQuery("cursor", "Mg")
 				c.SetQuery("status", "done")
+				c.SetQuery("user_id", "12")
+				c.SetQuery("q", "milk")
+				c.SetQuery("tags", "home", "work")
+				c.SetQuery("priority", "HIGH")
+				c.SetQuery("due_from", "2025-01-01T00:00:00Z")
+				c.SetQuery("due_to", "2025-02-01T00:00:00Z")
+				c.SetQuery("sort", "-due_at")
 
 				return c.Build()
 			},
 			want: FetchResponse{
 				Todos: []Todo{{
-					ID:          11,
-					Title:       "title",
-					Description: "description",
-					Status:      enum.New(domain.TodoStatusDone).String(),
+					ID:            11,
+					Title:         "title",
+					Description:   "description",
+					Status:        enum.New(domain.TodoStatusDone).String(),
+					Priority:      "MEDIUM",
+					Tags:          []string{},
+					Collaborators: []Collaborator{},
 				}},
 				Pagination: Pagination{
 					NextCursor: "NTY",
@@ -397,15 +625,22 @@ func Test_httpEndpoint_Fetch(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				fetchMock := mockz.NewMockFetch(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Fetch")
 				defer span.End()
 
 				in := domain.FetchInput{
-					Cursor: "Mg",
-					Limit:  "1",
-					Status: "done",
+					Cursor:   "Mg",
+					Limit:    "1",
+					Status:   "done",
+					UserID:   "12",
+					Query:    "milk",
+					Tags:     []string{"home", "work"},
+					Priority: "HIGH",
+					DueFrom:  "2025-01-01T00:00:00Z",
+					DueTo:    "2025-02-01T00:00:00Z",
+					Sort:     "-due_at",
 				}
 				out := &domain.FetchOutput{
 					Todos: []domain.Todo{{
@@ -413,6 +648,7 @@ func Test_httpEndpoint_Fetch(t *testing.T) {
 						Title:       "title",
 						Description: "description",
 						Status:      enum.New(domain.TodoStatusDone),
+						Priority:    enum.New(domain.TodoPriorityMedium),
 					}},
 					NextCursor: "NTY",
 					HasMore:    true,
@@ -457,9 +693,9 @@ func Test_httpEndpoint_UpdateStatus(t *testing.T) {
 				return c.Build()
 			},
 			want:    nil,
-			wantErr: errFailedParseToUint,
+			wantErr: errBindID,
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.UpdateStatus")
 				defer span.End()
@@ -481,7 +717,7 @@ func Test_httpEndpoint_UpdateStatus(t *testing.T) {
 			want:    nil,
 			wantErr: errInvalidBody,
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.UpdateStatus")
 				defer span.End()
@@ -504,7 +740,7 @@ func Test_httpEndpoint_UpdateStatus(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updateStatusMock := mockz.NewMockUpdateStatus(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.UpdateStatus")
 				defer span.End()
@@ -533,7 +769,7 @@ func Test_httpEndpoint_UpdateStatus(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updateStatusMock := mockz.NewMockUpdateStatus(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.UpdateStatus")
 				defer span.End()
@@ -580,9 +816,9 @@ func Test_httpEndpoint_Update(t *testing.T) {
 				return c.Build()
 			},
 			want:    nil,
-			wantErr: errFailedParseToUint,
+			wantErr: errBindID,
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.UpdateStatus")
 				defer span.End()
@@ -605,7 +841,7 @@ func Test_httpEndpoint_Update(t *testing.T) {
 			want:    nil,
 			wantErr: errInvalidBody,
 			mockFn: func(ctx context.Context) *httpEndpoint {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Update")
 				defer span.End()
@@ -628,7 +864,7 @@ func Test_httpEndpoint_Update(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updateMock := mockz.NewMockUpdate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Update")
 				defer span.End()
@@ -659,16 +895,19 @@ func Test_httpEndpoint_Update(t *testing.T) {
 				return c.Build()
 			},
 			want: UpdateResponse{
-				ID:          2,
-				UserID:      12,
-				Title:       "title",
-				Description: "description",
-				Status:      enum.New(domain.TodoStatusDone).String(),
+				ID:            2,
+				UserID:        12,
+				Title:         "title",
+				Description:   "description",
+				Status:        enum.New(domain.TodoStatusDone).String(),
+				Priority:      "MEDIUM",
+				Tags:          []string{},
+				Collaborators: []Collaborator{},
 			},
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updateMock := mockz.NewMockUpdate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Update")
 				defer span.End()
@@ -685,6 +924,7 @@ func Test_httpEndpoint_Update(t *testing.T) {
 					Title:       "title",
 					Description: "description",
 					Status:      enum.New(domain.TodoStatusDone),
+					Priority:    enum.New(domain.TodoPriorityMedium),
 				}
 				updateMock.EXPECT().
 					Call(ctx, in).
@@ -708,3 +948,1114 @@ func Test_httpEndpoint_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_httpEndpoint_History(t *testing.T) {
+	changedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/history", nil)
+				c.SetParam("id", "n/a")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.History")
+				defer span.End()
+
+				return &httpEndpoint{
+					tel:       tel,
+					historyUC: nil,
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/history", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				historyMock := mockz.NewMockHistory(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.History")
+				defer span.End()
+
+				in := domain.HistoryInput{ID: 1}
+				historyMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:       tel,
+					historyUC: historyMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/history", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want: HistoryResponse{ID: 1, Changes: []StatusChange{
+				{From: "INITIATE", To: "IN_PROGRESS", ChangedBy: 11, ChangedAt: changedAt},
+			}},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				historyMock := mockz.NewMockHistory(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.History")
+				defer span.End()
+
+				in := domain.HistoryInput{ID: 1}
+				out := &domain.HistoryOutput{ID: 1, Changes: []domain.TodoStatusChange{{
+					ID: 100, TodoID: 1, From: enum.New(domain.TodoStatusInitiate),
+					To: enum.New(domain.TodoStatusInProgress), ChangedBy: 11, ChangedAt: changedAt,
+				}}}
+				historyMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:       tel,
+					historyUC: historyMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.History(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_Batch(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorDecodeBody",
+			c: func() framework.Context {
+				body := bytes.NewBufferString("fake request")
+				c := framework.NewTestContext(http.MethodPost, "/todos:batch", body)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errInvalidBody,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Batch")
+				defer span.End()
+
+				return &httpEndpoint{
+					tel: tel,
+				}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(`{"atomic":true,"delete":[{"id":"3"}]}`)
+				c := framework.NewTestContext(http.MethodPost, "/todos:batch", body)
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				batchMock := mockz.NewMockBatch(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Batch")
+				defer span.End()
+
+				in := domain.BatchInput{Atomic: true, Delete: []domain.DeleteInput{{ID: 3}}}
+				batchMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:     tel,
+					batchUC: batchMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(`{"create":[{"title":"title","description":"description",` +
+					`"priority":"HIGH","tags":["work"]}],"update_status":[{"id":"2","status":"DONE"}],` +
+					`"delete":[{"id":"3"}]}`)
+				c := framework.NewTestContext(http.MethodPost, "/todos:batch", body)
+
+				return c.Build()
+			},
+			want: BatchResponse{
+				Create:       []BatchResult{{ID: 1}},
+				UpdateStatus: []BatchResult{{ID: 2, Error: "todo not found"}},
+				Delete:       []BatchResult{{ID: 3}},
+			},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				batchMock := mockz.NewMockBatch(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Batch")
+				defer span.End()
+
+				in := domain.BatchInput{
+					Create: []domain.CreateInput{{
+						Title: "title", Description: "description", Priority: "HIGH", Tags: []string{"work"},
+					}},
+					UpdateStatus: []domain.UpdateStatusInput{{ID: 2, Status: "DONE"}},
+					Delete:       []domain.DeleteInput{{ID: 3}},
+				}
+				out := &domain.BatchOutput{
+					Create:       []domain.BatchResult{{ID: 1}},
+					UpdateStatus: []domain.BatchResult{{ID: 2, Err: errors.New("todo not found")}},
+					Delete:       []domain.BatchResult{{ID: 3}},
+				}
+				batchMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:     tel,
+					batchUC: batchMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Batch(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_Share(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/collaborators", nil)
+				c.SetParam("id", "n/a")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Share")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorDecodeBody",
+			c: func() framework.Context {
+				body := bytes.NewBufferString("fake request")
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/collaborators", body)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errInvalidBody,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Share")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(`{"email":"bob@mail.com","role":"VIEWER"}`)
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/collaborators", body)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				shareMock := mockz.NewMockShare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Share")
+				defer span.End()
+
+				in := domain.ShareInput{ID: 1, Email: "bob@mail.com", Role: "VIEWER"}
+				shareMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:     tel,
+					shareUC: shareMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				body := bytes.NewBufferString(`{"email":"bob@mail.com","role":"EDITOR"}`)
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/collaborators", body)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    ShareResponse{ID: 1, Email: "bob@mail.com", Role: "EDITOR"},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				shareMock := mockz.NewMockShare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Share")
+				defer span.End()
+
+				in := domain.ShareInput{ID: 1, Email: "bob@mail.com", Role: "EDITOR"}
+				out := &domain.ShareOutput{ID: 1, Email: "bob@mail.com", Role: "EDITOR"}
+				shareMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:     tel,
+					shareUC: shareMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Share(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_Unshare(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/collaborators/bob@mail.com", nil)
+				c.SetParam("id", "n/a")
+				c.SetParam("email", "bob@mail.com")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Unshare")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/collaborators/bob@mail.com", nil)
+				c.SetParam("id", "1")
+				c.SetParam("email", "bob@mail.com")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				unshareMock := mockz.NewMockUnshare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Unshare")
+				defer span.End()
+
+				in := domain.UnshareInput{ID: 1, Email: "bob@mail.com"}
+				unshareMock.EXPECT().
+					Call(ctx, in).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:       tel,
+					unshareUC: unshareMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/collaborators/bob@mail.com", nil)
+				c.SetParam("id", "1")
+				c.SetParam("email", "bob@mail.com")
+
+				return c.Build()
+			},
+			want:    UnshareResponse{ID: 1, Email: "bob@mail.com"},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				unshareMock := mockz.NewMockUnshare(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Unshare")
+				defer span.End()
+
+				in := domain.UnshareInput{ID: 1, Email: "bob@mail.com"}
+				out := &domain.UnshareOutput{ID: 1, Email: "bob@mail.com"}
+				unshareMock.EXPECT().
+					Call(ctx, in).
+					Return(out, nil)
+
+				return &httpEndpoint{
+					tel:       tel,
+					unshareUC: unshareMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Unshare(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_Accept(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/accept", nil)
+				c.SetParam("id", "n/a")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Accept")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/accept", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				acceptMock := mockz.NewMockAccept(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Accept")
+				defer span.End()
+
+				acceptMock.EXPECT().
+					Call(ctx, domain.AcceptInput{ID: 1}).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:      tel,
+					acceptUC: acceptMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/accept", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    AcceptResponse{ID: 1},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				acceptMock := mockz.NewMockAccept(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Accept")
+				defer span.End()
+
+				acceptMock.EXPECT().
+					Call(ctx, domain.AcceptInput{ID: 1}).
+					Return(&domain.AcceptOutput{ID: 1}, nil)
+
+				return &httpEndpoint{
+					tel:      tel,
+					acceptUC: acceptMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Accept(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// newUploadContext builds an Attach request with the content sent as the form field named field.
+func newUploadContext(t *testing.T, field, filename, content string) *framework.TestCtx {
+	t.Helper()
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile(field, filename)
+	assert.NoError(t, err)
+	_, err = io.WriteString(part, content)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+
+	c := framework.NewTestContext(http.MethodPost, "/todos/1/attachments", &body)
+	c.SetHeader("Content-Type", w.FormDataContentType())
+
+	return c
+}
+
+func Test_httpEndpoint_Attach(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	att := domain.TodoAttachment{
+		ID:          5,
+		TodoID:      1,
+		Filename:    "notes.txt",
+		ContentType: "text/plain",
+		Size:        5,
+		StorageKey:  "todos/1/5/notes.txt",
+		CreatedBy:   11,
+		CreatedAt:   now,
+	}
+	matchInput := mock.MatchedBy(func(in domain.AttachInput) bool {
+		body, err := io.ReadAll(in.Body)
+
+		return err == nil && in.ID == 1 && in.Filename == "notes.txt" && in.Size == 5 && string(body) == "hello"
+	})
+
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := newUploadContext(t, "file", "notes.txt", "hello")
+				c.SetParam("id", "n/a")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errFailedParseToUint,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attach")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel, uploadLimit: 1 << 20}
+			},
+		},
+		{
+			name: "ErrorNotMultipart",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodPost, "/todos/1/attachments", strings.NewReader("hello"))
+				c.SetHeader("Content-Type", "text/plain")
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errInvalidBody,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attach")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel, uploadLimit: 1 << 20}
+			},
+		},
+		{
+			name: "ErrorTooLarge",
+			c: func() framework.Context {
+				c := newUploadContext(t, "file", "notes.txt", strings.Repeat("a", 3<<20))
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("attachment is larger than 1 MB", goerror.CodeInvalidInput),
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attach")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel, uploadLimit: 1 << 20}
+			},
+		},
+		{
+			name: "ErrorMissingFile",
+			c: func() framework.Context {
+				c := newUploadContext(t, "document", "notes.txt", "hello")
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errMissingFile,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attach")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel, uploadLimit: 1 << 20}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := newUploadContext(t, "file", "notes.txt", "hello")
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				attachMock := mockz.NewMockAttach(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attach")
+				defer span.End()
+
+				attachMock.EXPECT().
+					Call(ctx, matchInput).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:         tel,
+					attachUC:    attachMock,
+					uploadLimit: 1 << 20,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := newUploadContext(t, "file", "notes.txt", "hello")
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want: AttachResponse{Attachment: Attachment{
+				ID:          5,
+				TodoID:      1,
+				Filename:    "notes.txt",
+				ContentType: "text/plain",
+				Size:        5,
+				CreatedBy:   11,
+				CreatedAt:   now,
+			}},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				attachMock := mockz.NewMockAttach(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attach")
+				defer span.End()
+
+				attachMock.EXPECT().
+					Call(ctx, matchInput).
+					Return(&domain.AttachOutput{Attachment: att}, nil)
+
+				return &httpEndpoint{
+					tel:         tel,
+					attachUC:    attachMock,
+					uploadLimit: 1 << 20,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Attach(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_Attachments(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/attachments", nil)
+				c.SetParam("id", "n/a")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attachments")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/attachments", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				attachmentsMock := mockz.NewMockAttachments(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attachments")
+				defer span.End()
+
+				attachmentsMock.EXPECT().
+					Call(ctx, domain.AttachmentsInput{ID: 1}).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:           tel,
+					attachmentsUC: attachmentsMock,
+				}
+			},
+		},
+		{
+			name: "SuccessEmpty",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/attachments", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want:    AttachmentsResponse{ID: 1, Attachments: []Attachment{}},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				attachmentsMock := mockz.NewMockAttachments(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attachments")
+				defer span.End()
+
+				attachmentsMock.EXPECT().
+					Call(ctx, domain.AttachmentsInput{ID: 1}).
+					Return(&domain.AttachmentsOutput{ID: 1}, nil)
+
+				return &httpEndpoint{
+					tel:           tel,
+					attachmentsUC: attachmentsMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/attachments", nil)
+				c.SetParam("id", "1")
+
+				return c.Build()
+			},
+			want: AttachmentsResponse{ID: 1, Attachments: []Attachment{{
+				ID:          5,
+				TodoID:      1,
+				Filename:    "notes.txt",
+				ContentType: "text/plain",
+				Size:        5,
+				CreatedBy:   11,
+				CreatedAt:   now,
+			}}},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				attachmentsMock := mockz.NewMockAttachments(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Attachments")
+				defer span.End()
+
+				attachmentsMock.EXPECT().
+					Call(ctx, domain.AttachmentsInput{ID: 1}).
+					Return(&domain.AttachmentsOutput{ID: 1, Attachments: []domain.TodoAttachment{{
+						ID:          5,
+						TodoID:      1,
+						Filename:    "notes.txt",
+						ContentType: "text/plain",
+						Size:        5,
+						StorageKey:  "todos/1/5/notes.txt",
+						CreatedBy:   11,
+						CreatedAt:   now,
+					}}}, nil)
+
+				return &httpEndpoint{
+					tel:           tel,
+					attachmentsUC: attachmentsMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Attachments(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_Download(t *testing.T) {
+	expiresAt := time.Date(2025, 1, 2, 3, 19, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/attachments/5", nil)
+				c.SetParam("id", "n/a")
+				c.SetParam("attachment_id", "5")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Download")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorParseAttachmentToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/attachments/5", nil)
+				c.SetParam("id", "1")
+				c.SetParam("attachment_id", "n/a")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindAttachmentID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Download")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/attachments/5", nil)
+				c.SetParam("id", "1")
+				c.SetParam("attachment_id", "5")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				downloadMock := mockz.NewMockDownload(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Download")
+				defer span.End()
+
+				downloadMock.EXPECT().
+					Call(ctx, domain.DownloadInput{ID: 1, AttachmentID: 5}).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:        tel,
+					downloadUC: downloadMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodGet, "/todos/1/attachments/5", nil)
+				c.SetParam("id", "1")
+				c.SetParam("attachment_id", "5")
+
+				return c.Build()
+			},
+			want:    DownloadResponse{URL: "http://localhost/blobs/todos/1/5/notes.txt", ExpiresAt: expiresAt},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				downloadMock := mockz.NewMockDownload(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Download")
+				defer span.End()
+
+				downloadMock.EXPECT().
+					Call(ctx, domain.DownloadInput{ID: 1, AttachmentID: 5}).
+					Return(&domain.DownloadOutput{
+						URL:       "http://localhost/blobs/todos/1/5/notes.txt",
+						ExpiresAt: expiresAt,
+					}, nil)
+
+				return &httpEndpoint{
+					tel:        tel,
+					downloadUC: downloadMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Download(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_httpEndpoint_Detach(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       func() framework.Context
+		want    any
+		wantErr error
+		mockFn  func(ctx context.Context) *httpEndpoint
+	}{
+		{
+			name: "ErrorParseToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/attachments/5", nil)
+				c.SetParam("id", "n/a")
+				c.SetParam("attachment_id", "5")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Detach")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorParseAttachmentToUint",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/attachments/5", nil)
+				c.SetParam("id", "1")
+				c.SetParam("attachment_id", "n/a")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: errBindAttachmentID,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				tel := lib.NewTelemetry()
+
+				_, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Detach")
+				defer span.End()
+
+				return &httpEndpoint{tel: tel}
+			},
+		},
+		{
+			name: "ErrorCallUC",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/attachments/5", nil)
+				c.SetParam("id", "1")
+				c.SetParam("attachment_id", "5")
+
+				return c.Build()
+			},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				detachMock := mockz.NewMockDetach(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Detach")
+				defer span.End()
+
+				detachMock.EXPECT().
+					Call(ctx, domain.DetachInput{ID: 1, AttachmentID: 5}).
+					Return(nil, assert.AnError)
+
+				return &httpEndpoint{
+					tel:      tel,
+					detachUC: detachMock,
+				}
+			},
+		},
+		{
+			name: "Success",
+			c: func() framework.Context {
+				c := framework.NewTestContext(http.MethodDelete, "/todos/1/attachments/5", nil)
+				c.SetParam("id", "1")
+				c.SetParam("attachment_id", "5")
+
+				return c.Build()
+			},
+			want:    DetachResponse{ID: 1, AttachmentID: 5},
+			wantErr: nil,
+			mockFn: func(ctx context.Context) *httpEndpoint {
+				detachMock := mockz.NewMockDetach(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(ctx, "todo.inbound.httpEndpoint.Detach")
+				defer span.End()
+
+				detachMock.EXPECT().
+					Call(ctx, domain.DetachInput{ID: 1, AttachmentID: 5}).
+					Return(&domain.DetachOutput{ID: 1, AttachmentID: 5}, nil)
+
+				return &httpEndpoint{
+					tel:      tel,
+					detachUC: detachMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := tt.c()
+			e := tt.mockFn(c.Context())
+			got, err := e.Detach(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
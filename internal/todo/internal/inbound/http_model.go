@@ -1,11 +1,113 @@
 package inbound
 
+import (
+	"mime/multipart"
+	"time"
+
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
 type Todo struct {
-	ID          uint64 `json:"id,string"`
-	UserID      uint64 `json:"user_id,string"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Status      string `json:"status"`
+	ID          uint64     `json:"id,string"`
+	UserID      uint64     `json:"user_id,string"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	Priority    string     `json:"priority"`
+	DueAt       *time.Time `json:"due_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	Tags        []string   `json:"tags"`
+	// Collaborators are the users the todo is shared with.
+	Collaborators []Collaborator `json:"collaborators"`
+}
+
+func newTodo(t domain.Todo) Todo {
+	tags := t.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return Todo{
+		ID:          t.ID,
+		UserID:      t.UserID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      t.Status.String(),
+		Priority:    t.Priority.String(),
+		DueAt:       t.DueAt,
+		CompletedAt: t.CompletedAt,
+		Tags:        tags,
+		// always a list, even for a todo shared with no one
+		Collaborators: newCollaborators(t.Collaborators),
+	}
+}
+
+type Collaborator struct {
+	Email      string     `json:"email"`
+	UserID     uint64     `json:"user_id,string"` // zero while the invitation is pending
+	Role       string     `json:"role"`
+	InvitedAt  time.Time  `json:"invited_at"`
+	AcceptedAt *time.Time `json:"accepted_at"`
+}
+
+func newCollaborators(cs []domain.TodoCollaborator) []Collaborator {
+	out := make([]Collaborator, 0, len(cs))
+	for _, c := range cs {
+		out = append(out, Collaborator{
+			Email:      c.Email,
+			UserID:     c.UserID,
+			Role:       c.Role.String(),
+			InvitedAt:  c.InvitedAt,
+			AcceptedAt: c.AcceptedAt,
+		})
+	}
+
+	return out
+}
+
+type Attachment struct {
+	ID          uint64    `json:"id,string"`
+	TodoID      uint64    `json:"todo_id,string"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	CreatedBy   uint64    `json:"created_by,string"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func newAttachment(a domain.TodoAttachment) Attachment {
+	return Attachment{
+		ID:          a.ID,
+		TodoID:      a.TodoID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		CreatedBy:   a.CreatedBy,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func newAttachments(as []domain.TodoAttachment) []Attachment {
+	out := make([]Attachment, 0, len(as))
+	for _, a := range as {
+		out = append(out, newAttachment(a))
+	}
+
+	return out
+}
+
+func newBatchResults(results []domain.BatchResult) []BatchResult {
+	out := make([]BatchResult, 0, len(results))
+	for _, res := range results {
+		item := BatchResult{ID: res.ID}
+		if res.Err != nil {
+			item.Error = res.Err.Error()
+		}
+
+		out = append(out, item)
+	}
+
+	return out
 }
 
 type Pagination struct {
@@ -16,8 +118,11 @@ type Pagination struct {
 // for request and response Create.
 type (
 	CreateRequest struct {
-		Title       string `json:"title"`
-		Description string `json:"description"`
+		Title       string     `json:"title"`
+		Description string     `json:"description"`
+		Priority    string     `json:"priority"`
+		DueAt       *time.Time `json:"due_at"`
+		Tags        []string   `json:"tags"`
 	}
 
 	CreateResponse struct {
@@ -28,7 +133,7 @@ type (
 // for request and response Delete.
 type (
 	DeleteRequest struct {
-		ID uint64 `json:"id"`
+		ID uint64 `param:"id"`
 	}
 
 	DeleteResponse struct {
@@ -39,25 +144,37 @@ type (
 // for request and response Find.
 type (
 	FindRequest struct {
-		ID uint64 `json:"id"`
+		ID uint64 `param:"id"`
 	}
 
 	FindResponse struct {
-		ID          uint64 `json:"id,string"`
-		UserID      uint64 `json:"user_id,string"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Status      string `json:"status"`
+		ID          uint64     `json:"id,string"`
+		UserID      uint64     `json:"user_id,string"`
+		Title       string     `json:"title"`
+		Description string     `json:"description"`
+		Status      string     `json:"status"`
+		Priority    string     `json:"priority"`
+		DueAt       *time.Time `json:"due_at"`
+		CompletedAt *time.Time `json:"completed_at"`
+		Tags        []string   `json:"tags"`
+		// Collaborators are the users the todo is shared with.
+		Collaborators []Collaborator `json:"collaborators"`
 	}
 )
 
 // for request and response Fetch.
 type (
 	FetchRequest struct {
-		ID          string `json:"id"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Status      string `json:"status"`
+		Cursor   string   `query:"cursor"`
+		Limit    string   `query:"limit"`
+		Status   string   `query:"status"`
+		UserID   string   `query:"user_id"`
+		Query    string   `query:"q"`
+		Tags     []string `query:"tags"`
+		Priority string   `query:"priority"`
+		DueFrom  string   `query:"due_from"`
+		DueTo    string   `query:"due_to"`
+		Sort     string   `query:"sort"`
 	}
 
 	FetchResponse struct {
@@ -69,6 +186,7 @@ type (
 // for request and response UpdateStatus.
 type (
 	UpdateStatusRequest struct {
+		ID     uint64 `param:"id"`
 		Status string `json:"status"`
 	}
 
@@ -81,16 +199,185 @@ type (
 // for request and response Update.
 type (
 	UpdateRequest struct {
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Status      string `json:"status"`
+		ID          uint64     `param:"id"`
+		Title       string     `json:"title"`
+		Description string     `json:"description"`
+		Status      string     `json:"status"`
+		Priority    string     `json:"priority"`
+		DueAt       *time.Time `json:"due_at"`
+		Tags        []string   `json:"tags"`
 	}
 
 	UpdateResponse struct {
-		ID          uint64 `json:"id,string"`
-		UserID      uint64 `json:"user_id,string"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Status      string `json:"status"`
+		ID          uint64     `json:"id,string"`
+		UserID      uint64     `json:"user_id,string"`
+		Title       string     `json:"title"`
+		Description string     `json:"description"`
+		Status      string     `json:"status"`
+		Priority    string     `json:"priority"`
+		DueAt       *time.Time `json:"due_at"`
+		CompletedAt *time.Time `json:"completed_at"`
+		Tags        []string   `json:"tags"`
+		// Collaborators are the users the todo is shared with.
+		Collaborators []Collaborator `json:"collaborators"`
+	}
+)
+
+// for request and response Restore.
+type (
+	RestoreRequest struct {
+		ID uint64 `param:"id"`
+	}
+
+	RestoreResponse struct {
+		ID uint64 `json:"id,string"`
+	}
+)
+
+// for request and response Purge.
+type (
+	PurgeRequest struct {
+		ID uint64 `param:"id"`
+	}
+
+	PurgeResponse struct {
+		ID uint64 `json:"id,string"`
+	}
+)
+
+// for request and response History.
+type (
+	HistoryRequest struct {
+		ID uint64 `param:"id"`
+	}
+
+	StatusChange struct {
+		From      string    `json:"from"`
+		To        string    `json:"to"`
+		ChangedBy uint64    `json:"changed_by,string"`
+		ChangedAt time.Time `json:"changed_at"`
+	}
+
+	HistoryResponse struct {
+		ID      uint64         `json:"id,string"`
+		Changes []StatusChange `json:"changes"`
+	}
+)
+
+// for request and response Batch.
+type (
+	BatchRequest struct {
+		Atomic       bool                 `json:"atomic"`
+		Create       []CreateRequest      `json:"create"`
+		UpdateStatus []BatchStatusRequest `json:"update_status"`
+		Delete       []BatchDeleteRequest `json:"delete"`
+	}
+
+	BatchStatusRequest struct {
+		ID     uint64 `json:"id,string"`
+		Status string `json:"status"`
+	}
+
+	BatchDeleteRequest struct {
+		ID uint64 `json:"id,string"`
+	}
+
+	BatchResult struct {
+		ID    uint64 `json:"id,string"`
+		Error string `json:"error,omitempty"`
+	}
+
+	BatchResponse struct {
+		Create       []BatchResult `json:"create"`
+		UpdateStatus []BatchResult `json:"update_status"`
+		Delete       []BatchResult `json:"delete"`
+	}
+)
+
+// for request and response Share.
+type (
+	ShareRequest struct {
+		ID    uint64 `param:"id"`
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}
+
+	ShareResponse struct {
+		ID    uint64 `json:"id,string"`
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}
+)
+
+// for request and response Unshare.
+type (
+	UnshareRequest struct {
+		ID    uint64 `param:"id"`
+		Email string `param:"email"`
+	}
+
+	UnshareResponse struct {
+		ID    uint64 `json:"id,string"`
+		Email string `json:"email"`
+	}
+)
+
+// for request and response Accept.
+type (
+	AcceptRequest struct {
+		ID uint64 `param:"id"`
+	}
+
+	AcceptResponse struct {
+		ID uint64 `json:"id,string"`
+	}
+)
+
+// for request and response Attach.
+type (
+	AttachRequest struct {
+		File *multipart.FileHeader `form:"file"`
+	}
+
+	AttachResponse struct {
+		Attachment
+	}
+)
+
+// for request and response Attachments.
+type (
+	AttachmentsRequest struct {
+		ID uint64 `param:"id"`
+	}
+
+	AttachmentsResponse struct {
+		ID          uint64       `json:"id,string"`
+		Attachments []Attachment `json:"attachments"`
+	}
+)
+
+// for request and response Download.
+type (
+	DownloadRequest struct {
+		ID           uint64 `param:"id"`
+		AttachmentID uint64 `param:"attachment_id"`
+	}
+
+	DownloadResponse struct {
+		URL       string    `json:"url"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+)
+
+// for request and response Detach.
+type (
+	DetachRequest struct {
+		ID           uint64 `param:"id"`
+		AttachmentID uint64 `param:"attachment_id"`
+	}
+
+	DetachResponse struct {
+		ID           uint64 `json:"id,string"`
+		AttachmentID uint64 `json:"attachment_id,string"`
 	}
 )
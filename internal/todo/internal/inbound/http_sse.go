@@ -1,109 +1,108 @@
 //nolint:errcheck,revive // it will be ignored
 package inbound
 
-// Known Issue:
-//  - WriteTimeout Limitation: The current setup shares a router with REST endpoints,
-//    which may be subject to a WriteTimeout limit on the server, potentially causing
-//    the connection to close prematurely. To address this, consider separating
-//    the SSE endpoints onto a different server or
-//    adjusting the WriteTimeout setting for this particular route.
-
 import (
 	"fmt"
-	"log"
 	"net/http"
-	"sync"
+	"strconv"
 	"time"
 
 	"github.com/shandysiswandi/goreng/codec"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
-type Event struct {
-	Name  string `json:"name"`
-	Value any    `json:"value"`
-}
+const sseKeepAlive = 10 * time.Second
 
 type sseEndpoint struct {
+	tel       *lib.Telemetry
 	codecJSON codec.Codec
-	clients   map[chan []byte]struct{}
-	mu        sync.RWMutex
+	events    domain.TodoEventSubscriber
+	keepAlive time.Duration
 }
 
-func (s *sseEndpoint) TrigerEvent(w http.ResponseWriter, r *http.Request) {
-	s.doBackground()
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte(time.Now().Format(time.RFC3339)))
-}
+// HandleEvent streams the todo changes of the caller. A reconnecting client
+// sends back the last id it got in `Last-Event-ID` to replay what it missed.
+//
+// The WriteTimeout of the server would end the stream, so every write pushes the
+// deadline two keep-alives away instead. A client that stopped reading is still
+// let go once its connection can not take any more.
+func (s *sseEndpoint) HandleEvent(w http.ResponseWriter, r *http.Request) {
+	ctx, span := s.tel.Tracer().Start(r.Context(), "todo.inbound.sseEndpoint.HandleEvent")
+	defer span.End()
 
-func (s *sseEndpoint) addClient(ch chan []byte) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.clients[ch] = struct{}{}
-}
+	clm := lib.GetJWTClaim(ctx)
+	if clm == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 
-func (s *sseEndpoint) delClient(ch chan []byte) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	delete(s.clients, ch)
-	close(ch)
-}
+		return
+	}
 
-func (s *sseEndpoint) doBackground() {
-	event := Event{Name: "CREATE_TODO", Value: "TODO"}
-	data, err := s.codecJSON.Encode(event)
-	if err != nil {
-		log.Println("Failed to encode event:", err)
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported!", http.StatusInternalServerError)
 
 		return
 	}
 
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	for client := range s.clients {
-		// Send data asynchronously to avoid blocking on slow clients
-		go func(ch chan []byte) {
-			ch <- data
-		}(client)
+	lastID, _ := strconv.ParseUint(r.Header.Get("Last-Event-ID"), 10, 64)
+
+	keepAlive := s.keepAlive
+	if keepAlive <= 0 {
+		keepAlive = sseKeepAlive
 	}
-}
 
-func (s *sseEndpoint) HandleEvent(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Expose-Headers", "Content-Type")
+	rc := http.NewResponseController(w)
+	extendDeadline := func() { rc.SetWriteDeadline(time.Now().Add(2 * keepAlive)) }
+	extendDeadline()
+
 	w.Header().Set("Content-Type", "text/event-stream")
 	w.Header().Set("Cache-Control", "no-cache")
 	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
 
-	flusher, ok := w.(http.Flusher)
-	if !ok {
-		http.Error(w, "Streaming unsupported!", http.StatusInternalServerError)
-
-		return
+	replay, events := s.events.Subscribe(ctx, clm.AuthID, lastID)
+	for _, ev := range replay {
+		if !s.write(w, ev) {
+			return
+		}
 	}
+	flusher.Flush()
 
-	keepAliveTickler := time.NewTicker(10 * time.Second)
-	messageChan := make(chan []byte)
-	s.addClient(messageChan)
-
-	defer func() {
-		s.delClient(messageChan)
-	}()
-
-	go func() {
-		<-r.Context().Done()
-		s.delClient(messageChan)
-		keepAliveTickler.Stop()
-		log.Println("client connection has been done")
-	}()
+	ticker := time.NewTicker(keepAlive)
+	defer ticker.Stop()
 
 	for {
 		select {
-		case <-keepAliveTickler.C:
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			extendDeadline()
 			fmt.Fprintf(w, ":keepalive\n\n")
 			flusher.Flush()
-		case data := <-messageChan:
-			fmt.Fprintf(w, "data: %s\n\n", data)
+		case ev, ok := <-events:
+			if !ok {
+				// the bus gave up on this client, it reconnects with Last-Event-ID
+				return
+			}
+
+			extendDeadline()
+			if !s.write(w, ev) {
+				return
+			}
 			flusher.Flush()
 		}
 	}
 }
+
+func (s *sseEndpoint) write(w http.ResponseWriter, ev domain.TodoEvent) bool {
+	data, err := s.codecJSON.Encode(newTodo(ev.Todo))
+	if err != nil {
+		return false
+	}
+
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.ID, ev.Type.String(), data)
+
+	return true
+}
@@ -1,114 +1,166 @@
 package inbound
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/shandysiswandi/goreng/enum"
 	mockCodec "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
-type sseResponseRecorder struct{}
+type sseResponseRecorder struct {
+	rec *httptest.ResponseRecorder
+}
+
+func (s sseResponseRecorder) Header() http.Header         { return s.rec.Header() }
+func (s sseResponseRecorder) Write(b []byte) (int, error) { return s.rec.Write(b) }
+func (s sseResponseRecorder) WriteHeader(statusCode int)  { s.rec.WriteHeader(statusCode) }
 
-func (sseResponseRecorder) Header() http.Header        { return make(http.Header) }
-func (sseResponseRecorder) Write([]byte) (int, error)  { return 0, nil }
-func (sseResponseRecorder) WriteHeader(statusCode int) {}
-func (sseResponseRecorder) Result() *http.Response     { return &http.Response{Body: http.NoBody} }
+func Test_sseEndpoint_HandleEvent(t *testing.T) {
+	claim := lib.NewJWTClaim(11, "email", time.Time{}, nil)
+	todo := domain.Todo{
+		ID:          5,
+		UserID:      11,
+		Title:       "title",
+		Description: "description",
+		Status:      enum.New(domain.TodoStatusInitiate),
+		Priority:    enum.New(domain.TodoPriorityHigh),
+		Tags:        []string{"work"},
+	}
+	todoJSON := Todo{
+		ID:            5,
+		UserID:        11,
+		Title:         "title",
+		Description:   "description",
+		Status:        "INITIATE",
+		Priority:      "HIGH",
+		Tags:          []string{"work"},
+		Collaborators: []Collaborator{},
+	}
 
-func Test_sseEndpoint_TrigerEvent(t *testing.T) {
 	tests := []struct {
-		name   string
-		mockFn func() (*sseEndpoint, *http.Request)
+		name       string
+		noFlush    bool
+		wantStatus int
+		wantBody   []string
+		mockFn     func() (*sseEndpoint, *http.Request)
 	}{
 		{
-			name: "ErrorEncode",
+			name:       "ErrorWithoutClaim",
+			wantStatus: http.StatusUnauthorized,
 			mockFn: func() (*sseEndpoint, *http.Request) {
-				r := httptest.NewRequest(http.MethodGet, "/", nil)
-				jsonMock := mockCodec.NewMockCodec(t)
-
-				jsonMock.EXPECT().
-					Encode(Event{Name: "CREATE_TODO", Value: "TODO"}).
-					Return(nil, assert.AnError)
-
-				se := &sseEndpoint{
-					codecJSON: jsonMock,
-					clients:   map[chan []byte]struct{}{},
-				}
+				r := httptest.NewRequest(http.MethodGet, "/events", nil)
 
-				ca := make(chan []byte)
-				se.addClient(ca)
-				se.delClient(ca)
+				return &sseEndpoint{tel: lib.NewTelemetry()}, r
+			},
+		},
+		{
+			name:       "ErrorStreamingUnsupported",
+			noFlush:    true,
+			wantStatus: http.StatusInternalServerError,
+			mockFn: func() (*sseEndpoint, *http.Request) {
+				r := httptest.NewRequest(http.MethodGet, "/events", nil)
+				r = r.WithContext(lib.SetJWTClaim(r.Context(), claim))
 
-				return se, r
+				return &sseEndpoint{tel: lib.NewTelemetry()}, r
 			},
 		},
 		{
-			name: "Success",
+			name:       "ErrorEncode",
+			wantStatus: http.StatusOK,
 			mockFn: func() (*sseEndpoint, *http.Request) {
-				r := httptest.NewRequest(http.MethodGet, "/", nil)
-				jsonMock := mockCodec.NewMockCodec(t)
+				r := httptest.NewRequest(http.MethodGet, "/events", nil)
+				r = r.WithContext(lib.SetJWTClaim(r.Context(), claim))
+				r.Header.Set("Last-Event-ID", "4")
 
-				jsonMock.EXPECT().
-					Encode(Event{Name: "CREATE_TODO", Value: "TODO"}).
-					Return(nil, nil)
+				jsonMock := mockCodec.NewMockCodec(t)
+				subMock := mockz.NewMockTodoEventSubscriber(t)
 
-				se := &sseEndpoint{
-					codecJSON: jsonMock,
-					clients:   map[chan []byte]struct{}{},
-				}
+				replay := []domain.TodoEvent{{ID: 5, Type: enum.New(domain.TodoEventTypeCreated), Todo: todo}}
+				subMock.EXPECT().
+					Subscribe(mock.Anything, uint64(11), uint64(4)).
+					Return(replay, make(chan domain.TodoEvent))
 
-				ca := make(chan []byte)
-				se.addClient(ca)
+				jsonMock.EXPECT().Encode(todoJSON).Return(nil, assert.AnError)
 
-				return se, r
+				return &sseEndpoint{tel: lib.NewTelemetry(), codecJSON: jsonMock, events: subMock}, r
 			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
+		{
+			name:       "SuccessReplayThenDropped",
+			wantStatus: http.StatusOK,
+			wantBody: []string{
+				"id: 5\nevent: TODO_CREATED\ndata: {\"id\":\"5\"}\n\n",
+				"id: 6\nevent: TODO_DELETED\ndata: {\"id\":\"5\"}\n\n",
+			},
+			mockFn: func() (*sseEndpoint, *http.Request) {
+				r := httptest.NewRequest(http.MethodGet, "/events", nil)
+				r = r.WithContext(lib.SetJWTClaim(r.Context(), claim))
+				r.Header.Set("Last-Event-ID", "4")
 
-			se, r := tt.mockFn()
-			w := httptest.NewRecorder()
-			se.TrigerEvent(w, r)
+				jsonMock := mockCodec.NewMockCodec(t)
+				subMock := mockz.NewMockTodoEventSubscriber(t)
 
-			res := w.Result()
-			defer res.Body.Close()
+				replay := []domain.TodoEvent{{ID: 5, Type: enum.New(domain.TodoEventTypeCreated), Todo: todo}}
+				events := make(chan domain.TodoEvent, 1)
+				events <- domain.TodoEvent{ID: 6, Type: enum.New(domain.TodoEventTypeDeleted), Todo: todo}
+				close(events)
 
-			assert.Equal(t, http.StatusOK, res.StatusCode)
-		})
-	}
-}
+				subMock.EXPECT().
+					Subscribe(mock.Anything, uint64(11), uint64(4)).
+					Return(replay, events)
 
-func Test_sseEndpoint_HandleEvent(t *testing.T) {
-	tests := []struct {
-		name   string
-		want   int
-		mockFn func() (*sseEndpoint, *http.Request)
-	}{
+				jsonMock.EXPECT().Encode(todoJSON).Return([]byte(`{"id":"5"}`), nil).Twice()
+
+				return &sseEndpoint{tel: lib.NewTelemetry(), codecJSON: jsonMock, events: subMock}, r
+			},
+		},
 		{
-			name: "NotHTTPFlusher",
-			want: 0,
+			name:       "SuccessKeepAliveUntilDone",
+			wantStatus: http.StatusOK,
+			wantBody:   []string{":keepalive\n\n"},
 			mockFn: func() (*sseEndpoint, *http.Request) {
-				r := httptest.NewRequest(http.MethodGet, "/events", nil)
-				return &sseEndpoint{}, r
+				ctx, cancel := context.WithTimeout(lib.SetJWTClaim(context.Background(), claim), 50*time.Millisecond)
+				t.Cleanup(cancel)
+
+				r := httptest.NewRequest(http.MethodGet, "/events", nil).WithContext(ctx)
+
+				subMock := mockz.NewMockTodoEventSubscriber(t)
+				subMock.EXPECT().
+					Subscribe(mock.Anything, uint64(11), uint64(0)).
+					Return(nil, make(chan domain.TodoEvent))
+
+				return &sseEndpoint{tel: lib.NewTelemetry(), events: subMock, keepAlive: 5 * time.Millisecond}, r
 			},
 		},
-		// next case cannot be tested, because it looping
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
 			se, r := tt.mockFn()
-			w := sseResponseRecorder{}
-			se.HandleEvent(w, r)
+			w := httptest.NewRecorder()
+			if tt.noFlush {
+				se.HandleEvent(sseResponseRecorder{rec: w}, r)
+			} else {
+				se.HandleEvent(w, r)
+			}
 
 			res := w.Result()
 			defer res.Body.Close()
 
-			assert.Equal(t, tt.want, res.StatusCode)
+			assert.Equal(t, tt.wantStatus, res.StatusCode)
+			for _, body := range tt.wantBody {
+				assert.Contains(t, w.Body.String(), body)
+			}
 		})
 	}
 }
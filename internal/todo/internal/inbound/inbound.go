@@ -2,12 +2,13 @@ package inbound
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/shandysiswandi/goreng/codec"
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	ql "github.com/shandysiswandi/gostarter/api/gen-gql/todo"
 	pb "github.com/shandysiswandi/gostarter/api/gen-proto/todo"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"google.golang.org/grpc"
@@ -16,14 +17,18 @@ import (
 var (
 	errFailedParseToUint = goerror.NewInvalidFormat("failed parse id to uint")
 	errInvalidBody       = goerror.NewInvalidFormat("Request payload malformed")
+	errUnauthenticated   = goerror.NewBusiness("authentication required", goerror.CodeUnauthorized)
+	errMissingFile       = goerror.NewInvalidFormat("file is required")
+	errWatchLagging      = goerror.NewBusiness("watch fell behind, resume with the last event id", goerror.CodeTimeout)
 )
 
 type Inbound struct {
-	Telemetry  *telemetry.Telemetry
+	Telemetry  *lib.Telemetry
 	Router     *framework.Router
 	GQLRouter  *framework.Router
 	GRPCServer *grpc.Server
 	CodecJSON  codec.Codec
+	Events     domain.TodoEventSubscriber
 	//
 	CreateUC       domain.Create
 	DeleteUC       domain.Delete
@@ -31,8 +36,30 @@ type Inbound struct {
 	FetchUC        domain.Fetch
 	UpdateStatusUC domain.UpdateStatus
 	UpdateUC       domain.Update
+	RestoreUC      domain.Restore
+	PurgeUC        domain.Purge
+	HistoryUC      domain.History
+	BatchUC        domain.Batch
+	ShareUC        domain.Share
+	UnshareUC      domain.Unshare
+	AcceptUC       domain.Accept
+	AttachUC       domain.Attach
+	AttachmentsUC  domain.Attachments
+	DownloadUC     domain.Download
+	DetachUC       domain.Detach
+	// UploadLimit is the largest attachment accepted over http, in bytes.
+	UploadLimit int64
+	// UploadTimeout is how long an attachment may take to arrive over http.
+	UploadTimeout time.Duration
 }
 
+const (
+	// multipartOverhead is the room left for the multipart framing around the file.
+	multipartOverhead = 1 << 20
+	// multipartMemory is how much of an upload is kept in memory before spilling to disk.
+	multipartMemory = 1 << 20
+)
+
 func (in Inbound) RegisterTodoServiceServer() {
 	he := &httpEndpoint{
 		tel: in.Telemetry,
@@ -43,11 +70,24 @@ func (in Inbound) RegisterTodoServiceServer() {
 		fetchUC:        in.FetchUC,
 		updateStatusUC: in.UpdateStatusUC,
 		updateUC:       in.UpdateUC,
+		restoreUC:      in.RestoreUC,
+		purgeUC:        in.PurgeUC,
+		historyUC:      in.HistoryUC,
+		batchUC:        in.BatchUC,
+		shareUC:        in.ShareUC,
+		unshareUC:      in.UnshareUC,
+		acceptUC:       in.AcceptUC,
+		attachUC:       in.AttachUC,
+		attachmentsUC:  in.AttachmentsUC,
+		downloadUC:     in.DownloadUC,
+		detachUC:       in.DetachUC,
+		uploadLimit:    in.UploadLimit,
 	}
 
 	se := &sseEndpoint{
+		tel:       in.Telemetry,
 		codecJSON: in.CodecJSON,
-		clients:   make(map[chan []byte]struct{}),
+		events:    in.Events,
 	}
 
 	ge := &grpcEndpoint{
@@ -59,6 +99,13 @@ func (in Inbound) RegisterTodoServiceServer() {
 		fetchUC:        in.FetchUC,
 		updateStatusUC: in.UpdateStatusUC,
 		updateUC:       in.UpdateUC,
+		restoreUC:      in.RestoreUC,
+		purgeUC:        in.PurgeUC,
+		batchUC:        in.BatchUC,
+		shareUC:        in.ShareUC,
+		unshareUC:      in.UnshareUC,
+		acceptUC:       in.AcceptUC,
+		events:         in.Events,
 	}
 
 	gql := &gqlEndpoint{
@@ -70,24 +117,111 @@ func (in Inbound) RegisterTodoServiceServer() {
 		fetchUC:        in.FetchUC,
 		updateStatusUC: in.UpdateStatusUC,
 		updateUC:       in.UpdateUC,
+		restoreUC:      in.RestoreUC,
+		purgeUC:        in.PurgeUC,
+		batchUC:        in.BatchUC,
+		shareUC:        in.ShareUC,
+		unshareUC:      in.UnshareUC,
+		acceptUC:       in.AcceptUC,
+		events:         in.Events,
 	}
 
 	//
-	in.Router.Endpoint(http.MethodGet, "/todos/:id", he.Find)
-	in.Router.Endpoint(http.MethodGet, "/todos", he.Fetch)
-	in.Router.Endpoint(http.MethodPost, "/todos", he.Create)
-	in.Router.Endpoint(http.MethodPut, "/todos/:id", he.Update)
-	in.Router.Endpoint(http.MethodPatch, "/todos/:id/status", he.UpdateStatus)
-	in.Router.Endpoint(http.MethodDelete, "/todos/:id", he.Delete)
+	todos := in.Router.Group("/todos")
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Get a todo",
+		Response: FindResponse{},
+	}).Endpoint(http.MethodGet, "/:id", he.Find, framework.ETag())
+	todos.Doc(framework.RouteDoc{
+		Summary:  "List the todos",
+		Request:  FetchRequest{},
+		Response: FetchResponse{},
+	}).Endpoint(http.MethodGet, "", he.Fetch)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Create a todo",
+		Request:  CreateRequest{},
+		Response: CreateResponse{},
+	}).Endpoint(http.MethodPost, "", he.Create)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Update a todo",
+		Request:  UpdateRequest{},
+		Response: UpdateResponse{},
+	}).Endpoint(http.MethodPut, "/:id", he.Update)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Change the status of a todo",
+		Request:  UpdateStatusRequest{},
+		Response: UpdateStatusResponse{},
+	}).Endpoint(http.MethodPatch, "/:id/status", he.UpdateStatus)
+	todos.Doc(framework.RouteDoc{
+		Summary:     "Delete a todo",
+		Description: "The todo can be restored until it is purged.",
+		Response:    DeleteResponse{},
+	}).Endpoint(http.MethodDelete, "/:id", he.Delete)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Restore a deleted todo",
+		Response: RestoreResponse{},
+	}).Endpoint(http.MethodPost, "/:id/restore", he.Restore)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Purge a deleted todo",
+		Response: PurgeResponse{},
+	}).Endpoint(http.MethodDelete, "/:id/purge", he.Purge)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Get the status history of a todo",
+		Response: HistoryResponse{},
+	}).Endpoint(http.MethodGet, "/:id/history", he.History)
+	todos.Doc(framework.RouteDoc{
+		Summary:     "Create, update and delete todos at once",
+		Description: "An atomic batch is applied all or nothing.",
+		Request:     BatchRequest{},
+		Response:    BatchResponse{},
+	}).Endpoint(http.MethodPost, ":batch", he.Batch)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Share a todo",
+		Request:  ShareRequest{},
+		Response: ShareResponse{},
+	}).Endpoint(http.MethodPost, "/:id/collaborators", he.Share)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Stop sharing a todo",
+		Response: UnshareResponse{},
+	}).Endpoint(http.MethodDelete, "/:id/collaborators/:email", he.Unshare)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Accept the invitation to a todo",
+		Response: AcceptResponse{},
+	}).Endpoint(http.MethodPost, "/:id/accept", he.Accept)
+	todos.Doc(framework.RouteDoc{
+		Summary:     "Attach a file to a todo",
+		Request:     AttachRequest{},
+		RequestType: "multipart/form-data",
+		Response:    AttachResponse{},
+	}).Endpoint(http.MethodPost, "/:id/attachments", he.Attach,
+		// a large file takes longer to arrive than the other requests
+		framework.ReadTimeout(in.UploadTimeout))
+	todos.Doc(framework.RouteDoc{
+		Summary:  "List the attachments of a todo",
+		Response: AttachmentsResponse{},
+	}).Endpoint(http.MethodGet, "/:id/attachments", he.Attachments)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Get a download link of an attachment",
+		Response: DownloadResponse{},
+	}).Endpoint(http.MethodGet, "/:id/attachments/:attachment_id", he.Download)
+	todos.Doc(framework.RouteDoc{
+		Summary:  "Remove an attachment",
+		Response: DetachResponse{},
+	}).Endpoint(http.MethodDelete, "/:id/attachments/:attachment_id", he.Detach)
 
 	//
-	in.Router.HandleFunc(http.MethodGet, "/events", se.HandleEvent)
-	in.Router.HandleFunc(http.MethodGet, "/trigger-event", se.HandleEvent)
+	in.Router.Doc(framework.RouteDoc{
+		Summary:      "Stream the todo changes",
+		Description:  "A reconnecting client sends the last id it got in Last-Event-ID to replay what it missed.",
+		Tags:         []string{"todos"},
+		ResponseType: "text/event-stream",
+	}).HandleFunc(http.MethodGet, "/events", se.HandleEvent)
 
 	//
 	pb.RegisterTodoServiceServer(in.GRPCServer, ge)
 
 	//
-	gqlServer := framework.HandlerGQL(ql.NewExecutableSchema(ql.Config{Resolvers: gql}))
+	gqlServer := framework.HandlerGQL(ql.NewExecutableSchema(ql.Config{Resolvers: gql}), framework.WithWebsocket())
 	in.GQLRouter.Handler(http.MethodPost, "/graphql", gqlServer)
+	in.GQLRouter.Handler(http.MethodGet, "/graphql", gqlServer)
 }
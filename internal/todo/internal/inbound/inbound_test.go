@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/shandysiswandi/gostarter/pkg/framework"
+	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 )
 
@@ -33,7 +34,9 @@ func TestInbound_RegisterTodoServiceServer(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			tt.in().RegisterTodoServiceServer()
+			in := tt.in()
+			in.RegisterTodoServiceServer()
+			assert.Empty(t, in.Router.Undocumented())
 		})
 	}
 }
@@ -5,7 +5,8 @@ import (
 	"github.com/shandysiswandi/goreng/config"
 	"github.com/shandysiswandi/goreng/messaging"
 	"github.com/shandysiswandi/goreng/task"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/uid"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
@@ -13,7 +14,8 @@ type Dependency struct {
 	Messaging    messaging.Client
 	Config       config.Config
 	CodecJSON    codec.Codec
-	Telemetry    *telemetry.Telemetry
+	Telemetry    *lib.Telemetry
+	UIDString    uid.StringID
 	DomainCreate domain.Create
 }
 
@@ -32,10 +34,11 @@ func New(dep Dependency) []task.Runner {
 	}
 
 	todoPub := &todoPublisher{
-		cjson: dep.CodecJSON,
-		mc:    dep.Messaging,
-		tel:   dep.Telemetry,
-		topic: "todo.creator.topic",
+		cjson:  dep.CodecJSON,
+		mc:     dep.Messaging,
+		tel:    dep.Telemetry,
+		uidstr: dep.UIDString,
+		topic:  "todo.creator.topic",
 	}
 
 	return []task.Runner{
@@ -44,10 +44,11 @@ func TestNew(t *testing.T) {
 			},
 			want: []task.Runner{
 				&todoPublisher{
-					cjson: nil,
-					mc:    nil,
-					tel:   nil,
-					topic: "todo.creator.topic",
+					cjson:  nil,
+					mc:     nil,
+					tel:    nil,
+					uidstr: nil,
+					topic:  "todo.creator.topic",
 				},
 				&todoSubscriber{
 					cjson:        nil,
@@ -6,15 +6,17 @@ import (
 
 	"github.com/shandysiswandi/goreng/codec"
 	"github.com/shandysiswandi/goreng/messaging"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/uid"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
 type todoPublisher struct {
-	cjson codec.Codec
-	mc    messaging.Client
-	tel   *telemetry.Telemetry
-	topic string
+	cjson  codec.Codec
+	mc     messaging.Client
+	tel    *lib.Telemetry
+	uidstr uid.StringID
+	topic  string
 }
 
 func (e *todoPublisher) Start() error {
@@ -24,6 +26,10 @@ func (e *todoPublisher) Start() error {
 	e.tel.Logger().Info(ctx, "todo publisher has started")
 
 	go func() {
+		// published outside of any request, the messages get an ID of their own to be followed by
+		requestID := e.uidstr.Generate()
+		ctx := lib.SetRequestID(ctx, requestID)
+
 		messages := make([]*messaging.Data, 0, 10)
 		for i := range 10 {
 			index := i + 1
@@ -39,7 +45,10 @@ func (e *todoPublisher) Start() error {
 				return
 			}
 
-			messages = append(messages, &messaging.Data{Msg: bt, Attributes: nil})
+			messages = append(messages, &messaging.Data{
+				Msg:        bt,
+				Attributes: map[string]string{lib.AttributeRequestID: requestID},
+			})
 		}
 
 		if err := e.mc.Publish(ctx, e.topic, messages[0]); err != nil {
@@ -5,8 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shandysiswandi/goreng/messaging"
 	"github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -21,9 +22,15 @@ func Test_todoPublisher_Start(t *testing.T) {
 			name:    "ErrorEncode",
 			wantErr: nil,
 			mockFn: func() *todoPublisher {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				jsonMock := mocker.NewMockCodec(t)
 				msgMock := mocker.NewMockMessagingClient(t)
+				uidMock := mocker.NewMockStringID(t)
+
+				uidMock.EXPECT().
+					Generate().
+					Return("req-1").
+					Once()
 
 				jsonMock.EXPECT().
 					Encode(mock.Anything).
@@ -31,10 +38,11 @@ func Test_todoPublisher_Start(t *testing.T) {
 					Once()
 
 				return &todoPublisher{
-					cjson: jsonMock,
-					mc:    msgMock,
-					tel:   tel,
-					topic: "topic",
+					cjson:  jsonMock,
+					mc:     msgMock,
+					tel:    tel,
+					uidstr: uidMock,
+					topic:  "topic",
 				}
 			},
 		},
@@ -42,9 +50,15 @@ func Test_todoPublisher_Start(t *testing.T) {
 			name:    "ErrorPublish",
 			wantErr: nil,
 			mockFn: func() *todoPublisher {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				jsonMock := mocker.NewMockCodec(t)
 				msgMock := mocker.NewMockMessagingClient(t)
+				uidMock := mocker.NewMockStringID(t)
+
+				uidMock.EXPECT().
+					Generate().
+					Return("req-1").
+					Once()
 
 				jsonMock.EXPECT().
 					Encode(mock.Anything).
@@ -56,10 +70,11 @@ func Test_todoPublisher_Start(t *testing.T) {
 					Return(assert.AnError)
 
 				return &todoPublisher{
-					cjson: jsonMock,
-					mc:    msgMock,
-					tel:   tel,
-					topic: "topic",
+					cjson:  jsonMock,
+					mc:     msgMock,
+					tel:    tel,
+					uidstr: uidMock,
+					topic:  "topic",
 				}
 			},
 		},
@@ -67,9 +82,15 @@ func Test_todoPublisher_Start(t *testing.T) {
 			name:    "ErrorBulkPublish",
 			wantErr: nil,
 			mockFn: func() *todoPublisher {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				jsonMock := mocker.NewMockCodec(t)
 				msgMock := mocker.NewMockMessagingClient(t)
+				uidMock := mocker.NewMockStringID(t)
+
+				uidMock.EXPECT().
+					Generate().
+					Return("req-1").
+					Once()
 
 				jsonMock.EXPECT().
 					Encode(mock.Anything).
@@ -85,10 +106,11 @@ func Test_todoPublisher_Start(t *testing.T) {
 					Return(assert.AnError)
 
 				return &todoPublisher{
-					cjson: jsonMock,
-					mc:    msgMock,
-					tel:   tel,
-					topic: "topic",
+					cjson:  jsonMock,
+					mc:     msgMock,
+					tel:    tel,
+					uidstr: uidMock,
+					topic:  "topic",
 				}
 			},
 		},
@@ -96,9 +118,15 @@ func Test_todoPublisher_Start(t *testing.T) {
 			name:    "Success",
 			wantErr: nil,
 			mockFn: func() *todoPublisher {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				jsonMock := mocker.NewMockCodec(t)
 				msgMock := mocker.NewMockMessagingClient(t)
+				uidMock := mocker.NewMockStringID(t)
+
+				uidMock.EXPECT().
+					Generate().
+					Return("req-1").
+					Once()
 
 				jsonMock.EXPECT().
 					Encode(mock.Anything).
@@ -106,7 +134,10 @@ func Test_todoPublisher_Start(t *testing.T) {
 					Times(10)
 
 				msgMock.EXPECT().
-					Publish(mock.Anything, mock.Anything, mock.Anything).
+					Publish(mock.Anything, "topic", &messaging.Data{
+						Msg:        []byte{},
+						Attributes: map[string]string{lib.AttributeRequestID: "req-1"},
+					}).
 					Return(nil)
 
 				msgMock.EXPECT().
@@ -114,10 +145,11 @@ func Test_todoPublisher_Start(t *testing.T) {
 					Return(nil)
 
 				return &todoPublisher{
-					cjson: jsonMock,
-					mc:    msgMock,
-					tel:   tel,
-					topic: "topic",
+					cjson:  jsonMock,
+					mc:     msgMock,
+					tel:    tel,
+					uidstr: uidMock,
+					topic:  "topic",
 				}
 			},
 		},
@@ -145,7 +177,7 @@ func Test_todoPublisher_Stop(t *testing.T) {
 			wantErr: nil,
 			mockFn: func() *todoPublisher {
 				return &todoPublisher{
-					tel: telemetry.NewTelemetry(),
+					tel: lib.NewTelemetry(),
 				}
 			},
 		},
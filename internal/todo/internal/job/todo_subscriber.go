@@ -5,14 +5,14 @@ import (
 
 	"github.com/shandysiswandi/goreng/codec"
 	"github.com/shandysiswandi/goreng/messaging"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
 type todoSubscriber struct {
 	cjson               codec.Codec
 	mc                  messaging.Client
-	tel                 *telemetry.Telemetry
+	tel                 *lib.Telemetry
 	createUC            domain.Create
 	topic, subscription string
 }
@@ -25,6 +25,11 @@ func (e *todoSubscriber) Start() error {
 }
 
 func (e *todoSubscriber) do(ctx context.Context, data *messaging.Data) error {
+	// carry on with the request the message was published for
+	if id := data.Attributes[lib.AttributeRequestID]; lib.ValidRequestID(id) {
+		ctx = lib.SetRequestID(ctx, id)
+	}
+
 	var todo domain.Todo
 	if err := e.cjson.Decode(data.Msg, &todo); err != nil {
 		return err
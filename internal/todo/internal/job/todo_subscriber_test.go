@@ -6,7 +6,7 @@ import (
 
 	"github.com/shandysiswandi/goreng/messaging"
 	"github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
 	"github.com/stretchr/testify/assert"
@@ -23,7 +23,7 @@ func Test_todoSubscriber_Start(t *testing.T) {
 			name:    "ErrorSubscribe",
 			wantErr: assert.AnError,
 			mockFn: func() *todoSubscriber {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				msgMock := mocker.NewMockMessagingClient(t)
 
 				msgMock.EXPECT().
@@ -67,7 +67,7 @@ func Test_todoSubscriber_do(t *testing.T) {
 			},
 			wantErr: assert.AnError,
 			mockFn: func(a args) *todoSubscriber {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				jsonMock := mocker.NewMockCodec(t)
 				msgMock := mocker.NewMockMessagingClient(t)
 
@@ -93,7 +93,7 @@ func Test_todoSubscriber_do(t *testing.T) {
 			},
 			wantErr: assert.AnError,
 			mockFn: func(a args) *todoSubscriber {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				jsonMock := mocker.NewMockCodec(t)
 				createUC := mockz.NewMockCreate(t)
 
@@ -120,7 +120,7 @@ func Test_todoSubscriber_do(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *todoSubscriber {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				jsonMock := mocker.NewMockCodec(t)
 				createUC := mockz.NewMockCreate(t)
 
@@ -139,6 +139,34 @@ func Test_todoSubscriber_do(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "SuccessWithRequestID",
+			args: args{
+				ctx:  context.Background(),
+				data: &messaging.Data{Attributes: map[string]string{lib.AttributeRequestID: "req-1"}},
+			},
+			wantErr: nil,
+			mockFn: func(a args) *todoSubscriber {
+				tel := lib.NewTelemetry()
+				jsonMock := mocker.NewMockCodec(t)
+				createUC := mockz.NewMockCreate(t)
+
+				jsonMock.EXPECT().
+					Decode(a.data.Msg, mock.Anything).
+					Return(nil)
+
+				createUC.EXPECT().
+					Call(mock.MatchedBy(func(ctx context.Context) bool { return lib.GetRequestID(ctx) == "req-1" }),
+						mock.Anything).
+					Return(&domain.CreateOutput{}, nil)
+
+				return &todoSubscriber{
+					cjson:    jsonMock,
+					tel:      tel,
+					createUC: createUC,
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -162,7 +190,7 @@ func Test_todoSubscriber_Stop(t *testing.T) {
 			wantErr: nil,
 			mockFn: func() *todoSubscriber {
 				return &todoSubscriber{
-					tel: telemetry.NewTelemetry(),
+					tel: lib.NewTelemetry(),
 				}
 			},
 		},
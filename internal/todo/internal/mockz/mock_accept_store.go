@@ -0,0 +1,87 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAcceptStore is an autogenerated mock type for the AcceptStore type
+type MockAcceptStore struct {
+	mock.Mock
+}
+
+type MockAcceptStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAcceptStore) EXPECT() *MockAcceptStore_Expecter {
+	return &MockAcceptStore_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function with given fields: ctx, todoID, email, userID, at
+func (_m *MockAcceptStore) Accept(ctx context.Context, todoID uint64, email string, userID uint64, at time.Time) error {
+	ret := _m.Called(ctx, todoID, email, userID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accept")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, time.Time) error); ok {
+		r0 = rf(ctx, todoID, email, userID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAcceptStore_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type MockAcceptStore_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uint64
+//   - email string
+//   - userID uint64
+//   - at time.Time
+func (_e *MockAcceptStore_Expecter) Accept(ctx interface{}, todoID interface{}, email interface{}, userID interface{}, at interface{}) *MockAcceptStore_Accept_Call {
+	return &MockAcceptStore_Accept_Call{Call: _e.mock.On("Accept", ctx, todoID, email, userID, at)}
+}
+
+func (_c *MockAcceptStore_Accept_Call) Run(run func(ctx context.Context, todoID uint64, email string, userID uint64, at time.Time)) *MockAcceptStore_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(uint64), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAcceptStore_Accept_Call) Return(_a0 error) *MockAcceptStore_Accept_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAcceptStore_Accept_Call) RunAndReturn(run func(context.Context, uint64, string, uint64, time.Time) error) *MockAcceptStore_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAcceptStore creates a new instance of MockAcceptStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAcceptStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAcceptStore {
+	mock := &MockAcceptStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,144 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAttachStore is an autogenerated mock type for the AttachStore type
+type MockAttachStore struct {
+	mock.Mock
+}
+
+type MockAttachStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachStore) EXPECT() *MockAttachStore_Expecter {
+	return &MockAttachStore_Expecter{mock: &_m.Mock}
+}
+
+// AddAttachment provides a mock function with given fields: ctx, a
+func (_m *MockAttachStore) AddAttachment(ctx context.Context, a domain.TodoAttachment) error {
+	ret := _m.Called(ctx, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TodoAttachment) error); ok {
+		r0 = rf(ctx, a)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachStore_AddAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAttachment'
+type MockAttachStore_AddAttachment_Call struct {
+	*mock.Call
+}
+
+// AddAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - a domain.TodoAttachment
+func (_e *MockAttachStore_Expecter) AddAttachment(ctx interface{}, a interface{}) *MockAttachStore_AddAttachment_Call {
+	return &MockAttachStore_AddAttachment_Call{Call: _e.mock.On("AddAttachment", ctx, a)}
+}
+
+func (_c *MockAttachStore_AddAttachment_Call) Run(run func(ctx context.Context, a domain.TodoAttachment)) *MockAttachStore_AddAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TodoAttachment))
+	})
+	return _c
+}
+
+func (_c *MockAttachStore_AddAttachment_Call) Return(_a0 error) *MockAttachStore_AddAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAttachStore_AddAttachment_Call) RunAndReturn(run func(context.Context, domain.TodoAttachment) error) *MockAttachStore_AddAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMember provides a mock function with given fields: ctx, id, member
+func (_m *MockAttachStore) FindMember(ctx context.Context, id uint64, member uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMember")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, member)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, member)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachStore_FindMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMember'
+type MockAttachStore_FindMember_Call struct {
+	*mock.Call
+}
+
+// FindMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - member uint64
+func (_e *MockAttachStore_Expecter) FindMember(ctx interface{}, id interface{}, member interface{}) *MockAttachStore_FindMember_Call {
+	return &MockAttachStore_FindMember_Call{Call: _e.mock.On("FindMember", ctx, id, member)}
+}
+
+func (_c *MockAttachStore_FindMember_Call) Run(run func(ctx context.Context, id uint64, member uint64)) *MockAttachStore_FindMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachStore_FindMember_Call) Return(_a0 *domain.Todo, _a1 error) *MockAttachStore_FindMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachStore_FindMember_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockAttachStore_FindMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachStore creates a new instance of MockAttachStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachStore {
+	mock := &MockAttachStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
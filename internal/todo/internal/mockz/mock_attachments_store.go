@@ -0,0 +1,156 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAttachmentsStore is an autogenerated mock type for the AttachmentsStore type
+type MockAttachmentsStore struct {
+	mock.Mock
+}
+
+type MockAttachmentsStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentsStore) EXPECT() *MockAttachmentsStore_Expecter {
+	return &MockAttachmentsStore_Expecter{mock: &_m.Mock}
+}
+
+// Attachments provides a mock function with given fields: ctx, todoID
+func (_m *MockAttachmentsStore) Attachments(ctx context.Context, todoID uint64) ([]domain.TodoAttachment, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attachments")
+	}
+
+	var r0 []domain.TodoAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]domain.TodoAttachment, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []domain.TodoAttachment); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TodoAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentsStore_Attachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attachments'
+type MockAttachmentsStore_Attachments_Call struct {
+	*mock.Call
+}
+
+// Attachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uint64
+func (_e *MockAttachmentsStore_Expecter) Attachments(ctx interface{}, todoID interface{}) *MockAttachmentsStore_Attachments_Call {
+	return &MockAttachmentsStore_Attachments_Call{Call: _e.mock.On("Attachments", ctx, todoID)}
+}
+
+func (_c *MockAttachmentsStore_Attachments_Call) Run(run func(ctx context.Context, todoID uint64)) *MockAttachmentsStore_Attachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsStore_Attachments_Call) Return(_a0 []domain.TodoAttachment, _a1 error) *MockAttachmentsStore_Attachments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentsStore_Attachments_Call) RunAndReturn(run func(context.Context, uint64) ([]domain.TodoAttachment, error)) *MockAttachmentsStore_Attachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMember provides a mock function with given fields: ctx, id, member
+func (_m *MockAttachmentsStore) FindMember(ctx context.Context, id uint64, member uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMember")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, member)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, member)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentsStore_FindMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMember'
+type MockAttachmentsStore_FindMember_Call struct {
+	*mock.Call
+}
+
+// FindMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - member uint64
+func (_e *MockAttachmentsStore_Expecter) FindMember(ctx interface{}, id interface{}, member interface{}) *MockAttachmentsStore_FindMember_Call {
+	return &MockAttachmentsStore_FindMember_Call{Call: _e.mock.On("FindMember", ctx, id, member)}
+}
+
+func (_c *MockAttachmentsStore_FindMember_Call) Run(run func(ctx context.Context, id uint64, member uint64)) *MockAttachmentsStore_FindMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsStore_FindMember_Call) Return(_a0 *domain.Todo, _a1 error) *MockAttachmentsStore_FindMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentsStore_FindMember_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockAttachmentsStore_FindMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentsStore creates a new instance of MockAttachmentsStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentsStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentsStore {
+	mock := &MockAttachmentsStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
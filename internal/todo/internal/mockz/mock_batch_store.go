@@ -0,0 +1,303 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockBatchStore is an autogenerated mock type for the BatchStore type
+type MockBatchStore struct {
+	mock.Mock
+}
+
+type MockBatchStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBatchStore) EXPECT() *MockBatchStore_Expecter {
+	return &MockBatchStore_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in
+func (_m *MockBatchStore) Create(ctx context.Context, in domain.Todo) error {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Todo) error); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBatchStore_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockBatchStore_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in domain.Todo
+func (_e *MockBatchStore_Expecter) Create(ctx interface{}, in interface{}) *MockBatchStore_Create_Call {
+	return &MockBatchStore_Create_Call{Call: _e.mock.On("Create", ctx, in)}
+}
+
+func (_c *MockBatchStore_Create_Call) Run(run func(ctx context.Context, in domain.Todo)) *MockBatchStore_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Todo))
+	})
+	return _c
+}
+
+func (_c *MockBatchStore_Create_Call) Return(_a0 error) *MockBatchStore_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBatchStore_Create_Call) RunAndReturn(run func(context.Context, domain.Todo) error) *MockBatchStore_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, owner, at
+func (_m *MockBatchStore) Delete(ctx context.Context, id uint64, owner uint64, at time.Time) error {
+	ret := _m.Called(ctx, id, owner, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time) error); ok {
+		r0 = rf(ctx, id, owner, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBatchStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBatchStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner uint64
+//   - at time.Time
+func (_e *MockBatchStore_Expecter) Delete(ctx interface{}, id interface{}, owner interface{}, at interface{}) *MockBatchStore_Delete_Call {
+	return &MockBatchStore_Delete_Call{Call: _e.mock.On("Delete", ctx, id, owner, at)}
+}
+
+func (_c *MockBatchStore_Delete_Call) Run(run func(ctx context.Context, id uint64, owner uint64, at time.Time)) *MockBatchStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockBatchStore_Delete_Call) Return(_a0 error) *MockBatchStore_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBatchStore_Delete_Call) RunAndReturn(run func(context.Context, uint64, uint64, time.Time) error) *MockBatchStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: ctx, id, owner
+func (_m *MockBatchStore) Find(ctx context.Context, id uint64, owner uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, owner)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBatchStore_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockBatchStore_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner uint64
+func (_e *MockBatchStore_Expecter) Find(ctx interface{}, id interface{}, owner interface{}) *MockBatchStore_Find_Call {
+	return &MockBatchStore_Find_Call{Call: _e.mock.On("Find", ctx, id, owner)}
+}
+
+func (_c *MockBatchStore_Find_Call) Run(run func(ctx context.Context, id uint64, owner uint64)) *MockBatchStore_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockBatchStore_Find_Call) Return(_a0 *domain.Todo, _a1 error) *MockBatchStore_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBatchStore_Find_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockBatchStore_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMember provides a mock function with given fields: ctx, id, member
+func (_m *MockBatchStore) FindMember(ctx context.Context, id uint64, member uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMember")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, member)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, member)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBatchStore_FindMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMember'
+type MockBatchStore_FindMember_Call struct {
+	*mock.Call
+}
+
+// FindMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - member uint64
+func (_e *MockBatchStore_Expecter) FindMember(ctx interface{}, id interface{}, member interface{}) *MockBatchStore_FindMember_Call {
+	return &MockBatchStore_FindMember_Call{Call: _e.mock.On("FindMember", ctx, id, member)}
+}
+
+func (_c *MockBatchStore_FindMember_Call) Run(run func(ctx context.Context, id uint64, member uint64)) *MockBatchStore_FindMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockBatchStore_FindMember_Call) Return(_a0 *domain.Todo, _a1 error) *MockBatchStore_FindMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBatchStore_FindMember_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockBatchStore_FindMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, todo, history
+func (_m *MockBatchStore) UpdateStatus(ctx context.Context, todo domain.Todo, history []domain.TodoStatusChange) error {
+	ret := _m.Called(ctx, todo, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Todo, []domain.TodoStatusChange) error); ok {
+		r0 = rf(ctx, todo, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBatchStore_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockBatchStore_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todo domain.Todo
+//   - history []domain.TodoStatusChange
+func (_e *MockBatchStore_Expecter) UpdateStatus(ctx interface{}, todo interface{}, history interface{}) *MockBatchStore_UpdateStatus_Call {
+	return &MockBatchStore_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, todo, history)}
+}
+
+func (_c *MockBatchStore_UpdateStatus_Call) Run(run func(ctx context.Context, todo domain.Todo, history []domain.TodoStatusChange)) *MockBatchStore_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Todo), args[2].([]domain.TodoStatusChange))
+	})
+	return _c
+}
+
+func (_c *MockBatchStore_UpdateStatus_Call) Return(_a0 error) *MockBatchStore_UpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBatchStore_UpdateStatus_Call) RunAndReturn(run func(context.Context, domain.Todo, []domain.TodoStatusChange) error) *MockBatchStore_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBatchStore creates a new instance of MockBatchStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBatchStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBatchStore {
+	mock := &MockBatchStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,7 +5,10 @@ package mockz
 import (
 	context "context"
 
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockDeleteStore is an autogenerated mock type for the DeleteStore type
@@ -21,17 +24,17 @@ func (_m *MockDeleteStore) EXPECT() *MockDeleteStore_Expecter {
 	return &MockDeleteStore_Expecter{mock: &_m.Mock}
 }
 
-// Delete provides a mock function with given fields: ctx, in
-func (_m *MockDeleteStore) Delete(ctx context.Context, in uint64) error {
-	ret := _m.Called(ctx, in)
+// Delete provides a mock function with given fields: ctx, id, owner, at
+func (_m *MockDeleteStore) Delete(ctx context.Context, id uint64, owner uint64, at time.Time) error {
+	ret := _m.Called(ctx, id, owner, at)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
-		r0 = rf(ctx, in)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time) error); ok {
+		r0 = rf(ctx, id, owner, at)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,14 +49,16 @@ type MockDeleteStore_Delete_Call struct {
 
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in uint64
-func (_e *MockDeleteStore_Expecter) Delete(ctx interface{}, in interface{}) *MockDeleteStore_Delete_Call {
-	return &MockDeleteStore_Delete_Call{Call: _e.mock.On("Delete", ctx, in)}
+//   - id uint64
+//   - owner uint64
+//   - at time.Time
+func (_e *MockDeleteStore_Expecter) Delete(ctx interface{}, id interface{}, owner interface{}, at interface{}) *MockDeleteStore_Delete_Call {
+	return &MockDeleteStore_Delete_Call{Call: _e.mock.On("Delete", ctx, id, owner, at)}
 }
 
-func (_c *MockDeleteStore_Delete_Call) Run(run func(ctx context.Context, in uint64)) *MockDeleteStore_Delete_Call {
+func (_c *MockDeleteStore_Delete_Call) Run(run func(ctx context.Context, id uint64, owner uint64, at time.Time)) *MockDeleteStore_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(time.Time))
 	})
 	return _c
 }
@@ -63,7 +68,67 @@ func (_c *MockDeleteStore_Delete_Call) Return(_a0 error) *MockDeleteStore_Delete
 	return _c
 }
 
-func (_c *MockDeleteStore_Delete_Call) RunAndReturn(run func(context.Context, uint64) error) *MockDeleteStore_Delete_Call {
+func (_c *MockDeleteStore_Delete_Call) RunAndReturn(run func(context.Context, uint64, uint64, time.Time) error) *MockDeleteStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: ctx, id, owner
+func (_m *MockDeleteStore) Find(ctx context.Context, id uint64, owner uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, owner)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeleteStore_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockDeleteStore_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner uint64
+func (_e *MockDeleteStore_Expecter) Find(ctx interface{}, id interface{}, owner interface{}) *MockDeleteStore_Find_Call {
+	return &MockDeleteStore_Find_Call{Call: _e.mock.On("Find", ctx, id, owner)}
+}
+
+func (_c *MockDeleteStore_Find_Call) Run(run func(ctx context.Context, id uint64, owner uint64)) *MockDeleteStore_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDeleteStore_Find_Call) Return(_a0 *domain.Todo, _a1 error) *MockDeleteStore_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeleteStore_Find_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockDeleteStore_Find_Call {
 	_c.Call.Return(run)
 	return _c
 }
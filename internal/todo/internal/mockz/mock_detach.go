@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDetach is an autogenerated mock type for the Detach type
+type MockDetach struct {
+	mock.Mock
+}
+
+type MockDetach_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDetach) EXPECT() *MockDetach_Expecter {
+	return &MockDetach_Expecter{mock: &_m.Mock}
+}
+
+// Call provides a mock function with given fields: ctx, in
+func (_m *MockDetach) Call(ctx context.Context, in domain.DetachInput) (*domain.DetachOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Call")
+	}
+
+	var r0 *domain.DetachOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DetachInput) (*domain.DetachOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DetachInput) *domain.DetachOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DetachOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DetachInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDetach_Call_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Call'
+type MockDetach_Call_Call struct {
+	*mock.Call
+}
+
+// Call is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in domain.DetachInput
+func (_e *MockDetach_Expecter) Call(ctx interface{}, in interface{}) *MockDetach_Call_Call {
+	return &MockDetach_Call_Call{Call: _e.mock.On("Call", ctx, in)}
+}
+
+func (_c *MockDetach_Call_Call) Run(run func(ctx context.Context, in domain.DetachInput)) *MockDetach_Call_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DetachInput))
+	})
+	return _c
+}
+
+func (_c *MockDetach_Call_Call) Return(_a0 *domain.DetachOutput, _a1 error) *MockDetach_Call_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDetach_Call_Call) RunAndReturn(run func(context.Context, domain.DetachInput) (*domain.DetachOutput, error)) *MockDetach_Call_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDetach creates a new instance of MockDetach. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDetach(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDetach {
+	mock := &MockDetach{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,205 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDetachStore is an autogenerated mock type for the DetachStore type
+type MockDetachStore struct {
+	mock.Mock
+}
+
+type MockDetachStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDetachStore) EXPECT() *MockDetachStore_Expecter {
+	return &MockDetachStore_Expecter{mock: &_m.Mock}
+}
+
+// DeleteAttachment provides a mock function with given fields: ctx, todoID, id
+func (_m *MockDetachStore) DeleteAttachment(ctx context.Context, todoID uint64, id uint64) error {
+	ret := _m.Called(ctx, todoID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, todoID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDetachStore_DeleteAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachment'
+type MockDetachStore_DeleteAttachment_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uint64
+//   - id uint64
+func (_e *MockDetachStore_Expecter) DeleteAttachment(ctx interface{}, todoID interface{}, id interface{}) *MockDetachStore_DeleteAttachment_Call {
+	return &MockDetachStore_DeleteAttachment_Call{Call: _e.mock.On("DeleteAttachment", ctx, todoID, id)}
+}
+
+func (_c *MockDetachStore_DeleteAttachment_Call) Run(run func(ctx context.Context, todoID uint64, id uint64)) *MockDetachStore_DeleteAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDetachStore_DeleteAttachment_Call) Return(_a0 error) *MockDetachStore_DeleteAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDetachStore_DeleteAttachment_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockDetachStore_DeleteAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAttachment provides a mock function with given fields: ctx, todoID, id
+func (_m *MockDetachStore) FindAttachment(ctx context.Context, todoID uint64, id uint64) (*domain.TodoAttachment, error) {
+	ret := _m.Called(ctx, todoID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAttachment")
+	}
+
+	var r0 *domain.TodoAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.TodoAttachment, error)); ok {
+		return rf(ctx, todoID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.TodoAttachment); ok {
+		r0 = rf(ctx, todoID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TodoAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, todoID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDetachStore_FindAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAttachment'
+type MockDetachStore_FindAttachment_Call struct {
+	*mock.Call
+}
+
+// FindAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uint64
+//   - id uint64
+func (_e *MockDetachStore_Expecter) FindAttachment(ctx interface{}, todoID interface{}, id interface{}) *MockDetachStore_FindAttachment_Call {
+	return &MockDetachStore_FindAttachment_Call{Call: _e.mock.On("FindAttachment", ctx, todoID, id)}
+}
+
+func (_c *MockDetachStore_FindAttachment_Call) Run(run func(ctx context.Context, todoID uint64, id uint64)) *MockDetachStore_FindAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDetachStore_FindAttachment_Call) Return(_a0 *domain.TodoAttachment, _a1 error) *MockDetachStore_FindAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDetachStore_FindAttachment_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.TodoAttachment, error)) *MockDetachStore_FindAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMember provides a mock function with given fields: ctx, id, member
+func (_m *MockDetachStore) FindMember(ctx context.Context, id uint64, member uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMember")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, member)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, member)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDetachStore_FindMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMember'
+type MockDetachStore_FindMember_Call struct {
+	*mock.Call
+}
+
+// FindMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - member uint64
+func (_e *MockDetachStore_Expecter) FindMember(ctx interface{}, id interface{}, member interface{}) *MockDetachStore_FindMember_Call {
+	return &MockDetachStore_FindMember_Call{Call: _e.mock.On("FindMember", ctx, id, member)}
+}
+
+func (_c *MockDetachStore_FindMember_Call) Run(run func(ctx context.Context, id uint64, member uint64)) *MockDetachStore_FindMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDetachStore_FindMember_Call) Return(_a0 *domain.Todo, _a1 error) *MockDetachStore_FindMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDetachStore_FindMember_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockDetachStore_FindMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDetachStore creates a new instance of MockDetachStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDetachStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDetachStore {
+	mock := &MockDetachStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
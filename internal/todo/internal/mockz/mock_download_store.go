@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDownloadStore is an autogenerated mock type for the DownloadStore type
+type MockDownloadStore struct {
+	mock.Mock
+}
+
+type MockDownloadStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDownloadStore) EXPECT() *MockDownloadStore_Expecter {
+	return &MockDownloadStore_Expecter{mock: &_m.Mock}
+}
+
+// FindAttachment provides a mock function with given fields: ctx, todoID, id
+func (_m *MockDownloadStore) FindAttachment(ctx context.Context, todoID uint64, id uint64) (*domain.TodoAttachment, error) {
+	ret := _m.Called(ctx, todoID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAttachment")
+	}
+
+	var r0 *domain.TodoAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.TodoAttachment, error)); ok {
+		return rf(ctx, todoID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.TodoAttachment); ok {
+		r0 = rf(ctx, todoID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TodoAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, todoID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDownloadStore_FindAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAttachment'
+type MockDownloadStore_FindAttachment_Call struct {
+	*mock.Call
+}
+
+// FindAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uint64
+//   - id uint64
+func (_e *MockDownloadStore_Expecter) FindAttachment(ctx interface{}, todoID interface{}, id interface{}) *MockDownloadStore_FindAttachment_Call {
+	return &MockDownloadStore_FindAttachment_Call{Call: _e.mock.On("FindAttachment", ctx, todoID, id)}
+}
+
+func (_c *MockDownloadStore_FindAttachment_Call) Run(run func(ctx context.Context, todoID uint64, id uint64)) *MockDownloadStore_FindAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDownloadStore_FindAttachment_Call) Return(_a0 *domain.TodoAttachment, _a1 error) *MockDownloadStore_FindAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDownloadStore_FindAttachment_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.TodoAttachment, error)) *MockDownloadStore_FindAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMember provides a mock function with given fields: ctx, id, member
+func (_m *MockDownloadStore) FindMember(ctx context.Context, id uint64, member uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMember")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, member)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, member)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDownloadStore_FindMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMember'
+type MockDownloadStore_FindMember_Call struct {
+	*mock.Call
+}
+
+// FindMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - member uint64
+func (_e *MockDownloadStore_Expecter) FindMember(ctx interface{}, id interface{}, member interface{}) *MockDownloadStore_FindMember_Call {
+	return &MockDownloadStore_FindMember_Call{Call: _e.mock.On("FindMember", ctx, id, member)}
+}
+
+func (_c *MockDownloadStore_FindMember_Call) Run(run func(ctx context.Context, id uint64, member uint64)) *MockDownloadStore_FindMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDownloadStore_FindMember_Call) Return(_a0 *domain.Todo, _a1 error) *MockDownloadStore_FindMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDownloadStore_FindMember_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockDownloadStore_FindMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDownloadStore creates a new instance of MockDownloadStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDownloadStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDownloadStore {
+	mock := &MockDownloadStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,29 +22,29 @@ func (_m *MockFindStore) EXPECT() *MockFindStore_Expecter {
 	return &MockFindStore_Expecter{mock: &_m.Mock}
 }
 
-// Find provides a mock function with given fields: ctx, id
-func (_m *MockFindStore) Find(ctx context.Context, id uint64) (*domain.Todo, error) {
-	ret := _m.Called(ctx, id)
+// FindMember provides a mock function with given fields: ctx, id, member
+func (_m *MockFindStore) FindMember(ctx context.Context, id uint64, member uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, member)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Find")
+		panic("no return value specified for FindMember")
 	}
 
 	var r0 *domain.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*domain.Todo, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, member)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) *domain.Todo); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, member)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Todo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, member)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,31 +52,32 @@ func (_m *MockFindStore) Find(ctx context.Context, id uint64) (*domain.Todo, err
 	return r0, r1
 }
 
-// MockFindStore_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
-type MockFindStore_Find_Call struct {
+// MockFindStore_FindMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMember'
+type MockFindStore_FindMember_Call struct {
 	*mock.Call
 }
 
-// Find is a helper method to define mock.On call
+// FindMember is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uint64
-func (_e *MockFindStore_Expecter) Find(ctx interface{}, id interface{}) *MockFindStore_Find_Call {
-	return &MockFindStore_Find_Call{Call: _e.mock.On("Find", ctx, id)}
+//   - member uint64
+func (_e *MockFindStore_Expecter) FindMember(ctx interface{}, id interface{}, member interface{}) *MockFindStore_FindMember_Call {
+	return &MockFindStore_FindMember_Call{Call: _e.mock.On("FindMember", ctx, id, member)}
 }
 
-func (_c *MockFindStore_Find_Call) Run(run func(ctx context.Context, id uint64)) *MockFindStore_Find_Call {
+func (_c *MockFindStore_FindMember_Call) Run(run func(ctx context.Context, id uint64, member uint64)) *MockFindStore_FindMember_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
 	})
 	return _c
 }
 
-func (_c *MockFindStore_Find_Call) Return(_a0 *domain.Todo, _a1 error) *MockFindStore_Find_Call {
+func (_c *MockFindStore_FindMember_Call) Return(_a0 *domain.Todo, _a1 error) *MockFindStore_FindMember_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockFindStore_Find_Call) RunAndReturn(run func(context.Context, uint64) (*domain.Todo, error)) *MockFindStore_Find_Call {
+func (_c *MockFindStore_FindMember_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockFindStore_FindMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,156 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHistoryStore is an autogenerated mock type for the HistoryStore type
+type MockHistoryStore struct {
+	mock.Mock
+}
+
+type MockHistoryStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHistoryStore) EXPECT() *MockHistoryStore_Expecter {
+	return &MockHistoryStore_Expecter{mock: &_m.Mock}
+}
+
+// Find provides a mock function with given fields: ctx, id, owner
+func (_m *MockHistoryStore) Find(ctx context.Context, id uint64, owner uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, owner)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHistoryStore_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockHistoryStore_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner uint64
+func (_e *MockHistoryStore_Expecter) Find(ctx interface{}, id interface{}, owner interface{}) *MockHistoryStore_Find_Call {
+	return &MockHistoryStore_Find_Call{Call: _e.mock.On("Find", ctx, id, owner)}
+}
+
+func (_c *MockHistoryStore_Find_Call) Run(run func(ctx context.Context, id uint64, owner uint64)) *MockHistoryStore_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockHistoryStore_Find_Call) Return(_a0 *domain.Todo, _a1 error) *MockHistoryStore_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHistoryStore_Find_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockHistoryStore_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// History provides a mock function with given fields: ctx, todoID
+func (_m *MockHistoryStore) History(ctx context.Context, todoID uint64) ([]domain.TodoStatusChange, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []domain.TodoStatusChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]domain.TodoStatusChange, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []domain.TodoStatusChange); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TodoStatusChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHistoryStore_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type MockHistoryStore_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uint64
+func (_e *MockHistoryStore_Expecter) History(ctx interface{}, todoID interface{}) *MockHistoryStore_History_Call {
+	return &MockHistoryStore_History_Call{Call: _e.mock.On("History", ctx, todoID)}
+}
+
+func (_c *MockHistoryStore_History_Call) Run(run func(ctx context.Context, todoID uint64)) *MockHistoryStore_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockHistoryStore_History_Call) Return(_a0 []domain.TodoStatusChange, _a1 error) *MockHistoryStore_History_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHistoryStore_History_Call) RunAndReturn(run func(context.Context, uint64) ([]domain.TodoStatusChange, error)) *MockHistoryStore_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHistoryStore creates a new instance of MockHistoryStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHistoryStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHistoryStore {
+	mock := &MockHistoryStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
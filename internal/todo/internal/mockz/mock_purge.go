@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPurge is an autogenerated mock type for the Purge type
+type MockPurge struct {
+	mock.Mock
+}
+
+type MockPurge_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPurge) EXPECT() *MockPurge_Expecter {
+	return &MockPurge_Expecter{mock: &_m.Mock}
+}
+
+// Call provides a mock function with given fields: ctx, in
+func (_m *MockPurge) Call(ctx context.Context, in domain.PurgeInput) (*domain.PurgeOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Call")
+	}
+
+	var r0 *domain.PurgeOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PurgeInput) (*domain.PurgeOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PurgeInput) *domain.PurgeOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PurgeOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PurgeInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPurge_Call_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Call'
+type MockPurge_Call_Call struct {
+	*mock.Call
+}
+
+// Call is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in domain.PurgeInput
+func (_e *MockPurge_Expecter) Call(ctx interface{}, in interface{}) *MockPurge_Call_Call {
+	return &MockPurge_Call_Call{Call: _e.mock.On("Call", ctx, in)}
+}
+
+func (_c *MockPurge_Call_Call) Run(run func(ctx context.Context, in domain.PurgeInput)) *MockPurge_Call_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PurgeInput))
+	})
+	return _c
+}
+
+func (_c *MockPurge_Call_Call) Return(_a0 *domain.PurgeOutput, _a1 error) *MockPurge_Call_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPurge_Call_Call) RunAndReturn(run func(context.Context, domain.PurgeInput) (*domain.PurgeOutput, error)) *MockPurge_Call_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPurge creates a new instance of MockPurge. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPurge(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPurge {
+	mock := &MockPurge{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
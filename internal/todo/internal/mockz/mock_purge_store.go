@@ -0,0 +1,144 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPurgeStore is an autogenerated mock type for the PurgeStore type
+type MockPurgeStore struct {
+	mock.Mock
+}
+
+type MockPurgeStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPurgeStore) EXPECT() *MockPurgeStore_Expecter {
+	return &MockPurgeStore_Expecter{mock: &_m.Mock}
+}
+
+// Attachments provides a mock function with given fields: ctx, todoID
+func (_m *MockPurgeStore) Attachments(ctx context.Context, todoID uint64) ([]domain.TodoAttachment, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attachments")
+	}
+
+	var r0 []domain.TodoAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]domain.TodoAttachment, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []domain.TodoAttachment); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TodoAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPurgeStore_Attachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attachments'
+type MockPurgeStore_Attachments_Call struct {
+	*mock.Call
+}
+
+// Attachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uint64
+func (_e *MockPurgeStore_Expecter) Attachments(ctx interface{}, todoID interface{}) *MockPurgeStore_Attachments_Call {
+	return &MockPurgeStore_Attachments_Call{Call: _e.mock.On("Attachments", ctx, todoID)}
+}
+
+func (_c *MockPurgeStore_Attachments_Call) Run(run func(ctx context.Context, todoID uint64)) *MockPurgeStore_Attachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockPurgeStore_Attachments_Call) Return(_a0 []domain.TodoAttachment, _a1 error) *MockPurgeStore_Attachments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPurgeStore_Attachments_Call) RunAndReturn(run func(context.Context, uint64) ([]domain.TodoAttachment, error)) *MockPurgeStore_Attachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, id, owner
+func (_m *MockPurgeStore) Purge(ctx context.Context, id uint64, owner uint64) error {
+	ret := _m.Called(ctx, id, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, id, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPurgeStore_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockPurgeStore_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner uint64
+func (_e *MockPurgeStore_Expecter) Purge(ctx interface{}, id interface{}, owner interface{}) *MockPurgeStore_Purge_Call {
+	return &MockPurgeStore_Purge_Call{Call: _e.mock.On("Purge", ctx, id, owner)}
+}
+
+func (_c *MockPurgeStore_Purge_Call) Run(run func(ctx context.Context, id uint64, owner uint64)) *MockPurgeStore_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockPurgeStore_Purge_Call) Return(_a0 error) *MockPurgeStore_Purge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPurgeStore_Purge_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockPurgeStore_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPurgeStore creates a new instance of MockPurgeStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPurgeStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPurgeStore {
+	mock := &MockPurgeStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
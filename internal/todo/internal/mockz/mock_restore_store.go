@@ -0,0 +1,145 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRestoreStore is an autogenerated mock type for the RestoreStore type
+type MockRestoreStore struct {
+	mock.Mock
+}
+
+type MockRestoreStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestoreStore) EXPECT() *MockRestoreStore_Expecter {
+	return &MockRestoreStore_Expecter{mock: &_m.Mock}
+}
+
+// FindDeleted provides a mock function with given fields: ctx, id, owner
+func (_m *MockRestoreStore) FindDeleted(ctx context.Context, id uint64, owner uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDeleted")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, owner)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRestoreStore_FindDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDeleted'
+type MockRestoreStore_FindDeleted_Call struct {
+	*mock.Call
+}
+
+// FindDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner uint64
+func (_e *MockRestoreStore_Expecter) FindDeleted(ctx interface{}, id interface{}, owner interface{}) *MockRestoreStore_FindDeleted_Call {
+	return &MockRestoreStore_FindDeleted_Call{Call: _e.mock.On("FindDeleted", ctx, id, owner)}
+}
+
+func (_c *MockRestoreStore_FindDeleted_Call) Run(run func(ctx context.Context, id uint64, owner uint64)) *MockRestoreStore_FindDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRestoreStore_FindDeleted_Call) Return(_a0 *domain.Todo, _a1 error) *MockRestoreStore_FindDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRestoreStore_FindDeleted_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockRestoreStore_FindDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id, owner
+func (_m *MockRestoreStore) Restore(ctx context.Context, id uint64, owner uint64) error {
+	ret := _m.Called(ctx, id, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, id, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRestoreStore_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockRestoreStore_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner uint64
+func (_e *MockRestoreStore_Expecter) Restore(ctx interface{}, id interface{}, owner interface{}) *MockRestoreStore_Restore_Call {
+	return &MockRestoreStore_Restore_Call{Call: _e.mock.On("Restore", ctx, id, owner)}
+}
+
+func (_c *MockRestoreStore_Restore_Call) Run(run func(ctx context.Context, id uint64, owner uint64)) *MockRestoreStore_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRestoreStore_Restore_Call) Return(_a0 error) *MockRestoreStore_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRestoreStore_Restore_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockRestoreStore_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestoreStore creates a new instance of MockRestoreStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestoreStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestoreStore {
+	mock := &MockRestoreStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
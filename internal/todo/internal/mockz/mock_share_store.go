@@ -0,0 +1,144 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockShareStore is an autogenerated mock type for the ShareStore type
+type MockShareStore struct {
+	mock.Mock
+}
+
+type MockShareStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShareStore) EXPECT() *MockShareStore_Expecter {
+	return &MockShareStore_Expecter{mock: &_m.Mock}
+}
+
+// Find provides a mock function with given fields: ctx, id, owner
+func (_m *MockShareStore) Find(ctx context.Context, id uint64, owner uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, owner)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShareStore_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockShareStore_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner uint64
+func (_e *MockShareStore_Expecter) Find(ctx interface{}, id interface{}, owner interface{}) *MockShareStore_Find_Call {
+	return &MockShareStore_Find_Call{Call: _e.mock.On("Find", ctx, id, owner)}
+}
+
+func (_c *MockShareStore_Find_Call) Run(run func(ctx context.Context, id uint64, owner uint64)) *MockShareStore_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockShareStore_Find_Call) Return(_a0 *domain.Todo, _a1 error) *MockShareStore_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShareStore_Find_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockShareStore_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Share provides a mock function with given fields: ctx, c
+func (_m *MockShareStore) Share(ctx context.Context, c domain.TodoCollaborator) error {
+	ret := _m.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Share")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TodoCollaborator) error); ok {
+		r0 = rf(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockShareStore_Share_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Share'
+type MockShareStore_Share_Call struct {
+	*mock.Call
+}
+
+// Share is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c domain.TodoCollaborator
+func (_e *MockShareStore_Expecter) Share(ctx interface{}, c interface{}) *MockShareStore_Share_Call {
+	return &MockShareStore_Share_Call{Call: _e.mock.On("Share", ctx, c)}
+}
+
+func (_c *MockShareStore_Share_Call) Run(run func(ctx context.Context, c domain.TodoCollaborator)) *MockShareStore_Share_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TodoCollaborator))
+	})
+	return _c
+}
+
+func (_c *MockShareStore_Share_Call) Return(_a0 error) *MockShareStore_Share_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShareStore_Share_Call) RunAndReturn(run func(context.Context, domain.TodoCollaborator) error) *MockShareStore_Share_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShareStore creates a new instance of MockShareStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShareStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShareStore {
+	mock := &MockShareStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
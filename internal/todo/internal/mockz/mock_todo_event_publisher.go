@@ -0,0 +1,71 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTodoEventPublisher is an autogenerated mock type for the TodoEventPublisher type
+type MockTodoEventPublisher struct {
+	mock.Mock
+}
+
+type MockTodoEventPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoEventPublisher) EXPECT() *MockTodoEventPublisher_Expecter {
+	return &MockTodoEventPublisher_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, ev
+func (_m *MockTodoEventPublisher) Publish(ctx context.Context, ev domain.TodoEvent) {
+	_m.Called(ctx, ev)
+}
+
+// MockTodoEventPublisher_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockTodoEventPublisher_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ev domain.TodoEvent
+func (_e *MockTodoEventPublisher_Expecter) Publish(ctx interface{}, ev interface{}) *MockTodoEventPublisher_Publish_Call {
+	return &MockTodoEventPublisher_Publish_Call{Call: _e.mock.On("Publish", ctx, ev)}
+}
+
+func (_c *MockTodoEventPublisher_Publish_Call) Run(run func(ctx context.Context, ev domain.TodoEvent)) *MockTodoEventPublisher_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TodoEvent))
+	})
+	return _c
+}
+
+func (_c *MockTodoEventPublisher_Publish_Call) Return() *MockTodoEventPublisher_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockTodoEventPublisher_Publish_Call) RunAndReturn(run func(context.Context, domain.TodoEvent)) *MockTodoEventPublisher_Publish_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockTodoEventPublisher creates a new instance of MockTodoEventPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoEventPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoEventPublisher {
+	mock := &MockTodoEventPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
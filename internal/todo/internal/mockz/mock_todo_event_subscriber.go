@@ -0,0 +1,99 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTodoEventSubscriber is an autogenerated mock type for the TodoEventSubscriber type
+type MockTodoEventSubscriber struct {
+	mock.Mock
+}
+
+type MockTodoEventSubscriber_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoEventSubscriber) EXPECT() *MockTodoEventSubscriber_Expecter {
+	return &MockTodoEventSubscriber_Expecter{mock: &_m.Mock}
+}
+
+// Subscribe provides a mock function with given fields: ctx, member, lastID
+func (_m *MockTodoEventSubscriber) Subscribe(ctx context.Context, member uint64, lastID uint64) ([]domain.TodoEvent, <-chan domain.TodoEvent) {
+	ret := _m.Called(ctx, member, lastID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 []domain.TodoEvent
+	var r1 <-chan domain.TodoEvent
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) ([]domain.TodoEvent, <-chan domain.TodoEvent)); ok {
+		return rf(ctx, member, lastID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []domain.TodoEvent); ok {
+		r0 = rf(ctx, member, lastID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TodoEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) <-chan domain.TodoEvent); ok {
+		r1 = rf(ctx, member, lastID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(<-chan domain.TodoEvent)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTodoEventSubscriber_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockTodoEventSubscriber_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member uint64
+//   - lastID uint64
+func (_e *MockTodoEventSubscriber_Expecter) Subscribe(ctx interface{}, member interface{}, lastID interface{}) *MockTodoEventSubscriber_Subscribe_Call {
+	return &MockTodoEventSubscriber_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, member, lastID)}
+}
+
+func (_c *MockTodoEventSubscriber_Subscribe_Call) Run(run func(ctx context.Context, member uint64, lastID uint64)) *MockTodoEventSubscriber_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockTodoEventSubscriber_Subscribe_Call) Return(_a0 []domain.TodoEvent, _a1 <-chan domain.TodoEvent) *MockTodoEventSubscriber_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoEventSubscriber_Subscribe_Call) RunAndReturn(run func(context.Context, uint64, uint64) ([]domain.TodoEvent, <-chan domain.TodoEvent)) *MockTodoEventSubscriber_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoEventSubscriber creates a new instance of MockTodoEventSubscriber. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoEventSubscriber(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoEventSubscriber {
+	mock := &MockTodoEventSubscriber{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
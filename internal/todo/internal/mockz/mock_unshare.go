@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUnshare is an autogenerated mock type for the Unshare type
+type MockUnshare struct {
+	mock.Mock
+}
+
+type MockUnshare_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUnshare) EXPECT() *MockUnshare_Expecter {
+	return &MockUnshare_Expecter{mock: &_m.Mock}
+}
+
+// Call provides a mock function with given fields: ctx, in
+func (_m *MockUnshare) Call(ctx context.Context, in domain.UnshareInput) (*domain.UnshareOutput, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Call")
+	}
+
+	var r0 *domain.UnshareOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UnshareInput) (*domain.UnshareOutput, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UnshareInput) *domain.UnshareOutput); ok {
+		r0 = rf(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UnshareOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UnshareInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUnshare_Call_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Call'
+type MockUnshare_Call_Call struct {
+	*mock.Call
+}
+
+// Call is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in domain.UnshareInput
+func (_e *MockUnshare_Expecter) Call(ctx interface{}, in interface{}) *MockUnshare_Call_Call {
+	return &MockUnshare_Call_Call{Call: _e.mock.On("Call", ctx, in)}
+}
+
+func (_c *MockUnshare_Call_Call) Run(run func(ctx context.Context, in domain.UnshareInput)) *MockUnshare_Call_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UnshareInput))
+	})
+	return _c
+}
+
+func (_c *MockUnshare_Call_Call) Return(_a0 *domain.UnshareOutput, _a1 error) *MockUnshare_Call_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUnshare_Call_Call) RunAndReturn(run func(context.Context, domain.UnshareInput) (*domain.UnshareOutput, error)) *MockUnshare_Call_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUnshare creates a new instance of MockUnshare. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUnshare(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUnshare {
+	mock := &MockUnshare{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,145 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mockz
+
+import (
+	context "context"
+
+	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUnshareStore is an autogenerated mock type for the UnshareStore type
+type MockUnshareStore struct {
+	mock.Mock
+}
+
+type MockUnshareStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUnshareStore) EXPECT() *MockUnshareStore_Expecter {
+	return &MockUnshareStore_Expecter{mock: &_m.Mock}
+}
+
+// Find provides a mock function with given fields: ctx, id, owner
+func (_m *MockUnshareStore) Find(ctx context.Context, id uint64, owner uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, owner)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUnshareStore_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockUnshareStore_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - owner uint64
+func (_e *MockUnshareStore_Expecter) Find(ctx interface{}, id interface{}, owner interface{}) *MockUnshareStore_Find_Call {
+	return &MockUnshareStore_Find_Call{Call: _e.mock.On("Find", ctx, id, owner)}
+}
+
+func (_c *MockUnshareStore_Find_Call) Run(run func(ctx context.Context, id uint64, owner uint64)) *MockUnshareStore_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockUnshareStore_Find_Call) Return(_a0 *domain.Todo, _a1 error) *MockUnshareStore_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUnshareStore_Find_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockUnshareStore_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unshare provides a mock function with given fields: ctx, todoID, email
+func (_m *MockUnshareStore) Unshare(ctx context.Context, todoID uint64, email string) error {
+	ret := _m.Called(ctx, todoID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unshare")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) error); ok {
+		r0 = rf(ctx, todoID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUnshareStore_Unshare_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unshare'
+type MockUnshareStore_Unshare_Call struct {
+	*mock.Call
+}
+
+// Unshare is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uint64
+//   - email string
+func (_e *MockUnshareStore_Expecter) Unshare(ctx interface{}, todoID interface{}, email interface{}) *MockUnshareStore_Unshare_Call {
+	return &MockUnshareStore_Unshare_Call{Call: _e.mock.On("Unshare", ctx, todoID, email)}
+}
+
+func (_c *MockUnshareStore_Unshare_Call) Run(run func(ctx context.Context, todoID uint64, email string)) *MockUnshareStore_Unshare_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUnshareStore_Unshare_Call) Return(_a0 error) *MockUnshareStore_Unshare_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUnshareStore_Unshare_Call) RunAndReturn(run func(context.Context, uint64, string) error) *MockUnshareStore_Unshare_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUnshareStore creates a new instance of MockUnshareStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUnshareStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUnshareStore {
+	mock := &MockUnshareStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
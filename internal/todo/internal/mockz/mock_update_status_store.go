@@ -5,9 +5,7 @@ package mockz
 import (
 	context "context"
 
-	enum "github.com/shandysiswandi/goreng/enum"
 	domain "github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
-
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -24,17 +22,77 @@ func (_m *MockUpdateStatusStore) EXPECT() *MockUpdateStatusStore_Expecter {
 	return &MockUpdateStatusStore_Expecter{mock: &_m.Mock}
 }
 
-// UpdateStatus provides a mock function with given fields: ctx, in, sts
-func (_m *MockUpdateStatusStore) UpdateStatus(ctx context.Context, in uint64, sts enum.Enum[domain.TodoStatus]) error {
-	ret := _m.Called(ctx, in, sts)
+// FindMember provides a mock function with given fields: ctx, id, member
+func (_m *MockUpdateStatusStore) FindMember(ctx context.Context, id uint64, member uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMember")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, member)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, member)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateStatusStore_FindMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMember'
+type MockUpdateStatusStore_FindMember_Call struct {
+	*mock.Call
+}
+
+// FindMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - member uint64
+func (_e *MockUpdateStatusStore_Expecter) FindMember(ctx interface{}, id interface{}, member interface{}) *MockUpdateStatusStore_FindMember_Call {
+	return &MockUpdateStatusStore_FindMember_Call{Call: _e.mock.On("FindMember", ctx, id, member)}
+}
+
+func (_c *MockUpdateStatusStore_FindMember_Call) Run(run func(ctx context.Context, id uint64, member uint64)) *MockUpdateStatusStore_FindMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockUpdateStatusStore_FindMember_Call) Return(_a0 *domain.Todo, _a1 error) *MockUpdateStatusStore_FindMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateStatusStore_FindMember_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockUpdateStatusStore_FindMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, todo, history
+func (_m *MockUpdateStatusStore) UpdateStatus(ctx context.Context, todo domain.Todo, history []domain.TodoStatusChange) error {
+	ret := _m.Called(ctx, todo, history)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, enum.Enum[domain.TodoStatus]) error); ok {
-		r0 = rf(ctx, in, sts)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Todo, []domain.TodoStatusChange) error); ok {
+		r0 = rf(ctx, todo, history)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,15 +107,15 @@ type MockUpdateStatusStore_UpdateStatus_Call struct {
 
 // UpdateStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in uint64
-//   - sts enum.Enum[domain.TodoStatus]
-func (_e *MockUpdateStatusStore_Expecter) UpdateStatus(ctx interface{}, in interface{}, sts interface{}) *MockUpdateStatusStore_UpdateStatus_Call {
-	return &MockUpdateStatusStore_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, in, sts)}
+//   - todo domain.Todo
+//   - history []domain.TodoStatusChange
+func (_e *MockUpdateStatusStore_Expecter) UpdateStatus(ctx interface{}, todo interface{}, history interface{}) *MockUpdateStatusStore_UpdateStatus_Call {
+	return &MockUpdateStatusStore_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, todo, history)}
 }
 
-func (_c *MockUpdateStatusStore_UpdateStatus_Call) Run(run func(ctx context.Context, in uint64, sts enum.Enum[domain.TodoStatus])) *MockUpdateStatusStore_UpdateStatus_Call {
+func (_c *MockUpdateStatusStore_UpdateStatus_Call) Run(run func(ctx context.Context, todo domain.Todo, history []domain.TodoStatusChange)) *MockUpdateStatusStore_UpdateStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(enum.Enum[domain.TodoStatus]))
+		run(args[0].(context.Context), args[1].(domain.Todo), args[2].([]domain.TodoStatusChange))
 	})
 	return _c
 }
@@ -67,7 +125,7 @@ func (_c *MockUpdateStatusStore_UpdateStatus_Call) Return(_a0 error) *MockUpdate
 	return _c
 }
 
-func (_c *MockUpdateStatusStore_UpdateStatus_Call) RunAndReturn(run func(context.Context, uint64, enum.Enum[domain.TodoStatus]) error) *MockUpdateStatusStore_UpdateStatus_Call {
+func (_c *MockUpdateStatusStore_UpdateStatus_Call) RunAndReturn(run func(context.Context, domain.Todo, []domain.TodoStatusChange) error) *MockUpdateStatusStore_UpdateStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
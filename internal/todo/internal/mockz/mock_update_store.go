@@ -22,17 +22,77 @@ func (_m *MockUpdateStore) EXPECT() *MockUpdateStore_Expecter {
 	return &MockUpdateStore_Expecter{mock: &_m.Mock}
 }
 
-// Update provides a mock function with given fields: ctx, in
-func (_m *MockUpdateStore) Update(ctx context.Context, in domain.Todo) error {
-	ret := _m.Called(ctx, in)
+// FindMember provides a mock function with given fields: ctx, id, member
+func (_m *MockUpdateStore) FindMember(ctx context.Context, id uint64, member uint64) (*domain.Todo, error) {
+	ret := _m.Called(ctx, id, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMember")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*domain.Todo, error)); ok {
+		return rf(ctx, id, member)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *domain.Todo); ok {
+		r0 = rf(ctx, id, member)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateStore_FindMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMember'
+type MockUpdateStore_FindMember_Call struct {
+	*mock.Call
+}
+
+// FindMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - member uint64
+func (_e *MockUpdateStore_Expecter) FindMember(ctx interface{}, id interface{}, member interface{}) *MockUpdateStore_FindMember_Call {
+	return &MockUpdateStore_FindMember_Call{Call: _e.mock.On("FindMember", ctx, id, member)}
+}
+
+func (_c *MockUpdateStore_FindMember_Call) Run(run func(ctx context.Context, id uint64, member uint64)) *MockUpdateStore_FindMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockUpdateStore_FindMember_Call) Return(_a0 *domain.Todo, _a1 error) *MockUpdateStore_FindMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateStore_FindMember_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*domain.Todo, error)) *MockUpdateStore_FindMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, history
+func (_m *MockUpdateStore) Update(ctx context.Context, in domain.Todo, history []domain.TodoStatusChange) error {
+	ret := _m.Called(ctx, in, history)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Todo) error); ok {
-		r0 = rf(ctx, in)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Todo, []domain.TodoStatusChange) error); ok {
+		r0 = rf(ctx, in, history)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,13 +108,14 @@ type MockUpdateStore_Update_Call struct {
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
 //   - in domain.Todo
-func (_e *MockUpdateStore_Expecter) Update(ctx interface{}, in interface{}) *MockUpdateStore_Update_Call {
-	return &MockUpdateStore_Update_Call{Call: _e.mock.On("Update", ctx, in)}
+//   - history []domain.TodoStatusChange
+func (_e *MockUpdateStore_Expecter) Update(ctx interface{}, in interface{}, history interface{}) *MockUpdateStore_Update_Call {
+	return &MockUpdateStore_Update_Call{Call: _e.mock.On("Update", ctx, in, history)}
 }
 
-func (_c *MockUpdateStore_Update_Call) Run(run func(ctx context.Context, in domain.Todo)) *MockUpdateStore_Update_Call {
+func (_c *MockUpdateStore_Update_Call) Run(run func(ctx context.Context, in domain.Todo, history []domain.TodoStatusChange)) *MockUpdateStore_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Todo))
+		run(args[0].(context.Context), args[1].(domain.Todo), args[2].([]domain.TodoStatusChange))
 	})
 	return _c
 }
@@ -64,7 +125,7 @@ func (_c *MockUpdateStore_Update_Call) Return(_a0 error) *MockUpdateStore_Update
 	return _c
 }
 
-func (_c *MockUpdateStore_Update_Call) RunAndReturn(run func(context.Context, domain.Todo) error) *MockUpdateStore_Update_Call {
+func (_c *MockUpdateStore_Update_Call) RunAndReturn(run func(context.Context, domain.Todo, []domain.TodoStatusChange) error) *MockUpdateStore_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,126 @@
+package outbound
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+const (
+	defaultEventReplaySize   = 256
+	defaultEventClientBuffer = 16
+)
+
+type eventSubscription struct {
+	member uint64
+	ch     chan domain.TodoEvent
+}
+
+// EventBus is an in-process fan out of todo events. It keeps the last
+// published events for replay, and drops subscribers whose buffer is full
+// instead of blocking the publisher on them.
+type EventBus struct {
+	telemetry  *lib.Telemetry
+	replaySize int
+	bufferSize int
+
+	mu     sync.Mutex
+	seq    uint64
+	replay []domain.TodoEvent
+	subs   map[*eventSubscription]struct{}
+}
+
+func NewEventBus(replaySize, bufferSize int, tel *lib.Telemetry) *EventBus {
+	if replaySize <= 0 {
+		replaySize = defaultEventReplaySize
+	}
+
+	if bufferSize <= 0 {
+		bufferSize = defaultEventClientBuffer
+	}
+
+	return &EventBus{
+		telemetry:  tel,
+		replaySize: replaySize,
+		bufferSize: bufferSize,
+		// ids start from the boot time, so an id seen before a restart is still
+		// lower than every id given after it
+		seq:    uint64(time.Now().UnixNano()),
+		replay: make([]domain.TodoEvent, 0, replaySize),
+		subs:   make(map[*eventSubscription]struct{}),
+	}
+}
+
+func (eb *EventBus) Publish(ctx context.Context, ev domain.TodoEvent) {
+	ctx, span := eb.telemetry.Tracer().Start(ctx, "todo.outbound.EventBus.Publish")
+	defer span.End()
+
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	eb.seq++
+	ev.ID = eb.seq
+
+	if len(eb.replay) == eb.replaySize {
+		copy(eb.replay, eb.replay[1:])
+		eb.replay = eb.replay[:len(eb.replay)-1]
+	}
+	eb.replay = append(eb.replay, ev)
+
+	for sub := range eb.subs {
+		if !ev.IsFor(sub.member) {
+			continue
+		}
+
+		select {
+		case sub.ch <- ev:
+		default:
+			eb.telemetry.Logger().Warn(ctx, "todo event subscriber is too slow, dropped")
+			eb.unsubscribe(sub)
+		}
+	}
+}
+
+func (eb *EventBus) Subscribe(ctx context.Context, member, lastID uint64) (
+	[]domain.TodoEvent, <-chan domain.TodoEvent,
+) {
+	sub := &eventSubscription{
+		member: member,
+		ch:     make(chan domain.TodoEvent, eb.bufferSize),
+	}
+
+	eb.mu.Lock()
+	var replay []domain.TodoEvent
+	if lastID > 0 {
+		for _, ev := range eb.replay {
+			if ev.ID > lastID && ev.IsFor(member) {
+				replay = append(replay, ev)
+			}
+		}
+	}
+	eb.subs[sub] = struct{}{}
+	eb.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		eb.mu.Lock()
+		defer eb.mu.Unlock()
+		eb.unsubscribe(sub)
+	}()
+
+	return replay, sub.ch
+}
+
+// unsubscribe must be called while holding the lock.
+func (eb *EventBus) unsubscribe(sub *eventSubscription) {
+	if _, ok := eb.subs[sub]; !ok {
+		return
+	}
+
+	delete(eb.subs, sub)
+	close(sub.ch)
+}
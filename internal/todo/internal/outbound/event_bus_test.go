@@ -0,0 +1,137 @@
+package outbound
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEventBus(t *testing.T) {
+	type args struct {
+		replaySize int
+		bufferSize int
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantReplay int
+		wantBuffer int
+	}{
+		{
+			name:       "Default",
+			args:       args{},
+			wantReplay: defaultEventReplaySize,
+			wantBuffer: defaultEventClientBuffer,
+		},
+		{
+			name:       "Custom",
+			args:       args{replaySize: 2, bufferSize: 1},
+			wantReplay: 2,
+			wantBuffer: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewEventBus(tt.args.replaySize, tt.args.bufferSize, lib.NewTelemetry())
+			assert.Equal(t, tt.wantReplay, got.replaySize)
+			assert.Equal(t, tt.wantBuffer, got.bufferSize)
+			assert.NotZero(t, got.seq)
+		})
+	}
+}
+
+func TestEventBus_PublishSubscribe(t *testing.T) {
+	created := func(todo domain.Todo) domain.TodoEvent {
+		return domain.NewTodoEvent(domain.TodoEventTypeCreated, todo)
+	}
+	accepted := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, eb *EventBus)
+	}{
+		{
+			name: "DeliverToMembersOnly",
+			run: func(t *testing.T, eb *EventBus) {
+				_, own := eb.Subscribe(context.Background(), 11, 0)
+				_, shared := eb.Subscribe(context.Background(), 12, 0)
+				_, invited := eb.Subscribe(context.Background(), 13, 0)
+				_, other := eb.Subscribe(context.Background(), 14, 0)
+
+				collaborators := []domain.TodoCollaborator{
+					{TodoID: 1, Email: "shared@example.com", UserID: 12, AcceptedAt: &accepted},
+					{TodoID: 1, Email: "invited@example.com", UserID: 13},
+				}
+				eb.Publish(context.Background(), created(domain.Todo{ID: 1, UserID: 11, Collaborators: collaborators}))
+
+				ev := <-own
+				assert.Equal(t, uint64(1), ev.Todo.ID)
+				assert.Equal(t, eb.seq, ev.ID)
+				ev = <-shared
+				assert.Equal(t, uint64(1), ev.Todo.ID)
+				assert.Empty(t, invited)
+				assert.Empty(t, other)
+			},
+		},
+		{
+			name: "ReplayAfterLastID",
+			run: func(t *testing.T, eb *EventBus) {
+				for i := range uint64(3) {
+					eb.Publish(context.Background(), created(domain.Todo{ID: i + 1, UserID: 11}))
+				}
+				eb.Publish(context.Background(), created(domain.Todo{ID: 9, UserID: 12}))
+
+				// the replay buffer holds 3 events, the first one is gone already
+				replay, _ := eb.Subscribe(context.Background(), 11, 1)
+				assert.Len(t, replay, 2)
+				assert.Equal(t, uint64(2), replay[0].Todo.ID)
+				assert.Equal(t, uint64(3), replay[1].Todo.ID)
+
+				replay, _ = eb.Subscribe(context.Background(), 11, 0)
+				assert.Empty(t, replay)
+			},
+		},
+		{
+			name: "DropSlowSubscriber",
+			run: func(t *testing.T, eb *EventBus) {
+				_, events := eb.Subscribe(context.Background(), 11, 0)
+
+				eb.Publish(context.Background(), created(domain.Todo{ID: 1, UserID: 11}))
+				eb.Publish(context.Background(), created(domain.Todo{ID: 2, UserID: 11}))
+
+				ev, ok := <-events
+				assert.True(t, ok)
+				assert.Equal(t, uint64(1), ev.Todo.ID)
+
+				_, ok = <-events
+				assert.False(t, ok)
+			},
+		},
+		{
+			name: "UnsubscribeWhenDone",
+			run: func(t *testing.T, eb *EventBus) {
+				ctx, cancel := context.WithCancel(context.Background())
+				_, events := eb.Subscribe(ctx, 11, 0)
+				cancel()
+
+				_, ok := <-events
+				assert.False(t, ok)
+
+				eb.mu.Lock()
+				defer eb.mu.Unlock()
+				assert.Empty(t, eb.subs)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.run(t, NewEventBus(3, 1, lib.NewTelemetry()))
+		})
+	}
+}
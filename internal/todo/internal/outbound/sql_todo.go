@@ -4,138 +4,692 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/shandysiswandi/goreng/enum"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/uid"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 )
 
+const todoColumns = `id, user_id, title, description, status, priority, due_at, completed_at, deleted_at, ` +
+	`created_at`
+
+// priorityRank sorts the priorities from LOW to HIGH instead of in alphabetical
+// order. The ranks are the values of domain.TodoPriority.
+const priorityRank = `CASE priority WHEN 'LOW' THEN 1 WHEN 'MEDIUM' THEN 2 WHEN 'HIGH' THEN 3 ELSE 0 END`
+
+// memberCondition keeps the todos owned by the user or shared with them, it
+// takes the user twice.
+const memberCondition = `(user_id = ? OR id IN (SELECT todo_id FROM todo_collaborators ` +
+	`WHERE user_id = ? AND accepted_at IS NOT NULL))`
+
+type todoTag struct {
+	TodoID uint64 `db:"todo_id"`
+	Name   string `db:"name"`
+}
+
 type SQLTodo struct {
-	db  *sql.DB
-	qu  goqu.DialectWrapper
-	tel *telemetry.Telemetry
+	db        *sqlkit.DB
+	uidnumber uid.NumberID
+	telemetry *lib.Telemetry
 }
 
-func NewSQLTodo(db *sql.DB, qu goqu.DialectWrapper, tel *telemetry.Telemetry) *SQLTodo {
+func NewSQLTodo(db *sqlkit.DB, uidnumber uid.NumberID, tel *lib.Telemetry) *SQLTodo {
 	return &SQLTodo{
-		db:  db,
-		qu:  qu,
-		tel: tel,
+		db:        db,
+		uidnumber: uidnumber,
+		telemetry: tel,
 	}
 }
 
 func (st *SQLTodo) Create(ctx context.Context, todo domain.Todo) error {
-	ctx, span := st.tel.Tracer().Start(ctx, "auth.outbound.SQLTodo.Create")
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Create")
+	defer span.End()
+
+	return st.db.Transaction(ctx, func(ctx context.Context) error {
+		query := `INSERT INTO todos(id, user_id, title, description, status, priority, due_at, completed_at) ` +
+			`VALUES(?, ?, ?, ?, ?, ?, ?, ?);`
+		args := []any{
+			todo.ID, todo.UserID, todo.Title, todo.Description,
+			todo.Status, todo.Priority, todo.DueAt, todo.CompletedAt,
+		}
+
+		result, err := sqlkit.Exec(ctx, st.db, query, args...)
+		if err != nil {
+			return err
+		}
+
+		if result.RowsAffected == 0 {
+			return domain.ErrTodoNotCreated
+		}
+
+		return st.addTags(ctx, todo)
+	})
+}
+
+// Delete moves the todo with the given id to the trash by setting deleted_at.
+// A non-zero owner limits the delete to the todos of that user.
+func (st *SQLTodo) Delete(ctx context.Context, id, owner uint64, at time.Time) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Delete")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return st.qu.Insert("todos").
-			Cols("id", "user_id", "title", "description", "status").
-			Vals([]any{todo.ID, todo.UserID, todo.Title, todo.Description, todo.Status}).
-			Prepared(true).
-			ToSQL()
+	query := `UPDATE todos SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`
+	args := []any{at, id}
+
+	if owner > 0 {
+		query += ` AND user_id = ?`
+		args = append(args, owner)
 	}
 
-	err := dbops.Exec(ctx, st.db, query, true)
-	if errors.Is(err, dbops.ErrZeroRowsAffected) {
-		return domain.ErrTodoNotCreated
+	result, err := sqlkit.Exec(ctx, st.db, query+";", args...)
+	if err != nil {
+		return err
 	}
 
-	return err
+	if result.RowsAffected == 0 {
+		return domain.ErrTodoNotDeleted
+	}
+
+	return nil
+}
+
+// Restore takes the todo with the given id back from the trash. A non-zero
+// owner limits the restore to the todos of that user.
+func (st *SQLTodo) Restore(ctx context.Context, id, owner uint64) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Restore")
+	defer span.End()
+
+	query := `UPDATE todos SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`
+	args := []any{id}
+
+	if owner > 0 {
+		query += ` AND user_id = ?`
+		args = append(args, owner)
+	}
+
+	result, err := sqlkit.Exec(ctx, st.db, query+";", args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrTodoNotUpdated
+	}
+
+	return nil
 }
 
-func (st *SQLTodo) Delete(ctx context.Context, id uint64) error {
-	ctx, span := st.tel.Tracer().Start(ctx, "auth.outbound.SQLTodo.Delete")
+// Purge removes the todo with the given id for good, only once it is in the
+// trash. Its tag links go along with it. A non-zero owner limits the purge
+// to the todos of that user.
+func (st *SQLTodo) Purge(ctx context.Context, id, owner uint64) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Purge")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return st.qu.Delete("todos").
-			Where(goqu.Ex{"id": id}).
-			Prepared(true).
-			ToSQL()
+	query := `DELETE FROM todos WHERE id = ? AND deleted_at IS NOT NULL`
+	args := []any{id}
+
+	if owner > 0 {
+		query += ` AND user_id = ?`
+		args = append(args, owner)
+	}
+
+	result, err := sqlkit.Exec(ctx, st.db, query+";", args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrTodoNotDeleted
 	}
 
-	return dbops.Exec(ctx, st.db, query)
+	return nil
+}
+
+// Find returns the todo with the given id unless it is in the trash. A non-zero
+// owner limits the lookup to the todos of that user.
+func (st *SQLTodo) Find(ctx context.Context, id, owner uint64) (*domain.Todo, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Find")
+	defer span.End()
+
+	return st.find(ctx, sqlkit.Ex{"id": id, "deleted_at": nil}, owner)
+}
+
+// FindDeleted returns the todo with the given id only when it is in the trash.
+// A non-zero owner limits the lookup to the todos of that user.
+func (st *SQLTodo) FindDeleted(ctx context.Context, id, owner uint64) (*domain.Todo, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.FindDeleted")
+	defer span.End()
+
+	return st.find(ctx, sqlkit.Ex{"id": id, "deleted_at": sqlkit.Op{"isNot": nil}}, owner)
 }
 
-func (st *SQLTodo) Find(ctx context.Context, id uint64) (*domain.Todo, error) {
-	ctx, span := st.tel.Tracer().Start(ctx, "auth.outbound.SQLTodo.Find")
+// FindMember returns the todo with the given id unless it is in the trash, with
+// its collaborators. A non-zero member limits the lookup to the todos owned by
+// that user or shared with them.
+func (st *SQLTodo) FindMember(ctx context.Context, id, member uint64) (*domain.Todo, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.FindMember")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return st.qu.Select("id", "user_id", "title", "description", "status").
-			From("todos").
-			Where(goqu.Ex{"id": id}).
-			Prepared(true).
-			ToSQL()
+	exps := []sqlkit.Expression{sqlkit.Ex{"id": id, "deleted_at": nil}}
+	if member > 0 {
+		exps = append(exps, goqu.L(memberCondition, member, member))
+	}
+
+	todo, err := sqlkit.One[domain.Todo](ctx, st.db, exps...)
+	if err != nil || todo == nil {
+		return todo, err
+	}
+
+	todos := []domain.Todo{*todo}
+	if err := st.loadTags(ctx, todos); err != nil {
+		return nil, err
+	}
+
+	if err := st.loadCollaborators(ctx, todos); err != nil {
+		return nil, err
+	}
+
+	return &todos[0], nil
+}
+
+func (st *SQLTodo) find(ctx context.Context, ex sqlkit.Ex, owner uint64) (*domain.Todo, error) {
+	if owner > 0 {
+		ex["user_id"] = owner
+	}
+
+	todo, err := sqlkit.One[domain.Todo](ctx, st.db, ex)
+	if err != nil || todo == nil {
+		return todo, err
+	}
+
+	todos := []domain.Todo{*todo}
+	if err := st.loadTags(ctx, todos); err != nil {
+		return nil, err
+	}
+
+	// the events of the change made to it are streamed to the collaborators
+	if err := st.loadCollaborators(ctx, todos); err != nil {
+		return nil, err
 	}
 
-	return dbops.SQLGet[domain.Todo](ctx, st.db, query)
+	return &todos[0], nil
 }
 
+// Fetch lists the todos matching the filter in the order of its sort. A page
+// continues after the todo given by the after_* keys, so the next page stays
+// stable whatever the order is.
 func (st *SQLTodo) Fetch(ctx context.Context, filter map[string]any) ([]domain.Todo, error) {
-	ctx, span := st.tel.Tracer().Start(ctx, "auth.outbound.SQLTodo.Fetch")
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Fetch")
+	defer span.End()
+
+	conds, args := st.fetchConditions(filter)
+
+	seek, seekArgs, order := fetchSeek(filter)
+	if seek != "" {
+		conds = append(conds, seek)
+		args = append(args, seekArgs...)
+	}
+
+	query := `SELECT ` + todoColumns + ` FROM todos WHERE ` + strings.Join(conds, " AND ") + ` ORDER BY ` + order
+
+	if limit, ok := filter["limit"].(int); ok {
+		query += ` LIMIT ?`
+		args = append(args, limit+1)
+	}
+
+	var todos []domain.Todo
+	if err := st.db.Scan(ctx, &todos, st.db.Rebind(query+";"), args...); err != nil {
+		return nil, err
+	}
+
+	if err := st.loadTags(ctx, todos); err != nil {
+		return nil, err
+	}
+
+	if err := st.loadCollaborators(ctx, todos); err != nil {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+func (st *SQLTodo) fetchConditions(filter map[string]any) ([]string, []any) {
+	conds := []string{"deleted_at IS NULL"}
+	var args []any
+
+	if owner, ok := filter["user_id"].(uint64); ok && owner > 0 {
+		conds = append(conds, "user_id = ?")
+		args = append(args, owner)
+	}
+
+	if member, ok := filter["member_id"].(uint64); ok && member > 0 {
+		conds = append(conds, memberCondition)
+		args = append(args, member, member)
+	}
+
+	if status, ok := filter["status"].(enum.Enum[domain.TodoStatus]); ok {
+		conds = append(conds, "status = ?")
+		args = append(args, status)
+	}
+
+	if priority, ok := filter["priority"].(enum.Enum[domain.TodoPriority]); ok {
+		conds = append(conds, "priority = ?")
+		args = append(args, priority)
+	}
+
+	if from, ok := filter["due_from"].(time.Time); ok {
+		conds = append(conds, "due_at >= ?")
+		args = append(args, from)
+	}
+
+	if to, ok := filter["due_to"].(time.Time); ok {
+		conds = append(conds, "due_at <= ?")
+		args = append(args, to)
+	}
+
+	if query, ok := filter["query"].(string); ok {
+		// both are backed by the full-text index of the todos migration, the
+		// placeholders of the query are numbered for PostgreSQL by Fetch
+		search := `MATCH(title, description) AGAINST(? IN NATURAL LANGUAGE MODE)`
+		if st.db.Driver() == sqlkit.PostgresDriver {
+			search = `search_vector @@ plainto_tsquery('simple', ?)`
+		}
+
+		conds = append(conds, search)
+		args = append(args, query)
+	}
+
+	if tags, ok := filter["tags"].([]string); ok && len(tags) > 0 {
+		// a todo has a tag once, so counting the matches keeps the todos having all of them
+		conds = append(conds, `id IN (SELECT tt.todo_id FROM todo_tags tt JOIN tags t ON t.id = tt.tag_id `+
+			`WHERE t.name IN (?`+strings.Repeat(", ?", len(tags)-1)+`) GROUP BY tt.todo_id HAVING COUNT(*) = ?)`)
+		for _, tag := range tags {
+			args = append(args, tag)
+		}
+		args = append(args, len(tags))
+	}
+
+	return conds, args
+}
+
+// fetchSeek returns the condition that skips the todos up to the cursor of the
+// filter, and the order of the sort. The id breaks the ties of the sort key.
+func fetchSeek(filter map[string]any) (string, []any, string) {
+	sort, _ := filter["sort"].(string)
+	desc, _ := filter["desc"].(bool)
+	afterID, hasAfter := filter["after_id"].(uint64)
+
+	dir, cmp := "ASC", ">"
+	if desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	var key string
+	var value any
+
+	switch sort {
+	case "created_at":
+		key, value = "created_at", filter["after_time"]
+	case "priority":
+		key, value = priorityRank, filter["after_rank"]
+	case "due_at":
+		at, _ := filter["after_time"].(*time.Time)
+
+		return dueAtSeek(at, afterID, hasAfter, desc)
+	default:
+		order := "id " + dir
+		if !hasAfter {
+			return "", nil, order
+		}
+
+		return "id " + cmp + " ?", []any{afterID}, order
+	}
+
+	order := key + " " + dir + ", id " + dir
+	if !hasAfter {
+		return "", nil, order
+	}
+
+	seek := "(" + key + " " + cmp + " ? OR (" + key + " = ? AND id " + cmp + " ?))"
+
+	return seek, []any{value, value, afterID}, order
+}
+
+// dueAtSeek is fetchSeek for the due date, where the todos without one come last,
+// or first when descending.
+func dueAtSeek(at *time.Time, afterID uint64, hasAfter, desc bool) (string, []any, string) {
+	if !desc {
+		order := "due_at IS NULL ASC, due_at ASC, id ASC"
+		if !hasAfter {
+			return "", nil, order
+		}
+
+		if at != nil {
+			return "(due_at IS NULL OR due_at > ? OR (due_at = ? AND id > ?))", []any{*at, *at, afterID}, order
+		}
+
+		return "(due_at IS NULL AND id > ?)", []any{afterID}, order
+	}
+
+	order := "due_at IS NULL DESC, due_at DESC, id DESC"
+	if !hasAfter {
+		return "", nil, order
+	}
+
+	if at != nil {
+		return "(due_at < ? OR (due_at = ? AND id < ?))", []any{*at, *at, afterID}, order
+	}
+
+	return "(due_at IS NOT NULL OR id < ?)", []any{afterID}, order
+}
+
+// UpdateStatus writes the new status of the todo along with its history. The
+// todo must still be in the status it was read with, otherwise nothing changes
+// and ErrTodoNotUpdated is returned.
+func (st *SQLTodo) UpdateStatus(
+	ctx context.Context, todo domain.Todo, history []domain.TodoStatusChange,
+) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.UpdateStatus")
+	defer span.End()
+
+	return st.db.Transaction(ctx, func(ctx context.Context) error {
+		query := `UPDATE todos SET status = ?, completed_at = ? WHERE id = ? AND status = ? AND deleted_at IS NULL;`
+		args := []any{todo.Status, todo.CompletedAt, todo.ID, previousStatus(todo, history)}
+
+		result, err := sqlkit.Exec(ctx, st.db, query, args...)
+		if err != nil {
+			return err
+		}
+
+		if result.RowsAffected == 0 {
+			return domain.ErrTodoNotUpdated
+		}
+
+		return st.addHistory(ctx, history)
+	})
+}
+
+// Update changes the content of the todo, replaces its tags and records the
+// status changes. The owner is part of the condition and never updated, so a
+// todo can not move to another user. Like UpdateStatus, a todo no longer in the
+// status it was read with is left as is and ErrTodoNotUpdated is returned.
+func (st *SQLTodo) Update(ctx context.Context, todo domain.Todo, history []domain.TodoStatusChange) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Update")
 	defer span.End()
 
-	cursor, hasCursor := filter["cursor"].(uint64)
-	limit, hasLimit := filter["limit"].(int)
-	status, hasStatus := filter["status"].(enum.Enum[domain.TodoStatus])
+	return st.db.Transaction(ctx, func(ctx context.Context) error {
+		query := `UPDATE todos SET title = ?, description = ?, status = ?, priority = ?, due_at = ?, ` +
+			`completed_at = ? WHERE id = ? AND user_id = ? AND status = ? AND deleted_at IS NULL;`
+		args := []any{
+			todo.Title, todo.Description, todo.Status, todo.Priority,
+			todo.DueAt, todo.CompletedAt, todo.ID, todo.UserID, previousStatus(todo, history),
+		}
 
-	query := func() (string, []any, error) {
-		q := st.qu.Select("id", "user_id", "title", "description", "status").
-			From("todos")
+		result, err := sqlkit.Exec(ctx, st.db, query, args...)
+		if err != nil {
+			return err
+		}
 
-		if hasCursor && cursor > 0 {
-			q = q.Where(goqu.Ex{"id": goqu.Op{"gt": cursor}})
+		if result.RowsAffected == 0 {
+			return domain.ErrTodoNotUpdated
 		}
 
-		if hasStatus {
-			q = q.Where(goqu.Ex{"status": status})
+		if _, err := sqlkit.Exec(ctx, st.db, `DELETE FROM todo_tags WHERE todo_id = ?;`, todo.ID); err != nil {
+			return err
 		}
 
-		if hasLimit {
-			q = q.Limit(uint(limit + 1))
+		if err := st.addTags(ctx, todo); err != nil {
+			return err
 		}
 
-		return q.Prepared(true).ToSQL()
+		return st.addHistory(ctx, history)
+	})
+}
+
+// previousStatus is the status the todo was read with: the one the first change
+// moved it from, or its status when it did not change.
+func previousStatus(todo domain.Todo, history []domain.TodoStatusChange) enum.Enum[domain.TodoStatus] {
+	if len(history) > 0 {
+		return history[0].From
+	}
+
+	return todo.Status
+}
+
+// History returns the status changes of the todo, oldest first.
+func (st *SQLTodo) History(ctx context.Context, todoID uint64) ([]domain.TodoStatusChange, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.History")
+	defer span.End()
+
+	query := `SELECT id, todo_id, from_status, to_status, changed_by, changed_at FROM todo_status_history ` +
+		`WHERE todo_id = ? ORDER BY changed_at, id;`
+
+	var changes []domain.TodoStatusChange
+	if err := st.db.Scan(ctx, &changes, query, todoID); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Share invites the email to the todo. Inviting an email again only changes its
+// role, an accepted invitation stays accepted.
+func (st *SQLTodo) Share(ctx context.Context, c domain.TodoCollaborator) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Share")
+	defer span.End()
+
+	query := `INSERT INTO todo_collaborators(todo_id, email, role, invited_by, invited_at) ` +
+		`VALUES(?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE role = VALUES(role);`
+	if st.db.Driver() == sqlkit.PostgresDriver {
+		query = `INSERT INTO todo_collaborators(todo_id, email, role, invited_by, invited_at) ` +
+			`VALUES($1, $2, $3, $4, $5) ON CONFLICT (todo_id, email) DO UPDATE SET role = EXCLUDED.role;`
+	}
+
+	_, err := sqlkit.Exec(ctx, st.db, query, c.TodoID, c.Email, c.Role, c.InvitedBy, c.InvitedAt)
+
+	return err
+}
+
+// Unshare removes the collaborator, or the invitation when it is still pending.
+func (st *SQLTodo) Unshare(ctx context.Context, todoID uint64, email string) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Unshare")
+	defer span.End()
+
+	query := `DELETE FROM todo_collaborators WHERE todo_id = ? AND email = ?;`
+
+	result, err := sqlkit.Exec(ctx, st.db, query, todoID, email)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCollaboratorNotFound
+	}
+
+	return nil
+}
+
+// Accept links the pending invitation of the email to the user.
+func (st *SQLTodo) Accept(
+	ctx context.Context, todoID uint64, email string, userID uint64, at time.Time,
+) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Accept")
+	defer span.End()
+
+	query := `UPDATE todo_collaborators SET user_id = ?, accepted_at = ? ` +
+		`WHERE todo_id = ? AND email = ? AND accepted_at IS NULL;`
+
+	result, err := sqlkit.Exec(ctx, st.db, query, userID, at, todoID, email)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCollaboratorNotFound
 	}
 
-	return dbops.SQLGets[domain.Todo](ctx, st.db, query)
+	return nil
+}
+
+// AddAttachment saves the metadata of a file stored in the blob store.
+func (st *SQLTodo) AddAttachment(ctx context.Context, a domain.TodoAttachment) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.AddAttachment")
+	defer span.End()
+
+	query := `INSERT INTO todo_attachments(id, todo_id, filename, content_type, size, storage_key, ` +
+		`created_by, created_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?);`
+	args := []any{a.ID, a.TodoID, a.Filename, a.ContentType, a.Size, a.StorageKey, a.CreatedBy, a.CreatedAt}
+
+	_, err := sqlkit.Exec(ctx, st.db, query, args...)
+
+	return err
 }
 
-func (st *SQLTodo) UpdateStatus(ctx context.Context, id uint64, sts enum.Enum[domain.TodoStatus]) error {
-	ctx, span := st.tel.Tracer().Start(ctx, "auth.outbound.SQLTodo.UpdateStatus")
+// Attachments returns the attachments of the todo, oldest first.
+func (st *SQLTodo) Attachments(ctx context.Context, todoID uint64) ([]domain.TodoAttachment, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.Attachments")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return st.qu.Update("todos").
-			Set(map[string]any{"status": sts}).
-			Where(goqu.Ex{"id": id}).
-			Prepared(true).
-			ToSQL()
+	query := `SELECT id, todo_id, filename, content_type, size, storage_key, created_by, created_at ` +
+		`FROM todo_attachments WHERE todo_id = ? ORDER BY created_at, id;`
+
+	var attachments []domain.TodoAttachment
+	if err := st.db.Scan(ctx, &attachments, query, todoID); err != nil {
+		return nil, err
 	}
 
-	return dbops.Exec(ctx, st.db, query)
+	return attachments, nil
 }
 
-func (st *SQLTodo) Update(ctx context.Context, todo domain.Todo) error {
-	ctx, span := st.tel.Tracer().Start(ctx, "auth.outbound.SQLTodo.Update")
+// FindAttachment returns the attachment when it belongs to the todo.
+func (st *SQLTodo) FindAttachment(ctx context.Context, todoID, id uint64) (*domain.TodoAttachment, error) {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.FindAttachment")
 	defer span.End()
 
-	query := func() (string, []any, error) {
-		return st.qu.Update("todos").
-			Set(map[string]any{
-				"user_id":     todo.UserID,
-				"title":       todo.Title,
-				"description": todo.Description,
-				"status":      todo.Status,
-			}).
-			Where(goqu.Ex{"id": todo.ID}).
-			Prepared(true).
-			ToSQL()
+	return sqlkit.One[domain.TodoAttachment](ctx, st.db, sqlkit.Ex{"id": id, "todo_id": todoID})
+}
+
+// DeleteAttachment removes the metadata of the attachment, the blob is left to
+// the caller.
+func (st *SQLTodo) DeleteAttachment(ctx context.Context, todoID, id uint64) error {
+	ctx, span := st.telemetry.Tracer().Start(ctx, "todo.outbound.SQLTodo.DeleteAttachment")
+	defer span.End()
+
+	query := `DELETE FROM todo_attachments WHERE id = ? AND todo_id = ?;`
+
+	_, err := sqlkit.Exec(ctx, st.db, query, id, todoID)
+
+	return err
+}
+
+// addHistory records the status changes. It runs in the transaction that
+// writes the status.
+func (st *SQLTodo) addHistory(ctx context.Context, history []domain.TodoStatusChange) error {
+	for _, change := range history {
+		query := `INSERT INTO todo_status_history(id, todo_id, from_status, to_status, changed_by, changed_at) ` +
+			`VALUES(?, ?, ?, ?, ?, ?);`
+		args := []any{
+			st.uidnumber.Generate(), change.TodoID, change.From,
+			change.To, change.ChangedBy, change.ChangedAt,
+		}
+
+		if _, err := sqlkit.Exec(ctx, st.db, query, args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addTags links the todo to its tags, creating the tags its owner does not have
+// yet. It runs in the transaction that writes the todo.
+func (st *SQLTodo) addTags(ctx context.Context, todo domain.Todo) error {
+	for _, name := range todo.Tags {
+		var tagID uint64
+		err := st.db.Scan(ctx, &tagID, `SELECT id FROM tags WHERE user_id = ? AND name = ?;`, todo.UserID, name)
+		if errors.Is(err, sql.ErrNoRows) {
+			tagID = st.uidnumber.Generate()
+			query := `INSERT INTO tags(id, user_id, name) VALUES(?, ?, ?);`
+			_, err = sqlkit.Exec(ctx, st.db, query, tagID, todo.UserID, name)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		query := `INSERT INTO todo_tags(todo_id, tag_id) VALUES(?, ?);`
+		if _, err := sqlkit.Exec(ctx, st.db, query, todo.ID, tagID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadTags fills the tags of the todos with a single query.
+func (st *SQLTodo) loadTags(ctx context.Context, todos []domain.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, len(todos))
+	for _, todo := range todos {
+		args = append(args, todo.ID)
+	}
+
+	query := `SELECT tt.todo_id, t.name FROM todo_tags tt JOIN tags t ON t.id = tt.tag_id ` +
+		`WHERE tt.todo_id IN (?` + strings.Repeat(", ?", len(todos)-1) + `) ORDER BY t.name;`
+
+	var rows []todoTag
+	if err := st.db.Scan(ctx, &rows, st.db.Rebind(query), args...); err != nil {
+		return err
+	}
+
+	tags := make(map[uint64][]string)
+	for _, row := range rows {
+		tags[row.TodoID] = append(tags[row.TodoID], row.Name)
+	}
+
+	for i := range todos {
+		todos[i].Tags = tags[todos[i].ID]
+	}
+
+	return nil
+}
+
+// loadCollaborators fills the collaborators of the todos with a single query.
+func (st *SQLTodo) loadCollaborators(ctx context.Context, todos []domain.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, len(todos))
+	for _, todo := range todos {
+		args = append(args, todo.ID)
+	}
+
+	query := `SELECT todo_id, email, user_id, role, invited_by, invited_at, accepted_at ` +
+		`FROM todo_collaborators WHERE todo_id IN (?` + strings.Repeat(", ?", len(todos)-1) + `) ` +
+		`ORDER BY invited_at, email;`
+
+	var rows []domain.TodoCollaborator
+	if err := st.db.Scan(ctx, &rows, st.db.Rebind(query), args...); err != nil {
+		return err
+	}
+
+	collaborators := make(map[uint64][]domain.TodoCollaborator)
+	for _, row := range rows {
+		collaborators[row.TodoID] = append(collaborators[row.TodoID], row)
+	}
+
+	for i := range todos {
+		todos[i].Collaborators = collaborators[todos[i].ID]
 	}
 
-	return dbops.Exec(ctx, st.db, query)
+	return nil
 }
@@ -2,23 +2,31 @@ package outbound
 
 import (
 	"context"
-	"database/sql"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
-	"github.com/doug-martin/goqu/v9"
 	"github.com/shandysiswandi/goreng/enum"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"github.com/stretchr/testify/assert"
 )
 
+var (
+	todoRowColumns = []string{
+		"id", "user_id", "title", "description", "status", "priority", "due_at", "completed_at", "deleted_at",
+		"created_at",
+	}
+	todoCreatedAt = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+)
+
 func TestNewSQLTodo(t *testing.T) {
 	type args struct {
-		db  *sql.DB
-		qu  goqu.DialectWrapper
-		tel *telemetry.Telemetry
+		db  *sqlkit.DB
+		tel *lib.Telemetry
 	}
 	tests := []struct {
 		name string
@@ -26,33 +34,36 @@ func TestNewSQLTodo(t *testing.T) {
 		want *SQLTodo
 	}{
 		{
-			name: "SuccessMySQL",
+			name: "Success",
 			args: args{
-				db: &sql.DB{},
-				qu: goqu.Dialect(dbops.MySQLDriver),
+				db:  &sqlkit.DB{},
+				tel: lib.NewTelemetry(),
 			},
-			want: &SQLTodo{db: &sql.DB{}, qu: goqu.Dialect(dbops.MySQLDriver)},
-		},
-		{
-			name: "SuccessPostgres",
-			args: args{
-				db: &sql.DB{},
-				qu: goqu.Dialect(dbops.PostgresDriver),
+			want: &SQLTodo{
+				db:        &sqlkit.DB{},
+				telemetry: lib.NewTelemetry(),
 			},
-			want: &SQLTodo{db: &sql.DB{}, qu: goqu.Dialect(dbops.PostgresDriver)},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := NewSQLTodo(tt.args.db, tt.args.qu, tt.args.tel)
+			got := NewSQLTodo(tt.args.db, nil, tt.args.tel)
 			assert.Equal(t, tt.want.db, got.db)
-			assert.Equal(t, tt.want.qu, got.qu)
+			assert.Equal(t, tt.want.telemetry, got.telemetry)
 		})
 	}
 }
 
 func TestSQLTodo_Create(t *testing.T) {
+	tel := lib.NewTelemetry()
+	due := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := regexp.QuoteMeta(`INSERT INTO todos(id, user_id, title, description, status, priority, due_at, ` +
+		`completed_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?);`)
+	queryFindTag := regexp.QuoteMeta(`SELECT id FROM tags WHERE user_id = ? AND name = ?;`)
+	queryAddTag := regexp.QuoteMeta(`INSERT INTO tags(id, user_id, name) VALUES(?, ?, ?);`)
+	queryLinkTag := regexp.QuoteMeta(`INSERT INTO todo_tags(todo_id, tag_id) VALUES(?, ?);`)
+
 	type args struct {
 		ctx  context.Context
 		todo domain.Todo
@@ -64,68 +75,106 @@ func TestSQLTodo_Create(t *testing.T) {
 		mockFn  func(a args) (*SQLTodo, func() error)
 	}{
 		{
-			name:    "Error",
-			args:    args{ctx: context.Background(), todo: domain.Todo{}},
+			name: "ErrorWhenExec",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusInitiate), Priority: enum.New(domain.TodoPriorityMedium),
+			}},
 			wantErr: assert.AnError,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Insert("todos").
-					Cols("id", "user_id", "title", "description", "status").
-					Vals([]any{a.todo.ID, a.todo.UserID, a.todo.Title, a.todo.Description, a.todo.Status}).
-					Prepared(true).ToSQL()
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				mock.ExpectExec(query).WithArgs(dbops.AnyToValue(args)...).WillReturnError(assert.AnError)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.ID, a.todo.UserID, a.todo.Title, a.todo.Description, "INITIATE", "MEDIUM", nil, nil).
+					WillReturnError(assert.AnError)
+				mock.ExpectRollback()
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name:    "SuccessButNoAffected",
-			args:    args{ctx: context.Background(), todo: domain.Todo{}},
+			name: "ErrorNoRowsAffected",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusInitiate), Priority: enum.New(domain.TodoPriorityMedium),
+			}},
 			wantErr: domain.ErrTodoNotCreated,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Insert("todos").
-					Cols("id", "user_id", "title", "description", "status").
-					Vals([]any{a.todo.ID, a.todo.UserID, a.todo.Title, a.todo.Description, a.todo.Status}).
-					Prepared(true).ToSQL()
-
-				mock.ExpectExec(query).WithArgs(dbops.AnyToValue(args)...).
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.ID, a.todo.UserID, a.todo.Title, a.todo.Description, "INITIATE", "MEDIUM", nil, nil).
 					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name:    "Success",
-			args:    args{ctx: context.Background(), todo: domain.Todo{}},
+			name: "ErrorWhenLinkTag",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusInitiate), Priority: enum.New(domain.TodoPriorityMedium),
+				Tags: []string{"work"},
+			}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.ID, a.todo.UserID, a.todo.Title, a.todo.Description, "INITIATE", "MEDIUM", nil, nil).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(queryFindTag).
+					WithArgs(a.todo.UserID, "work").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectExec(queryLinkTag).
+					WithArgs(a.todo.ID, 7).
+					WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusInitiate), Priority: enum.New(domain.TodoPriorityHigh),
+				DueAt: &due, Tags: []string{"home", "work"},
+			}},
 			wantErr: nil,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+				uidMock := mocker.NewMockNumberID(t)
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Insert("todos").
-					Cols("id", "user_id", "title", "description", "status").
-					Vals([]any{a.todo.ID, a.todo.UserID, a.todo.Title, a.todo.Description, a.todo.Status}).
-					Prepared(true).ToSQL()
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.ID, a.todo.UserID, a.todo.Title, a.todo.Description, "INITIATE", "HIGH", due, nil).
+					WillReturnResult(sqlmock.NewResult(1, 1))
 
-				mock.ExpectExec(query).WithArgs(dbops.AnyToValue(args)...).
+				// home is new, work is already a tag of the user
+				mock.ExpectQuery(queryFindTag).
+					WithArgs(a.todo.UserID, "home").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				uidMock.EXPECT().Generate().Return(100)
+				mock.ExpectExec(queryAddTag).
+					WithArgs(100, a.todo.UserID, "home").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(queryLinkTag).
+					WithArgs(a.todo.ID, 100).
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(queryFindTag).
+					WithArgs(a.todo.UserID, "work").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectExec(queryLinkTag).
+					WithArgs(a.todo.ID, 7).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), uidnumber: uidMock, telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -134,6 +183,7 @@ func TestSQLTodo_Create(t *testing.T) {
 			t.Parallel()
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
+
 			err := s.Create(tt.args.ctx, tt.args.todo)
 			assert.Equal(t, tt.wantErr, err)
 		})
@@ -141,54 +191,75 @@ func TestSQLTodo_Create(t *testing.T) {
 }
 
 func TestSQLTodo_Delete(t *testing.T) {
+	tel := lib.NewTelemetry()
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := `UPDATE todos SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`
+
 	type args struct {
-		ctx context.Context
-		id  uint64
+		ctx   context.Context
+		id    uint64
+		owner uint64
 	}
 	tests := []struct {
 		name    string
-		mockFn  func(a args) (*SQLTodo, func() error)
 		args    args
 		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
 	}{
 		{
-			name:    "Error",
-			args:    args{ctx: context.Background(), id: 1},
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
 			wantErr: assert.AnError,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Delete("todos").
-					Where(goqu.Ex{"id": a.id}).Prepared(true).ToSQL()
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				mock.ExpectExec(query).WithArgs(dbops.AnyToValue(args)...).
+				mock.ExpectExec(regexp.QuoteMeta(query+` AND user_id = ?;`)).
+					WithArgs(at, a.id, a.owner).
 					WillReturnError(assert.AnError)
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name:    "Success",
-			args:    args{ctx: context.Background(), id: 1},
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
+			wantErr: domain.ErrTodoNotDeleted,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(regexp.QuoteMeta(query+` AND user_id = ?;`)).
+					WithArgs(at, a.id, a.owner).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "SuccessWithOwner",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
 			wantErr: nil,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Delete("todos").
-					Where(goqu.Ex{"id": a.id}).Prepared(true).ToSQL()
+				mock.ExpectExec(regexp.QuoteMeta(query+` AND user_id = ?;`)).
+					WithArgs(at, a.id, a.owner).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 
-				mock.ExpectExec(query).WithArgs(dbops.AnyToValue(args)...).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "SuccessWithoutOwner",
+			args:    args{ctx: context.Background(), id: 1},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				mock.ExpectExec(regexp.QuoteMeta(query+`;`)).
+					WithArgs(at, a.id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -197,73 +268,68 @@ func TestSQLTodo_Delete(t *testing.T) {
 			t.Parallel()
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
-			err := s.Delete(tt.args.ctx, tt.args.id)
+
+			err := s.Delete(tt.args.ctx, tt.args.id, tt.args.owner, at)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
 }
 
-func TestSQLTodo_Find(t *testing.T) {
+func TestSQLTodo_Restore(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := `UPDATE todos SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`
+
 	type args struct {
-		ctx context.Context
-		id  uint64
+		ctx   context.Context
+		id    uint64
+		owner uint64
 	}
 	tests := []struct {
 		name    string
-		mockFn  func(a args) (*SQLTodo, func() error)
 		args    args
-		want    *domain.Todo
 		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
 	}{
 		{
-			name:    "Error",
-			args:    args{ctx: context.Background(), id: 1},
-			want:    nil,
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
 			wantErr: assert.AnError,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Select("id", "user_id", "title", "description", "status").
-					From("todos").Where(goqu.Ex{"id": a.id}).Prepared(true).ToSQL()
-
-				mock.ExpectQuery(query).WithArgs(dbops.AnyToValue(args)...).
+				mock.ExpectExec(regexp.QuoteMeta(query+` AND user_id = ?;`)).
+					WithArgs(a.id, a.owner).
 					WillReturnError(assert.AnError)
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name: "Success",
-			args: args{ctx: context.Background(), id: 1},
-			want: &domain.Todo{
-				ID:          1,
-				UserID:      11,
-				Title:       "title test",
-				Description: "description test",
-				Status:      enum.New(domain.TodoStatusInProgress),
-			},
-			wantErr: nil,
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
+			wantErr: domain.ErrTodoNotUpdated,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Select("id", "user_id", "title", "description", "status").
-					From("todos").Where(goqu.Ex{"id": a.id}).Prepared(true).ToSQL()
+				mock.ExpectExec(regexp.QuoteMeta(query+` AND user_id = ?;`)).
+					WithArgs(a.id, a.owner).
+					WillReturnResult(sqlmock.NewResult(0, 0))
 
-				row := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "status"}).
-					AddRow(1, 11, "title test", "description test", "IN_PROGRESS")
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "SuccessWithoutOwner",
+			args:    args{ctx: context.Background(), id: 1},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				mock.ExpectQuery(query).WithArgs(dbops.AnyToValue(args)...).
-					WillReturnRows(row)
+				mock.ExpectExec(regexp.QuoteMeta(query + `;`)).
+					WithArgs(a.id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -272,134 +338,68 @@ func TestSQLTodo_Find(t *testing.T) {
 			t.Parallel()
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
-			got, err := s.Find(tt.args.ctx, tt.args.id)
+
+			err := s.Restore(tt.args.ctx, tt.args.id, tt.args.owner)
 			assert.Equal(t, tt.wantErr, err)
-			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestSQLTodo_Fetch(t *testing.T) {
+func TestSQLTodo_Purge(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := `DELETE FROM todos WHERE id = ? AND deleted_at IS NOT NULL`
+
 	type args struct {
-		ctx context.Context
-		in  map[string]any
+		ctx   context.Context
+		id    uint64
+		owner uint64
 	}
 	tests := []struct {
 		name    string
-		mockFn  func(a args) (*SQLTodo, func() error)
 		args    args
-		want    []domain.Todo
 		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
 	}{
 		{
-			name:    "Error",
-			args:    args{ctx: context.Background(), in: make(map[string]any)},
-			want:    nil,
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
 			wantErr: assert.AnError,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Select("id", "user_id", "title", "description", "status").
-					From("todos").Prepared(true).ToSQL()
-
-				mock.ExpectQuery(query).WithArgs(dbops.AnyToValue(args)...).
+				mock.ExpectExec(regexp.QuoteMeta(query+` AND user_id = ?;`)).
+					WithArgs(a.id, a.owner).
 					WillReturnError(assert.AnError)
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name: "SuccessWithoutFilter",
-			args: args{ctx: context.Background(), in: make(map[string]any)},
-			want: []domain.Todo{
-				{
-					ID:          1,
-					UserID:      12,
-					Title:       "title test",
-					Description: "description test",
-					Status:      enum.New(domain.TodoStatusDrop),
-				}, {
-					ID:          2,
-					UserID:      13,
-					Title:       "title test 2",
-					Description: "description test 2",
-					Status:      enum.New(domain.TodoStatusInitiate),
-				},
-			},
-			wantErr: nil,
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
+			wantErr: domain.ErrTodoNotDeleted,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Select("id", "user_id", "title", "description", "status").
-					From("todos").Prepared(true).ToSQL()
-
-				rows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "status"}).
-					AddRow(1, 12, "title test", "description test", "DROP").
-					AddRow(2, 13, "title test 2", "description test 2", "INITIATE")
-
-				mock.ExpectQuery(query).WithArgs(dbops.AnyToValue(args)...).
-					WillReturnRows(rows)
+				mock.ExpectExec(regexp.QuoteMeta(query+` AND user_id = ?;`)).
+					WithArgs(a.id, a.owner).
+					WillReturnResult(sqlmock.NewResult(0, 0))
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name: "SuccessWithFilter",
-			args: args{ctx: context.Background(), in: map[string]any{
-				"cursor": uint64(1),
-				"limit":  int(1),
-				"status": enum.New(domain.TodoStatusDone),
-			}},
-			want: []domain.Todo{
-				{
-					ID:          1,
-					UserID:      12,
-					Title:       "title test",
-					Description: "description test",
-					Status:      enum.New(domain.TodoStatusDrop),
-				},
-				{
-					ID:          2,
-					UserID:      13,
-					Title:       "title test 2",
-					Description: "description test 2",
-					Status:      enum.New(domain.TodoStatusInitiate),
-				},
-			},
+			name:    "SuccessWithoutOwner",
+			args:    args{ctx: context.Background(), id: 1},
 			wantErr: nil,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).
-					Select("id", "user_id", "title", "description", "status").
-					From("todos").
-					Where(goqu.Ex{"id": goqu.Op{"gt": 1}}).
-					Where(goqu.Ex{"status": "DONE"}).
-					Limit(2).
-					Prepared(true).
-					ToSQL()
-
-				rows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "status"}).
-					AddRow(1, 12, "title test", "description test", "DROP").
-					AddRow(2, 13, "title test 2", "description test 2", "INITIATE")
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				mock.ExpectQuery(query).
-					WithArgs(dbops.AnyToValue(args)...).
-					WillReturnRows(rows)
+				mock.ExpectExec(regexp.QuoteMeta(query + `;`)).
+					WithArgs(a.id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -408,73 +408,170 @@ func TestSQLTodo_Fetch(t *testing.T) {
 			t.Parallel()
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
-			got, err := s.Fetch(tt.args.ctx, tt.args.in)
+
+			err := s.Purge(tt.args.ctx, tt.args.id, tt.args.owner)
 			assert.Equal(t, tt.wantErr, err)
-			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestSQLTodo_UpdateStatus(t *testing.T) {
+func TestSQLTodo_Find(t *testing.T) {
+	tel := lib.NewTelemetry()
+	queryTags := regexp.QuoteMeta(`SELECT tt.todo_id, t.name FROM todo_tags tt ` +
+		`JOIN tags t ON t.id = tt.tag_id WHERE tt.todo_id IN (?) ORDER BY t.name;`)
+	queryCollaborators := regexp.QuoteMeta(`SELECT todo_id, email, user_id, role, invited_by, invited_at, ` +
+		`accepted_at FROM todo_collaborators WHERE todo_id IN (?) ORDER BY invited_at, email;`)
+
 	type args struct {
-		ctx context.Context
-		id  uint64
-		sts enum.Enum[domain.TodoStatus]
+		ctx   context.Context
+		id    uint64
+		owner uint64
 	}
 	tests := []struct {
 		name    string
-		mockFn  func(a args) (*SQLTodo, func() error)
 		args    args
+		want    *domain.Todo
 		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
 	}{
 		{
-			name: "Error",
-			args: args{
-				ctx: context.Background(),
-				id:  1,
-				sts: enum.New(domain.TodoStatusDrop),
+			name:    "ErrorWhenQuery",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "todos" WHERE (("deleted_at" IS NULL) AND ("id" = 1) ` +
+					`AND ("user_id" = 12)) LIMIT 1`)).
+					WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "NotFound",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
+			want:    nil,
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "todos" WHERE (("deleted_at" IS NULL) AND ("id" = 1) ` +
+					`AND ("user_id" = 12)) LIMIT 1`)).
+					WillReturnRows(sqlmock.NewRows(todoRowColumns))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
+		},
+		{
+			name:    "ErrorWhenQueryTags",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
+			want:    nil,
 			wantErr: assert.AnError,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Update("todos").
-					Set(map[string]any{"status": a.sts}).
-					Where(goqu.Ex{"id": a.id}).Prepared(true).ToSQL()
+				row := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt)
 
-				mock.ExpectExec(query).WithArgs(dbops.AnyToValue(args)...).
-					WillReturnError(assert.AnError)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "todos" WHERE (("deleted_at" IS NULL) AND ("id" = 1) ` +
+					`AND ("user_id" = 12)) LIMIT 1`)).
+					WillReturnRows(row)
+				mock.ExpectQuery(queryTags).WithArgs(1).WillReturnError(assert.AnError)
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name: "Success",
-			args: args{
-				ctx: context.Background(),
-				id:  1,
-				sts: enum.New(domain.TodoStatusDone),
+			name:    "ErrorWhenQueryCollaborators",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				row := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "todos" WHERE (("deleted_at" IS NULL) AND ("id" = 1) ` +
+					`AND ("user_id" = 12)) LIMIT 1`)).
+					WillReturnRows(row)
+				mock.ExpectQuery(queryTags).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"todo_id", "name"}))
+				mock.ExpectQuery(queryCollaborators).WithArgs(1).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "SuccessWithOwner",
+			args: args{ctx: context.Background(), id: 1, owner: 12},
+			want: &domain.Todo{
+				ID:          1,
+				UserID:      12,
+				Title:       "title test",
+				Description: "description test",
+				Status:      enum.New(domain.TodoStatusDrop),
+				Priority:    enum.New(domain.TodoPriorityLow),
+				CreatedAt:   todoCreatedAt,
+				Tags:        []string{"home", "work"},
+				Collaborators: []domain.TodoCollaborator{{
+					TodoID:     1,
+					Email:      "friend@example.com",
+					UserID:     13,
+					Role:       enum.New(domain.TodoRoleViewer),
+					InvitedBy:  12,
+					InvitedAt:  todoCreatedAt,
+					AcceptedAt: &todoCreatedAt,
+				}},
 			},
 			wantErr: nil,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Update("todos").
-					Set(map[string]any{"status": a.sts}).
-					Where(goqu.Ex{"id": a.id}).Prepared(true).ToSQL()
+				row := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt)
+				tags := sqlmock.NewRows([]string{"todo_id", "name"}).
+					AddRow(1, "home").
+					AddRow(1, "work")
+				collaborators := sqlmock.NewRows([]string{
+					"todo_id", "email", "user_id", "role", "invited_by", "invited_at", "accepted_at",
+				}).AddRow(1, "friend@example.com", 13, "VIEWER", 12, todoCreatedAt, todoCreatedAt)
 
-				mock.ExpectExec(query).WithArgs(dbops.AnyToValue(args)...).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "todos" WHERE (("deleted_at" IS NULL) AND ("id" = 1) ` +
+					`AND ("user_id" = 12)) LIMIT 1`)).
+					WillReturnRows(row)
+				mock.ExpectQuery(queryTags).WithArgs(1).WillReturnRows(tags)
+				mock.ExpectQuery(queryCollaborators).WithArgs(1).WillReturnRows(collaborators)
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "SuccessWithoutOwner",
+			args: args{ctx: context.Background(), id: 1},
+			want: &domain.Todo{
+				ID:          1,
+				UserID:      12,
+				Title:       "title test",
+				Description: "description test",
+				Status:      enum.New(domain.TodoStatusDrop),
+				Priority:    enum.New(domain.TodoPriorityLow),
+				CreatedAt:   todoCreatedAt,
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				row := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "todos" WHERE (("deleted_at" IS NULL) AND ("id" = 1)) LIMIT 1`)).
+					WillReturnRows(row)
+				mock.ExpectQuery(queryTags).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"todo_id", "name"}))
+				mock.ExpectQuery(queryCollaborators).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"todo_id"}))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -483,69 +580,76 @@ func TestSQLTodo_UpdateStatus(t *testing.T) {
 			t.Parallel()
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
-			err := s.UpdateStatus(tt.args.ctx, tt.args.id, tt.args.sts)
+
+			got, err := s.Find(tt.args.ctx, tt.args.id, tt.args.owner)
 			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestSQLTodo_Update(t *testing.T) {
+func TestSQLTodo_FindDeleted(t *testing.T) {
+	tel := lib.NewTelemetry()
+	deletedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
 	type args struct {
-		ctx  context.Context
-		todo domain.Todo
+		ctx   context.Context
+		id    uint64
+		owner uint64
 	}
 	tests := []struct {
 		name    string
-		mockFn  func(a args) (*SQLTodo, func() error)
 		args    args
+		want    *domain.Todo
 		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
 	}{
 		{
-			name:    "Error",
-			args:    args{ctx: context.Background(), todo: domain.Todo{}},
-			wantErr: assert.AnError,
+			name:    "NotFound",
+			args:    args{ctx: context.Background(), id: 1, owner: 12},
+			want:    nil,
+			wantErr: nil,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Update("todos").Set(map[string]any{
-					"user_id":     a.todo.UserID,
-					"title":       a.todo.Title,
-					"description": a.todo.Description,
-					"status":      a.todo.Status,
-				}).Where(goqu.Ex{"id": a.todo.ID}).Prepared(true).ToSQL()
-
-				mock.ExpectExec(query).WithArgs(dbops.AnyToValue(args)...).
-					WillReturnError(assert.AnError)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "todos" WHERE (("deleted_at" IS NOT NULL) AND ("id" = 1) ` +
+					`AND ("user_id" = 12)) LIMIT 1`)).
+					WillReturnRows(sqlmock.NewRows(todoRowColumns))
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 		{
-			name:    "Success",
-			args:    args{ctx: context.Background(), todo: domain.Todo{}},
+			name: "Success",
+			args: args{ctx: context.Background(), id: 1, owner: 12},
+			want: &domain.Todo{
+				ID:          1,
+				UserID:      12,
+				Title:       "title test",
+				Description: "description test",
+				Status:      enum.New(domain.TodoStatusDrop),
+				Priority:    enum.New(domain.TodoPriorityLow),
+				CreatedAt:   todoCreatedAt,
+				DeletedAt:   &deletedAt,
+			},
 			wantErr: nil,
 			mockFn: func(a args) (*SQLTodo, func() error) {
-				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
 
-				query, args, _ := goqu.Dialect(dbops.PostgresDriver).Update("todos").Set(map[string]any{
-					"user_id":     a.todo.UserID,
-					"title":       a.todo.Title,
-					"description": a.todo.Description,
-					"status":      a.todo.Status,
-				}).Where(goqu.Ex{"id": a.todo.ID}).Prepared(true).ToSQL()
+				row := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, deletedAt, todoCreatedAt)
 
-				mock.ExpectExec(query).WithArgs(dbops.AnyToValue(args)...).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "todos" WHERE (("deleted_at" IS NOT NULL) AND ("id" = 1) ` +
+					`AND ("user_id" = 12)) LIMIT 1`)).
+					WillReturnRows(row)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM todo_tags`)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"todo_id", "name"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM todo_collaborators`)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"todo_id"}))
 
-				return &SQLTodo{
-					db:  db,
-					qu:  goqu.Dialect(dbops.PostgresDriver),
-					tel: telemetry.NewTelemetry(),
-				}, db.Close
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
 			},
 		},
 	}
@@ -554,7 +658,1369 @@ func TestSQLTodo_Update(t *testing.T) {
 			t.Parallel()
 			s, dbMockCloser := tt.mockFn(tt.args)
 			defer dbMockCloser()
-			err := s.Update(tt.args.ctx, tt.args.todo)
+
+			got, err := s.FindDeleted(tt.args.ctx, tt.args.id, tt.args.owner)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLTodo_FindMember(t *testing.T) {
+	tel := lib.NewTelemetry()
+	acceptedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	queryMember := regexp.QuoteMeta(`FROM "todos" WHERE ((("deleted_at" IS NULL) AND ("id" = 1)) ` +
+		`AND (user_id = 13 OR id IN (SELECT todo_id FROM todo_collaborators WHERE user_id = 13 ` +
+		`AND accepted_at IS NOT NULL))) LIMIT 1`)
+	queryTags := regexp.QuoteMeta(`SELECT tt.todo_id, t.name FROM todo_tags tt ` +
+		`JOIN tags t ON t.id = tt.tag_id WHERE tt.todo_id IN (?) ORDER BY t.name;`)
+	queryCollaborators := regexp.QuoteMeta(`SELECT todo_id, email, user_id, role, invited_by, invited_at, ` +
+		`accepted_at FROM todo_collaborators WHERE todo_id IN (?) ORDER BY invited_at, email;`)
+
+	type args struct {
+		ctx    context.Context
+		id     uint64
+		member uint64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.Todo
+		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
+	}{
+		{
+			name:    "ErrorWhenQuery",
+			args:    args{ctx: context.Background(), id: 1, member: 13},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(queryMember).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "NotFound",
+			args:    args{ctx: context.Background(), id: 1, member: 13},
+			want:    nil,
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(queryMember).WillReturnRows(sqlmock.NewRows(todoRowColumns))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorWhenQueryTags",
+			args:    args{ctx: context.Background(), id: 1, member: 13},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				row := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt)
+
+				mock.ExpectQuery(queryMember).WillReturnRows(row)
+				mock.ExpectQuery(queryTags).WithArgs(1).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorWhenQueryCollaborators",
+			args:    args{ctx: context.Background(), id: 1, member: 13},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				row := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt)
+
+				mock.ExpectQuery(queryMember).WillReturnRows(row)
+				mock.ExpectQuery(queryTags).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"todo_id", "name"}))
+				mock.ExpectQuery(queryCollaborators).WithArgs(1).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "SuccessWithMember",
+			args: args{ctx: context.Background(), id: 1, member: 13},
+			want: &domain.Todo{
+				ID:          1,
+				UserID:      12,
+				Title:       "title test",
+				Description: "description test",
+				Status:      enum.New(domain.TodoStatusDrop),
+				Priority:    enum.New(domain.TodoPriorityLow),
+				CreatedAt:   todoCreatedAt,
+				Tags:        []string{"work"},
+				Collaborators: []domain.TodoCollaborator{{
+					TodoID:     1,
+					Email:      "friend@example.com",
+					UserID:     13,
+					Role:       enum.New(domain.TodoRoleEditor),
+					InvitedBy:  12,
+					InvitedAt:  todoCreatedAt,
+					AcceptedAt: &acceptedAt,
+				}},
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				row := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt)
+				tags := sqlmock.NewRows([]string{"todo_id", "name"}).AddRow(1, "work")
+				collaborators := sqlmock.NewRows([]string{
+					"todo_id", "email", "user_id", "role", "invited_by", "invited_at", "accepted_at",
+				}).AddRow(1, "friend@example.com", 13, "EDITOR", 12, todoCreatedAt, acceptedAt)
+
+				mock.ExpectQuery(queryMember).WillReturnRows(row)
+				mock.ExpectQuery(queryTags).WithArgs(1).WillReturnRows(tags)
+				mock.ExpectQuery(queryCollaborators).WithArgs(1).WillReturnRows(collaborators)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "SuccessWithoutMember",
+			args: args{ctx: context.Background(), id: 1},
+			want: &domain.Todo{
+				ID:          1,
+				UserID:      12,
+				Title:       "title test",
+				Description: "description test",
+				Status:      enum.New(domain.TodoStatusDrop),
+				Priority:    enum.New(domain.TodoPriorityLow),
+				CreatedAt:   todoCreatedAt,
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				row := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "todos" WHERE (("deleted_at" IS NULL) AND ("id" = 1)) LIMIT 1`)).
+					WillReturnRows(row)
+				mock.ExpectQuery(queryTags).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"todo_id", "name"}))
+				mock.ExpectQuery(queryCollaborators).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"todo_id"}))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			got, err := s.FindMember(tt.args.ctx, tt.args.id, tt.args.member)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLTodo_Fetch(t *testing.T) {
+	tel := lib.NewTelemetry()
+	due := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := `SELECT id, user_id, title, description, status, priority, due_at, completed_at, deleted_at, ` +
+		`created_at FROM todos WHERE deleted_at IS NULL`
+	queryTags := regexp.QuoteMeta(`SELECT tt.todo_id, t.name FROM todo_tags tt ` +
+		`JOIN tags t ON t.id = tt.tag_id WHERE tt.todo_id IN (?, ?) ORDER BY t.name;`)
+	queryCollaborators := regexp.QuoteMeta(`SELECT todo_id, email, user_id, role, invited_by, invited_at, ` +
+		`accepted_at FROM todo_collaborators WHERE todo_id IN (?, ?) ORDER BY invited_at, email;`)
+
+	type args struct {
+		ctx context.Context
+		in  map[string]any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []domain.Todo
+		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
+	}{
+		{
+			name:    "ErrorWhenQuery",
+			args:    args{ctx: context.Background(), in: make(map[string]any)},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(regexp.QuoteMeta(query + ` ORDER BY id ASC;`)).
+					WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorWhenQueryTags",
+			args:    args{ctx: context.Background(), in: make(map[string]any)},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				rows := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt).
+					AddRow(2, 13, "title test 2", "description test 2", "INITIATE", "HIGH", nil, nil, nil, todoCreatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(query + ` ORDER BY id ASC;`)).WillReturnRows(rows)
+				mock.ExpectQuery(queryTags).WithArgs(1, 2).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorWhenQueryCollaborators",
+			args:    args{ctx: context.Background(), in: make(map[string]any)},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				rows := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt).
+					AddRow(2, 13, "title test 2", "description test 2", "INITIATE", "HIGH", nil, nil, nil, todoCreatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(query + ` ORDER BY id ASC;`)).WillReturnRows(rows)
+				mock.ExpectQuery(queryTags).WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"todo_id", "name"}))
+				mock.ExpectQuery(queryCollaborators).WithArgs(1, 2).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "SuccessWithoutFilter",
+			args: args{ctx: context.Background(), in: make(map[string]any)},
+			want: []domain.Todo{
+				{
+					ID:          1,
+					UserID:      12,
+					Title:       "title test",
+					Description: "description test",
+					Status:      enum.New(domain.TodoStatusDrop),
+					Priority:    enum.New(domain.TodoPriorityLow),
+					CreatedAt:   todoCreatedAt,
+					Tags:        []string{"work"},
+				},
+				{
+					ID:          2,
+					UserID:      13,
+					Title:       "title test 2",
+					Description: "description test 2",
+					Status:      enum.New(domain.TodoStatusInitiate),
+					Priority:    enum.New(domain.TodoPriorityHigh),
+					CreatedAt:   todoCreatedAt,
+					Collaborators: []domain.TodoCollaborator{{
+						TodoID:    2,
+						Email:     "friend@example.com",
+						Role:      enum.New(domain.TodoRoleViewer),
+						InvitedBy: 13,
+						InvitedAt: todoCreatedAt,
+					}},
+				},
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				rows := sqlmock.NewRows(todoRowColumns).
+					AddRow(1, 12, "title test", "description test", "DROP", "LOW", nil, nil, nil, todoCreatedAt).
+					AddRow(2, 13, "title test 2", "description test 2", "INITIATE", "HIGH", nil, nil, nil, todoCreatedAt)
+				tags := sqlmock.NewRows([]string{"todo_id", "name"}).AddRow(1, "work")
+				collaborators := sqlmock.NewRows([]string{
+					"todo_id", "email", "user_id", "role", "invited_by", "invited_at", "accepted_at",
+				}).AddRow(2, "friend@example.com", 0, "VIEWER", 13, todoCreatedAt, nil)
+
+				mock.ExpectQuery(regexp.QuoteMeta(query + ` ORDER BY id ASC;`)).WillReturnRows(rows)
+				mock.ExpectQuery(queryTags).WithArgs(1, 2).WillReturnRows(tags)
+				mock.ExpectQuery(queryCollaborators).WithArgs(1, 2).WillReturnRows(collaborators)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "SuccessWithFilter",
+			args: args{ctx: context.Background(), in: map[string]any{
+				"limit":    1,
+				"user_id":  uint64(12),
+				"status":   enum.New(domain.TodoStatusDrop),
+				"priority": enum.New(domain.TodoPriorityMedium),
+				"due_from": due,
+				"due_to":   due.Add(time.Hour),
+				"query":    "milk",
+				"tags":     []string{"home", "work"},
+				"sort":     "id",
+				"after_id": uint64(1),
+			}},
+			want: []domain.Todo{
+				{
+					ID:          2,
+					UserID:      12,
+					Title:       "title test 2",
+					Description: "description test 2",
+					Status:      enum.New(domain.TodoStatusDrop),
+					Priority:    enum.New(domain.TodoPriorityMedium),
+					DueAt:       &due,
+					CreatedAt:   todoCreatedAt,
+				},
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				rows := sqlmock.NewRows(todoRowColumns).
+					AddRow(2, 12, "title test 2", "description test 2", "DROP", "MEDIUM", due, nil, nil, todoCreatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(query+` AND user_id = ? AND status = ? AND priority = ? `+
+					`AND due_at >= ? AND due_at <= ? AND MATCH(title, description) AGAINST(? IN NATURAL LANGUAGE MODE) `+
+					`AND id IN (SELECT tt.todo_id FROM todo_tags tt JOIN tags t ON t.id = tt.tag_id `+
+					`WHERE t.name IN (?, ?) GROUP BY tt.todo_id HAVING COUNT(*) = ?) AND id > ? `+
+					`ORDER BY id ASC LIMIT ?;`)).
+					WithArgs(uint64(12), "DROP", "MEDIUM", due, due.Add(time.Hour), "milk", "home", "work", 2,
+						uint64(1), 2).
+					WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE tt.todo_id IN (?) ORDER BY t.name;`)).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"todo_id", "name"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM todo_collaborators WHERE todo_id IN (?)`)).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"todo_id"}))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "SuccessMember",
+			args: args{ctx: context.Background(), in: map[string]any{
+				"member_id": uint64(12),
+				"sort":      "id",
+			}},
+			want:    nil,
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(regexp.QuoteMeta(query+` AND (user_id = ? OR id IN (SELECT todo_id `+
+					`FROM todo_collaborators WHERE user_id = ? AND accepted_at IS NOT NULL)) ORDER BY id ASC;`)).
+					WithArgs(uint64(12), uint64(12)).
+					WillReturnRows(sqlmock.NewRows(todoRowColumns))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "SuccessSearchPostgres",
+			args: args{ctx: context.Background(), in: map[string]any{
+				"limit":   1,
+				"user_id": uint64(12),
+				"query":   "milk",
+				"sort":    "created_at",
+				"desc":    true,
+			}},
+			want: []domain.Todo{
+				{
+					ID:          2,
+					UserID:      12,
+					Title:       "buy milk",
+					Description: "description test",
+					Status:      enum.New(domain.TodoStatusInitiate),
+					Priority:    enum.New(domain.TodoPriorityLow),
+					CreatedAt:   todoCreatedAt,
+				},
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				rows := sqlmock.NewRows(todoRowColumns).
+					AddRow(2, 12, "buy milk", "description test", "INITIATE", "LOW", nil, nil, nil, todoCreatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(query+` AND user_id = $1 `+
+					`AND search_vector @@ plainto_tsquery('simple', $2) `+
+					`ORDER BY created_at DESC, id DESC LIMIT $3;`)).
+					WithArgs(uint64(12), "milk", 2).
+					WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE tt.todo_id IN ($1) ORDER BY t.name;`)).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"todo_id", "name"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM todo_collaborators WHERE todo_id IN ($1)`)).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"todo_id"}))
+
+				return &SQLTodo{db: sqlkit.New("postgres", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "SuccessEmpty",
+			args:    args{ctx: context.Background(), in: make(map[string]any)},
+			want:    nil,
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(regexp.QuoteMeta(query + ` ORDER BY id ASC;`)).
+					WillReturnRows(sqlmock.NewRows(todoRowColumns))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			got, err := s.Fetch(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_fetchSeek(t *testing.T) {
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		filter    map[string]any
+		wantSeek  string
+		wantArgs  []any
+		wantOrder string
+	}{
+		{
+			name:      "IDWithoutCursor",
+			filter:    map[string]any{},
+			wantOrder: "id ASC",
+		},
+		{
+			name:      "IDDescending",
+			filter:    map[string]any{"sort": "id", "desc": true, "after_id": uint64(9)},
+			wantSeek:  "id < ?",
+			wantArgs:  []any{uint64(9)},
+			wantOrder: "id DESC",
+		},
+		{
+			name:      "CreatedAtWithoutCursor",
+			filter:    map[string]any{"sort": "created_at", "desc": true},
+			wantOrder: "created_at DESC, id DESC",
+		},
+		{
+			name:      "CreatedAt",
+			filter:    map[string]any{"sort": "created_at", "after_id": uint64(9), "after_time": &at},
+			wantSeek:  "(created_at > ? OR (created_at = ? AND id > ?))",
+			wantArgs:  []any{&at, &at, uint64(9)},
+			wantOrder: "created_at ASC, id ASC",
+		},
+		{
+			name:      "PriorityDescending",
+			filter:    map[string]any{"sort": "priority", "desc": true, "after_id": uint64(9), "after_rank": 2},
+			wantSeek:  "(" + priorityRank + " < ? OR (" + priorityRank + " = ? AND id < ?))",
+			wantArgs:  []any{2, 2, uint64(9)},
+			wantOrder: priorityRank + " DESC, id DESC",
+		},
+		{
+			name:      "DueAtWithoutCursor",
+			filter:    map[string]any{"sort": "due_at"},
+			wantOrder: "due_at IS NULL ASC, due_at ASC, id ASC",
+		},
+		{
+			name:      "DueAtAfterDueDate",
+			filter:    map[string]any{"sort": "due_at", "after_id": uint64(9), "after_time": &at},
+			wantSeek:  "(due_at IS NULL OR due_at > ? OR (due_at = ? AND id > ?))",
+			wantArgs:  []any{at, at, uint64(9)},
+			wantOrder: "due_at IS NULL ASC, due_at ASC, id ASC",
+		},
+		{
+			name:      "DueAtAfterNoDueDate",
+			filter:    map[string]any{"sort": "due_at", "after_id": uint64(9), "after_time": (*time.Time)(nil)},
+			wantSeek:  "(due_at IS NULL AND id > ?)",
+			wantArgs:  []any{uint64(9)},
+			wantOrder: "due_at IS NULL ASC, due_at ASC, id ASC",
+		},
+		{
+			name:      "DueAtDescendingWithoutCursor",
+			filter:    map[string]any{"sort": "due_at", "desc": true},
+			wantOrder: "due_at IS NULL DESC, due_at DESC, id DESC",
+		},
+		{
+			name:      "DueAtDescendingAfterDueDate",
+			filter:    map[string]any{"sort": "due_at", "desc": true, "after_id": uint64(9), "after_time": &at},
+			wantSeek:  "(due_at < ? OR (due_at = ? AND id < ?))",
+			wantArgs:  []any{at, at, uint64(9)},
+			wantOrder: "due_at IS NULL DESC, due_at DESC, id DESC",
+		},
+		{
+			name:      "DueAtDescendingAfterNoDueDate",
+			filter:    map[string]any{"sort": "due_at", "desc": true, "after_id": uint64(9)},
+			wantSeek:  "(due_at IS NOT NULL OR id < ?)",
+			wantArgs:  []any{uint64(9)},
+			wantOrder: "due_at IS NULL DESC, due_at DESC, id DESC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			seek, args, order := fetchSeek(tt.filter)
+			assert.Equal(t, tt.wantSeek, seek)
+			assert.Equal(t, tt.wantArgs, args)
+			assert.Equal(t, tt.wantOrder, order)
+		})
+	}
+}
+
+func TestSQLTodo_UpdateStatus(t *testing.T) {
+	tel := lib.NewTelemetry()
+	done := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := regexp.QuoteMeta(`UPDATE todos SET status = ?, completed_at = ? WHERE id = ? AND status = ? ` +
+		`AND deleted_at IS NULL;`)
+	queryHistory := regexp.QuoteMeta(`INSERT INTO todo_status_history(id, todo_id, from_status, to_status, ` +
+		`changed_by, changed_at) VALUES(?, ?, ?, ?, ?, ?);`)
+	history := []domain.TodoStatusChange{{
+		TodoID:    1,
+		From:      enum.New(domain.TodoStatusInProgress),
+		To:        enum.New(domain.TodoStatusDone),
+		ChangedBy: 11,
+		ChangedAt: done,
+	}}
+
+	type args struct {
+		ctx     context.Context
+		todo    domain.Todo
+		history []domain.TodoStatusChange
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
+	}{
+		{
+			name: "ErrorWhenExec",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, Status: enum.New(domain.TodoStatusDone), CompletedAt: &done,
+			}, history: history},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).WithArgs("DONE", done, a.todo.ID, "IN_PROGRESS").WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "ErrorStatusChanged",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, Status: enum.New(domain.TodoStatusDone), CompletedAt: &done,
+			}, history: history},
+			wantErr: domain.ErrTodoNotUpdated,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("DONE", done, a.todo.ID, "IN_PROGRESS").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "ErrorWhenAddHistory",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, Status: enum.New(domain.TodoStatusDone), CompletedAt: &done,
+			}, history: history},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+				uidMock := mocker.NewMockNumberID(t)
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("DONE", done, a.todo.ID, "IN_PROGRESS").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				uidMock.EXPECT().Generate().Return(100)
+				mock.ExpectExec(queryHistory).
+					WithArgs(100, a.todo.ID, "IN_PROGRESS", "DONE", 11, done).
+					WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+
+				return &SQLTodo{
+					db:        sqlkit.New("mysql", db, tel.Logger()),
+					uidnumber: uidMock,
+					telemetry: tel,
+				}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, Status: enum.New(domain.TodoStatusDone), CompletedAt: &done,
+			}, history: history},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+				uidMock := mocker.NewMockNumberID(t)
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("DONE", done, a.todo.ID, "IN_PROGRESS").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				uidMock.EXPECT().Generate().Return(100)
+				mock.ExpectExec(queryHistory).
+					WithArgs(100, a.todo.ID, "IN_PROGRESS", "DONE", 11, done).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				return &SQLTodo{
+					db:        sqlkit.New("mysql", db, tel.Logger()),
+					uidnumber: uidMock,
+					telemetry: tel,
+				}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.UpdateStatus(tt.args.ctx, tt.args.todo, tt.args.history)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLTodo_Update(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`UPDATE todos SET title = ?, description = ?, status = ?, priority = ?, ` +
+		`due_at = ?, completed_at = ? WHERE id = ? AND user_id = ? AND status = ? AND deleted_at IS NULL;`)
+	queryUnlinkTags := regexp.QuoteMeta(`DELETE FROM todo_tags WHERE todo_id = ?;`)
+	queryHistory := regexp.QuoteMeta(`INSERT INTO todo_status_history(id, todo_id, from_status, to_status, ` +
+		`changed_by, changed_at) VALUES(?, ?, ?, ?, ?, ?);`)
+	changedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type args struct {
+		ctx     context.Context
+		todo    domain.Todo
+		history []domain.TodoStatusChange
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
+	}{
+		{
+			name: "ErrorWhenExec",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusInitiate), Priority: enum.New(domain.TodoPriorityMedium),
+			}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.Title, a.todo.Description, "INITIATE", "MEDIUM", nil, nil, a.todo.ID, a.todo.UserID,
+						"INITIATE").
+					WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "ErrorStatusChanged",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusInitiate), Priority: enum.New(domain.TodoPriorityMedium),
+			}},
+			wantErr: domain.ErrTodoNotUpdated,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.Title, a.todo.Description, "INITIATE", "MEDIUM", nil, nil, a.todo.ID, a.todo.UserID,
+						"INITIATE").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "ErrorWhenUnlinkTags",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusInitiate), Priority: enum.New(domain.TodoPriorityMedium),
+			}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.Title, a.todo.Description, "INITIATE", "MEDIUM", nil, nil, a.todo.ID, a.todo.UserID,
+						"INITIATE").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queryUnlinkTags).WithArgs(a.todo.ID).WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "ErrorWhenFindTag",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusInitiate), Priority: enum.New(domain.TodoPriorityMedium),
+				Tags: []string{"work"},
+			}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.Title, a.todo.Description, "INITIATE", "MEDIUM", nil, nil, a.todo.ID, a.todo.UserID,
+						"INITIATE").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queryUnlinkTags).WithArgs(a.todo.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM tags WHERE user_id = ? AND name = ?;`)).
+					WithArgs(a.todo.UserID, "work").
+					WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "ErrorWhenAddHistory",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusDrop), Priority: enum.New(domain.TodoPriorityMedium),
+			}, history: []domain.TodoStatusChange{{
+				TodoID: 1, From: enum.New(domain.TodoStatusInitiate), To: enum.New(domain.TodoStatusDrop),
+				ChangedBy: 12, ChangedAt: changedAt,
+			}}},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+				uidMock := mocker.NewMockNumberID(t)
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.Title, a.todo.Description, "DROP", "MEDIUM", nil, nil, a.todo.ID, a.todo.UserID,
+						"INITIATE").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queryUnlinkTags).WithArgs(a.todo.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+				uidMock.EXPECT().Generate().Return(100)
+				mock.ExpectExec(queryHistory).
+					WithArgs(100, a.todo.ID, "INITIATE", "DROP", 12, changedAt).
+					WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+
+				return &SQLTodo{
+					db:        sqlkit.New("mysql", db, tel.Logger()),
+					uidnumber: uidMock,
+					telemetry: tel,
+				}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			args: args{ctx: context.Background(), todo: domain.Todo{
+				ID: 1, UserID: 12, Title: "title", Description: "description",
+				Status: enum.New(domain.TodoStatusDrop), Priority: enum.New(domain.TodoPriorityMedium),
+				Tags: []string{"work"},
+			}, history: []domain.TodoStatusChange{{
+				TodoID: 1, From: enum.New(domain.TodoStatusInitiate), To: enum.New(domain.TodoStatusDrop),
+				ChangedBy: 12, ChangedAt: changedAt,
+			}}},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+				uidMock := mocker.NewMockNumberID(t)
+
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(a.todo.Title, a.todo.Description, "DROP", "MEDIUM", nil, nil, a.todo.ID, a.todo.UserID,
+						"INITIATE").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queryUnlinkTags).WithArgs(a.todo.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM tags WHERE user_id = ? AND name = ?;`)).
+					WithArgs(a.todo.UserID, "work").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO todo_tags(todo_id, tag_id) VALUES(?, ?);`)).
+					WithArgs(a.todo.ID, 7).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				uidMock.EXPECT().Generate().Return(100)
+				mock.ExpectExec(queryHistory).
+					WithArgs(100, a.todo.ID, "INITIATE", "DROP", 12, changedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				return &SQLTodo{
+					db:        sqlkit.New("mysql", db, tel.Logger()),
+					uidnumber: uidMock,
+					telemetry: tel,
+				}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.Update(tt.args.ctx, tt.args.todo, tt.args.history)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLTodo_History(t *testing.T) {
+	tel := lib.NewTelemetry()
+	changedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := regexp.QuoteMeta(`SELECT id, todo_id, from_status, to_status, changed_by, changed_at ` +
+		`FROM todo_status_history WHERE todo_id = ? ORDER BY changed_at, id;`)
+	columns := []string{"id", "todo_id", "from_status", "to_status", "changed_by", "changed_at"}
+
+	type args struct {
+		ctx    context.Context
+		todoID uint64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []domain.TodoStatusChange
+		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
+	}{
+		{
+			name:    "ErrorWhenQuery",
+			args:    args{ctx: context.Background(), todoID: 1},
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WithArgs(a.todoID).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			args: args{ctx: context.Background(), todoID: 1},
+			want: []domain.TodoStatusChange{
+				{
+					ID: 100, TodoID: 1, From: enum.New(domain.TodoStatusInitiate),
+					To: enum.New(domain.TodoStatusInProgress), ChangedBy: 11, ChangedAt: changedAt,
+				},
+				{
+					ID: 101, TodoID: 1, From: enum.New(domain.TodoStatusInProgress),
+					To: enum.New(domain.TodoStatusDone), ChangedBy: 11, ChangedAt: changedAt,
+				},
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				rows := sqlmock.NewRows(columns).
+					AddRow(100, 1, "INITIATE", "IN_PROGRESS", 11, changedAt).
+					AddRow(101, 1, "IN_PROGRESS", "DONE", 11, changedAt)
+				mock.ExpectQuery(query).WithArgs(a.todoID).WillReturnRows(rows)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			got, err := s.History(tt.args.ctx, tt.args.todoID)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLTodo_Share(t *testing.T) {
+	tel := lib.NewTelemetry()
+	collaborator := domain.TodoCollaborator{
+		TodoID:    1,
+		Email:     "friend@example.com",
+		Role:      enum.New(domain.TodoRoleViewer),
+		InvitedBy: 12,
+		InvitedAt: todoCreatedAt,
+	}
+	query := `INSERT INTO todo_collaborators(todo_id, email, role, invited_by, invited_at) ` +
+		`VALUES(?, ?, ?, ?, ?) `
+
+	type args struct {
+		ctx context.Context
+		c   domain.TodoCollaborator
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), c: collaborator},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(regexp.QuoteMeta(query+`ON DUPLICATE KEY UPDATE role = VALUES(role);`)).
+					WithArgs(a.c.TodoID, a.c.Email, "VIEWER", a.c.InvitedBy, a.c.InvitedAt).
+					WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "SuccessMySQL",
+			args:    args{ctx: context.Background(), c: collaborator},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(regexp.QuoteMeta(query+`ON DUPLICATE KEY UPDATE role = VALUES(role);`)).
+					WithArgs(a.c.TodoID, a.c.Email, "VIEWER", a.c.InvitedBy, a.c.InvitedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "SuccessPostgres",
+			args:    args{ctx: context.Background(), c: collaborator},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO todo_collaborators(todo_id, email, role, `+
+					`invited_by, invited_at) VALUES($1, $2, $3, $4, $5) ON CONFLICT (todo_id, email) `+
+					`DO UPDATE SET role = EXCLUDED.role;`)).
+					WithArgs(a.c.TodoID, a.c.Email, "VIEWER", a.c.InvitedBy, a.c.InvitedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLTodo{db: sqlkit.New("postgres", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.Share(tt.args.ctx, tt.args.c)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLTodo_Unshare(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`DELETE FROM todo_collaborators WHERE todo_id = ? AND email = ?;`)
+
+	type args struct {
+		ctx    context.Context
+		todoID uint64
+		email  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			args:    args{ctx: context.Background(), todoID: 1, email: "friend@example.com"},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WithArgs(a.todoID, a.email).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "ErrorNoRowsAffected",
+			args:    args{ctx: context.Background(), todoID: 1, email: "friend@example.com"},
+			wantErr: domain.ErrCollaboratorNotFound,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WithArgs(a.todoID, a.email).WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			args:    args{ctx: context.Background(), todoID: 1, email: "friend@example.com"},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WithArgs(a.todoID, a.email).WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.Unshare(tt.args.ctx, tt.args.todoID, tt.args.email)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLTodo_Accept(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`UPDATE todo_collaborators SET user_id = ?, accepted_at = ? ` +
+		`WHERE todo_id = ? AND email = ? AND accepted_at IS NULL;`)
+
+	type args struct {
+		ctx    context.Context
+		todoID uint64
+		email  string
+		userID uint64
+		at     time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		mockFn  func(a args) (*SQLTodo, func() error)
+	}{
+		{
+			name: "ErrorWhenExec",
+			args: args{
+				ctx: context.Background(), todoID: 1, email: "friend@example.com", userID: 13, at: todoCreatedAt,
+			},
+			wantErr: assert.AnError,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs(a.userID, a.at, a.todoID, a.email).
+					WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "ErrorNoRowsAffected",
+			args: args{
+				ctx: context.Background(), todoID: 1, email: "friend@example.com", userID: 13, at: todoCreatedAt,
+			},
+			wantErr: domain.ErrCollaboratorNotFound,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs(a.userID, a.at, a.todoID, a.email).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: context.Background(), todoID: 1, email: "friend@example.com", userID: 13, at: todoCreatedAt,
+			},
+			wantErr: nil,
+			mockFn: func(a args) (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs(a.userID, a.at, a.todoID, a.email).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn(tt.args)
+			defer dbMockCloser()
+
+			err := s.Accept(tt.args.ctx, tt.args.todoID, tt.args.email, tt.args.userID, tt.args.at)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLTodo_AddAttachment(t *testing.T) {
+	tel := lib.NewTelemetry()
+	attachment := domain.TodoAttachment{
+		ID:          5,
+		TodoID:      1,
+		Filename:    "notes.txt",
+		ContentType: "text/plain",
+		Size:        42,
+		StorageKey:  "todos/1/5/notes.txt",
+		CreatedBy:   12,
+		CreatedAt:   todoCreatedAt,
+	}
+	query := regexp.QuoteMeta(`INSERT INTO todo_attachments(id, todo_id, filename, content_type, size, ` +
+		`storage_key, created_by, created_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?);`)
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mockFn  func() (*SQLTodo, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs(5, 1, "notes.txt", "text/plain", 42, "todos/1/5/notes.txt", 12, todoCreatedAt).
+					WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			wantErr: nil,
+			mockFn: func() (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).
+					WithArgs(5, 1, "notes.txt", "text/plain", 42, "todos/1/5/notes.txt", 12, todoCreatedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			err := s.AddAttachment(context.Background(), attachment)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSQLTodo_Attachments(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`SELECT id, todo_id, filename, content_type, size, storage_key, created_by, ` +
+		`created_at FROM todo_attachments WHERE todo_id = ? ORDER BY created_at, id;`)
+	columns := []string{
+		"id", "todo_id", "filename", "content_type", "size", "storage_key", "created_by", "created_at",
+	}
+
+	tests := []struct {
+		name    string
+		want    []domain.TodoAttachment
+		wantErr error
+		mockFn  func() (*SQLTodo, func() error)
+	}{
+		{
+			name:    "ErrorWhenQuery",
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			want: []domain.TodoAttachment{
+				{
+					ID: 5, TodoID: 1, Filename: "notes.txt", ContentType: "text/plain", Size: 42,
+					StorageKey: "todos/1/5/notes.txt", CreatedBy: 12, CreatedAt: todoCreatedAt,
+				},
+			},
+			wantErr: nil,
+			mockFn: func() (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				rows := sqlmock.NewRows(columns).
+					AddRow(5, 1, "notes.txt", "text/plain", 42, "todos/1/5/notes.txt", 12, todoCreatedAt)
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			got, err := s.Attachments(context.Background(), 1)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLTodo_FindAttachment(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`FROM "todo_attachments" WHERE (("id" = 5) AND ("todo_id" = 1)) LIMIT 1`)
+	columns := []string{
+		"id", "todo_id", "filename", "content_type", "size", "storage_key", "created_by", "created_at",
+	}
+
+	tests := []struct {
+		name    string
+		want    *domain.TodoAttachment
+		wantErr error
+		mockFn  func() (*SQLTodo, func() error)
+	}{
+		{
+			name:    "ErrorWhenQuery",
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "SuccessNotFound",
+			want:    nil,
+			wantErr: nil,
+			mockFn: func() (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(columns))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name: "Success",
+			want: &domain.TodoAttachment{
+				ID: 5, TodoID: 1, Filename: "notes.txt", ContentType: "text/plain", Size: 42,
+				StorageKey: "todos/1/5/notes.txt", CreatedBy: 12, CreatedAt: todoCreatedAt,
+			},
+			wantErr: nil,
+			mockFn: func() (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				rows := sqlmock.NewRows(columns).
+					AddRow(5, 1, "notes.txt", "text/plain", 42, "todos/1/5/notes.txt", 12, todoCreatedAt)
+				mock.ExpectQuery(query).WillReturnRows(rows)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			got, err := s.FindAttachment(context.Background(), 1, 5)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSQLTodo_DeleteAttachment(t *testing.T) {
+	tel := lib.NewTelemetry()
+	query := regexp.QuoteMeta(`DELETE FROM todo_attachments WHERE id = ? AND todo_id = ?;`)
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mockFn  func() (*SQLTodo, func() error)
+	}{
+		{
+			name:    "ErrorWhenExec",
+			wantErr: assert.AnError,
+			mockFn: func() (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WithArgs(5, 1).WillReturnError(assert.AnError)
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+		{
+			name:    "Success",
+			wantErr: nil,
+			mockFn: func() (*SQLTodo, func() error) {
+				db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+
+				mock.ExpectExec(query).WithArgs(5, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+				return &SQLTodo{db: sqlkit.New("mysql", db, tel.Logger()), telemetry: tel}, db.Close
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, dbMockCloser := tt.mockFn()
+			defer dbMockCloser()
+
+			err := s.DeleteAttachment(context.Background(), 1, 5)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
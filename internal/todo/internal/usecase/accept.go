@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+type AcceptStore interface {
+	Accept(ctx context.Context, todoID uint64, email string, userID uint64, at time.Time) error
+}
+
+// Accept links the pending invitation made to the email of the caller to their
+// account, from then on the todo is shared with them.
+type Accept struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	clock     clock.Clocker
+	store     AcceptStore
+}
+
+func NewAccept(dep Dependency, s AcceptStore) *Accept {
+	return &Accept{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		clock:     dep.Clock,
+		store:     s,
+	}
+}
+
+func (s *Accept) Call(ctx context.Context, in domain.AcceptInput) (*domain.AcceptOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Accept")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	clm := lib.GetJWTClaim(ctx)
+	if clm == nil {
+		s.telemetry.Logger().Warn(ctx, "todo accept without claim")
+
+		return nil, errUnauthenticated
+	}
+
+	email := strings.ToLower(clm.Subject)
+
+	err := s.store.Accept(ctx, in.ID, email, clm.AuthID, s.clock.Now())
+	if errors.Is(err, domain.ErrCollaboratorNotFound) {
+		s.telemetry.Logger().Warn(ctx, "todo invitation is not found")
+
+		return nil, goerror.NewBusiness("invitation not found", goerror.CodeNotFound)
+	}
+
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to accept invitation", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	return &domain.AcceptOutput{ID: in.ID}, nil
+}
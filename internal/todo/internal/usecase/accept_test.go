@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAccept(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   AcceptStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Accept
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Accept{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewAccept(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAccept_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(12, "Friend@Example.com", time.Time{}, nil))
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type args struct {
+		ctx context.Context
+		in  domain.AcceptInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.AcceptOutput
+		wantErr error
+		mockFn  func(a args) *Accept
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AcceptInput{ID: 10},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Accept {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Accept")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Accept{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.AcceptInput{ID: 10},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Accept {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Accept")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Accept{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorInvitationNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AcceptInput{ID: 10},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("invitation not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Accept {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAcceptStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Accept")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				store.EXPECT().
+					Accept(ctx, a.in.ID, "friend@example.com", uint64(12), now).
+					Return(domain.ErrCollaboratorNotFound)
+
+				return &Accept{
+					telemetry: mtel,
+					validator: validator,
+					clock:     clock,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreAccept",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AcceptInput{ID: 10},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Accept {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAcceptStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Accept")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				store.EXPECT().
+					Accept(ctx, a.in.ID, "friend@example.com", uint64(12), now).
+					Return(assert.AnError)
+
+				return &Accept{
+					telemetry: mtel,
+					validator: validator,
+					clock:     clock,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AcceptInput{ID: 10},
+			},
+			want:    &domain.AcceptOutput{ID: 10},
+			wantErr: nil,
+			mockFn: func(a args) *Accept {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAcceptStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Accept")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				store.EXPECT().
+					Accept(ctx, a.in.ID, "friend@example.com", uint64(12), now).
+					Return(nil)
+
+				return &Accept{
+					telemetry: mtel,
+					validator: validator,
+					clock:     clock,
+					store:     store,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/uid"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+type AttachStore interface {
+	FindMember(ctx context.Context, id, member uint64) (*domain.Todo, error)
+	AddAttachment(ctx context.Context, a domain.TodoAttachment) error
+}
+
+// Attach stores a file in the blob store and links it to the todo, the owner
+// and editors can do it. The size and the sniffed type must fit the policy.
+type Attach struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	uidnumber uid.NumberID
+	clock     clock.Clocker
+	scope     scope
+	policy    attachmentPolicy
+	blob      BlobStore
+	store     AttachStore
+}
+
+func NewAttach(dep Dependency, s AttachStore) *Attach {
+	return &Attach{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		uidnumber: dep.UIDNumber,
+		clock:     dep.Clock,
+		scope:     newScope(dep.Config),
+		policy:    newAttachmentPolicy(dep.Config),
+		blob:      dep.Blob,
+		store:     s,
+	}
+}
+
+func (s *Attach) Call(ctx context.Context, in domain.AttachInput) (*domain.AttachOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Attach")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	member, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo attach without claim")
+
+		return nil, err
+	}
+
+	if in.Size > s.policy.maxSize {
+		s.telemetry.Logger().Warn(ctx, "todo attachment too large")
+
+		msg := fmt.Sprintf("attachment is larger than %d MB", s.policy.maxSize>>20)
+
+		return nil, goerror.NewBusiness(msg, goerror.CodeInvalidInput)
+	}
+
+	todo, err := s.store.FindMember(ctx, in.ID, member)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	if member > 0 && !todo.CanEdit(member) {
+		s.telemetry.Logger().Warn(ctx, "todo attach by viewer rejected")
+
+		return nil, errViewerReadOnly
+	}
+
+	// the type is sniffed from the content, the one sent by the client is not trusted
+	head := make([]byte, 512)
+	n, err := io.ReadFull(in.Body, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		s.telemetry.Logger().Error(ctx, "todo fail to read attachment", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if !s.policy.allows(contentType) {
+		s.telemetry.Logger().Warn(ctx, "todo attachment type not allowed")
+
+		return nil, goerror.NewBusiness("attachment type "+contentType+" is not allowed", goerror.CodeInvalidInput)
+	}
+
+	att := domain.TodoAttachment{
+		ID:          s.uidnumber.Generate(),
+		TodoID:      in.ID,
+		Filename:    attachmentName(in.Filename),
+		ContentType: contentType,
+		Size:        in.Size,
+		CreatedBy:   actor(ctx),
+		CreatedAt:   s.clock.Now(),
+	}
+	att.StorageKey = fmt.Sprintf("todos/%d/%d/%s", att.TodoID, att.ID, att.Filename)
+
+	body := io.MultiReader(bytes.NewReader(head[:n]), in.Body)
+	if err := s.blob.Put(ctx, att.StorageKey, body, in.Size, contentType); err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to store attachment", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if err := s.store.AddAttachment(ctx, att); err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to save attachment", err)
+
+		// nothing points to the blob without its metadata, so it goes too
+		if derr := s.blob.Delete(ctx, att.StorageKey); derr != nil {
+			s.telemetry.Logger().Error(ctx, "todo fail to remove attachment blob", derr)
+		}
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	return &domain.AttachOutput{Attachment: att}, nil
+}
+
+// attachmentName keeps the base name of the uploaded file without the
+// characters a storage key or a download header could trip on.
+func attachmentName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+
+		return r
+	}, name))
+
+	if name == "" || name == "." || name == ".." || name == "/" {
+		return "file"
+	}
+
+	return name
+}
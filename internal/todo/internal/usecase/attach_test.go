@@ -0,0 +1,512 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewAttach(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   AttachStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Attach
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Attach{policy: newAttachmentPolicy(nil)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewAttach(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAttach_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	policy := attachmentPolicy{maxSize: 1 << 20, types: mediaTypes("text/plain")}
+	viewer := &domain.Todo{ID: 10, UserID: 99, Collaborators: []domain.TodoCollaborator{
+		{TodoID: 10, UserID: 11, Role: enum.New(domain.TodoRoleViewer), AcceptedAt: &now},
+	}}
+	stored := domain.TodoAttachment{
+		ID:          5,
+		TodoID:      10,
+		Filename:    "notes.txt",
+		ContentType: "text/plain",
+		Size:        5,
+		StorageKey:  "todos/10/5/notes.txt",
+		CreatedBy:   11,
+		CreatedAt:   now,
+	}
+	putHello := func(_ context.Context, _ string, body io.Reader, _ int64, _ string) error {
+		data, err := io.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+
+		return nil
+	}
+
+	type args struct {
+		ctx context.Context
+		in  domain.AttachInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.AttachOutput
+		wantErr error
+		mockFn  func(a args) *Attach
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 5, Body: strings.NewReader("hello")},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 5, Body: strings.NewReader("hello")},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorTooLarge",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 2 << 20, Body: strings.NewReader("hello")},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("attachment is larger than 1 MB", goerror.CodeInvalidInput),
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+					policy:    policy,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 5, Body: strings.NewReader("hello")},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAttachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+					policy:    policy,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 5, Body: strings.NewReader("hello")},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAttachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+					policy:    policy,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorViewer",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 5, Body: strings.NewReader("hello")},
+			},
+			want:    nil,
+			wantErr: errViewerReadOnly,
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAttachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(viewer, nil)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+					policy:    policy,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorReadBody",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 5, Body: iotest.ErrReader(assert.AnError)},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAttachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+					policy:    policy,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorTypeNotAllowed",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 12, Body: strings.NewReader("<html><body>")},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("attachment type text/html is not allowed", goerror.CodeInvalidInput),
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAttachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+					policy:    policy,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorBlobPut",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 5, Body: strings.NewReader("hello")},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				uidnumber := vm.NewMockNumberID(t)
+				clock := vm.NewMockClocker(t)
+				blob := mockz.NewMockBlobStore(t)
+				store := mockz.NewMockAttachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				uidnumber.EXPECT().
+					Generate().
+					Return(5)
+
+				clock.EXPECT().
+					Now().
+					Return(now)
+
+				blob.EXPECT().
+					Put(ctx, "todos/10/5/notes.txt", mock.Anything, int64(5), "text/plain").
+					Return(assert.AnError)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+					uidnumber: uidnumber,
+					clock:     clock,
+					policy:    policy,
+					blob:      blob,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreAddAttachment",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: "notes.txt", Size: 5, Body: strings.NewReader("hello")},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				uidnumber := vm.NewMockNumberID(t)
+				clock := vm.NewMockClocker(t)
+				blob := mockz.NewMockBlobStore(t)
+				store := mockz.NewMockAttachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				uidnumber.EXPECT().
+					Generate().
+					Return(5)
+
+				clock.EXPECT().
+					Now().
+					Return(now)
+
+				blob.EXPECT().
+					Put(ctx, "todos/10/5/notes.txt", mock.Anything, int64(5), "text/plain").
+					RunAndReturn(putHello)
+
+				store.EXPECT().
+					AddAttachment(ctx, stored).
+					Return(assert.AnError)
+
+				blob.EXPECT().
+					Delete(ctx, "todos/10/5/notes.txt").
+					Return(assert.AnError)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+					uidnumber: uidnumber,
+					clock:     clock,
+					policy:    policy,
+					blob:      blob,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachInput{ID: 10, Filename: `C:\docs\notes.txt`, Size: 5, Body: strings.NewReader("hello")},
+			},
+			want:    &domain.AttachOutput{Attachment: stored},
+			wantErr: nil,
+			mockFn: func(a args) *Attach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				uidnumber := vm.NewMockNumberID(t)
+				clock := vm.NewMockClocker(t)
+				blob := mockz.NewMockBlobStore(t)
+				store := mockz.NewMockAttachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				uidnumber.EXPECT().
+					Generate().
+					Return(5)
+
+				clock.EXPECT().
+					Now().
+					Return(now)
+
+				blob.EXPECT().
+					Put(ctx, "todos/10/5/notes.txt", mock.Anything, int64(5), "text/plain").
+					RunAndReturn(putHello)
+
+				store.EXPECT().
+					AddAttachment(ctx, stored).
+					Return(nil)
+
+				return &Attach{
+					telemetry: mtel,
+					validator: validator,
+					uidnumber: uidnumber,
+					clock:     clock,
+					policy:    policy,
+					blob:      blob,
+					store:     store,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_attachmentName(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "Plain", in: "report.pdf", want: "report.pdf"},
+		{name: "UnixPath", in: "/home/me/report.pdf", want: "report.pdf"},
+		{name: "WindowsPath", in: `C:\Users\me\report.pdf`, want: "report.pdf"},
+		{name: "Traversal", in: "../../etc/passwd", want: "passwd"},
+		{name: "ControlAndQuote", in: "re\"port\r\n.pdf", want: "report.pdf"},
+		{name: "OnlyDots", in: "..", want: "file"},
+		{name: "Empty", in: " ", want: "file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, attachmentName(tt.in))
+		})
+	}
+}
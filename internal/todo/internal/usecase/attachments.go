@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+type AttachmentsStore interface {
+	FindMember(ctx context.Context, id, member uint64) (*domain.Todo, error)
+	Attachments(ctx context.Context, todoID uint64) ([]domain.TodoAttachment, error)
+}
+
+// Attachments lists the files attached to a todo the caller can see.
+type Attachments struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	scope     scope
+	store     AttachmentsStore
+}
+
+func NewAttachments(dep Dependency, s AttachmentsStore) *Attachments {
+	return &Attachments{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		scope:     newScope(dep.Config),
+		store:     s,
+	}
+}
+
+func (s *Attachments) Call(ctx context.Context, in domain.AttachmentsInput) (
+	*domain.AttachmentsOutput, error,
+) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Attachments")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	member, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo attachments without claim")
+
+		return nil, err
+	}
+
+	todo, err := s.store.FindMember(ctx, in.ID, member)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	attachments, err := s.store.Attachments(ctx, in.ID)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to fetch attachments", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	return &domain.AttachmentsOutput{ID: in.ID, Attachments: attachments}, nil
+}
@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAttachments(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   AttachmentsStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Attachments
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Attachments{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewAttachments(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAttachments_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	attachments := []domain.TodoAttachment{
+		{ID: 5, TodoID: 10, Filename: "notes.txt", StorageKey: "todos/10/5/notes.txt"},
+	}
+
+	type args struct {
+		ctx context.Context
+		in  domain.AttachmentsInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.AttachmentsOutput
+		wantErr error
+		mockFn  func(a args) *Attachments
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachmentsInput{ID: 10},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Attachments {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attachments")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Attachments{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.AttachmentsInput{ID: 10},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Attachments {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attachments")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Attachments{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachmentsInput{ID: 10},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Attachments {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAttachmentsStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attachments")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &Attachments{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachmentsInput{ID: 10},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Attachments {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAttachmentsStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attachments")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &Attachments{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreAttachments",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachmentsInput{ID: 10},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Attachments {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAttachmentsStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attachments")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				store.EXPECT().
+					Attachments(ctx, a.in.ID).
+					Return(nil, assert.AnError)
+
+				return &Attachments{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.AttachmentsInput{ID: 10},
+			},
+			want:    &domain.AttachmentsOutput{ID: 10, Attachments: attachments},
+			wantErr: nil,
+			mockFn: func(a args) *Attachments {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockAttachmentsStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Attachments")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 99}, nil)
+
+				store.EXPECT().
+					Attachments(ctx, a.in.ID).
+					Return(attachments, nil)
+
+				return &Attachments{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
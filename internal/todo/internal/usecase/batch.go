@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+)
+
+const batchMaxOperations = 100
+
+var errBatchSize = goerror.NewInvalidFormat("batch must have between 1 and 100 operations")
+
+type BatchStore interface {
+	CreateStore
+	UpdateStatusStore
+	DeleteStore
+}
+
+// batchEvents holds back the events of a batch operation, they are only
+// published once the transaction of the batch is committed.
+type batchEvents struct{}
+
+type batchPublisher struct{}
+
+func (batchPublisher) Publish(ctx context.Context, ev domain.TodoEvent) {
+	if events, ok := ctx.Value(batchEvents{}).(*[]domain.TodoEvent); ok {
+		*events = append(*events, ev)
+	}
+}
+
+// Batch runs the operations through the single todo use cases, each of them in
+// a savepoint of one transaction.
+type Batch struct {
+	telemetry      *lib.Telemetry
+	trx            sqlkit.Tx
+	publisher      domain.TodoEventPublisher
+	createUC       domain.Create
+	updateStatusUC domain.UpdateStatus
+	deleteUC       domain.Delete
+}
+
+func NewBatch(dep Dependency, s BatchStore) *Batch {
+	inner := dep
+	inner.Publisher = batchPublisher{}
+
+	return &Batch{
+		telemetry:      dep.Telemetry,
+		trx:            dep.Transaction,
+		publisher:      dep.Publisher,
+		createUC:       NewCreate(inner, s),
+		updateStatusUC: NewUpdateStatus(inner, s),
+		deleteUC:       NewDelete(inner, s),
+	}
+}
+
+func (s *Batch) Call(ctx context.Context, in domain.BatchInput) (*domain.BatchOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Batch")
+	defer span.End()
+
+	if n := len(in.Create) + len(in.UpdateStatus) + len(in.Delete); n == 0 || n > batchMaxOperations {
+		s.telemetry.Logger().Warn(ctx, "todo batch size rejected")
+
+		return nil, errBatchSize
+	}
+
+	out := &domain.BatchOutput{
+		Create:       make([]domain.BatchResult, 0, len(in.Create)),
+		UpdateStatus: make([]domain.BatchResult, 0, len(in.UpdateStatus)),
+		Delete:       make([]domain.BatchResult, 0, len(in.Delete)),
+	}
+	var events []domain.TodoEvent
+
+	err := s.trx.Transaction(ctx, func(ctx context.Context) error {
+		for i, item := range in.Create {
+			res, err := s.apply(ctx, in.Atomic, &events, func(ctx context.Context) (uint64, error) {
+				resp, err := s.createUC.Call(ctx, item)
+				if err != nil {
+					return 0, err
+				}
+
+				return resp.ID, nil
+			})
+			if err != nil {
+				s.telemetry.Logger().Warn(ctx, "todo batch aborted",
+					logger.KeyVal("operation", "create"), logger.KeyVal("index", i))
+
+				return err
+			}
+
+			out.Create = append(out.Create, res)
+		}
+
+		for i, item := range in.UpdateStatus {
+			res, err := s.apply(ctx, in.Atomic, &events, func(ctx context.Context) (uint64, error) {
+				_, err := s.updateStatusUC.Call(ctx, item)
+
+				return item.ID, err
+			})
+			if err != nil {
+				s.telemetry.Logger().Warn(ctx, "todo batch aborted",
+					logger.KeyVal("operation", "update_status"), logger.KeyVal("index", i))
+
+				return err
+			}
+
+			out.UpdateStatus = append(out.UpdateStatus, res)
+		}
+
+		for i, item := range in.Delete {
+			res, err := s.apply(ctx, in.Atomic, &events, func(ctx context.Context) (uint64, error) {
+				_, err := s.deleteUC.Call(ctx, item)
+
+				return item.ID, err
+			})
+			if err != nil {
+				s.telemetry.Logger().Warn(ctx, "todo batch aborted",
+					logger.KeyVal("operation", "delete"), logger.KeyVal("index", i))
+
+				return err
+			}
+
+			out.Delete = append(out.Delete, res)
+		}
+
+		return nil
+	})
+
+	var gerr *goerror.GoError
+	if errors.As(err, &gerr) {
+		return nil, err
+	}
+
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo batch fail to run", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	for _, ev := range events {
+		s.publisher.Publish(ctx, ev)
+	}
+
+	return out, nil
+}
+
+// apply runs one operation in its own savepoint and keeps its events once it
+// succeeded. The error is only returned in atomic mode, to abort the batch.
+func (s *Batch) apply(ctx context.Context, atomic bool, events *[]domain.TodoEvent,
+	call func(ctx context.Context) (uint64, error),
+) (domain.BatchResult, error) {
+	var id uint64
+	var pending []domain.TodoEvent
+
+	err := s.trx.Transaction(context.WithValue(ctx, batchEvents{}, &pending), func(ctx context.Context) error {
+		var err error
+		id, err = call(ctx)
+
+		return err
+	})
+	if err == nil {
+		*events = append(*events, pending...)
+
+		return domain.BatchResult{ID: id}, nil
+	}
+
+	if atomic {
+		return domain.BatchResult{}, err
+	}
+
+	// the use cases fail with goerror, anything else comes from the savepoint
+	var gerr *goerror.GoError
+	if !errors.As(err, &gerr) {
+		s.telemetry.Logger().Error(ctx, "todo batch fail to run operation", err)
+		err = goerror.NewServerInternal(err)
+	}
+
+	return domain.BatchResult{ID: id, Err: err}, nil
+}
@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewBatch(t *testing.T) {
+	inner := Dependency{Publisher: batchPublisher{}}
+
+	type args struct {
+		dep Dependency
+		s   BatchStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Batch
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Batch{
+				createUC:       NewCreate(inner, nil),
+				updateStatusUC: NewUpdateStatus(inner, nil),
+				deleteUC:       NewDelete(inner, nil),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewBatch(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBatch_Call(t *testing.T) {
+	created := domain.TodoEvent{Type: enum.New(domain.TodoEventTypeCreated), Todo: domain.Todo{ID: 1}}
+	deleted := domain.TodoEvent{Type: enum.New(domain.TodoEventTypeDeleted), Todo: domain.Todo{ID: 3}}
+	errConflict := goerror.NewBusiness("todo status can not change from DONE to INITIATE", goerror.CodeConflict)
+	in := domain.BatchInput{
+		Create:       []domain.CreateInput{{Title: "title", Description: "description"}},
+		UpdateStatus: []domain.UpdateStatusInput{{ID: 2, Status: "INITIATE"}},
+		Delete:       []domain.DeleteInput{{ID: 3}},
+	}
+
+	type args struct {
+		ctx context.Context
+		in  domain.BatchInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.BatchOutput
+		wantErr error
+		mockFn  func(a args) *Batch
+	}{
+		{
+			name:    "ErrorEmpty",
+			args:    args{ctx: context.Background(), in: domain.BatchInput{Atomic: true}},
+			want:    nil,
+			wantErr: errBatchSize,
+			mockFn: func(a args) *Batch {
+				mtel := lib.NewTelemetry()
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Batch")
+				defer span.End()
+
+				return &Batch{telemetry: mtel}
+			},
+		},
+		{
+			name: "ErrorTooLarge",
+			args: args{ctx: context.Background(), in: domain.BatchInput{
+				Delete: make([]domain.DeleteInput, batchMaxOperations+1),
+			}},
+			want:    nil,
+			wantErr: errBatchSize,
+			mockFn: func(a args) *Batch {
+				mtel := lib.NewTelemetry()
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Batch")
+				defer span.End()
+
+				return &Batch{telemetry: mtel}
+			},
+		},
+		{
+			name: "ErrorAtomic",
+			args: args{ctx: context.Background(), in: domain.BatchInput{
+				Atomic:       true,
+				Create:       in.Create,
+				UpdateStatus: in.UpdateStatus,
+				Delete:       in.Delete,
+			}},
+			want:    nil,
+			wantErr: errConflict,
+			mockFn: func(a args) *Batch {
+				mtel := lib.NewTelemetry()
+				createMock := mockz.NewMockCreate(t)
+				updateStatusMock := mockz.NewMockUpdateStatus(t)
+
+				createMock.EXPECT().
+					Call(mock.Anything, a.in.Create[0]).
+					RunAndReturn(func(ctx context.Context, _ domain.CreateInput) (*domain.CreateOutput, error) {
+						batchPublisher{}.Publish(ctx, created)
+
+						return &domain.CreateOutput{ID: 1}, nil
+					})
+
+				updateStatusMock.EXPECT().
+					Call(mock.Anything, a.in.UpdateStatus[0]).
+					Return(nil, errConflict)
+
+				// the created todo is rolled back, so its event is never published
+				return &Batch{
+					telemetry:      mtel,
+					trx:            sqlkit.NewNoopDB(),
+					publisher:      mockz.NewMockTodoEventPublisher(t),
+					createUC:       createMock,
+					updateStatusUC: updateStatusMock,
+				}
+			},
+		},
+		{
+			name: "ErrorAtomicNotGoError",
+			args: args{ctx: context.Background(), in: domain.BatchInput{
+				Atomic: true,
+				Delete: in.Delete,
+			}},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Batch {
+				mtel := lib.NewTelemetry()
+				deleteMock := mockz.NewMockDelete(t)
+
+				deleteMock.EXPECT().
+					Call(mock.Anything, a.in.Delete[0]).
+					Return(nil, assert.AnError)
+
+				return &Batch{
+					telemetry: mtel,
+					trx:       sqlkit.NewNoopDB(),
+					deleteUC:  deleteMock,
+				}
+			},
+		},
+		{
+			name: "SuccessBestEffort",
+			args: args{ctx: context.Background(), in: in},
+			want: &domain.BatchOutput{
+				Create:       []domain.BatchResult{{ID: 1}},
+				UpdateStatus: []domain.BatchResult{{ID: 2, Err: errConflict}},
+				Delete:       []domain.BatchResult{{ID: 3, Err: goerror.NewServerInternal(assert.AnError)}},
+			},
+			wantErr: nil,
+			mockFn: func(a args) *Batch {
+				mtel := lib.NewTelemetry()
+				createMock := mockz.NewMockCreate(t)
+				updateStatusMock := mockz.NewMockUpdateStatus(t)
+				deleteMock := mockz.NewMockDelete(t)
+				publisher := mockz.NewMockTodoEventPublisher(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Batch")
+				defer span.End()
+
+				createMock.EXPECT().
+					Call(mock.Anything, a.in.Create[0]).
+					RunAndReturn(func(ctx context.Context, _ domain.CreateInput) (*domain.CreateOutput, error) {
+						batchPublisher{}.Publish(ctx, created)
+
+						return &domain.CreateOutput{ID: 1}, nil
+					})
+
+				updateStatusMock.EXPECT().
+					Call(mock.Anything, a.in.UpdateStatus[0]).
+					Return(nil, errConflict)
+
+				deleteMock.EXPECT().
+					Call(mock.Anything, a.in.Delete[0]).
+					RunAndReturn(func(ctx context.Context, _ domain.DeleteInput) (*domain.DeleteOutput, error) {
+						batchPublisher{}.Publish(ctx, deleted)
+
+						return nil, assert.AnError
+					})
+
+				// only the operations that succeeded are announced
+				publisher.EXPECT().Publish(ctx, created)
+
+				return &Batch{
+					telemetry:      mtel,
+					trx:            sqlkit.NewNoopDB(),
+					publisher:      publisher,
+					createUC:       createMock,
+					updateStatusUC: updateStatusMock,
+					deleteUC:       deleteMock,
+				}
+			},
+		},
+		{
+			name: "SuccessAtomic",
+			args: args{ctx: context.Background(), in: domain.BatchInput{
+				Atomic: true,
+				Create: in.Create,
+				Delete: in.Delete,
+			}},
+			want: &domain.BatchOutput{
+				Create:       []domain.BatchResult{{ID: 1}},
+				UpdateStatus: []domain.BatchResult{},
+				Delete:       []domain.BatchResult{{ID: 3}},
+			},
+			wantErr: nil,
+			mockFn: func(a args) *Batch {
+				mtel := lib.NewTelemetry()
+				createMock := mockz.NewMockCreate(t)
+				deleteMock := mockz.NewMockDelete(t)
+				publisher := mockz.NewMockTodoEventPublisher(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Batch")
+				defer span.End()
+
+				createMock.EXPECT().
+					Call(mock.Anything, a.in.Create[0]).
+					RunAndReturn(func(ctx context.Context, _ domain.CreateInput) (*domain.CreateOutput, error) {
+						batchPublisher{}.Publish(ctx, created)
+
+						return &domain.CreateOutput{ID: 1}, nil
+					})
+
+				deleteMock.EXPECT().
+					Call(mock.Anything, a.in.Delete[0]).
+					RunAndReturn(func(ctx context.Context, _ domain.DeleteInput) (*domain.DeleteOutput, error) {
+						batchPublisher{}.Publish(ctx, deleted)
+
+						return &domain.DeleteOutput{ID: 3}, nil
+					})
+
+				publisher.EXPECT().Publish(ctx, created)
+				publisher.EXPECT().Publish(ctx, deleted)
+
+				return &Batch{
+					telemetry: mtel,
+					trx:       sqlkit.NewNoopDB(),
+					publisher: publisher,
+					createUC:  createMock,
+					deleteUC:  deleteMock,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/shandysiswandi/goreng/enum"
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/lib"
@@ -18,9 +17,10 @@ type CreateStore interface {
 }
 
 type Create struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 	validator validation.Validator
 	uidnumber uid.NumberID
+	publisher domain.TodoEventPublisher
 	store     CreateStore
 }
 
@@ -29,6 +29,7 @@ func NewCreate(dep Dependency, s CreateStore) *Create {
 		telemetry: dep.Telemetry,
 		uidnumber: dep.UIDNumber,
 		validator: dep.Validator,
+		publisher: dep.Publisher,
 		store:     s,
 	}
 }
@@ -49,13 +50,18 @@ func (s *Create) Call(ctx context.Context, in domain.CreateInput) (*domain.Creat
 		userID = clm.AuthID
 	}
 
-	err := s.store.Create(ctx, domain.Todo{
+	todo := domain.Todo{
 		ID:          id,
 		UserID:      userID,
 		Title:       in.Title,
 		Description: in.Description,
 		Status:      enum.New(domain.TodoStatusInitiate),
-	})
+		Priority:    priority(in.Priority),
+		DueAt:       in.DueAt,
+		Tags:        normalizeTags(in.Tags),
+	}
+
+	err := s.store.Create(ctx, todo)
 	if errors.Is(err, domain.ErrTodoNotCreated) {
 		s.telemetry.Logger().Warn(ctx, "todo created but db not affected")
 
@@ -68,5 +74,7 @@ func (s *Create) Call(ctx context.Context, in domain.CreateInput) (*domain.Creat
 		return nil, goerror.NewServerInternal(err)
 	}
 
+	s.publisher.Publish(ctx, domain.NewTodoEvent(domain.TodoEventTypeCreated, todo))
+
 	return &domain.CreateOutput{ID: id}, nil
 }
@@ -9,7 +9,6 @@ import (
 	"github.com/shandysiswandi/goreng/goerror"
 	um "github.com/shandysiswandi/goreng/mocker"
 	vm "github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
@@ -42,6 +41,7 @@ func TestNewCreate(t *testing.T) {
 }
 
 func TestCreate_Execute(t *testing.T) {
+	due := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
 	claim := lib.NewJWTClaim(11, "email", time.Time{}, nil)
 	ctx := lib.SetJWTClaim(context.Background(), claim)
 
@@ -69,7 +69,7 @@ func TestCreate_Execute(t *testing.T) {
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *Create {
 				validator := vm.NewMockValidator(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(a.ctx, "todo.usecase.Create")
 				defer span.End()
@@ -100,7 +100,7 @@ func TestCreate_Execute(t *testing.T) {
 				validator := vm.NewMockValidator(t)
 				idgen := um.NewMockNumberID(t)
 				store := mockz.NewMockCreateStore(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.usecase.Create")
 				defer span.End()
@@ -119,6 +119,7 @@ func TestCreate_Execute(t *testing.T) {
 					Title:       a.in.Title,
 					Description: a.in.Description,
 					Status:      enum.New(domain.TodoStatusInitiate),
+					Priority:    enum.New(domain.TodoPriorityMedium),
 				}
 				store.EXPECT().
 					Create(ctx, input).
@@ -147,7 +148,7 @@ func TestCreate_Execute(t *testing.T) {
 				validator := vm.NewMockValidator(t)
 				idgen := um.NewMockNumberID(t)
 				store := mockz.NewMockCreateStore(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.usecase.Create")
 				defer span.End()
@@ -166,6 +167,7 @@ func TestCreate_Execute(t *testing.T) {
 					Title:       a.in.Title,
 					Description: a.in.Description,
 					Status:      enum.New(domain.TodoStatusInitiate),
+					Priority:    enum.New(domain.TodoPriorityMedium),
 				}
 				store.EXPECT().
 					Create(ctx, input).
@@ -194,7 +196,7 @@ func TestCreate_Execute(t *testing.T) {
 				validator := vm.NewMockValidator(t)
 				idgen := um.NewMockNumberID(t)
 				store := mockz.NewMockCreateStore(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(a.ctx, "todo.usecase.Create")
 				defer span.End()
@@ -213,14 +215,78 @@ func TestCreate_Execute(t *testing.T) {
 					Title:       a.in.Title,
 					Description: a.in.Description,
 					Status:      enum.New(domain.TodoStatusInitiate),
+					Priority:    enum.New(domain.TodoPriorityMedium),
 				}
 				store.EXPECT().
 					Create(ctx, input).
 					Return(nil)
 
+				publisher := mockz.NewMockTodoEventPublisher(t)
+				publisher.EXPECT().
+					Publish(ctx, domain.NewTodoEvent(domain.TodoEventTypeCreated, input))
+
+				return &Create{
+					telemetry: tel,
+					store:     store,
+					publisher: publisher,
+					uidnumber: idgen,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "SuccessWithDetails",
+			args: args{
+				ctx: ctx,
+				in: domain.CreateInput{
+					Title:       "title",
+					Description: "description",
+					Priority:    "HIGH",
+					DueAt:       &due,
+					Tags:        []string{" Work ", "work", "home", ""},
+				},
+			},
+			want:    &domain.CreateOutput{ID: 101},
+			wantErr: nil,
+			mockFn: func(a args) *Create {
+				validator := vm.NewMockValidator(t)
+				idgen := um.NewMockNumberID(t)
+				store := mockz.NewMockCreateStore(t)
+				tel := lib.NewTelemetry()
+
+				ctx, span := tel.Tracer().Start(a.ctx, "todo.usecase.Create")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				idgen.EXPECT().
+					Generate().
+					Return(101)
+
+				input := domain.Todo{
+					ID:          101,
+					UserID:      11,
+					Title:       a.in.Title,
+					Description: a.in.Description,
+					Status:      enum.New(domain.TodoStatusInitiate),
+					Priority:    enum.New(domain.TodoPriorityHigh),
+					DueAt:       &due,
+					Tags:        []string{"work", "home"},
+				}
+				store.EXPECT().
+					Create(ctx, input).
+					Return(nil)
+
+				publisher := mockz.NewMockTodoEventPublisher(t)
+				publisher.EXPECT().
+					Publish(ctx, domain.NewTodoEvent(domain.TodoEventTypeCreated, input))
+
 				return &Create{
 					telemetry: tel,
 					store:     store,
+					publisher: publisher,
 					uidnumber: idgen,
 					validator: validator,
 				}
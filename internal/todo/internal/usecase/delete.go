@@ -2,20 +2,27 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/shandysiswandi/goreng/clock"
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
 type DeleteStore interface {
-	Delete(ctx context.Context, in uint64) error
+	Find(ctx context.Context, id, owner uint64) (*domain.Todo, error)
+	Delete(ctx context.Context, id, owner uint64, at time.Time) error
 }
 
 type Delete struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 	validator validation.Validator
+	clock     clock.Clocker
+	scope     scope
+	publisher domain.TodoEventPublisher
 	store     DeleteStore
 }
 
@@ -23,6 +30,9 @@ func NewDelete(dep Dependency, s DeleteStore) *Delete {
 	return &Delete{
 		telemetry: dep.Telemetry,
 		validator: dep.Validator,
+		clock:     dep.Clock,
+		scope:     newScope(dep.Config),
+		publisher: dep.Publisher,
 		store:     s,
 	}
 }
@@ -37,11 +47,44 @@ func (s *Delete) Call(ctx context.Context, in domain.DeleteInput) (*domain.Delet
 		return nil, goerror.NewInvalidInput("Invalid request payload", err)
 	}
 
-	if err := s.store.Delete(ctx, in.ID); err != nil {
+	owner, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo delete without claim")
+
+		return nil, err
+	}
+
+	// looked up first, so the event reaches its members even when an admin deletes it
+	todo, err := s.store.Find(ctx, in.ID, owner)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	// the todo goes to the trash, it can be restored or purged later
+	now := s.clock.Now()
+	err = s.store.Delete(ctx, in.ID, owner, now)
+	if errors.Is(err, domain.ErrTodoNotDeleted) {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	if err != nil {
 		s.telemetry.Logger().Error(ctx, "todo fail to delete", err)
 
 		return nil, goerror.NewServerInternal(err)
 	}
 
+	todo.DeletedAt = &now
+	s.publisher.Publish(ctx, domain.NewTodoEvent(domain.TodoEventTypeDeleted, *todo))
+
 	return &domain.DeleteOutput{ID: in.ID}, nil
 }
@@ -3,10 +3,11 @@ package usecase
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/shandysiswandi/goreng/goerror"
 	vm "github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +39,9 @@ func TestNewDelete(t *testing.T) {
 }
 
 func TestDelete_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
 	type args struct {
 		ctx context.Context
 		in  domain.DeleteInput
@@ -52,13 +56,13 @@ func TestDelete_Call(t *testing.T) {
 		{
 			name: "ErrorValidation",
 			args: args{
-				ctx: context.Background(),
+				ctx: ctxJWT,
 				in:  domain.DeleteInput{ID: 12},
 			},
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *Delete {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 
 				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Delete")
@@ -76,15 +80,41 @@ func TestDelete_Call(t *testing.T) {
 			},
 		},
 		{
-			name: "ErrorStore",
+			name: "ErrorWithoutClaim",
 			args: args{
 				ctx: context.Background(),
 				in:  domain.DeleteInput{ID: 12},
 			},
 			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Delete {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Delete")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Delete{
+					telemetry: mtel,
+					store:     nil,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DeleteInput{ID: 12},
+			},
+			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Delete {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockDeleteStore(t)
 
@@ -96,28 +126,185 @@ func TestDelete_Call(t *testing.T) {
 					Return(nil)
 
 				store.EXPECT().
-					Delete(ctx, a.in.ID).
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &Delete{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DeleteInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Delete {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDeleteStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Delete")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &Delete{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreDelete",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DeleteInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Delete {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDeleteStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Delete")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				store.EXPECT().
+					Delete(ctx, a.in.ID, uint64(11), now).
 					Return(assert.AnError)
 
 				return &Delete{
 					telemetry: mtel,
 					store:     store,
 					validator: validator,
+					clock:     clock,
+				}
+			},
+		},
+		{
+			name: "ErrorNotDeleted",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DeleteInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Delete {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDeleteStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Delete")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				store.EXPECT().
+					Delete(ctx, a.in.ID, uint64(11), now).
+					Return(domain.ErrTodoNotDeleted)
+
+				return &Delete{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+					clock:     clock,
+				}
+			},
+		},
+		{
+			name: "SuccessAsAdmin",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DeleteInput{ID: 12},
+			},
+			want:    &domain.DeleteOutput{ID: 12},
+			wantErr: nil,
+			mockFn: func(a args) *Delete {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDeleteStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Delete")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(0)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 20}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				store.EXPECT().
+					Delete(ctx, a.in.ID, uint64(0), now).Return(nil)
+
+				publisher := mockz.NewMockTodoEventPublisher(t)
+				publisher.EXPECT().
+					Publish(ctx, domain.NewTodoEvent(
+						domain.TodoEventTypeDeleted,
+						domain.Todo{ID: a.in.ID, UserID: 20, DeletedAt: &now},
+					))
+
+				return &Delete{
+					telemetry: mtel,
+					store:     store,
+					publisher: publisher,
+					validator: validator,
+					clock:     clock,
+					scope:     scope{admins: map[uint64]struct{}{11: {}}},
 				}
 			},
 		},
 		{
 			name: "Success",
 			args: args{
-				ctx: context.Background(),
+				ctx: ctxJWT,
 				in:  domain.DeleteInput{ID: 12},
 			},
 			want:    &domain.DeleteOutput{ID: 12},
 			wantErr: nil,
 			mockFn: func(a args) *Delete {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockDeleteStore(t)
+				clock := vm.NewMockClocker(t)
 
 				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Delete")
 				defer span.End()
@@ -127,12 +314,27 @@ func TestDelete_Call(t *testing.T) {
 					Return(nil)
 
 				store.EXPECT().
-					Delete(ctx, a.in.ID).Return(nil)
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				store.EXPECT().
+					Delete(ctx, a.in.ID, uint64(11), now).Return(nil)
+
+				publisher := mockz.NewMockTodoEventPublisher(t)
+				publisher.EXPECT().
+					Publish(ctx, domain.NewTodoEvent(
+						domain.TodoEventTypeDeleted,
+						domain.Todo{ID: a.in.ID, UserID: 11, DeletedAt: &now},
+					))
 
 				return &Delete{
 					telemetry: mtel,
 					store:     store,
+					publisher: publisher,
 					validator: validator,
+					clock:     clock,
 				}
 			},
 		},
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+type DetachStore interface {
+	FindMember(ctx context.Context, id, member uint64) (*domain.Todo, error)
+	FindAttachment(ctx context.Context, todoID, id uint64) (*domain.TodoAttachment, error)
+	DeleteAttachment(ctx context.Context, todoID, id uint64) error
+}
+
+// Detach removes an attachment and its blob, the owner and editors can do it.
+type Detach struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	scope     scope
+	blob      BlobStore
+	store     DetachStore
+}
+
+func NewDetach(dep Dependency, s DetachStore) *Detach {
+	return &Detach{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		scope:     newScope(dep.Config),
+		blob:      dep.Blob,
+		store:     s,
+	}
+}
+
+func (s *Detach) Call(ctx context.Context, in domain.DetachInput) (*domain.DetachOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Detach")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	member, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo detach without claim")
+
+		return nil, err
+	}
+
+	todo, err := s.store.FindMember(ctx, in.ID, member)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	if member > 0 && !todo.CanEdit(member) {
+		s.telemetry.Logger().Warn(ctx, "todo detach by viewer rejected")
+
+		return nil, errViewerReadOnly
+	}
+
+	att, err := s.store.FindAttachment(ctx, in.ID, in.AttachmentID)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find attachment", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if att == nil {
+		s.telemetry.Logger().Warn(ctx, "todo attachment is not found")
+
+		return nil, goerror.NewBusiness("attachment not found", goerror.CodeNotFound)
+	}
+
+	if err := s.store.DeleteAttachment(ctx, in.ID, in.AttachmentID); err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to delete attachment", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	// the attachment is gone for the users already, a blob left behind is only logged
+	if err := s.blob.Delete(ctx, att.StorageKey); err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to remove attachment blob", err)
+	}
+
+	return &domain.DetachOutput{ID: in.ID, AttachmentID: in.AttachmentID}, nil
+}
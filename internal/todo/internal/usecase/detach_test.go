@@ -0,0 +1,418 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDetach(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   DetachStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Detach
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Detach{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewDetach(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDetach_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	attachment := &domain.TodoAttachment{
+		ID: 5, TodoID: 10, Filename: "notes.txt", StorageKey: "todos/10/5/notes.txt",
+	}
+	viewer := &domain.Todo{ID: 10, UserID: 99, Collaborators: []domain.TodoCollaborator{
+		{TodoID: 10, UserID: 11, Role: enum.New(domain.TodoRoleViewer), AcceptedAt: &now},
+	}}
+	editor := &domain.Todo{ID: 10, UserID: 99, Collaborators: []domain.TodoCollaborator{
+		{TodoID: 10, UserID: 11, Role: enum.New(domain.TodoRoleEditor), AcceptedAt: &now},
+	}}
+
+	type args struct {
+		ctx context.Context
+		in  domain.DetachInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.DetachOutput
+		wantErr error
+		mockFn  func(a args) *Detach
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDetachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDetachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorViewer",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: errViewerReadOnly,
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDetachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(viewer, nil)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFindAttachment",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDetachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				store.EXPECT().
+					FindAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(nil, assert.AnError)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorAttachmentNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("attachment not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDetachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				store.EXPECT().
+					FindAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(nil, nil)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreDeleteAttachment",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDetachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				store.EXPECT().
+					FindAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(attachment, nil)
+
+				store.EXPECT().
+					DeleteAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(assert.AnError)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "SuccessBlobLeftBehind",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    &domain.DetachOutput{ID: 10, AttachmentID: 5},
+			wantErr: nil,
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				blob := mockz.NewMockBlobStore(t)
+				store := mockz.NewMockDetachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				store.EXPECT().
+					FindAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(attachment, nil)
+
+				store.EXPECT().
+					DeleteAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(nil)
+
+				blob.EXPECT().
+					Delete(ctx, "todos/10/5/notes.txt").
+					Return(assert.AnError)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+					blob:      blob,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DetachInput{ID: 10, AttachmentID: 5},
+			},
+			want:    &domain.DetachOutput{ID: 10, AttachmentID: 5},
+			wantErr: nil,
+			mockFn: func(a args) *Detach {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				blob := mockz.NewMockBlobStore(t)
+				store := mockz.NewMockDetachStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Detach")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(editor, nil)
+
+				store.EXPECT().
+					FindAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(attachment, nil)
+
+				store.EXPECT().
+					DeleteAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(nil)
+
+				blob.EXPECT().
+					Delete(ctx, "todos/10/5/notes.txt").
+					Return(nil)
+
+				return &Detach{
+					telemetry: mtel,
+					validator: validator,
+					blob:      blob,
+					store:     store,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
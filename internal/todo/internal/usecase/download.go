@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+type DownloadStore interface {
+	FindMember(ctx context.Context, id, member uint64) (*domain.Todo, error)
+	FindAttachment(ctx context.Context, todoID, id uint64) (*domain.TodoAttachment, error)
+}
+
+// Download hands out a signed link to an attachment of a todo the caller can
+// see. The link expires after `todo.attachment.url.ttl` minutes.
+type Download struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	clock     clock.Clocker
+	scope     scope
+	policy    attachmentPolicy
+	blob      BlobStore
+	store     DownloadStore
+}
+
+func NewDownload(dep Dependency, s DownloadStore) *Download {
+	return &Download{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		clock:     dep.Clock,
+		scope:     newScope(dep.Config),
+		policy:    newAttachmentPolicy(dep.Config),
+		blob:      dep.Blob,
+		store:     s,
+	}
+}
+
+func (s *Download) Call(ctx context.Context, in domain.DownloadInput) (*domain.DownloadOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Download")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	member, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo download without claim")
+
+		return nil, err
+	}
+
+	todo, err := s.store.FindMember(ctx, in.ID, member)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	att, err := s.store.FindAttachment(ctx, in.ID, in.AttachmentID)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find attachment", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if att == nil {
+		s.telemetry.Logger().Warn(ctx, "todo attachment is not found")
+
+		return nil, goerror.NewBusiness("attachment not found", goerror.CodeNotFound)
+	}
+
+	url, err := s.blob.SignedURL(ctx, att.StorageKey, s.policy.linkTTL)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to sign attachment url", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	return &domain.DownloadOutput{URL: url, ExpiresAt: s.clock.Now().Add(s.policy.linkTTL)}, nil
+}
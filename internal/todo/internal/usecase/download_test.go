@@ -0,0 +1,342 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDownload(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   DownloadStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Download
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Download{policy: newAttachmentPolicy(nil)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewDownload(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDownload_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	policy := attachmentPolicy{linkTTL: 15 * time.Minute}
+	attachment := &domain.TodoAttachment{
+		ID: 5, TodoID: 10, Filename: "notes.txt", StorageKey: "todos/10/5/notes.txt",
+	}
+
+	type args struct {
+		ctx context.Context
+		in  domain.DownloadInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.DownloadOutput
+		wantErr error
+		mockFn  func(a args) *Download
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DownloadInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Download {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Download")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Download{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.DownloadInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Download {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Download")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Download{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DownloadInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Download {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDownloadStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Download")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &Download{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DownloadInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Download {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDownloadStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Download")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &Download{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFindAttachment",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DownloadInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Download {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDownloadStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Download")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				store.EXPECT().
+					FindAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(nil, assert.AnError)
+
+				return &Download{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorAttachmentNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DownloadInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("attachment not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Download {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockDownloadStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Download")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				store.EXPECT().
+					FindAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(nil, nil)
+
+				return &Download{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorSignURL",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DownloadInput{ID: 10, AttachmentID: 5},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Download {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				blob := mockz.NewMockBlobStore(t)
+				store := mockz.NewMockDownloadStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Download")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				store.EXPECT().
+					FindAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(attachment, nil)
+
+				blob.EXPECT().
+					SignedURL(ctx, "todos/10/5/notes.txt", 15*time.Minute).
+					Return("", assert.AnError)
+
+				return &Download{
+					telemetry: mtel,
+					validator: validator,
+					policy:    policy,
+					blob:      blob,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.DownloadInput{ID: 10, AttachmentID: 5},
+			},
+			want:    &domain.DownloadOutput{URL: "https://blob/notes.txt", ExpiresAt: now.Add(15 * time.Minute)},
+			wantErr: nil,
+			mockFn: func(a args) *Download {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				clock := vm.NewMockClocker(t)
+				blob := mockz.NewMockBlobStore(t)
+				store := mockz.NewMockDownloadStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Download")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: 10, UserID: 11}, nil)
+
+				store.EXPECT().
+					FindAttachment(ctx, a.in.ID, a.in.AttachmentID).
+					Return(attachment, nil)
+
+				blob.EXPECT().
+					SignedURL(ctx, "todos/10/5/notes.txt", 15*time.Minute).
+					Return("https://blob/notes.txt", nil)
+
+				clock.EXPECT().
+					Now().
+					Return(now)
+
+				return &Download{
+					telemetry: mtel,
+					validator: validator,
+					clock:     clock,
+					policy:    policy,
+					blob:      blob,
+					store:     store,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,27 +3,81 @@ package usecase
 import (
 	"context"
 	"encoding/base64"
-	"strconv"
+	"encoding/json"
+	"strings"
+	"time"
 
 	"github.com/shandysiswandi/goreng/enum"
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/pagination"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
+var errInvalidCursor = goerror.NewInvalidFormat("cursor is invalid for this sort")
+
 type FetchStore interface {
 	Fetch(ctx context.Context, filter map[string]any) ([]domain.Todo, error)
 }
 
+// fetchCursor is the position of the last todo of a page. It keeps the sort it
+// was made for and the sort key of that todo, with the id as tie breaker, so the
+// next page continues right after it whatever the order is.
+type fetchCursor struct {
+	Sort string     `json:"s"`
+	ID   uint64     `json:"i"`
+	Time *time.Time `json:"t,omitempty"` // created_at or due_at, nil for a todo without due date
+	Rank int        `json:"r,omitempty"` // priority
+}
+
+func newFetchCursor(sort string, todo domain.Todo) fetchCursor {
+	c := fetchCursor{Sort: sort, ID: todo.ID}
+
+	switch strings.TrimPrefix(sort, "-") {
+	case "created_at":
+		c.Time = &todo.CreatedAt
+	case "due_at":
+		c.Time = todo.DueAt
+	case "priority":
+		c.Rank = int(todo.Priority.Enum())
+	}
+
+	return c
+}
+
+func (c fetchCursor) encode() string {
+	//nolint:errchkjson // fetchCursor always encodes
+	raw, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeFetchCursor(s string) (fetchCursor, error) {
+	var c fetchCursor
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+
+	err = json.Unmarshal(raw, &c)
+
+	return c, err
+}
+
 type Fetch struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	scope     scope
 	store     FetchStore
 }
 
 func NewFetch(dep Dependency, s FetchStore) *Fetch {
 	return &Fetch{
 		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		scope:     newScope(dep.Config),
 		store:     s,
 	}
 }
@@ -32,15 +86,41 @@ func (s *Fetch) Call(ctx context.Context, in domain.FetchInput) (*domain.FetchOu
 	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Fetch")
 	defer span.End()
 
-	cursor, limit := pagination.ParseCursorBased(in.Cursor, in.Limit)
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
 
-	filter := map[string]any{
-		"cursor": cursor,
-		"limit":  limit,
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
 	}
 
-	if in.Status != "" {
-		filter["status"] = enum.New(enum.Parse[domain.TodoStatus](in.Status))
+	owner, err := s.scope.fetchOwner(ctx, in.UserID)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo fetch scope rejected")
+
+		return nil, err
+	}
+
+	sort := in.Sort
+	if sort == "" {
+		sort = "id"
+	}
+
+	// the cursor is composite, only the limit is parsed the shared way
+	_, limit := pagination.ParseCursorBased("", in.Limit)
+
+	filter, err := fetchFilter(in, sort)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo fetch filter rejected")
+
+		return nil, err
+	}
+
+	filter["limit"] = limit
+
+	// listing their own todos, users also get the todos shared with them
+	if in.UserID == "" {
+		filter["member_id"] = owner
+	} else {
+		filter["user_id"] = owner
 	}
 
 	todos, err := s.store.Fetch(ctx, filter)
@@ -51,11 +131,11 @@ func (s *Fetch) Call(ctx context.Context, in domain.FetchInput) (*domain.FetchOu
 	}
 
 	nextCursor := ""
-	hasMore := len(todos) > limit
+	hasMore := limit > 0 && len(todos) > limit
 
 	if hasMore {
-		nextCursor = base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(todos[limit].ID, 10)))
 		todos = todos[:limit]
+		nextCursor = newFetchCursor(sort, todos[limit-1]).encode()
 	}
 
 	return &domain.FetchOutput{
@@ -64,3 +144,54 @@ func (s *Fetch) Call(ctx context.Context, in domain.FetchInput) (*domain.FetchOu
 		HasMore:    hasMore,
 	}, nil
 }
+
+// fetchFilter turns the input into the filter of the store, without the limit
+// and the owner.
+func fetchFilter(in domain.FetchInput, sort string) (map[string]any, error) {
+	filter := map[string]any{
+		"sort": strings.TrimPrefix(sort, "-"),
+		"desc": strings.HasPrefix(sort, "-"),
+	}
+
+	if in.Cursor != "" {
+		cursor, err := decodeFetchCursor(in.Cursor)
+		if err != nil || cursor.Sort != sort {
+			return nil, errInvalidCursor
+		}
+
+		filter["after_id"] = cursor.ID
+		filter["after_time"] = cursor.Time
+		filter["after_rank"] = cursor.Rank
+	}
+
+	if in.Status != "" {
+		filter["status"] = enum.New(enum.Parse[domain.TodoStatus](in.Status))
+	}
+
+	if in.Query != "" {
+		filter["query"] = in.Query
+	}
+
+	if tags := normalizeTags(in.Tags); len(tags) > 0 {
+		filter["tags"] = tags
+	}
+
+	if in.Priority != "" {
+		filter["priority"] = priority(in.Priority)
+	}
+
+	for key, value := range map[string]string{"due_from": in.DueFrom, "due_to": in.DueTo} {
+		if value == "" {
+			continue
+		}
+
+		at, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, goerror.NewInvalidFormat(key + " must be a RFC 3339 time")
+		}
+
+		filter[key] = at
+	}
+
+	return filter, nil
+}
@@ -3,11 +3,13 @@ package usecase
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/shandysiswandi/goreng/enum"
 	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
 	"github.com/shandysiswandi/goreng/pagination"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +41,26 @@ func TestNewFetch(t *testing.T) {
 }
 
 func TestFetch_Execute(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(2, "email", time.Time{}, nil))
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	dueFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	dueTo := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// a full page and one more todo, newest first, for the limit of the input
+	_, limit := pagination.ParseCursorBased("", "2")
+	page := make([]domain.Todo, 0, limit+1)
+	for i := range limit + 1 {
+		page = append(page, domain.Todo{
+			ID:        uint64(100 - i),
+			UserID:    2,
+			Title:     "test",
+			Status:    enum.New(domain.TodoStatusDone),
+			Priority:  enum.New(domain.TodoPriorityHigh),
+			CreatedAt: createdAt.Add(-time.Duration(i) * time.Hour),
+		})
+	}
+	last := page[limit-1]
+
 	type args struct {
 		ctx context.Context
 		in  domain.FetchInput
@@ -51,29 +73,201 @@ func TestFetch_Execute(t *testing.T) {
 		mockFn  func(a args) *Fetch
 	}{
 		{
-			name: "ErrorStore",
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.FetchInput{Sort: "title"},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Fetch {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Fetch")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Fetch{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
 			args: args{
 				ctx: context.Background(),
+				in: domain.FetchInput{
+					Limit:  "1",
+					UserID: "",
+				},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Fetch {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Fetch")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Fetch{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorInvalidUserID",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.FetchInput{
+					Limit:  "1",
+					UserID: "abc",
+				},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidFormat("user_id must be a positive number"),
+			mockFn: func(a args) *Fetch {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Fetch")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Fetch{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorOverrideNotAdmin",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.FetchInput{
+					Limit:  "1",
+					UserID: "3",
+				},
+			},
+			want:    nil,
+			wantErr: errScopeForbidden,
+			mockFn: func(a args) *Fetch {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Fetch")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Fetch{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorMalformedCursor",
+			args: args{
+				ctx: ctxJWT,
 				in: domain.FetchInput{
 					Cursor: "NTY",
 					Limit:  "1",
+				},
+			},
+			want:    nil,
+			wantErr: errInvalidCursor,
+			mockFn: func(a args) *Fetch {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Fetch")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Fetch{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorCursorOfOtherSort",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.FetchInput{
+					Cursor: fetchCursor{Sort: "id", ID: 9}.encode(),
+					Limit:  "1",
+					Sort:   "-created_at",
+				},
+			},
+			want:    nil,
+			wantErr: errInvalidCursor,
+			mockFn: func(a args) *Fetch {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Fetch")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Fetch{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStore",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.FetchInput{
+					Limit:  "1",
 					Status: "",
 				},
 			},
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Fetch {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockFetchStore(t)
 
 				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Fetch")
 				defer span.End()
 
-				cursor, limit := pagination.ParseCursorBased(a.in.Cursor, a.in.Limit)
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				_, limit := pagination.ParseCursorBased("", a.in.Limit)
 
 				filter := map[string]any{
-					"cursor": cursor,
-					"limit":  limit,
+					"limit":     limit,
+					"member_id": uint64(2),
+					"sort":      "id",
+					"desc":      false,
 				}
 				store.EXPECT().
 					Fetch(ctx, filter).
@@ -81,6 +275,7 @@ func TestFetch_Execute(t *testing.T) {
 
 				return &Fetch{
 					telemetry: mtel,
+					validator: validator,
 					store:     store,
 				}
 			},
@@ -88,53 +283,111 @@ func TestFetch_Execute(t *testing.T) {
 		{
 			name: "Success",
 			args: args{
-				ctx: context.Background(),
+				ctx: ctxJWT,
+				in: domain.FetchInput{
+					Cursor:   fetchCursor{Sort: "-created_at", ID: 101, Time: &createdAt}.encode(),
+					Limit:    "2",
+					Status:   "DONE",
+					Query:    "milk",
+					Tags:     []string{"Home", " work"},
+					Priority: "HIGH",
+					DueFrom:  "2025-01-01T00:00:00Z",
+					DueTo:    "2025-02-01T00:00:00Z",
+					Sort:     "-created_at",
+				},
+			},
+			want: &domain.FetchOutput{
+				Todos:      page[:limit],
+				NextCursor: fetchCursor{Sort: "-created_at", ID: last.ID, Time: &last.CreatedAt}.encode(),
+				HasMore:    true,
+			},
+			wantErr: nil,
+			mockFn: func(a args) *Fetch {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockFetchStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Fetch")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				filter := map[string]any{
+					"limit":      limit,
+					"member_id":  uint64(2),
+					"sort":       "created_at",
+					"desc":       true,
+					"after_id":   uint64(101),
+					"after_time": &createdAt,
+					"after_rank": 0,
+					"status":     enum.New(domain.TodoStatusDone),
+					"query":      "milk",
+					"tags":       []string{"home", "work"},
+					"priority":   enum.New(domain.TodoPriorityHigh),
+					"due_from":   dueFrom,
+					"due_to":     dueTo,
+				}
+				store.EXPECT().
+					Fetch(ctx, filter).
+					Return(page, nil)
+
+				return &Fetch{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "SuccessOverrideAsAdmin",
+			args: args{
+				ctx: ctxJWT,
 				in: domain.FetchInput{
-					Cursor: "",
 					Limit:  "1",
-					Status: "done",
+					UserID: "3",
 				},
 			},
 			want: &domain.FetchOutput{
 				Todos: []domain.Todo{{
-					ID:          1,
-					UserID:      2,
-					Title:       "test 1",
-					Description: "test 1",
-					Status:      enum.New(domain.TodoStatusDone),
+					ID:          5,
+					UserID:      3,
+					Title:       "test 5",
+					Description: "test 5",
+					Status:      enum.New(domain.TodoStatusInitiate),
 				}},
-				NextCursor: "Mg",
-				HasMore:    true,
+				NextCursor: "",
+				HasMore:    false,
 			},
 			wantErr: nil,
 			mockFn: func(a args) *Fetch {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockFetchStore(t)
 
 				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Fetch")
 				defer span.End()
 
-				cursor, limit := pagination.ParseCursorBased(a.in.Cursor, a.in.Limit)
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				_, limit := pagination.ParseCursorBased("", a.in.Limit)
 
 				filter := map[string]any{
-					"cursor": cursor,
-					"limit":  limit,
-					"status": enum.New(enum.Parse[domain.TodoStatus](a.in.Status)),
+					"limit":   limit,
+					"user_id": uint64(3),
+					"sort":    "id",
+					"desc":    false,
 				}
 				todos := []domain.Todo{
 					{
-						ID:          1,
-						UserID:      2,
-						Title:       "test 1",
-						Description: "test 1",
-						Status:      enum.New(domain.TodoStatusDone),
-					},
-					{
-						ID:          2,
-						UserID:      2,
-						Title:       "test 2",
-						Description: "test 2",
-						Status:      enum.New(domain.TodoStatusDone),
+						ID:          5,
+						UserID:      3,
+						Title:       "test 5",
+						Description: "test 5",
+						Status:      enum.New(domain.TodoStatusInitiate),
 					},
 				}
 				store.EXPECT().
@@ -143,7 +396,9 @@ func TestFetch_Execute(t *testing.T) {
 
 				return &Fetch{
 					telemetry: mtel,
+					validator: validator,
 					store:     store,
+					scope:     scope{admins: map[uint64]struct{}{2: {}}},
 				}
 			},
 		},
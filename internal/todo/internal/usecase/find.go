@@ -4,18 +4,19 @@ import (
 	"context"
 
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
 type FindStore interface {
-	Find(ctx context.Context, id uint64) (*domain.Todo, error)
+	FindMember(ctx context.Context, id, member uint64) (*domain.Todo, error)
 }
 
 type Find struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 	validator validation.Validator
+	scope     scope
 	store     FindStore
 }
 
@@ -23,6 +24,7 @@ func NewFind(dep Dependency, s FindStore) *Find {
 	return &Find{
 		telemetry: dep.Telemetry,
 		validator: dep.Validator,
+		scope:     newScope(dep.Config),
 		store:     s,
 	}
 }
@@ -37,7 +39,14 @@ func (s *Find) Call(ctx context.Context, in domain.FindInput) (*domain.Todo, err
 		return nil, goerror.NewInvalidInput("Invalid request payload", err)
 	}
 
-	todo, err := s.store.Find(ctx, in.ID)
+	member, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo find without claim")
+
+		return nil, err
+	}
+
+	todo, err := s.store.FindMember(ctx, in.ID, member)
 	if err != nil {
 		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
 
@@ -56,5 +65,7 @@ func (s *Find) Call(ctx context.Context, in domain.FindInput) (*domain.Todo, err
 		Title:       todo.Title,
 		Description: todo.Description,
 		Status:      todo.Status,
+		// the collaborators are shown to everyone the todo is shared with
+		Collaborators: todo.Collaborators,
 	}, nil
 }
@@ -3,11 +3,12 @@ package usecase
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/shandysiswandi/goreng/enum"
 	"github.com/shandysiswandi/goreng/goerror"
 	vm "github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +40,12 @@ func TestNewFind(t *testing.T) {
 }
 
 func TestFind_Execute(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	acceptedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	collaborators := []domain.TodoCollaborator{
+		{TodoID: 10, Email: "email", UserID: 11, Role: enum.New(domain.TodoRoleViewer), AcceptedAt: &acceptedAt},
+	}
+
 	type args struct {
 		ctx context.Context
 		in  domain.FindInput
@@ -53,13 +60,13 @@ func TestFind_Execute(t *testing.T) {
 		{
 			name: "ErrorValidation",
 			args: args{
-				ctx: context.Background(),
+				ctx: ctxJWT,
 				in:  domain.FindInput{ID: 11},
 			},
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *Find {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 
 				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Find")
@@ -77,15 +84,41 @@ func TestFind_Execute(t *testing.T) {
 			},
 		},
 		{
-			name: "ErrorStore",
+			name: "ErrorWithoutClaim",
 			args: args{
 				ctx: context.Background(),
 				in:  domain.FindInput{ID: 11},
 			},
 			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Find {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Find")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Find{
+					telemetry: mtel,
+					store:     nil,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStore",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.FindInput{ID: 11},
+			},
+			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Find {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockFindStore(t)
 
@@ -97,7 +130,7 @@ func TestFind_Execute(t *testing.T) {
 					Return(nil)
 
 				store.EXPECT().
-					Find(ctx, a.in.ID).
+					FindMember(ctx, a.in.ID, uint64(11)).
 					Return(nil, assert.AnError)
 
 				return &Find{
@@ -110,13 +143,13 @@ func TestFind_Execute(t *testing.T) {
 		{
 			name: "StoreNotFound",
 			args: args{
-				ctx: context.Background(),
+				ctx: ctxJWT,
 				in:  domain.FindInput{ID: 11},
 			},
 			want:    nil,
 			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
 			mockFn: func(a args) *Find {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockFindStore(t)
 
@@ -128,7 +161,7 @@ func TestFind_Execute(t *testing.T) {
 					Return(nil)
 
 				store.EXPECT().
-					Find(ctx, a.in.ID).
+					FindMember(ctx, a.in.ID, uint64(11)).
 					Return(nil, nil)
 
 				return &Find{
@@ -141,7 +174,7 @@ func TestFind_Execute(t *testing.T) {
 		{
 			name: "Success",
 			args: args{
-				ctx: context.Background(),
+				ctx: ctxJWT,
 				in:  domain.FindInput{ID: 10},
 			},
 			want: &domain.Todo{
@@ -153,7 +186,7 @@ func TestFind_Execute(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *Find {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockFindStore(t)
 
@@ -172,13 +205,104 @@ func TestFind_Execute(t *testing.T) {
 					Status:      enum.New(domain.TodoStatusDrop),
 				}
 				store.EXPECT().
-					Find(ctx, a.in.ID).
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(todo, nil)
+
+				return &Find{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "SuccessShared",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.FindInput{ID: 10},
+			},
+			want: &domain.Todo{
+				ID:            10,
+				UserID:        20,
+				Title:         "test 1",
+				Description:   "test 2",
+				Status:        enum.New(domain.TodoStatusDrop),
+				Collaborators: collaborators,
+			},
+			wantErr: nil,
+			mockFn: func(a args) *Find {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockFindStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Find")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				todo := &domain.Todo{
+					ID:            10,
+					UserID:        20,
+					Title:         "test 1",
+					Description:   "test 2",
+					Status:        enum.New(domain.TodoStatusDrop),
+					Collaborators: collaborators,
+				}
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(todo, nil)
+
+				return &Find{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "SuccessAsAdmin",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.FindInput{ID: 10},
+			},
+			want: &domain.Todo{
+				ID:          10,
+				UserID:      20,
+				Title:       "test 1",
+				Description: "test 2",
+				Status:      enum.New(domain.TodoStatusDrop),
+			},
+			wantErr: nil,
+			mockFn: func(a args) *Find {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockFindStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Find")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				todo := &domain.Todo{
+					ID:          10,
+					UserID:      20,
+					Title:       "test 1",
+					Description: "test 2",
+					Status:      enum.New(domain.TodoStatusDrop),
+				}
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(0)).
 					Return(todo, nil)
 
 				return &Find{
 					telemetry: mtel,
 					store:     store,
 					validator: validator,
+					scope:     scope{admins: map[uint64]struct{}{11: {}}},
 				}
 			},
 		},
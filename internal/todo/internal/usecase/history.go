@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+type HistoryStore interface {
+	Find(ctx context.Context, id, owner uint64) (*domain.Todo, error)
+	History(ctx context.Context, todoID uint64) ([]domain.TodoStatusChange, error)
+}
+
+type History struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	scope     scope
+	store     HistoryStore
+}
+
+func NewHistory(dep Dependency, s HistoryStore) *History {
+	return &History{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		scope:     newScope(dep.Config),
+		store:     s,
+	}
+}
+
+func (s *History) Call(ctx context.Context, in domain.HistoryInput) (*domain.HistoryOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.History")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	owner, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo history without claim")
+
+		return nil, err
+	}
+
+	// the todo is looked up first so the history of others stays hidden
+	todo, err := s.store.Find(ctx, in.ID, owner)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	changes, err := s.store.History(ctx, in.ID)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to fetch status history", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	return &domain.HistoryOutput{ID: in.ID, Changes: changes}, nil
+}
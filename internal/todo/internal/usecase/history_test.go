@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHistory(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   HistoryStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *History
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &History{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewHistory(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHistory_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	changedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	changes := []domain.TodoStatusChange{
+		{
+			ID: 100, TodoID: 12, From: enum.New(domain.TodoStatusInitiate),
+			To: enum.New(domain.TodoStatusInProgress), ChangedBy: 11, ChangedAt: changedAt,
+		},
+	}
+
+	type args struct {
+		ctx context.Context
+		in  domain.HistoryInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.HistoryOutput
+		wantErr error
+		mockFn  func(a args) *History
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.HistoryInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *History {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.History")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &History{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.HistoryInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *History {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.History")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &History{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.HistoryInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *History {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockHistoryStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.History")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &History{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.HistoryInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *History {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockHistoryStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.History")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &History{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreHistory",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.HistoryInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *History {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockHistoryStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.History")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				store.EXPECT().
+					History(ctx, a.in.ID).
+					Return(nil, assert.AnError)
+
+				return &History{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.HistoryInput{ID: 12},
+			},
+			want:    &domain.HistoryOutput{ID: 12, Changes: changes},
+			wantErr: nil,
+			mockFn: func(a args) *History {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockHistoryStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.History")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				store.EXPECT().
+					History(ctx, a.in.ID).
+					Return(changes, nil)
+
+				return &History{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
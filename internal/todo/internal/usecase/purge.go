@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+type PurgeStore interface {
+	Purge(ctx context.Context, id, owner uint64) error
+	Attachments(ctx context.Context, todoID uint64) ([]domain.TodoAttachment, error)
+}
+
+type Purge struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	scope     scope
+	blob      BlobStore
+	store     PurgeStore
+}
+
+func NewPurge(dep Dependency, s PurgeStore) *Purge {
+	return &Purge{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		scope:     newScope(dep.Config),
+		blob:      dep.Blob,
+		store:     s,
+	}
+}
+
+// Call removes a todo for good. Only todos in the trash can be purged, and no
+// event is published since the delete already told the subscribers. The blobs
+// of its attachments are removed once the todo is gone.
+func (s *Purge) Call(ctx context.Context, in domain.PurgeInput) (*domain.PurgeOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Purge")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	owner, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo purge without claim")
+
+		return nil, err
+	}
+
+	// the metadata goes with the todo, so the attachments are listed before
+	attachments, err := s.store.Attachments(ctx, in.ID)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to fetch attachments", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	err = s.store.Purge(ctx, in.ID, owner)
+	if errors.Is(err, domain.ErrTodoNotDeleted) {
+		s.telemetry.Logger().Warn(ctx, "todo is not found in trash")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to purge", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	for _, att := range attachments {
+		if err := s.blob.Delete(ctx, att.StorageKey); err != nil {
+			s.telemetry.Logger().Error(ctx, "todo fail to remove attachment blob", err)
+		}
+	}
+
+	return &domain.PurgeOutput{ID: in.ID}, nil
+}
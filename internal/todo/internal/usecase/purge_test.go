@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPurge(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   PurgeStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Purge
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Purge{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewPurge(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPurge_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+
+	type args struct {
+		ctx context.Context
+		in  domain.PurgeInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.PurgeOutput
+		wantErr error
+		mockFn  func(a args) *Purge
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.PurgeInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Purge {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Purge")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Purge{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.PurgeInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Purge {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Purge")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Purge{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreAttachments",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.PurgeInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Purge {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockPurgeStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Purge")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Attachments(ctx, a.in.ID).
+					Return(nil, assert.AnError)
+
+				return &Purge{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStorePurge",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.PurgeInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Purge {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockPurgeStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Purge")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Attachments(ctx, a.in.ID).
+					Return(nil, nil)
+
+				store.EXPECT().
+					Purge(ctx, a.in.ID, uint64(11)).
+					Return(assert.AnError)
+
+				return &Purge{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorNotInTrash",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.PurgeInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Purge {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockPurgeStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Purge")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Attachments(ctx, a.in.ID).
+					Return(nil, nil)
+
+				store.EXPECT().
+					Purge(ctx, a.in.ID, uint64(11)).
+					Return(domain.ErrTodoNotDeleted)
+
+				return &Purge{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "SuccessAsAdmin",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.PurgeInput{ID: 12},
+			},
+			want:    &domain.PurgeOutput{ID: 12},
+			wantErr: nil,
+			mockFn: func(a args) *Purge {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockPurgeStore(t)
+				blob := mockz.NewMockBlobStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Purge")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Attachments(ctx, a.in.ID).
+					Return([]domain.TodoAttachment{
+						{ID: 1, StorageKey: "todos/12/1/a.txt"},
+						{ID: 2, StorageKey: "todos/12/2/b.txt"},
+					}, nil)
+
+				store.EXPECT().
+					Purge(ctx, a.in.ID, uint64(0)).
+					Return(nil)
+
+				// a blob that can not be removed does not fail the purge
+				blob.EXPECT().
+					Delete(ctx, "todos/12/1/a.txt").
+					Return(assert.AnError)
+
+				blob.EXPECT().
+					Delete(ctx, "todos/12/2/b.txt").
+					Return(nil)
+
+				return &Purge{
+					telemetry: mtel,
+					store:     store,
+					blob:      blob,
+					validator: validator,
+					scope:     scope{admins: map[uint64]struct{}{11: {}}},
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+type RestoreStore interface {
+	FindDeleted(ctx context.Context, id, owner uint64) (*domain.Todo, error)
+	Restore(ctx context.Context, id, owner uint64) error
+}
+
+type Restore struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	scope     scope
+	publisher domain.TodoEventPublisher
+	store     RestoreStore
+}
+
+func NewRestore(dep Dependency, s RestoreStore) *Restore {
+	return &Restore{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		scope:     newScope(dep.Config),
+		publisher: dep.Publisher,
+		store:     s,
+	}
+}
+
+func (s *Restore) Call(ctx context.Context, in domain.RestoreInput) (*domain.RestoreOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Restore")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	owner, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo restore without claim")
+
+		return nil, err
+	}
+
+	todo, err := s.store.FindDeleted(ctx, in.ID, owner)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find deleted", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found in trash")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	err = s.store.Restore(ctx, in.ID, owner)
+	if errors.Is(err, domain.ErrTodoNotUpdated) {
+		s.telemetry.Logger().Warn(ctx, "todo is not found in trash")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to restore", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	// subscribers get the todo back as an update of the one they saw deleted
+	todo.DeletedAt = nil
+	s.publisher.Publish(ctx, domain.NewTodoEvent(domain.TodoEventTypeUpdated, *todo))
+
+	return &domain.RestoreOutput{ID: in.ID}, nil
+}
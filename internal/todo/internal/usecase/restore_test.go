@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRestore(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   RestoreStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Restore
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Restore{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewRestore(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRestore_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	deletedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type args struct {
+		ctx context.Context
+		in  domain.RestoreInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.RestoreOutput
+		wantErr error
+		mockFn  func(a args) *Restore
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.RestoreInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Restore {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Restore")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Restore{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.RestoreInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Restore {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Restore")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Restore{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFindDeleted",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.RestoreInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Restore {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockRestoreStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Restore")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindDeleted(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &Restore{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorNotInTrash",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.RestoreInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Restore {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockRestoreStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Restore")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindDeleted(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &Restore{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreRestore",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.RestoreInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Restore {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockRestoreStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Restore")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindDeleted(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, DeletedAt: &deletedAt}, nil)
+
+				store.EXPECT().
+					Restore(ctx, a.in.ID, uint64(11)).
+					Return(assert.AnError)
+
+				return &Restore{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorNotRestored",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.RestoreInput{ID: 12},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Restore {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockRestoreStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Restore")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindDeleted(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, DeletedAt: &deletedAt}, nil)
+
+				store.EXPECT().
+					Restore(ctx, a.in.ID, uint64(11)).
+					Return(domain.ErrTodoNotUpdated)
+
+				return &Restore{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.RestoreInput{ID: 12},
+			},
+			want:    &domain.RestoreOutput{ID: 12},
+			wantErr: nil,
+			mockFn: func(a args) *Restore {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockRestoreStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Restore")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindDeleted(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, DeletedAt: &deletedAt}, nil)
+
+				store.EXPECT().
+					Restore(ctx, a.in.ID, uint64(11)).
+					Return(nil)
+
+				publisher := mockz.NewMockTodoEventPublisher(t)
+				publisher.EXPECT().
+					Publish(ctx, domain.NewTodoEvent(
+						domain.TodoEventTypeUpdated,
+						domain.Todo{ID: a.in.ID, UserID: 11},
+					))
+
+				return &Restore{
+					telemetry: mtel,
+					store:     store,
+					publisher: publisher,
+					validator: validator,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
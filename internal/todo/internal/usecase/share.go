@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/telemetry/logger"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+var errShareSelf = goerror.NewBusiness("todo can not be shared with yourself", goerror.CodeConflict)
+
+type ShareStore interface {
+	Find(ctx context.Context, id, owner uint64) (*domain.Todo, error)
+	Share(ctx context.Context, c domain.TodoCollaborator) error
+}
+
+// Share invites an email to the todo, only its owner and admins can do it.
+// Inviting the same email again changes the role and keeps the acceptance.
+type Share struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	clock     clock.Clocker
+	scope     scope
+	store     ShareStore
+}
+
+func NewShare(dep Dependency, s ShareStore) *Share {
+	return &Share{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		clock:     dep.Clock,
+		scope:     newScope(dep.Config),
+		store:     s,
+	}
+}
+
+func (s *Share) Call(ctx context.Context, in domain.ShareInput) (*domain.ShareOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Share")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	owner, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo share without claim")
+
+		return nil, err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(in.Email))
+	if strings.EqualFold(email, lib.GetJWTClaim(ctx).Subject) {
+		s.telemetry.Logger().Warn(ctx, "todo share with self rejected")
+
+		return nil, errShareSelf
+	}
+
+	todo, err := s.store.Find(ctx, in.ID, owner)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	err = s.store.Share(ctx, domain.TodoCollaborator{
+		TodoID:    in.ID,
+		Email:     email,
+		Role:      enum.New(enum.Parse[domain.TodoRole](in.Role)),
+		InvitedBy: actor(ctx),
+		InvitedAt: s.clock.Now(),
+	})
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to share", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	// there is no mailer yet, the invitee accepts the invitation through the api
+	s.telemetry.Logger().Info(ctx, "todo invitation created",
+		logger.KeyVal("todo.id", in.ID), logger.KeyVal("email", email))
+
+	return &domain.ShareOutput{ID: in.ID, Email: email, Role: in.Role}, nil
+}
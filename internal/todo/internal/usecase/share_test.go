@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewShare(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   ShareStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Share
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Share{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewShare(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestShare_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "owner@example.com", time.Time{}, nil))
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type args struct {
+		ctx context.Context
+		in  domain.ShareInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.ShareOutput
+		wantErr error
+		mockFn  func(a args) *Share
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.ShareInput{ID: 10, Email: "friend@example.com", Role: "EDITOR"},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Share {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Share")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Share{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.ShareInput{ID: 10, Email: "friend@example.com", Role: "EDITOR"},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Share {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Share")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Share{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorSelf",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.ShareInput{ID: 10, Email: "Owner@Example.com", Role: "EDITOR"},
+			},
+			want:    nil,
+			wantErr: errShareSelf,
+			mockFn: func(a args) *Share {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Share")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Share{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.ShareInput{ID: 10, Email: "friend@example.com", Role: "EDITOR"},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Share {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockShareStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Share")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &Share{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.ShareInput{ID: 10, Email: "friend@example.com", Role: "EDITOR"},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Share {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockShareStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Share")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &Share{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreShare",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.ShareInput{ID: 10, Email: "friend@example.com", Role: "EDITOR"},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Share {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockShareStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Share")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				store.EXPECT().
+					Share(ctx, domain.TodoCollaborator{
+						TodoID:    a.in.ID,
+						Email:     "friend@example.com",
+						Role:      enum.New(domain.TodoRoleEditor),
+						InvitedBy: 11,
+						InvitedAt: now,
+					}).
+					Return(assert.AnError)
+
+				return &Share{
+					telemetry: mtel,
+					validator: validator,
+					clock:     clock,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.ShareInput{ID: 10, Email: " Friend@Example.com ", Role: "VIEWER"},
+			},
+			want:    &domain.ShareOutput{ID: 10, Email: "friend@example.com", Role: "VIEWER"},
+			wantErr: nil,
+			mockFn: func(a args) *Share {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockShareStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Share")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				store.EXPECT().
+					Share(ctx, domain.TodoCollaborator{
+						TodoID:    a.in.ID,
+						Email:     "friend@example.com",
+						Role:      enum.New(domain.TodoRoleViewer),
+						InvitedBy: 11,
+						InvitedAt: now,
+					}).
+					Return(nil)
+
+				return &Share{
+					telemetry: mtel,
+					validator: validator,
+					clock:     clock,
+					store:     store,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+)
+
+type UnshareStore interface {
+	Find(ctx context.Context, id, owner uint64) (*domain.Todo, error)
+	Unshare(ctx context.Context, todoID uint64, email string) error
+}
+
+// Unshare removes a collaborator or a pending invitation. The owner and admins
+// can remove anyone, a collaborator can only leave the todo.
+type Unshare struct {
+	telemetry *lib.Telemetry
+	validator validation.Validator
+	scope     scope
+	store     UnshareStore
+}
+
+func NewUnshare(dep Dependency, s UnshareStore) *Unshare {
+	return &Unshare{
+		telemetry: dep.Telemetry,
+		validator: dep.Validator,
+		scope:     newScope(dep.Config),
+		store:     s,
+	}
+}
+
+func (s *Unshare) Call(ctx context.Context, in domain.UnshareInput) (*domain.UnshareOutput, error) {
+	ctx, span := s.telemetry.Tracer().Start(ctx, "todo.usecase.Unshare")
+	defer span.End()
+
+	if err := s.validator.Validate(in); err != nil {
+		s.telemetry.Logger().Warn(ctx, "validation failed")
+
+		return nil, goerror.NewInvalidInput("Invalid request payload", err)
+	}
+
+	owner, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo unshare without claim")
+
+		return nil, err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(in.Email))
+	if !strings.EqualFold(email, lib.GetJWTClaim(ctx).Subject) {
+		todo, err := s.store.Find(ctx, in.ID, owner)
+		if err != nil {
+			s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+			return nil, goerror.NewServerInternal(err)
+		}
+
+		if todo == nil {
+			s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+			return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+		}
+	}
+
+	err = s.store.Unshare(ctx, in.ID, email)
+	if errors.Is(err, domain.ErrCollaboratorNotFound) {
+		s.telemetry.Logger().Warn(ctx, "todo collaborator is not found")
+
+		return nil, goerror.NewBusiness("collaborator not found", goerror.CodeNotFound)
+	}
+
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to unshare", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	return &domain.UnshareOutput{ID: in.ID, Email: email}, nil
+}
@@ -0,0 +1,314 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	vm "github.com/shandysiswandi/goreng/mocker"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUnshare(t *testing.T) {
+	type args struct {
+		dep Dependency
+		s   UnshareStore
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Unshare
+	}{
+		{
+			name: "Success",
+			args: args{},
+			want: &Unshare{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewUnshare(tt.args.dep, tt.args.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnshare_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "owner@example.com", time.Time{}, nil))
+
+	type args struct {
+		ctx context.Context
+		in  domain.UnshareInput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.UnshareOutput
+		wantErr error
+		mockFn  func(a args) *Unshare
+	}{
+		{
+			name: "ErrorValidation",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.UnshareInput{ID: 10, Email: "friend@example.com"},
+			},
+			want:    nil,
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+			mockFn: func(a args) *Unshare {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Unshare")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(assert.AnError)
+
+				return &Unshare{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in:  domain.UnshareInput{ID: 10, Email: "friend@example.com"},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Unshare {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Unshare")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Unshare{
+					telemetry: mtel,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.UnshareInput{ID: 10, Email: "friend@example.com"},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Unshare {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUnshareStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Unshare")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &Unshare{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.UnshareInput{ID: 10, Email: "friend@example.com"},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Unshare {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUnshareStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Unshare")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &Unshare{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorCollaboratorNotFound",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.UnshareInput{ID: 10, Email: "friend@example.com"},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("collaborator not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Unshare {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUnshareStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Unshare")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				store.EXPECT().
+					Unshare(ctx, a.in.ID, a.in.Email).
+					Return(domain.ErrCollaboratorNotFound)
+
+				return &Unshare{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreUnshare",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.UnshareInput{ID: 10, Email: "friend@example.com"},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Unshare {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUnshareStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Unshare")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				store.EXPECT().
+					Unshare(ctx, a.in.ID, a.in.Email).
+					Return(assert.AnError)
+
+				return &Unshare{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "Success",
+			args: args{
+				ctx: ctxJWT,
+				in:  domain.UnshareInput{ID: 10, Email: "friend@example.com"},
+			},
+			want:    &domain.UnshareOutput{ID: 10, Email: "friend@example.com"},
+			wantErr: nil,
+			mockFn: func(a args) *Unshare {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUnshareStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Unshare")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					Find(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11}, nil)
+
+				store.EXPECT().
+					Unshare(ctx, a.in.ID, a.in.Email).
+					Return(nil)
+
+				return &Unshare{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+		{
+			name: "SuccessLeave",
+			args: args{
+				ctx: lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(12, "friend@example.com", time.Time{}, nil)),
+				in:  domain.UnshareInput{ID: 10, Email: "friend@example.com"},
+			},
+			want:    &domain.UnshareOutput{ID: 10, Email: "friend@example.com"},
+			wantErr: nil,
+			mockFn: func(a args) *Unshare {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUnshareStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Unshare")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				// collaborators leave without being the owner
+				store.EXPECT().
+					Unshare(ctx, a.in.ID, a.in.Email).
+					Return(nil)
+
+				return &Unshare{
+					telemetry: mtel,
+					validator: validator,
+					store:     store,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := tt.mockFn(tt.args)
+			got, err := s.Call(tt.args.ctx, tt.args.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
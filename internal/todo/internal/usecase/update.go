@@ -2,22 +2,26 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
-	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/clock"
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
 type UpdateStore interface {
-	Update(ctx context.Context, in domain.Todo) error
+	FindMember(ctx context.Context, id, member uint64) (*domain.Todo, error)
+	Update(ctx context.Context, in domain.Todo, history []domain.TodoStatusChange) error
 }
 
 type Update struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 	validator validation.Validator
+	clock     clock.Clocker
+	scope     scope
+	publisher domain.TodoEventPublisher
 	store     UpdateStore
 }
 
@@ -25,6 +29,9 @@ func NewUpdate(dep Dependency, s UpdateStore) *Update {
 	return &Update{
 		telemetry: dep.Telemetry,
 		validator: dep.Validator,
+		clock:     dep.Clock,
+		scope:     newScope(dep.Config),
+		publisher: dep.Publisher,
 		store:     s,
 	}
 }
@@ -39,30 +46,68 @@ func (s *Update) Call(ctx context.Context, in domain.UpdateInput) (*domain.Todo,
 		return nil, goerror.NewInvalidInput("Invalid request payload", err)
 	}
 
-	sts := enum.New(enum.Parse[domain.TodoStatus](in.Status))
-	userID := uint64(0)
-	if clm := lib.GetJWTClaim(ctx); clm != nil {
-		userID = clm.AuthID
+	member, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo update without claim")
+
+		return nil, err
+	}
+
+	todo, err := s.store.FindMember(ctx, in.ID, member)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	if member > 0 && !todo.CanEdit(member) {
+		s.telemetry.Logger().Warn(ctx, "todo change by viewer rejected")
+
+		return nil, errViewerReadOnly
 	}
 
-	err := s.store.Update(ctx, domain.Todo{
+	// the owner is kept as is, so an admin editing a todo does not take it over
+	updated := domain.Todo{
 		ID:          in.ID,
-		UserID:      userID,
+		UserID:      todo.UserID,
 		Title:       in.Title,
 		Description: in.Description,
-		Status:      sts,
-	})
+		Status:      todo.Status,
+		Priority:    priority(in.Priority),
+		DueAt:       in.DueAt,
+		CompletedAt: todo.CompletedAt,
+		Tags:        normalizeTags(in.Tags),
+		// sharing is managed apart, the collaborators stay as they are
+		Collaborators: todo.Collaborators,
+	}
+
+	history, err := transition(&updated, in.Status, actor(ctx), s.clock.Now())
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo status transition rejected")
+
+		return nil, err
+	}
+
+	err = s.store.Update(ctx, updated, history)
+	if errors.Is(err, domain.ErrTodoNotUpdated) {
+		s.telemetry.Logger().Warn(ctx, "todo changed while being updated")
+
+		return nil, errTodoChanged
+	}
+
 	if err != nil {
 		s.telemetry.Logger().Error(ctx, "todo fail to update", err)
 
 		return nil, goerror.NewServerInternal(err)
 	}
 
-	return &domain.Todo{
-		ID:          in.ID,
-		UserID:      userID,
-		Title:       in.Title,
-		Description: in.Description,
-		Status:      sts,
-	}, nil
+	s.publisher.Publish(ctx, domain.NewTodoEvent(domain.TodoEventTypeUpdated, updated))
+
+	return &updated, nil
 }
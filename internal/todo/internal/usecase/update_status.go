@@ -2,21 +2,26 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
-	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/clock"
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 )
 
 type UpdateStatusStore interface {
-	UpdateStatus(ctx context.Context, in uint64, sts enum.Enum[domain.TodoStatus]) error
+	FindMember(ctx context.Context, id, member uint64) (*domain.Todo, error)
+	UpdateStatus(ctx context.Context, todo domain.Todo, history []domain.TodoStatusChange) error
 }
 
 type UpdateStatus struct {
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 	validator validation.Validator
+	clock     clock.Clocker
+	scope     scope
+	publisher domain.TodoEventPublisher
 	store     UpdateStatusStore
 }
 
@@ -24,6 +29,9 @@ func NewUpdateStatus(dep Dependency, s UpdateStatusStore) *UpdateStatus {
 	return &UpdateStatus{
 		telemetry: dep.Telemetry,
 		validator: dep.Validator,
+		clock:     dep.Clock,
+		scope:     newScope(dep.Config),
+		publisher: dep.Publisher,
 		store:     s,
 	}
 }
@@ -40,16 +48,61 @@ func (s *UpdateStatus) Call(ctx context.Context, in domain.UpdateStatusInput) (
 		return nil, goerror.NewInvalidInput("Invalid request payload", err)
 	}
 
-	sts := enum.New(enum.Parse[domain.TodoStatus](in.Status))
-	err := s.store.UpdateStatus(ctx, in.ID, sts)
+	member, err := s.scope.owner(ctx)
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo update status without claim")
+
+		return nil, err
+	}
+
+	todo, err := s.store.FindMember(ctx, in.ID, member)
+	if err != nil {
+		s.telemetry.Logger().Error(ctx, "todo fail to find", err)
+
+		return nil, goerror.NewServerInternal(err)
+	}
+
+	if todo == nil {
+		s.telemetry.Logger().Warn(ctx, "todo is not found")
+
+		return nil, goerror.NewBusiness("todo not found", goerror.CodeNotFound)
+	}
+
+	if member > 0 && !todo.CanEdit(member) {
+		s.telemetry.Logger().Warn(ctx, "todo change by viewer rejected")
+
+		return nil, errViewerReadOnly
+	}
+
+	history, err := transition(todo, in.Status, actor(ctx), s.clock.Now())
+	if err != nil {
+		s.telemetry.Logger().Warn(ctx, "todo status transition rejected")
+
+		return nil, err
+	}
+
+	// the todo is already in this status, there is nothing to record
+	if len(history) == 0 {
+		return &domain.UpdateStatusOutput{ID: in.ID, Status: todo.Status}, nil
+	}
+
+	err = s.store.UpdateStatus(ctx, *todo, history)
+	if errors.Is(err, domain.ErrTodoNotUpdated) {
+		s.telemetry.Logger().Warn(ctx, "todo changed while being updated")
+
+		return nil, errTodoChanged
+	}
+
 	if err != nil {
 		s.telemetry.Logger().Error(ctx, "todo fail to update status", err)
 
 		return nil, goerror.NewServerInternal(err)
 	}
 
+	s.publisher.Publish(ctx, domain.NewTodoEvent(domain.TodoEventTypeStatusUpdated, *todo))
+
 	return &domain.UpdateStatusOutput{
 		ID:     in.ID,
-		Status: sts,
+		Status: todo.Status,
 	}, nil
 }
@@ -3,11 +3,12 @@ package usecase
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/shandysiswandi/goreng/enum"
 	"github.com/shandysiswandi/goreng/goerror"
 	vm "github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +40,9 @@ func TestNewUpdateStatus(t *testing.T) {
 }
 
 func TestUpdateStatus_Call(t *testing.T) {
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(11, "email", time.Time{}, nil))
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
 	type args struct {
 		ctx context.Context
 		in  domain.UpdateStatusInput
@@ -53,7 +57,7 @@ func TestUpdateStatus_Call(t *testing.T) {
 		{
 			name: "ErrorValidation",
 			args: args{
-				ctx: context.Background(),
+				ctx: ctxJWT,
 				in: domain.UpdateStatusInput{
 					ID:     10,
 					Status: "done",
@@ -62,7 +66,7 @@ func TestUpdateStatus_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *UpdateStatus {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 
 				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.UpdateStatus")
@@ -80,7 +84,7 @@ func TestUpdateStatus_Call(t *testing.T) {
 			},
 		},
 		{
-			name: "ErrorStore",
+			name: "ErrorWithoutClaim",
 			args: args{
 				ctx: context.Background(),
 				in: domain.UpdateStatusInput{
@@ -89,9 +93,38 @@ func TestUpdateStatus_Call(t *testing.T) {
 				},
 			},
 			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *UpdateStatus {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.UpdateStatus")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &UpdateStatus{
+					telemetry: mtel,
+					store:     nil,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.UpdateStatusInput{
+					ID:     10,
+					Status: "done",
+				},
+			},
+			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *UpdateStatus {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockUpdateStatusStore(t)
 
@@ -102,10 +135,9 @@ func TestUpdateStatus_Call(t *testing.T) {
 					Validate(a.in).
 					Return(nil)
 
-				sts := enum.New(enum.Parse[domain.TodoStatus](a.in.Status))
 				store.EXPECT().
-					UpdateStatus(ctx, a.in.ID, sts).
-					Return(assert.AnError)
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
 
 				return &UpdateStatus{
 					telemetry: mtel,
@@ -115,23 +147,273 @@ func TestUpdateStatus_Call(t *testing.T) {
 			},
 		},
 		{
-			name: "Success",
+			name: "ErrorNotFound",
 			args: args{
-				ctx: context.Background(),
+				ctx: ctxJWT,
 				in: domain.UpdateStatusInput{
 					ID:     10,
 					Status: "done",
 				},
 			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *UpdateStatus {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStatusStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.UpdateStatus")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &UpdateStatus{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorViewer",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.UpdateStatusInput{
+					ID:     10,
+					Status: "done",
+				},
+			},
+			want:    nil,
+			wantErr: errViewerReadOnly,
+			mockFn: func(a args) *UpdateStatus {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStatusStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.UpdateStatus")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 12, Collaborators: []domain.TodoCollaborator{
+						{TodoID: a.in.ID, UserID: 11, Role: enum.New(domain.TodoRoleViewer), AcceptedAt: &now},
+					}}, nil)
+
+				return &UpdateStatus{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorTransition",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.UpdateStatusInput{
+					ID:     10,
+					Status: "INITIATE",
+				},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo status can not change from DONE to INITIATE", goerror.CodeConflict),
+			mockFn: func(a args) *UpdateStatus {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStatusStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.UpdateStatus")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusDone)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				return &UpdateStatus{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+					clock:     clock,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreUpdateStatus",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.UpdateStatusInput{
+					ID:     10,
+					Status: "DONE",
+				},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *UpdateStatus {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStatusStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.UpdateStatus")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusInProgress)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				done := domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusDone), CompletedAt: &now}
+				history := []domain.TodoStatusChange{{
+					TodoID:    a.in.ID,
+					From:      enum.New(domain.TodoStatusInProgress),
+					To:        enum.New(domain.TodoStatusDone),
+					ChangedBy: 11,
+					ChangedAt: now,
+				}}
+				store.EXPECT().
+					UpdateStatus(ctx, done, history).
+					Return(assert.AnError)
+
+				return &UpdateStatus{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+					clock:     clock,
+				}
+			},
+		},
+		{
+			name: "ErrorUpdateStatusChanged",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.UpdateStatusInput{
+					ID:     10,
+					Status: "DONE",
+				},
+			},
+			want:    nil,
+			wantErr: errTodoChanged,
+			mockFn: func(a args) *UpdateStatus {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStatusStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.UpdateStatus")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusInProgress)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				done := domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusDone), CompletedAt: &now}
+				history := []domain.TodoStatusChange{{
+					TodoID:    a.in.ID,
+					From:      enum.New(domain.TodoStatusInProgress),
+					To:        enum.New(domain.TodoStatusDone),
+					ChangedBy: 11,
+					ChangedAt: now,
+				}}
+				store.EXPECT().
+					UpdateStatus(ctx, done, history).
+					Return(domain.ErrTodoNotUpdated)
+
+				return &UpdateStatus{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+					clock:     clock,
+				}
+			},
+		},
+		{
+			name: "SuccessSameStatus",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.UpdateStatusInput{
+					ID:     10,
+					Status: "IN_PROGRESS",
+				},
+			},
+			want: &domain.UpdateStatusOutput{
+				ID:     10,
+				Status: enum.New(domain.TodoStatusInProgress),
+			},
+			wantErr: nil,
+			mockFn: func(a args) *UpdateStatus {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStatusStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.UpdateStatus")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusInProgress)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				return &UpdateStatus{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+					clock:     clock,
+				}
+			},
+		},
+		{
+			name: "SuccessAsAdmin",
+			args: args{
+				ctx: ctxJWT,
+				in: domain.UpdateStatusInput{
+					ID:     10,
+					Status: "DONE",
+				},
+			},
 			want: &domain.UpdateStatusOutput{
 				ID:     10,
-				Status: enum.New(domain.TodoStatusUnknown),
+				Status: enum.New(domain.TodoStatusDone),
 			},
 			wantErr: nil,
 			mockFn: func(a args) *UpdateStatus {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockUpdateStatusStore(t)
+				clock := vm.NewMockClocker(t)
 
 				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.UpdateStatus")
 				defer span.End()
@@ -140,15 +422,39 @@ func TestUpdateStatus_Call(t *testing.T) {
 					Validate(a.in).
 					Return(nil)
 
-				sts := enum.New(enum.Parse[domain.TodoStatus](a.in.Status))
+				// the todo of user 12 is changed by the admin 11
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(0)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 12, Status: enum.New(domain.TodoStatusInProgress)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				done := domain.Todo{ID: a.in.ID, UserID: 12, Status: enum.New(domain.TodoStatusDone), CompletedAt: &now}
+				history := []domain.TodoStatusChange{{
+					TodoID:    a.in.ID,
+					From:      enum.New(domain.TodoStatusInProgress),
+					To:        enum.New(domain.TodoStatusDone),
+					ChangedBy: 11,
+					ChangedAt: now,
+				}}
 				store.EXPECT().
-					UpdateStatus(ctx, a.in.ID, sts).
+					UpdateStatus(ctx, done, history).
 					Return(nil)
 
+				publisher := mockz.NewMockTodoEventPublisher(t)
+				publisher.EXPECT().
+					Publish(ctx, domain.NewTodoEvent(
+						domain.TodoEventTypeStatusUpdated,
+						done,
+					))
+
 				return &UpdateStatus{
 					telemetry: mtel,
 					store:     store,
+					publisher: publisher,
 					validator: validator,
+					clock:     clock,
+					scope:     scope{admins: map[uint64]struct{}{11: {}}},
 				}
 			},
 		},
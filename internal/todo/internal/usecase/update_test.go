@@ -8,7 +8,6 @@ import (
 	"github.com/shandysiswandi/goreng/enum"
 	"github.com/shandysiswandi/goreng/goerror"
 	vm "github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/mockz"
@@ -41,6 +40,8 @@ func TestNewUpdate(t *testing.T) {
 }
 
 func TestUpdate_Execute(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	due := now.Add(24 * time.Hour)
 	claim := lib.NewJWTClaim(11, "email", time.Time{}, nil)
 	ctx := lib.SetJWTClaim(context.Background(), claim)
 
@@ -69,7 +70,7 @@ func TestUpdate_Execute(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *Update {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 
 				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
@@ -87,7 +88,110 @@ func TestUpdate_Execute(t *testing.T) {
 			},
 		},
 		{
-			name: "ErrorStore",
+			name: "ErrorWithoutClaim",
+			args: args{
+				ctx: context.Background(),
+				in: domain.UpdateInput{
+					ID:          10,
+					Title:       "title",
+					Description: "description",
+					Status:      "done",
+				},
+			},
+			want:    nil,
+			wantErr: errUnauthenticated,
+			mockFn: func(a args) *Update {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+
+				_, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				return &Update{
+					telemetry: mtel,
+					store:     nil,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreFind",
+			args: args{
+				ctx: ctx,
+				in: domain.UpdateInput{
+					ID:          10,
+					Title:       "title",
+					Description: "description",
+					Status:      "done",
+				},
+			},
+			want:    nil,
+			wantErr: goerror.NewServerInternal(assert.AnError),
+			mockFn: func(a args) *Update {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, assert.AnError)
+
+				return &Update{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorNotFound",
+			args: args{
+				ctx: ctx,
+				in: domain.UpdateInput{
+					ID:          10,
+					Title:       "title",
+					Description: "description",
+					Status:      "done",
+				},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo not found", goerror.CodeNotFound),
+			mockFn: func(a args) *Update {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(nil, nil)
+
+				return &Update{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorViewer",
 			args: args{
 				ctx: ctx,
 				in: domain.UpdateInput{
@@ -98,11 +202,90 @@ func TestUpdate_Execute(t *testing.T) {
 				},
 			},
 			want:    nil,
+			wantErr: errViewerReadOnly,
+			mockFn: func(a args) *Update {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStore(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 12, Collaborators: []domain.TodoCollaborator{
+						{TodoID: a.in.ID, UserID: 11, Role: enum.New(domain.TodoRoleViewer), AcceptedAt: &now},
+					}}, nil)
+
+				return &Update{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+				}
+			},
+		},
+		{
+			name: "ErrorTransition",
+			args: args{
+				ctx: ctx,
+				in: domain.UpdateInput{
+					ID:          10,
+					Title:       "title",
+					Description: "description",
+					Status:      "INITIATE",
+				},
+			},
+			want:    nil,
+			wantErr: goerror.NewBusiness("todo status can not change from DONE to INITIATE", goerror.CodeConflict),
+			mockFn: func(a args) *Update {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusDone)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				return &Update{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+					clock:     clock,
+				}
+			},
+		},
+		{
+			name: "ErrorStoreUpdate",
+			args: args{
+				ctx: ctx,
+				in: domain.UpdateInput{
+					ID:          10,
+					Title:       "title",
+					Description: "description",
+					Status:      "INITIATE",
+				},
+			},
+			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Update {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockUpdateStore(t)
+				clock := vm.NewMockClocker(t)
 
 				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
 				defer span.End()
@@ -111,22 +294,81 @@ func TestUpdate_Execute(t *testing.T) {
 					Validate(a.in).
 					Return(nil)
 
-				sts := enum.New(enum.Parse[domain.TodoStatus](a.in.Status))
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusInitiate)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
 				data := domain.Todo{
 					ID:          a.in.ID,
 					UserID:      11,
 					Title:       a.in.Title,
 					Description: a.in.Description,
-					Status:      sts,
+					Status:      enum.New(domain.TodoStatusInitiate),
+					Priority:    enum.New(domain.TodoPriorityMedium),
 				}
 				store.EXPECT().
-					Update(ctx, data).
+					Update(ctx, data, []domain.TodoStatusChange(nil)).
 					Return(assert.AnError)
 
 				return &Update{
 					telemetry: mtel,
 					store:     store,
 					validator: validator,
+					clock:     clock,
+				}
+			},
+		},
+		{
+			name: "ErrorUpdateChanged",
+			args: args{
+				ctx: ctx,
+				in: domain.UpdateInput{
+					ID:          10,
+					Title:       "title",
+					Description: "description",
+					Status:      "INITIATE",
+				},
+			},
+			want:    nil,
+			wantErr: errTodoChanged,
+			mockFn: func(a args) *Update {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusInitiate)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				data := domain.Todo{
+					ID:          a.in.ID,
+					UserID:      11,
+					Title:       a.in.Title,
+					Description: a.in.Description,
+					Status:      enum.New(domain.TodoStatusInitiate),
+					Priority:    enum.New(domain.TodoPriorityMedium),
+				}
+				store.EXPECT().
+					Update(ctx, data, []domain.TodoStatusChange(nil)).
+					Return(domain.ErrTodoNotUpdated)
+
+				return &Update{
+					telemetry: mtel,
+					store:     store,
+					validator: validator,
+					clock:     clock,
 				}
 			},
 		},
@@ -139,6 +381,9 @@ func TestUpdate_Execute(t *testing.T) {
 					Title:       "title",
 					Description: "description",
 					Status:      "DONE",
+					Priority:    "LOW",
+					DueAt:       &due,
+					Tags:        []string{"Work"},
 				},
 			},
 			want: &domain.Todo{
@@ -147,12 +392,17 @@ func TestUpdate_Execute(t *testing.T) {
 				Title:       "title",
 				Description: "description",
 				Status:      enum.New(domain.TodoStatusDone),
+				Priority:    enum.New(domain.TodoPriorityLow),
+				DueAt:       &due,
+				CompletedAt: &now,
+				Tags:        []string{"work"},
 			},
 			wantErr: nil,
 			mockFn: func(a args) *Update {
-				mtel := telemetry.NewTelemetry()
+				mtel := lib.NewTelemetry()
 				validator := vm.NewMockValidator(t)
 				store := mockz.NewMockUpdateStore(t)
+				clock := vm.NewMockClocker(t)
 
 				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
 				defer span.End()
@@ -161,21 +411,118 @@ func TestUpdate_Execute(t *testing.T) {
 					Validate(a.in).
 					Return(nil)
 
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(11)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 11, Status: enum.New(domain.TodoStatusInProgress)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
 				data := domain.Todo{
 					ID:          a.in.ID,
 					UserID:      11,
 					Title:       a.in.Title,
 					Description: a.in.Description,
-					Status:      enum.New(enum.Parse[domain.TodoStatus](a.in.Status)),
+					Status:      enum.New(domain.TodoStatusDone),
+					Priority:    enum.New(domain.TodoPriorityLow),
+					DueAt:       &due,
+					CompletedAt: &now,
+					Tags:        []string{"work"},
 				}
+				history := []domain.TodoStatusChange{{
+					TodoID:    a.in.ID,
+					From:      enum.New(domain.TodoStatusInProgress),
+					To:        enum.New(domain.TodoStatusDone),
+					ChangedBy: 11,
+					ChangedAt: now,
+				}}
 				store.EXPECT().
-					Update(ctx, data).
+					Update(ctx, data, history).
 					Return(nil)
 
+				publisher := mockz.NewMockTodoEventPublisher(t)
+				publisher.EXPECT().
+					Publish(ctx, domain.NewTodoEvent(domain.TodoEventTypeUpdated, data))
+
+				return &Update{
+					telemetry: mtel,
+					store:     store,
+					publisher: publisher,
+					validator: validator,
+					clock:     clock,
+				}
+			},
+		},
+		{
+			name: "SuccessAsAdmin",
+			args: args{
+				ctx: ctx,
+				in: domain.UpdateInput{
+					ID:          10,
+					Title:       "title",
+					Description: "description",
+					Status:      "DONE",
+				},
+			},
+			want: &domain.Todo{
+				ID:          10,
+				UserID:      20,
+				Title:       "title",
+				Description: "description",
+				Status:      enum.New(domain.TodoStatusDone),
+				Priority:    enum.New(domain.TodoPriorityMedium),
+				CompletedAt: &now,
+			},
+			wantErr: nil,
+			mockFn: func(a args) *Update {
+				mtel := lib.NewTelemetry()
+				validator := vm.NewMockValidator(t)
+				store := mockz.NewMockUpdateStore(t)
+				clock := vm.NewMockClocker(t)
+
+				ctx, span := mtel.Tracer().Start(a.ctx, "todo.usecase.Update")
+				defer span.End()
+
+				validator.EXPECT().
+					Validate(a.in).
+					Return(nil)
+
+				store.EXPECT().
+					FindMember(ctx, a.in.ID, uint64(0)).
+					Return(&domain.Todo{ID: a.in.ID, UserID: 20, Status: enum.New(domain.TodoStatusInProgress)}, nil)
+
+				clock.EXPECT().Now().Return(now)
+
+				data := domain.Todo{
+					ID:          a.in.ID,
+					UserID:      20,
+					Title:       a.in.Title,
+					Description: a.in.Description,
+					Status:      enum.New(domain.TodoStatusDone),
+					Priority:    enum.New(domain.TodoPriorityMedium),
+					CompletedAt: &now,
+				}
+				history := []domain.TodoStatusChange{{
+					TodoID:    a.in.ID,
+					From:      enum.New(domain.TodoStatusInProgress),
+					To:        enum.New(domain.TodoStatusDone),
+					ChangedBy: 11,
+					ChangedAt: now,
+				}}
+				store.EXPECT().
+					Update(ctx, data, history).
+					Return(nil)
+
+				publisher := mockz.NewMockTodoEventPublisher(t)
+				publisher.EXPECT().
+					Publish(ctx, domain.NewTodoEvent(domain.TodoEventTypeUpdated, data))
+
 				return &Update{
 					telemetry: mtel,
 					store:     store,
+					publisher: publisher,
 					validator: validator,
+					clock:     clock,
+					scope:     scope{admins: map[uint64]struct{}{11: {}}},
 				}
 			},
 		},
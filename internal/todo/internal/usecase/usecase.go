@@ -1,13 +1,237 @@
 package usecase
 
 import (
-	"github.com/shandysiswandi/goreng/telemetry"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shandysiswandi/goreng/clock"
+	"github.com/shandysiswandi/goreng/config"
+	"github.com/shandysiswandi/goreng/enum"
+	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/shandysiswandi/gostarter/internal/todo/internal/domain"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+)
+
+var (
+	errUnauthenticated = goerror.NewBusiness("authentication required", goerror.CodeUnauthorized)
+	errScopeForbidden  = goerror.NewBusiness("not allowed to access todos of other users", goerror.CodeForbidden)
+	errViewerReadOnly  = goerror.NewBusiness("todo is shared with you as viewer", goerror.CodeForbidden)
+	errTodoChanged     = goerror.NewBusiness("todo was changed meanwhile, try again", goerror.CodeConflict)
 )
 
 type Dependency struct {
-	UIDNumber uid.NumberID
-	Validator validation.Validator
-	Telemetry *telemetry.Telemetry
+	Config      config.Config
+	Clock       clock.Clocker
+	UIDNumber   uid.NumberID
+	Validator   validation.Validator
+	Telemetry   *lib.Telemetry
+	Publisher   domain.TodoEventPublisher
+	Transaction sqlkit.Tx
+	Blob        BlobStore
+}
+
+// BlobStore keeps the content of the attachments, pkg/blobstore has the
+// implementations.
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// scope limits todo queries to the todos owned by the caller, or shared with
+// them where the use case allows it. Users listed in `todo.admin.users` can
+// reach every todo by id and may list the todos of another user through an
+// explicit filter.
+type scope struct {
+	admins map[uint64]struct{}
+}
+
+func newScope(cfg config.Config) scope {
+	if cfg == nil {
+		return scope{}
+	}
+
+	var admins map[uint64]struct{}
+	for _, v := range strings.Split(cfg.GetString("todo.admin.users"), ",") {
+		if id, err := strconv.ParseUint(strings.TrimSpace(v), 10, 64); err == nil {
+			if admins == nil {
+				admins = make(map[uint64]struct{})
+			}
+			admins[id] = struct{}{}
+		}
+	}
+
+	return scope{admins: admins}
+}
+
+func (s scope) isAdmin(id uint64) bool {
+	_, ok := s.admins[id]
+
+	return ok
+}
+
+// owner returns the user a query by todo id is limited to. It is zero for
+// admins, meaning the query is not limited to any user.
+func (s scope) owner(ctx context.Context) (uint64, error) {
+	clm := lib.GetJWTClaim(ctx)
+	if clm == nil {
+		return 0, errUnauthenticated
+	}
+
+	if s.isAdmin(clm.AuthID) {
+		return 0, nil
+	}
+
+	return clm.AuthID, nil
+}
+
+// fetchOwner returns the owner a listing is limited to. Everyone lists their
+// own todos unless an admin asks for another user with override.
+func (s scope) fetchOwner(ctx context.Context, override string) (uint64, error) {
+	clm := lib.GetJWTClaim(ctx)
+	if clm == nil {
+		return 0, errUnauthenticated
+	}
+
+	if override == "" {
+		return clm.AuthID, nil
+	}
+
+	id, err := strconv.ParseUint(override, 10, 64)
+	if err != nil || id == 0 {
+		return 0, goerror.NewInvalidFormat("user_id must be a positive number")
+	}
+
+	if id != clm.AuthID && !s.isAdmin(clm.AuthID) {
+		return 0, errScopeForbidden
+	}
+
+	return id, nil
+}
+
+// attachmentPolicy holds the `todo.attachment.*` limits, a key left out of the
+// config keeps its default.
+type attachmentPolicy struct {
+	maxSize int64
+	types   map[string]struct{}
+	linkTTL time.Duration
+}
+
+func newAttachmentPolicy(cfg config.Config) attachmentPolicy {
+	p := attachmentPolicy{
+		maxSize: 10 << 20,
+		types:   mediaTypes("image/png,image/jpeg,image/gif,application/pdf,text/plain"),
+		linkTTL: 15 * time.Minute,
+	}
+
+	if cfg == nil {
+		return p
+	}
+
+	if v := cfg.GetInt("todo.attachment.max.size"); v > 0 {
+		p.maxSize = v << 20
+	}
+
+	if v := cfg.GetString("todo.attachment.mime.types"); v != "" {
+		p.types = mediaTypes(v)
+	}
+
+	if v := cfg.GetInt("todo.attachment.url.ttl"); v > 0 {
+		p.linkTTL = time.Duration(v) * time.Minute
+	}
+
+	return p
+}
+
+// AttachmentLimit is the largest attachment in bytes, the transports use it to
+// cut off an upload before it reaches the usecase.
+func AttachmentLimit(cfg config.Config) int64 {
+	return newAttachmentPolicy(cfg).maxSize
+}
+
+func (p attachmentPolicy) allows(mediaType string) bool {
+	_, ok := p.types[mediaType]
+
+	return ok
+}
+
+func mediaTypes(csv string) map[string]struct{} {
+	types := make(map[string]struct{})
+	for _, v := range strings.Split(csv, ",") {
+		if v = strings.ToLower(strings.TrimSpace(v)); v != "" {
+			types[v] = struct{}{}
+		}
+	}
+
+	return types
+}
+
+// actor returns the user making the change. It is only called once the owner
+// was resolved, so the claim is there.
+func actor(ctx context.Context) uint64 {
+	return lib.GetJWTClaim(ctx).AuthID
+}
+
+// transition moves the todo to the validated status if the state machine
+// allows it. It returns the changes to keep in the history, none when the status
+// stays the same.
+func transition(
+	todo *domain.Todo, status string, by uint64, now time.Time,
+) ([]domain.TodoStatusChange, error) {
+	from := todo.Status
+	to := enum.New(enum.Parse[domain.TodoStatus](status))
+
+	if !from.Enum().CanTransitionTo(to.Enum()) {
+		msg := fmt.Sprintf("todo status can not change from %s to %s", from, to)
+
+		return nil, goerror.NewBusiness(msg, goerror.CodeConflict)
+	}
+
+	todo.SetStatus(to, now)
+	if from.Enum() == to.Enum() {
+		return nil, nil
+	}
+
+	return []domain.TodoStatusChange{{
+		TodoID:    todo.ID,
+		From:      from,
+		To:        to,
+		ChangedBy: by,
+		ChangedAt: now,
+	}}, nil
+}
+
+// priority parses a validated priority, an empty one is MEDIUM.
+func priority(s string) enum.Enum[domain.TodoPriority] {
+	if s == "" {
+		return enum.New(domain.TodoPriorityMedium)
+	}
+
+	return enum.New(enum.Parse[domain.TodoPriority](s))
+}
+
+// normalizeTags trims and lowercases the tags and drops the duplicates, so
+// "Work" and "work " end up as the same tag.
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	var out []string
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if _, ok := seen[tag]; ok || tag == "" {
+			continue
+		}
+
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+
+	return out
 }
@@ -1,21 +1,23 @@
 package todo
 
 import (
-	"database/sql"
+	"time"
 
-	"github.com/doug-martin/goqu/v9"
+	"github.com/shandysiswandi/goreng/clock"
 	"github.com/shandysiswandi/goreng/codec"
 	"github.com/shandysiswandi/goreng/config"
 	"github.com/shandysiswandi/goreng/messaging"
 	"github.com/shandysiswandi/goreng/task"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/uid"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/inbound"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/job"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/outbound"
 	"github.com/shandysiswandi/gostarter/internal/todo/internal/usecase"
+	"github.com/shandysiswandi/gostarter/pkg/blobstore"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 	"google.golang.org/grpc"
 )
 
@@ -24,28 +26,40 @@ type Expose struct {
 }
 
 type Dependency struct {
-	Database     *sql.DB
-	QueryBuilder goqu.DialectWrapper
-	Messaging    messaging.Client
-	Config       config.Config
-	UIDNumber    uid.NumberID
-	CodecJSON    codec.Codec
-	Validator    validation.Validator
-	Router       *framework.Router
-	GQLRouter    *framework.Router
-	GRPCServer   *grpc.Server
-	Telemetry    *telemetry.Telemetry
+	SQLKitDB   *sqlkit.DB
+	Messaging  messaging.Client
+	Config     config.Config
+	Clock      clock.Clocker
+	UIDNumber  uid.NumberID
+	UIDString  uid.StringID
+	CodecJSON  codec.Codec
+	Validator  validation.Validator
+	Router     *framework.Router
+	GQLRouter  *framework.Router
+	GRPCServer *grpc.Server
+	Telemetry  *lib.Telemetry
+	Blob       blobstore.BlobStore
 }
 
 func New(dep Dependency) (*Expose, error) {
 	// This block initializes outbound services: Database, HTTP client, gRPC client, Redis, etc.
-	sqlTodo := outbound.NewSQLTodo(dep.Database, dep.QueryBuilder, dep.Telemetry)
+	sqlTodo := outbound.NewSQLTodo(dep.SQLKitDB, dep.UIDNumber, dep.Telemetry)
+	eventBus := outbound.NewEventBus(
+		int(dep.Config.GetInt("todo.event.replay.size")),
+		int(dep.Config.GetInt("todo.event.client.buffer")),
+		dep.Telemetry,
+	)
 
 	// This block initializes core business logic or use cases to handle user interaction
 	ucDep := usecase.Dependency{
-		UIDNumber: dep.UIDNumber,
-		Validator: dep.Validator,
-		Telemetry: dep.Telemetry,
+		Config:      dep.Config,
+		Clock:       dep.Clock,
+		UIDNumber:   dep.UIDNumber,
+		Validator:   dep.Validator,
+		Telemetry:   dep.Telemetry,
+		Publisher:   eventBus,
+		Transaction: dep.SQLKitDB.Tx(),
+		Blob:        dep.Blob,
 	}
 	findUC := usecase.NewFind(ucDep, sqlTodo)
 	fetchUC := usecase.NewFetch(ucDep, sqlTodo)
@@ -53,6 +67,17 @@ func New(dep Dependency) (*Expose, error) {
 	deleteUC := usecase.NewDelete(ucDep, sqlTodo)
 	updateUC := usecase.NewUpdate(ucDep, sqlTodo)
 	updateStatusUC := usecase.NewUpdateStatus(ucDep, sqlTodo)
+	restoreUC := usecase.NewRestore(ucDep, sqlTodo)
+	purgeUC := usecase.NewPurge(ucDep, sqlTodo)
+	historyUC := usecase.NewHistory(ucDep, sqlTodo)
+	batchUC := usecase.NewBatch(ucDep, sqlTodo)
+	shareUC := usecase.NewShare(ucDep, sqlTodo)
+	unshareUC := usecase.NewUnshare(ucDep, sqlTodo)
+	acceptUC := usecase.NewAccept(ucDep, sqlTodo)
+	attachUC := usecase.NewAttach(ucDep, sqlTodo)
+	attachmentsUC := usecase.NewAttachments(ucDep, sqlTodo)
+	downloadUC := usecase.NewDownload(ucDep, sqlTodo)
+	detachUC := usecase.NewDetach(ucDep, sqlTodo)
 
 	// This block initializes REST, SSE, gRPC, and graphQL API endpoints to handle core user workflows:
 	inbound := inbound.Inbound{
@@ -61,6 +86,7 @@ func New(dep Dependency) (*Expose, error) {
 		GRPCServer: dep.GRPCServer,
 		CodecJSON:  dep.CodecJSON,
 		Telemetry:  dep.Telemetry,
+		Events:     eventBus,
 		//
 		CreateUC:       createUC,
 		DeleteUC:       deleteUC,
@@ -68,6 +94,19 @@ func New(dep Dependency) (*Expose, error) {
 		FetchUC:        fetchUC,
 		UpdateStatusUC: updateStatusUC,
 		UpdateUC:       updateUC,
+		RestoreUC:      restoreUC,
+		PurgeUC:        purgeUC,
+		HistoryUC:      historyUC,
+		BatchUC:        batchUC,
+		ShareUC:        shareUC,
+		UnshareUC:      unshareUC,
+		AcceptUC:       acceptUC,
+		AttachUC:       attachUC,
+		AttachmentsUC:  attachmentsUC,
+		DownloadUC:     downloadUC,
+		DetachUC:       detachUC,
+		UploadLimit:    usecase.AttachmentLimit(dep.Config),
+		UploadTimeout:  uploadTimeout(dep.Config),
 	}
 	inbound.RegisterTodoServiceServer()
 
@@ -77,8 +116,19 @@ func New(dep Dependency) (*Expose, error) {
 		Config:       dep.Config,
 		CodecJSON:    dep.CodecJSON,
 		Telemetry:    dep.Telemetry,
+		UIDString:    dep.UIDString,
 		DomainCreate: createUC,
 	})
 
 	return &Expose{Tasks: jobs}, nil
 }
+
+// uploadTimeout is how long an attachment may take to arrive, the ReadTimeout
+// of the server is too short for a large file. It is two minutes when unset.
+func uploadTimeout(cfg config.Config) time.Duration {
+	if v := cfg.GetInt("server.http.timeout.upload"); v > 0 {
+		return time.Duration(v) * time.Second
+	}
+
+	return 2 * time.Minute
+}
@@ -3,7 +3,6 @@ package todo
 import (
 	"testing"
 
-	"github.com/doug-martin/goqu/v9"
 	configMock "github.com/shandysiswandi/goreng/mocker"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/stretchr/testify/assert"
@@ -20,20 +19,26 @@ func TestNew(t *testing.T) {
 			name: "Success",
 			dep: func() Dependency {
 				mc := configMock.NewMockConfig(t)
+				mc.EXPECT().GetString("todo.admin.users").Return("")
+				mc.EXPECT().GetInt("todo.event.replay.size").Return(0)
+				mc.EXPECT().GetInt("todo.event.client.buffer").Return(0)
+				mc.EXPECT().GetInt("todo.attachment.max.size").Return(0)
+				mc.EXPECT().GetString("todo.attachment.mime.types").Return("")
+				mc.EXPECT().GetInt("todo.attachment.url.ttl").Return(0)
+				mc.EXPECT().GetInt("server.http.timeout.upload").Return(0)
 				mc.EXPECT().GetBool("feature.flag.todo.job").Return(true).Once()
 
 				return Dependency{
-					Database:     nil,
-					QueryBuilder: goqu.DialectWrapper{},
-					Messaging:    nil,
-					Config:       mc,
-					UIDNumber:    nil,
-					CodecJSON:    nil,
-					Validator:    nil,
-					Router:       framework.NewRouter(),
-					GQLRouter:    framework.NewRouter(),
-					GRPCServer:   grpc.NewServer(),
-					Telemetry:    nil,
+					SQLKitDB:   nil,
+					Messaging:  nil,
+					Config:     mc,
+					UIDNumber:  nil,
+					CodecJSON:  nil,
+					Validator:  nil,
+					Router:     framework.NewRouter(),
+					GQLRouter:  framework.NewRouter(),
+					GRPCServer: grpc.NewServer(),
+					Telemetry:  nil,
 				}
 			},
 			wantErr: nil,
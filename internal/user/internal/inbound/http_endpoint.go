@@ -1,16 +1,13 @@
 package inbound
 
 import (
-	"encoding/json"
-
-	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 )
 
 type httpEndpoint struct {
-	tel *telemetry.Telemetry
+	tel *lib.Telemetry
 
 	profileUC        domain.Profile
 	updateUC         domain.Update
@@ -38,9 +35,9 @@ func (h *httpEndpoint) Update(c framework.Context) (any, error) {
 	ctx, span := h.tel.Tracer().Start(c.Context(), "user.inbound.httpEndpoint.Update")
 	defer span.End()
 
-	var req UpdateRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, goerror.NewInvalidFormat("Request payload malformed")
+	req, err := framework.Bind[UpdateRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.updateUC.Call(ctx, domain.UpdateInput{Name: req.Name})
@@ -59,9 +56,9 @@ func (h *httpEndpoint) UpdatePassword(c framework.Context) (any, error) {
 	ctx, span := h.tel.Tracer().Start(c.Context(), "user.inbound.httpEndpoint.UpdatePassword")
 	defer span.End()
 
-	var req UpdatePasswordRequest
-	if err := json.NewDecoder(c.Body()).Decode(&req); err != nil {
-		return nil, goerror.NewInvalidFormat("Request payload malformed")
+	req, err := framework.Bind[UpdatePasswordRequest](c)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := h.updatePasswordUC.Call(ctx, domain.UpdatePasswordInput{
@@ -7,7 +7,7 @@ import (
 	"testing"
 
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/mockz"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
@@ -32,7 +32,7 @@ func Test_httpEndpoint_Profile(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				profileMock := mockz.NewMockProfile(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.Profile")
 				defer span.End()
@@ -64,7 +64,7 @@ func Test_httpEndpoint_Profile(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				profileMock := mockz.NewMockProfile(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.Profile")
 				defer span.End()
@@ -120,7 +120,7 @@ func Test_httpEndpoint_Update(t *testing.T) {
 			wantErr: goerror.NewInvalidFormat("Request payload malformed"),
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updateMock := mockz.NewMockUpdate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.Update")
 				defer span.End()
@@ -144,7 +144,7 @@ func Test_httpEndpoint_Update(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updateMock := mockz.NewMockUpdate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.Update")
 				defer span.End()
@@ -177,7 +177,7 @@ func Test_httpEndpoint_Update(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updateMock := mockz.NewMockUpdate(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.Update")
 				defer span.End()
@@ -233,7 +233,7 @@ func Test_httpEndpoint_UpdatePassword(t *testing.T) {
 			wantErr: goerror.NewInvalidFormat("Request payload malformed"),
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updatePasswordMock := mockz.NewMockUpdatePassword(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				_, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.UpdatePassword")
 				defer span.End()
@@ -258,7 +258,7 @@ func Test_httpEndpoint_UpdatePassword(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updatePasswordMock := mockz.NewMockUpdatePassword(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.UpdatePassword")
 				defer span.End()
@@ -295,7 +295,7 @@ func Test_httpEndpoint_UpdatePassword(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				updatePasswordMock := mockz.NewMockUpdatePassword(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.UpdatePassword")
 				defer span.End()
@@ -355,7 +355,7 @@ func Test_httpEndpoint_Logout(t *testing.T) {
 			wantErr: assert.AnError,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				logoutMock := mockz.NewMockLogout(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.Logout")
 				defer span.End()
@@ -384,7 +384,7 @@ func Test_httpEndpoint_Logout(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(ctx context.Context) *httpEndpoint {
 				logoutMock := mockz.NewMockLogout(t)
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 
 				ctx, span := tel.Tracer().Start(ctx, "user.inbound.httpEndpoint.Logout")
 				defer span.End()
@@ -3,14 +3,14 @@ package inbound
 import (
 	"net/http"
 
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 )
 
 type Inbound struct {
 	Router    *framework.Router
-	Telemetry *telemetry.Telemetry
+	Telemetry *lib.Telemetry
 	//
 	ProfileUC        domain.Profile
 	UpdateUC         domain.Update
@@ -28,8 +28,23 @@ func (in Inbound) RegisterUserServiceServer() {
 		logoutUC:         in.LogoutUC,
 	}
 
-	in.Router.Endpoint(http.MethodGet, "/me/profile", he.Profile)
-	in.Router.Endpoint(http.MethodPatch, "/me/profile", he.Update)
-	in.Router.Endpoint(http.MethodPatch, "/me/password", he.UpdatePassword)
-	in.Router.Endpoint(http.MethodPost, "/me/logout", he.Logout)
+	me := in.Router.Group("/me")
+	me.Doc(framework.RouteDoc{
+		Summary:  "Get the profile",
+		Response: User{},
+	}).Endpoint(http.MethodGet, "/profile", he.Profile, framework.ETag())
+	me.Doc(framework.RouteDoc{
+		Summary:  "Update the profile",
+		Request:  UpdateRequest{},
+		Response: User{},
+	}).Endpoint(http.MethodPatch, "/profile", he.Update)
+	me.Doc(framework.RouteDoc{
+		Summary:  "Change the password",
+		Request:  UpdatePasswordRequest{},
+		Response: User{},
+	}).Endpoint(http.MethodPatch, "/password", he.UpdatePassword)
+	me.Doc(framework.RouteDoc{
+		Summary:  "Log out",
+		Response: LogoutResponse{},
+	}).Endpoint(http.MethodPost, "/logout", he.Logout)
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/shandysiswandi/gostarter/pkg/framework"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestInbound_RegisterUserServiceServer(t *testing.T) {
@@ -25,6 +26,7 @@ func TestInbound_RegisterUserServiceServer(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			tt.in.RegisterUserServiceServer()
+			assert.Empty(t, tt.in.Router.Undocumented())
 		})
 	}
 }
@@ -3,17 +3,17 @@ package outbound
 import (
 	"context"
 
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
 )
 
 type SQL struct {
 	db        *sqlkit.DB
-	telemetry *telemetry.Telemetry
+	telemetry *lib.Telemetry
 }
 
-func NewSQL(db *sqlkit.DB, tel *telemetry.Telemetry) *SQL {
+func NewSQL(db *sqlkit.DB, tel *lib.Telemetry) *SQL {
 	return &SQL{
 		db:        db,
 		telemetry: tel,
@@ -7,7 +7,7 @@ package outbound
 
 // 	"github.com/DATA-DOG/go-sqlmock"
 // 	"github.com/doug-martin/goqu/v9"
-// 	"github.com/shandysiswandi/goreng/telemetry"
+// 	"github.com/shandysiswandi/gostarter/internal/lib"
 // 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 // 	"github.com/stretchr/testify/assert"
 // )
@@ -16,7 +16,7 @@ package outbound
 // 	type args struct {
 // 		db  *sql.DB
 // 		qu  goqu.DialectWrapper
-// 		tel *telemetry.Telemetry
+// 		tel *lib.Telemetry
 // 	}
 // 	tests := []struct {
 // 		name string
@@ -28,12 +28,12 @@ package outbound
 // 			args: args{
 // 				db:  &sql.DB{},
 // 				qu:  goqu.Dialect(dbops.MySQLDriver),
-// 				tel: telemetry.NewTelemetry(),
+// 				tel: lib.NewTelemetry(),
 // 			},
 // 			want: &SQL{
 // 				db:        &sql.DB{},
 // 				qu:        goqu.Dialect(dbops.MySQLDriver),
-// 				telemetry: telemetry.NewTelemetry(),
+// 				telemetry: lib.NewTelemetry(),
 // 			},
 // 		},
 // 		{
@@ -41,12 +41,12 @@ package outbound
 // 			args: args{
 // 				db:  &sql.DB{},
 // 				qu:  goqu.Dialect(dbops.MySQLDriver),
-// 				tel: telemetry.NewTelemetry(),
+// 				tel: lib.NewTelemetry(),
 // 			},
 // 			want: &SQL{
 // 				db:        &sql.DB{},
 // 				qu:        goqu.Dialect(dbops.MySQLDriver),
-// 				telemetry: telemetry.NewTelemetry(),
+// 				telemetry: lib.NewTelemetry(),
 // 			},
 // 		},
 // 	}
@@ -98,7 +98,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -127,7 +127,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -165,7 +165,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -220,7 +220,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -249,7 +249,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -287,7 +287,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -340,7 +340,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -368,7 +368,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -423,7 +423,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -454,7 +454,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -505,7 +505,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -532,7 +532,7 @@ package outbound
 // 				return &SQL{
 // 					db:        db,
 // 					qu:        goqu.Dialect(dbops.MySQLDriver),
-// 					telemetry: telemetry.NewTelemetry(),
+// 					telemetry: lib.NewTelemetry(),
 // 				}, db.Close
 // 			},
 // 		},
@@ -5,8 +5,8 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/hash"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 )
 
@@ -15,7 +15,7 @@ type LogoutStore interface {
 }
 
 type Logout struct {
-	tel       *telemetry.Telemetry
+	tel       *lib.Telemetry
 	validator validation.Validator
 	secHash   hash.Hash
 	store     LogoutStore
@@ -7,7 +7,7 @@ import (
 	"github.com/shandysiswandi/goreng/goerror"
 	mockHash "github.com/shandysiswandi/goreng/mocker"
 	mockValidation "github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/mockz"
 	"github.com/stretchr/testify/assert"
@@ -57,7 +57,7 @@ func TestLogout_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *Logout {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 
 				_, span := tel.Tracer().Start(a.ctx, "auth.usecase.Logout")
@@ -84,7 +84,7 @@ func TestLogout_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Logout {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				secHashMock := mockHash.NewMockHash(t)
 
@@ -116,7 +116,7 @@ func TestLogout_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Logout {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				secHashMock := mockHash.NewMockHash(t)
 				storeMock := mockz.NewMockLogoutStore(t)
@@ -153,7 +153,7 @@ func TestLogout_Call(t *testing.T) {
 			want:    &domain.LogoutOutput{Message: "You have successfully logged out!"},
 			wantErr: nil,
 			mockFn: func(a args) *Logout {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				secHashMock := mockHash.NewMockHash(t)
 				storeMock := mockz.NewMockLogoutStore(t)
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
@@ -15,7 +14,7 @@ type ProfileStore interface {
 }
 
 type Profile struct {
-	tel   *telemetry.Telemetry
+	tel   *lib.Telemetry
 	store ProfileStore
 }
 
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/mockz"
@@ -60,7 +59,7 @@ func TestProfile_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Profile {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				storeMock := mockz.NewMockProfileStore(t)
 
 				ctx, span := tel.Tracer().Start(a.ctx, "user.usecase.Profile")
@@ -85,7 +84,7 @@ func TestProfile_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("user not found", goerror.CodeNotFound),
 			mockFn: func(a args) *Profile {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				storeMock := mockz.NewMockProfileStore(t)
 
 				ctx, span := tel.Tracer().Start(a.ctx, "user.usecase.Profile")
@@ -115,7 +114,7 @@ func TestProfile_Call(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *Profile {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				storeMock := mockz.NewMockProfileStore(t)
 
 				ctx, span := tel.Tracer().Start(a.ctx, "user.usecase.Profile")
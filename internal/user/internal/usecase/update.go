@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/shandysiswandi/goreng/goerror"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/lib"
@@ -16,7 +15,7 @@ type UpdateStore interface {
 }
 
 type Update struct {
-	tel       *telemetry.Telemetry
+	tel       *lib.Telemetry
 	validator validation.Validator
 	store     UpdateStore
 }
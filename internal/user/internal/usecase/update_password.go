@@ -5,7 +5,6 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/hash"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 	"github.com/shandysiswandi/goreng/validation"
 	"github.com/shandysiswandi/gostarter/internal/lib"
@@ -18,7 +17,7 @@ type UpdatePasswordStore interface {
 }
 
 type UpdatePassword struct {
-	tel       *telemetry.Telemetry
+	tel       *lib.Telemetry
 	validator validation.Validator
 	hash      hash.Hash
 	store     UpdatePasswordStore
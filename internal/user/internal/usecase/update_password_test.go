@@ -8,7 +8,6 @@ import (
 	"github.com/shandysiswandi/goreng/goerror"
 	mockHash "github.com/shandysiswandi/goreng/mocker"
 	mockValidation "github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/mockz"
@@ -65,7 +64,7 @@ func TestUpdatePassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *UpdatePassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 
 				_, span := tel.Tracer().Start(a.ctx, "user.usecase.UpdatePassword")
@@ -95,7 +94,7 @@ func TestUpdatePassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *UpdatePassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				storeMock := mockz.NewMockUpdatePasswordStore(t)
 
@@ -130,7 +129,7 @@ func TestUpdatePassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Invalid credentials", goerror.CodeUnauthorized),
 			mockFn: func(a args) *UpdatePassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				storeMock := mockz.NewMockUpdatePasswordStore(t)
 
@@ -165,7 +164,7 @@ func TestUpdatePassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewBusiness("Invalid credentials", goerror.CodeUnauthorized),
 			mockFn: func(a args) *UpdatePassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				hashMock := mockHash.NewMockHash(t)
 				storeMock := mockz.NewMockUpdatePasswordStore(t)
@@ -211,7 +210,7 @@ func TestUpdatePassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *UpdatePassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				hashMock := mockHash.NewMockHash(t)
 				storeMock := mockz.NewMockUpdatePasswordStore(t)
@@ -261,7 +260,7 @@ func TestUpdatePassword_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *UpdatePassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				hashMock := mockHash.NewMockHash(t)
 				storeMock := mockz.NewMockUpdatePasswordStore(t)
@@ -322,7 +321,7 @@ func TestUpdatePassword_Call(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *UpdatePassword {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				hashMock := mockHash.NewMockHash(t)
 				storeMock := mockz.NewMockUpdatePasswordStore(t)
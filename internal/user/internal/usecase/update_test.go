@@ -7,7 +7,6 @@ import (
 
 	"github.com/shandysiswandi/goreng/goerror"
 	mockValidation "github.com/shandysiswandi/goreng/mocker"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/domain"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/mockz"
@@ -61,7 +60,7 @@ func TestUpdate_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
 			mockFn: func(a args) *Update {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 
 				_, span := tel.Tracer().Start(a.ctx, "user.usecase.Update")
@@ -87,7 +86,7 @@ func TestUpdate_Call(t *testing.T) {
 			want:    nil,
 			wantErr: goerror.NewServerInternal(assert.AnError),
 			mockFn: func(a args) *Update {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				storeMock := mockz.NewMockUpdateStore(t)
 
@@ -127,7 +126,7 @@ func TestUpdate_Call(t *testing.T) {
 			},
 			wantErr: nil,
 			mockFn: func(a args) *Update {
-				tel := telemetry.NewTelemetry()
+				tel := lib.NewTelemetry()
 				validatorMock := mockValidation.NewMockValidator(t)
 				storeMock := mockz.NewMockUpdateStore(t)
 
@@ -2,12 +2,12 @@ package usecase
 
 import (
 	"github.com/shandysiswandi/goreng/hash"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 )
 
 type Dependency struct {
-	Telemetry *telemetry.Telemetry
+	Telemetry *lib.Telemetry
 	Validator validation.Validator
 	Hash      hash.Hash
 }
@@ -2,8 +2,8 @@ package user
 
 import (
 	"github.com/shandysiswandi/goreng/hash"
-	"github.com/shandysiswandi/goreng/telemetry"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/inbound"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/outbound"
 	"github.com/shandysiswandi/gostarter/internal/user/internal/usecase"
@@ -18,7 +18,7 @@ type Dependency struct {
 	Validator validation.Validator
 	Hash      hash.Hash
 	Router    *framework.Router
-	Telemetry *telemetry.Telemetry
+	Telemetry *lib.Telemetry
 }
 
 func New(dep Dependency) (*Expose, error) {
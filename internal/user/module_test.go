@@ -3,7 +3,7 @@ package user
 import (
 	"testing"
 
-	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/shandysiswandi/gostarter/pkg/framework"
 	"github.com/stretchr/testify/assert"
 )
@@ -22,7 +22,7 @@ func TestNew(t *testing.T) {
 					Hash:      nil,
 					Validator: nil,
 					Router:    framework.NewRouter(),
-					Telemetry: telemetry.NewTelemetry(),
+					Telemetry: lib.NewTelemetry(),
 				}
 			},
 			wantErr: nil,
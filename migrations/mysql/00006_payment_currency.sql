@@ -0,0 +1,51 @@
+-- +goose Up
+ALTER TABLE accounts
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR' AFTER user_id,
+    MODIFY COLUMN balance DECIMAL(20, 4) NOT NULL DEFAULT 0.00;
+
+CREATE UNIQUE INDEX accounts_user_id_currency_idx ON accounts (user_id, currency);
+
+ALTER TABLE transactions
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR' AFTER amount,
+    MODIFY COLUMN amount DECIMAL(20, 4) NOT NULL DEFAULT 0.00;
+
+ALTER TABLE topups
+    MODIFY COLUMN amount DECIMAL(20, 4) NOT NULL DEFAULT 0.00;
+
+ALTER TABLE transfers
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR' AFTER amount,
+    ADD COLUMN target_amount DECIMAL(20, 4) NOT NULL DEFAULT 0.00 AFTER currency,
+    ADD COLUMN target_currency CHAR(3) NOT NULL DEFAULT 'IDR' AFTER target_amount,
+    ADD COLUMN rate DECIMAL(24, 10) NOT NULL DEFAULT 1 AFTER target_currency, -- applied rate, currency -> target_currency
+    MODIFY COLUMN amount DECIMAL(20, 4) NOT NULL DEFAULT 0.00;
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    base_currency CHAR(3) NOT NULL,
+    quote_currency CHAR(3) NOT NULL,
+    rate DECIMAL(24, 10) NOT NULL, -- 1 base_currency = rate quote_currency
+    updated_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+    PRIMARY KEY (base_currency, quote_currency)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE transfers
+    DROP COLUMN rate,
+    DROP COLUMN target_currency,
+    DROP COLUMN target_amount,
+    DROP COLUMN currency,
+    MODIFY COLUMN amount DECIMAL(16, 2) NOT NULL DEFAULT 0.00;
+
+ALTER TABLE topups
+    MODIFY COLUMN amount DECIMAL(16, 2) NOT NULL DEFAULT 0.00;
+
+ALTER TABLE transactions
+    DROP COLUMN currency,
+    MODIFY COLUMN amount DECIMAL(16, 2) NOT NULL DEFAULT 0.00;
+
+DROP INDEX accounts_user_id_currency_idx ON accounts;
+
+ALTER TABLE accounts
+    DROP COLUMN currency,
+    MODIFY COLUMN balance DECIMAL(16, 2) NOT NULL DEFAULT 0.00;
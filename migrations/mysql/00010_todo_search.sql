@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE FULLTEXT INDEX todos_title_description_ft ON todos (title, description);
+
+CREATE INDEX todos_user_id_created_at_idx ON todos (user_id, created_at);
+CREATE INDEX todos_user_id_due_at_idx ON todos (user_id, due_at);
+
+-- +goose Down
+DROP INDEX todos_user_id_due_at_idx ON todos;
+DROP INDEX todos_user_id_created_at_idx ON todos;
+DROP INDEX todos_title_description_ft ON todos;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS todo_collaborators (
+    todo_id BIGINT UNSIGNED NOT NULL,
+    email VARCHAR(255) NOT NULL, -- the invitation is made to an email, the user may not exist yet
+    user_id BIGINT UNSIGNED NOT NULL DEFAULT 0, -- set once the invitation is accepted
+    role VARCHAR(50) NOT NULL,
+    invited_by BIGINT UNSIGNED NOT NULL,
+    invited_at TIMESTAMP(3) NOT NULL,
+    accepted_at TIMESTAMP(3) NULL,
+    PRIMARY KEY (todo_id, email),
+    FOREIGN KEY (todo_id) REFERENCES todos(id) ON DELETE CASCADE
+);
+
+CREATE INDEX todo_collaborators_user_id_idx ON todo_collaborators (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS todo_collaborators;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- the permissions the rbac routes require and an admin role holding them, the
+-- role is granted with a row in user_roles; rbac.admin.users needs none
+INSERT INTO permissions (id, name, description) VALUES
+    (1, 'rbac.role.read', 'List and get the roles'),
+    (2, 'rbac.role.write', 'Create and update the roles'),
+    (3, 'rbac.permission.read', 'List and get the permissions'),
+    (4, 'rbac.permission.write', 'Create and update the permissions');
+
+INSERT INTO roles (id, name, description) VALUES
+    (1, 'admin', 'Manages the roles and the permissions');
+
+INSERT INTO role_permissions (role_id, permission_id) VALUES
+    (1, 1),
+    (1, 2),
+    (1, 3),
+    (1, 4);
+
+-- +goose Down
+DELETE FROM role_permissions WHERE role_id = 1;
+DELETE FROM roles WHERE id = 1;
+DELETE FROM permissions WHERE id IN (1, 2, 3, 4);
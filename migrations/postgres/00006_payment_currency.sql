@@ -0,0 +1,55 @@
+-- +goose Up
+ALTER TABLE accounts
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR',
+    ALTER COLUMN balance TYPE DECIMAL(20, 4);
+
+CREATE UNIQUE INDEX accounts_user_id_currency_idx ON accounts (user_id, currency);
+
+ALTER TABLE transactions
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR',
+    ALTER COLUMN amount TYPE DECIMAL(20, 4);
+
+ALTER TABLE topups
+    ALTER COLUMN amount TYPE DECIMAL(20, 4);
+
+ALTER TABLE transfers
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR',
+    ADD COLUMN target_amount DECIMAL(20, 4) NOT NULL DEFAULT 0.00,
+    ADD COLUMN target_currency CHAR(3) NOT NULL DEFAULT 'IDR',
+    ADD COLUMN rate DECIMAL(24, 10) NOT NULL DEFAULT 1, -- applied rate, currency -> target_currency
+    ALTER COLUMN amount TYPE DECIMAL(20, 4);
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    base_currency CHAR(3) NOT NULL,
+    quote_currency CHAR(3) NOT NULL,
+    rate DECIMAL(24, 10) NOT NULL, -- 1 base_currency = rate quote_currency
+    updated_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (base_currency, quote_currency)
+);
+
+CREATE TRIGGER update_exchange_rates_updated_at
+BEFORE UPDATE ON exchange_rates
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- +goose Down
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE transfers
+    DROP COLUMN rate,
+    DROP COLUMN target_currency,
+    DROP COLUMN target_amount,
+    DROP COLUMN currency,
+    ALTER COLUMN amount TYPE DECIMAL(16, 2);
+
+ALTER TABLE topups
+    ALTER COLUMN amount TYPE DECIMAL(16, 2);
+
+ALTER TABLE transactions
+    DROP COLUMN currency,
+    ALTER COLUMN amount TYPE DECIMAL(16, 2);
+
+DROP INDEX IF EXISTS accounts_user_id_currency_idx;
+
+ALTER TABLE accounts
+    DROP COLUMN currency,
+    ALTER COLUMN balance TYPE DECIMAL(16, 2);
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS risk_decisions (
+    id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    operation VARCHAR(50) NOT NULL, -- TOPUP, TRANSFER
+    amount DECIMAL(20, 4) NOT NULL DEFAULT 0.00,
+    currency CHAR(3) NOT NULL,
+    allowed BOOLEAN NOT NULL,
+    rule VARCHAR(50) NOT NULL DEFAULT '', -- the rule that rejected the request, empty when allowed
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX risk_decisions_user_id_idx ON risk_decisions (user_id);
+
+-- velocity limits sum the user's transactions per currency over a time window
+CREATE INDEX transactions_user_currency_created_idx ON transactions (user_id, currency, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS transactions_user_currency_created_idx;
+DROP TABLE IF EXISTS risk_decisions;
@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS scheduled_payments (
+    id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    recipient_id BIGINT NOT NULL,
+    amount DECIMAL(20, 4) NOT NULL DEFAULT 0.00,
+    currency CHAR(3) NOT NULL,
+    target_currency CHAR(3) NOT NULL,
+    schedule VARCHAR(100) NOT NULL, -- @once, @every 24h, @daily or a five field cron expression
+    next_run_at TIMESTAMP(3) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    attempts INT NOT NULL DEFAULT 0, -- failed attempts of the current run
+    lease_owner VARCHAR(255) NOT NULL DEFAULT '', -- runner instance executing the schedule
+    lease_until TIMESTAMP(3) NULL,
+    created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX scheduled_payments_active_next_run_at_idx ON scheduled_payments (active, next_run_at);
+CREATE INDEX scheduled_payments_user_id_idx ON scheduled_payments (user_id);
+
+CREATE TABLE IF NOT EXISTS scheduled_payment_runs (
+    id BIGINT PRIMARY KEY,
+    schedule_id BIGINT NOT NULL,
+    attempt INT NOT NULL,
+    status VARCHAR(50) NOT NULL, -- SUCCESS, RETRY, FAILED
+    transfer_id BIGINT NOT NULL DEFAULT 0, -- zero when the transfer did not happen
+    error VARCHAR(255) NOT NULL DEFAULT '',
+    run_at TIMESTAMP(3) NOT NULL,
+    FOREIGN KEY (schedule_id) REFERENCES scheduled_payments(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS scheduled_payment_runs;
+DROP TABLE IF EXISTS scheduled_payments;
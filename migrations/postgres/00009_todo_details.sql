@@ -0,0 +1,35 @@
+-- +goose Up
+ALTER TABLE todos
+    ADD COLUMN priority VARCHAR(50) NOT NULL DEFAULT 'MEDIUM',
+    ADD COLUMN due_at TIMESTAMP(3) NULL,
+    ADD COLUMN completed_at TIMESTAMP(3) NULL, -- set when the status becomes DONE
+    ADD COLUMN deleted_at TIMESTAMP(3) NULL; -- soft delete, restored by setting it back to NULL
+
+CREATE INDEX todos_user_id_deleted_at_idx ON todos (user_id, deleted_at);
+
+CREATE TABLE IF NOT EXISTS tags (
+    id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS todo_tags (
+    todo_id BIGINT NOT NULL,
+    tag_id BIGINT NOT NULL,
+    PRIMARY KEY (todo_id, tag_id),
+    FOREIGN KEY (todo_id) REFERENCES todos(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS todo_tags;
+DROP TABLE IF EXISTS tags;
+DROP INDEX IF EXISTS todos_user_id_deleted_at_idx;
+ALTER TABLE todos
+    DROP COLUMN deleted_at,
+    DROP COLUMN completed_at,
+    DROP COLUMN due_at,
+    DROP COLUMN priority;
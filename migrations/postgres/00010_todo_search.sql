@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE todos
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('simple', title || ' ' || COALESCE(description, ''))
+    ) STORED; -- kept by the database, matched with plainto_tsquery('simple', ...)
+
+CREATE INDEX todos_search_vector_idx ON todos USING GIN (search_vector);
+
+CREATE INDEX todos_user_id_created_at_idx ON todos (user_id, created_at);
+CREATE INDEX todos_user_id_due_at_idx ON todos (user_id, due_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS todos_user_id_due_at_idx;
+DROP INDEX IF EXISTS todos_user_id_created_at_idx;
+DROP INDEX IF EXISTS todos_search_vector_idx;
+ALTER TABLE todos DROP COLUMN search_vector;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS todo_status_history (
+    id BIGINT PRIMARY KEY,
+    todo_id BIGINT NOT NULL,
+    from_status VARCHAR(50) NOT NULL,
+    to_status VARCHAR(50) NOT NULL,
+    changed_by BIGINT NOT NULL, -- the user who made the change, an admin may change todos of others
+    changed_at TIMESTAMP(3) NOT NULL,
+    FOREIGN KEY (todo_id) REFERENCES todos(id) ON DELETE CASCADE
+);
+
+CREATE INDEX todo_status_history_todo_id_changed_at_idx ON todo_status_history (todo_id, changed_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS todo_status_history;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS todo_attachments (
+    id BIGINT PRIMARY KEY,
+    todo_id BIGINT NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL, -- sniffed from the content on upload
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(512) NOT NULL, -- key of the content in the blob store
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMP(3) NOT NULL,
+    FOREIGN KEY (todo_id) REFERENCES todos(id) ON DELETE CASCADE
+);
+
+CREATE INDEX todo_attachments_todo_id_idx ON todo_attachments (todo_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS todo_attachments;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- the token of the reservation, only the request holding it completes or releases the key
+ALTER TABLE idempotency_keys ADD COLUMN token VARCHAR(64) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP COLUMN token;
@@ -0,0 +1,44 @@
+// Package blobstore keeps binary objects, such as uploaded files, out of the
+// database. Objects are written and removed by key and read back through signed,
+// expiring URLs, so the content never has to stream through the application.
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidKey is returned when a key is empty or would escape the store root.
+	ErrInvalidKey = errors.New("blobstore: invalid key")
+
+	// ErrSizeMismatch is returned when the body is shorter or longer than the announced size.
+	ErrSizeMismatch = errors.New("blobstore: body size does not match")
+)
+
+// BlobStore writes, removes and hands out signed download URLs for objects.
+// Keys are slash separated paths like "todos/1/2/report.pdf".
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// validKey reports whether every segment of the key is a plain name, so a key
+// can never point above the store root.
+func validKey(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for _, seg := range strings.Split(key, "/") {
+		if seg == "" || seg == "." || seg == ".." || strings.Contains(seg, `\`) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,184 @@
+package blobstore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLocal(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  []byte
+		wantErr bool
+	}{
+		{name: "ErrorNoSecret", secret: nil, wantErr: true},
+		{name: "Success", secret: []byte("secret"), wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewLocal(t.TempDir(), "http://localhost/blobs", tt.secret)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestLocal_Put(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		body    string
+		size    int64
+		wantErr error
+	}{
+		{name: "ErrorInvalidKey", key: "todos/../../etc/passwd", body: "x", size: 1, wantErr: ErrInvalidKey},
+		{name: "ErrorSizeMismatch", key: "todos/1/a.txt", body: "hello", size: 3, wantErr: ErrSizeMismatch},
+		{name: "Success", key: "todos/1/a.txt", body: "hello", size: 5, wantErr: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			l, err := NewLocal(dir, "http://localhost/blobs", []byte("secret"))
+			require.NoError(t, err)
+
+			err = l.Put(context.Background(), tt.key, strings.NewReader(tt.body), tt.size, "text/plain")
+			assert.Equal(t, tt.wantErr, err)
+
+			entries, _ := filepath.Glob(filepath.Join(dir, "todos", "1", "*"))
+			if tt.wantErr != nil {
+				assert.Empty(t, entries)
+
+				return
+			}
+
+			data, err := os.ReadFile(filepath.Join(dir, "todos", "1", "a.txt"))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.body, string(data))
+			assert.Len(t, entries, 1)
+		})
+	}
+}
+
+func TestLocal_Delete(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewLocal(dir, "http://localhost/blobs", []byte("secret"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	assert.NoError(t, l.Put(ctx, "a.txt", strings.NewReader("a"), 1, "text/plain"))
+	assert.NoError(t, l.Delete(ctx, "a.txt"))
+	assert.NoFileExists(t, filepath.Join(dir, "a.txt"))
+
+	assert.NoError(t, l.Delete(ctx, "a.txt"))
+	assert.Equal(t, ErrInvalidKey, l.Delete(ctx, "/a.txt"))
+}
+
+func TestLocal_SignedURL(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	l, err := NewLocal(t.TempDir(), "http://localhost:8080/blobs/", []byte("secret"))
+	require.NoError(t, err)
+	l.now = func() time.Time { return now }
+
+	got, err := l.SignedURL(context.Background(), "todos/1/my file.txt", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/blobs/todos/1/my%20file.txt?expires=1735787105&signature="+
+		l.sign("todos/1/my file.txt", now.Add(time.Minute).Unix()), got)
+
+	_, err = l.SignedURL(context.Background(), "", time.Minute)
+	assert.Equal(t, ErrInvalidKey, err)
+}
+
+func TestLocal_Handler(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	l, err := NewLocal(t.TempDir(), "http://localhost/blobs", []byte("secret"))
+	require.NoError(t, err)
+	l.now = func() time.Time { return now }
+	err = l.Put(context.Background(), "todos/1/page.html", strings.NewReader("<h1>"), 4, "text/html")
+	assert.NoError(t, err)
+
+	signed := func(key string) string {
+		link, err := l.SignedURL(context.Background(), key, time.Minute)
+		assert.NoError(t, err)
+
+		u, _ := url.Parse(link)
+
+		return u.RequestURI()
+	}
+
+	tests := []struct {
+		name       string
+		target     func() string
+		now        time.Time
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "ErrorInvalidKey",
+			target:     func() string { return "/blobs/todos//page.html" },
+			now:        now,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "ErrorMissingSignature",
+			target:     func() string { return "/blobs/todos/1/page.html" },
+			now:        now,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "ErrorSignatureOfOtherKey",
+			target: func() string {
+				return "/blobs/todos/1/other.html?" + strings.SplitN(signed("todos/1/page.html"), "?", 2)[1]
+			},
+			now:        now,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "ErrorExpired",
+			target:     func() string { return signed("todos/1/page.html") },
+			now:        now.Add(2 * time.Minute),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "ErrorNotFound",
+			target:     func() string { return signed("todos/1/gone.html") },
+			now:        now,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Success",
+			target:     func() string { return signed("todos/1/page.html") },
+			now:        now,
+			wantStatus: http.StatusOK,
+			wantBody:   "<h1>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := tt.target()
+			l.now = func() time.Time { return tt.now }
+			defer func() { l.now = func() time.Time { return now } }()
+
+			rec := httptest.NewRecorder()
+			l.Handler("/blobs/").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantBody, rec.Body.String())
+				assert.Equal(t, `attachment; filename=page.html`, rec.Header().Get("Content-Disposition"))
+				assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+			}
+		})
+	}
+}
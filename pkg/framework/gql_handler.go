@@ -1,11 +1,15 @@
 package framework
 
 import (
+	"context"
+
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/shandysiswandi/gostarter/internal/lib"
 	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // GQlOption represents a functional option for configuring the GQLConfig.
@@ -14,6 +18,7 @@ type GQlOption func(*GQLConfig)
 // GQLConfig holds configuration options for a GraphQL server.
 type GQLConfig struct {
 	introspection bool // Enables or disables schema introspection.
+	websocket     bool // Enables or disables subscriptions over websocket.
 }
 
 // WithIntrospection returns a GQlOption that enables introspection for the GraphQL server.
@@ -24,6 +29,15 @@ func WithIntrospection() GQlOption {
 	}
 }
 
+// WithWebsocket returns a GQlOption that serves operations, subscriptions included,
+// over websocket with the graphql-ws and graphql-transport-ws protocols. The
+// connection is authenticated by the `Authorization` field of its init payload.
+func WithWebsocket() GQlOption {
+	return func(c *GQLConfig) {
+		c.websocket = true
+	}
+}
+
 // HandlerGQL initializes a new GraphQL server handler with the provided executable schema
 // and optional configuration options. The handler is configured with default transports
 // and extensions, including support for persisted queries and optional introspection.
@@ -39,6 +53,11 @@ func HandlerGQL(es graphql.ExecutableSchema, opts ...GQlOption) *handler.Server
 	// Configure the server.
 	srv.AddTransport(transportPOST{})                    // Add support for POST requests.
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000)) // Cache query documents.
+	srv.SetErrorPresenter(presentError)                  // Quote the request ID in the errors.
+
+	if cfg.websocket {
+		srv.AddTransport(transportWebsocket()) // Add support for websocket connections.
+	}
 
 	if cfg.introspection {
 		srv.Use(extension.Introspection{}) // Enable schema introspection if configured.
@@ -48,3 +67,17 @@ func HandlerGQL(es graphql.ExecutableSchema, opts ...GQlOption) *handler.Server
 
 	return srv
 }
+
+// presentError adds the ID of the request to the extensions of every error, so
+// a client can quote it, see RequestID.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if id := lib.GetRequestID(ctx); id != "" {
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = make(map[string]any)
+		}
+		gqlErr.Extensions["request_id"] = id
+	}
+
+	return gqlErr
+}
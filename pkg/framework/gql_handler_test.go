@@ -1,11 +1,14 @@
 package framework
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestWithIntrospection(t *testing.T) {
@@ -44,3 +47,26 @@ func TestHandlerGQL(t *testing.T) {
 		})
 	}
 }
+
+func Test_presentError(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want map[string]any
+	}{
+		{name: "NoRequestID", ctx: context.Background(), want: nil},
+		{
+			name: "RequestID",
+			ctx:  lib.SetRequestID(context.Background(), "req-1"),
+			want: map[string]any{"request_id": "req-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := presentError(tt.ctx, assert.AnError)
+			assert.Equal(t, assert.AnError.Error(), got.Message)
+			assert.Equal(t, tt.want, got.Extensions)
+		})
+	}
+}
@@ -48,6 +48,13 @@ func (transportPOST) Do(w http.ResponseWriter, r *http.Request, exec graphql.Gra
 	dec := json.NewDecoder(r.Body)
 	dec.UseNumber()
 	if err := dec.Decode(params); err != nil {
+		if cfg, ok := problemEnabled(r); ok {
+			writeProblem(w, newProblem(r, cfg, http.StatusBadRequest, goerror.CodeInvalidFormat,
+				"Request payload malformed"))
+
+			return
+		}
+
 		data := exec.DispatchError(ctx, gqlerror.List{gqlerror.Errorf("Request payload malformed")})
 		writeJSON(w, data, http.StatusBadRequest)
 
@@ -56,6 +63,13 @@ func (transportPOST) Do(w http.ResponseWriter, r *http.Request, exec graphql.Gra
 
 	rc, err := exec.CreateOperationContext(ctx, params)
 	if err != nil {
+		if cfg, ok := problemEnabled(r); ok {
+			writeProblem(w, newProblem(r, cfg, http.StatusUnprocessableEntity, goerror.CodeInvalidInput,
+				err.Error()))
+
+			return
+		}
+
 		data := exec.DispatchError(graphql.WithOperationContext(ctx, rc), err)
 		writeJSON(w, data, http.StatusUnprocessableEntity)
 
@@ -69,7 +83,18 @@ func (transportPOST) Do(w http.ResponseWriter, r *http.Request, exec graphql.Gra
 	var goErr *goerror.GoError
 	if resp.Errors.As(&goErr) {
 		code = goErr.StatusCode()
+
+		// a failed operation without data has nothing a GraphQL client could still use
+		if cfg, ok := problemEnabled(r); ok && isNullData(resp.Data) {
+			writeProblem(w, problemFromError(r, cfg, goErr))
+
+			return
+		}
 	}
 
 	writeJSON(w, resp, code)
 }
+
+func isNullData(data json.RawMessage) bool {
+	return len(data) == 0 || string(data) == "null"
+}
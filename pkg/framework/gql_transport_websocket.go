@@ -0,0 +1,46 @@
+package framework
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+)
+
+// transportWebsocket returns the gqlgen websocket transport, it speaks both
+// graphql-ws and graphql-transport-ws. Any origin is accepted because the
+// connection is authenticated by a token in its init payload, not by cookies.
+func transportWebsocket() transport.Websocket {
+	return transport.Websocket{
+		Upgrader: websocket.Upgrader{
+			CheckOrigin:     func(*http.Request) bool { return true },
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+		},
+		InitFunc:              websocketInitJWT,
+		InitTimeout:           10 * time.Second,
+		KeepAlivePingInterval: 10 * time.Second,
+	}
+}
+
+// websocketInitJWT authenticates a websocket connection the same way the JWT
+// middleware does for HTTP, but with the token sent in the init payload.
+func websocketInitJWT(ctx context.Context, payload transport.InitPayload) (
+	context.Context, *transport.InitPayload, error,
+) {
+	clm := lib.ExtractJWTClaim(strings.TrimPrefix(payload.Authorization(), "Bearer "))
+	if clm == nil {
+		return ctx, nil, goerror.NewBusiness("invalid token", goerror.CodeUnauthorized)
+	}
+
+	if err := clm.Validate(); err != nil {
+		return ctx, nil, goerror.NewBusiness("invalid validation token", goerror.CodeUnauthorized)
+	}
+
+	return lib.SetJWTClaim(ctx, clm), &payload, nil
+}
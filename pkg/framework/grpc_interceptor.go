@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -21,7 +22,7 @@ func UnaryServerRecovery(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
 ) {
 	defer func() {
 		if r := recover(); r != nil {
-			log.Printf("panic because: %v\n", r)
+			log.Printf("panic because: %v, request id: %s\n", r, lib.GetRequestID(ctx))
 			debug.PrintStack()
 
 			err = status.Error(codes.Internal, "Internal server error")
@@ -31,6 +32,41 @@ func UnaryServerRecovery(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
 	return next(ctx, req)
 }
 
+// UnaryServerRequestID gives every call an ID like the RequestID middleware
+// does, from the x-request-id metadata or gen. The ID is put in the context and
+// sent back in the x-request-id header metadata. Chain it before the recovery.
+func UnaryServerRequestID(gen func() string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+		id := grpcRequestID(ctx, gen)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(lib.HeaderRequestID, id)); err != nil {
+			log.Println("grpc.SetHeader(request id)", err)
+		}
+
+		return next(lib.SetRequestID(ctx, id), req)
+	}
+}
+
+// StreamServerRequestID is the stream counterpart of UnaryServerRequestID.
+func StreamServerRequestID(gen func() string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		id := grpcRequestID(ss.Context(), gen)
+		if err := ss.SetHeader(metadata.Pairs(lib.HeaderRequestID, id)); err != nil {
+			log.Println("ss.SetHeader(request id)", err)
+		}
+
+		return next(srv, &serverStream{ServerStream: ss, ctx: lib.SetRequestID(ss.Context(), id)})
+	}
+}
+
+func grpcRequestID(ctx context.Context, gen func() string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(lib.HeaderRequestID); len(ids) > 0 && lib.ValidRequestID(ids[0]) {
+		return ids[0]
+	}
+
+	return gen()
+}
+
 func UnaryServerError(ctx context.Context, req any, _ *grpc.UnaryServerInfo, next grpc.UnaryHandler) (
 	any, error,
 ) {
@@ -73,22 +109,35 @@ func UnaryServerJWT(audience string, skipMethods ...string) grpc.UnaryServerInte
 func doUnaryServerJWT(ctx context.Context, req any, next grpc.UnaryHandler,
 	audience string,
 ) (any, error) {
+	clm, err := grpcJWTClaim(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return next(lib.SetJWTClaim(ctx, clm), req)
+}
+
+func grpcJWTClaim(ctx context.Context) (*lib.JWTClaim, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, goerror.NewServerInternal(nil)
 	}
 
-	clm := lib.ExtractJWTClaim(strings.TrimPrefix(md["authorization"][0], "Bearer "))
+	auth := md.Get("authorization")
+	if len(auth) == 0 {
+		return nil, goerror.NewBusiness("invalid token", goerror.CodeUnauthorized)
+	}
+
+	clm := lib.ExtractJWTClaim(strings.TrimPrefix(auth[0], "Bearer "))
 	if clm == nil {
 		return nil, goerror.NewBusiness("invalid token", goerror.CodeUnauthorized)
 	}
 
 	if err := clm.Validate(); err != nil {
 		return nil, goerror.NewBusiness("invalid validation token", goerror.CodeUnauthorized)
-
 	}
 
-	return next(lib.SetJWTClaim(ctx, clm), req)
+	return clm, nil
 }
 
 func UnaryServerProtoValidate(validator validation.Validator) grpc.UnaryServerInterceptor {
@@ -100,3 +149,112 @@ func UnaryServerProtoValidate(validator validation.Validator) grpc.UnaryServerIn
 		return next(ctx, req)
 	}
 }
+
+// UnaryServerRateLimit is the gRPC counterpart of RateLimit, the class of a method
+// comes from cfg.Methods. The state of the limit is sent in the header metadata,
+// a call over the limit fails with ResourceExhausted.
+//
+// Clients are told apart by their token, so the interceptor should be placed after UnaryServerJWT.
+func UnaryServerRateLimit(cfg RateLimitConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+		var ip, apiKey string
+		if p, ok := peer.FromContext(ctx); ok {
+			ip = remoteIP(p.Addr.String())
+		}
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(HeaderAPIKey)) > 0 {
+			apiKey = md.Get(HeaderAPIKey)[0]
+		}
+		id := cfg.identity(ctx, ip, apiKey)
+
+		rate, res, ok := cfg.allow(ctx, methodClass(cfg.Methods, info.FullMethod), id)
+		if !ok {
+			return next(ctx, req)
+		}
+
+		if err := grpc.SetHeader(ctx, metadata.New(rateLimitHeaders(rate, res))); err != nil {
+			log.Print(err)
+		}
+
+		if !res.Allowed {
+			return nil, status.Error(codes.ResourceExhausted, "too many requests")
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// methodClass returns the class of the longest prefix of fullMethod in methods.
+func methodClass(methods map[string]string, fullMethod string) string {
+	class, longest := "", -1
+	for prefix, c := range methods {
+		if strings.HasPrefix(fullMethod, prefix) && len(prefix) > longest {
+			class, longest = c, len(prefix)
+		}
+	}
+
+	return class
+}
+
+// serverStream overrides the context of a grpc.ServerStream, the stream
+// counterpart of passing a new ctx to the next unary handler.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func StreamServerRecovery(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, next grpc.StreamHandler) (
+	err error,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("panic because: %v, request id: %s\n", r, lib.GetRequestID(ss.Context()))
+			debug.PrintStack()
+
+			err = status.Error(codes.Internal, "Internal server error")
+		}
+	}()
+
+	return next(srv, ss)
+}
+
+func StreamServerError(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+	err := next(srv, ss)
+	if err != nil {
+		var errs *goerror.GoError
+		if ok := errors.As(err, &errs); ok {
+			dataMD := make(metadata.MD)
+			dataMD.Set("error_code", errs.Code().String())
+			dataMD.Set("error_type", errs.Type().String())
+			dataMD.Set("error_message", errs.Msg())
+
+			ss.SetTrailer(dataMD)
+
+			return errs.GRPCStatus().Err()
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func StreamServerJWT(audience string, skipMethods ...string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		for _, prefix := range skipMethods {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return next(srv, ss)
+			}
+		}
+
+		clm, err := grpcJWTClaim(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return next(srv, &serverStream{ServerStream: ss, ctx: lib.SetJWTClaim(ss.Context(), clm)})
+	}
+}
@@ -2,11 +2,20 @@ package framework
 
 import (
 	"context"
+	"net"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 func TestUnaryServerRecovery(t *testing.T) {
@@ -136,3 +145,250 @@ func TestUnaryServerProtoValidate(t *testing.T) {
 		})
 	}
 }
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
+func (s *testServerStream) SetHeader(md metadata.MD) error {
+	s.header = md
+
+	return nil
+}
+
+func (s *testServerStream) SetTrailer(md metadata.MD) { s.trailer = md }
+
+func TestStreamServerRecovery(t *testing.T) {
+	tests := []struct {
+		name    string
+		next    grpc.StreamHandler
+		wantErr error
+	}{
+		{
+			name:    "Panic",
+			next:    func(any, grpc.ServerStream) error { panic("boom") },
+			wantErr: status.Error(codes.Internal, "Internal server error"),
+		},
+		{
+			name:    "Success",
+			next:    func(any, grpc.ServerStream) error { return nil },
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := StreamServerRecovery(nil, &testServerStream{ctx: context.Background()}, nil, tt.next)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestStreamServerError(t *testing.T) {
+	goErr := goerror.NewBusiness("not found", goerror.CodeNotFound)
+
+	tests := []struct {
+		name        string
+		next        grpc.StreamHandler
+		wantErr     error
+		wantTrailer metadata.MD
+	}{
+		{
+			name:    "ErrorGoError",
+			next:    func(any, grpc.ServerStream) error { return goErr },
+			wantErr: goErr.GRPCStatus().Err(),
+			wantTrailer: metadata.MD{
+				"error_code":    []string{goErr.Code().String()},
+				"error_type":    []string{goErr.Type().String()},
+				"error_message": []string{"not found"},
+			},
+		},
+		{
+			name:    "ErrorOther",
+			next:    func(any, grpc.ServerStream) error { return assert.AnError },
+			wantErr: assert.AnError,
+		},
+		{
+			name:    "Success",
+			next:    func(any, grpc.ServerStream) error { return nil },
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ss := &testServerStream{ctx: context.Background()}
+			err := StreamServerError(nil, ss, nil, tt.next)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantTrailer, ss.trailer)
+		})
+	}
+}
+
+func TestStreamServerJWT(t *testing.T) {
+	withAuth := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", token))
+	}
+
+	tests := []struct {
+		name      string
+		method    string
+		ctx       context.Context
+		wantErr   error
+		wantClaim *lib.JWTClaim
+	}{
+		{
+			name:    "SkipMethod",
+			method:  "/gostarter.api.auth.AuthService/Login",
+			ctx:     context.Background(),
+			wantErr: nil,
+		},
+		{
+			name:    "ErrorNoMetadata",
+			method:  "/gostarter.api.todo.TodoService/Watch",
+			ctx:     context.Background(),
+			wantErr: goerror.NewServerInternal(nil),
+		},
+		{
+			name:    "ErrorNoAuthorization",
+			method:  "/gostarter.api.todo.TodoService/Watch",
+			ctx:     metadata.NewIncomingContext(context.Background(), metadata.MD{}),
+			wantErr: goerror.NewBusiness("invalid token", goerror.CodeUnauthorized),
+		},
+		{
+			name:    "ErrorInvalidToken",
+			method:  "/gostarter.api.todo.TodoService/Watch",
+			ctx:     withAuth("Bearer token"),
+			wantErr: goerror.NewBusiness("invalid token", goerror.CodeUnauthorized),
+		},
+		{
+			name:      "Success",
+			method:    "/gostarter.api.todo.TodoService/Watch",
+			ctx:       withAuth("Bearer a.eyJhdXRoX2lkIjoiMTEifQ.a"),
+			wantErr:   nil,
+			wantClaim: &lib.JWTClaim{AuthID: 11},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotClaim *lib.JWTClaim
+			next := func(_ any, ss grpc.ServerStream) error {
+				gotClaim = lib.GetJWTClaim(ss.Context())
+
+				return nil
+			}
+
+			interceptor := StreamServerJWT("gostarter.access.token", "/gostarter.api.auth.AuthService")
+			err := interceptor(nil, &testServerStream{ctx: tt.ctx}, &grpc.StreamServerInfo{FullMethod: tt.method}, next)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantClaim, gotClaim)
+		})
+	}
+}
+
+func TestUnaryServerRequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want string
+	}{
+		{name: "Generated", md: nil, want: "generated"},
+		{name: "Invalid", md: metadata.Pairs(lib.HeaderRequestID, "bad id"), want: "generated"},
+		{name: "FromClient", md: metadata.Pairs(lib.HeaderRequestID, "client-id"), want: "client-id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			next := func(ctx context.Context, _ any) (any, error) {
+				got = lib.GetRequestID(ctx)
+
+				return "ok", nil
+			}
+
+			interceptor := UnaryServerRequestID(func() string { return "generated" })
+			_, err := interceptor(metadata.NewIncomingContext(context.Background(), tt.md), nil, nil, next)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStreamServerRequestID(t *testing.T) {
+	md := metadata.Pairs(lib.HeaderRequestID, "client-id")
+	ss := &testServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+
+	var got string
+	next := func(_ any, ss grpc.ServerStream) error {
+		got = lib.GetRequestID(ss.Context())
+
+		return nil
+	}
+
+	err := StreamServerRequestID(func() string { return "generated" })(nil, ss, nil, next)
+	assert.NoError(t, err)
+	assert.Equal(t, "client-id", got)
+	assert.Equal(t, []string{"client-id"}, ss.header.Get(lib.HeaderRequestID))
+}
+
+func TestUnaryServerRateLimit(t *testing.T) {
+	limiter := NewRateLimitMemory()
+	limiter.now = func() time.Time { return rateLimitNow }
+
+	interceptor := UnaryServerRateLimit(RateLimitConfig{
+		Limiter: limiter,
+		Rates: map[string]Rate{
+			DefaultRateClass: {Limit: 2, Window: time.Minute},
+			"auth":           {Limit: 1, Window: time.Minute},
+		},
+		Methods: map[string]string{"/gostarter.api.auth.AuthService": "auth"},
+		APIKey:  func(_ context.Context, key string) bool { return key == "secret" },
+	})
+	next := func(context.Context, any) (any, error) { return "ok", nil }
+
+	call := func(method, ip string, md metadata.MD) error {
+		addr := &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		ctx = metadata.NewIncomingContext(ctx, md)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, next)
+
+		return err
+	}
+
+	login := "/gostarter.api.auth.AuthService/Login"
+	assert.NoError(t, call(login, "192.0.2.1", nil))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(login, "192.0.2.1", nil)))
+
+	// an API key is counted on its own, whatever the address
+	apiKey := metadata.Pairs(HeaderAPIKey, "secret")
+	assert.NoError(t, call(login, "192.0.2.1", apiKey))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(login, "192.0.2.2", apiKey)))
+
+	// a key that is not accepted is counted by the address
+	forged := metadata.Pairs(HeaderAPIKey, "forged")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(login, "192.0.2.1", forged)))
+
+	todo := "/gostarter.api.todo.TodoService/Find"
+	assert.NoError(t, call(todo, "192.0.2.1", nil))
+	assert.NoError(t, call(todo, "192.0.2.1", nil))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(todo, "192.0.2.1", nil)))
+}
+
+func Test_methodClass(t *testing.T) {
+	methods := map[string]string{
+		"/gostarter.api.auth.AuthService":       "auth",
+		"/gostarter.api.auth.AuthService/Login": "login",
+	}
+
+	assert.Equal(t, "login", methodClass(methods, "/gostarter.api.auth.AuthService/Login"))
+	assert.Equal(t, "auth", methodClass(methods, "/gostarter.api.auth.AuthService/Register"))
+	assert.Equal(t, "", methodClass(methods, "/gostarter.api.todo.TodoService/Find"))
+}
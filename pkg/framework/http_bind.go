@@ -0,0 +1,381 @@
+package framework
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+)
+
+// defaultMaxBodySize is the largest request body Bind reads unless the router says otherwise.
+const defaultMaxBodySize = 1 << 20
+
+var (
+	errBindMalformed   = goerror.NewInvalidFormat("Request payload malformed")
+	errBindTooLarge    = goerror.NewInvalidFormat("Request payload too large")
+	errBindContentType = goerror.NewInvalidFormat("Request content type not supported")
+)
+
+// RouterOption represents a functional option for configuring the Router.
+type RouterOption func(*Router)
+
+// BindConfig holds how Bind reads and checks a request.
+type BindConfig struct {
+	validator       validation.Validator // Validates the bound value, skipped when nil.
+	maxBodySize     int64                // Largest body in bytes, no limit when zero or less.
+	disallowUnknown bool                 // Rejects body fields the target does not declare.
+}
+
+// WithValidator returns a RouterOption that validates every value bound by Bind.
+func WithValidator(v validation.Validator) RouterOption {
+	return func(r *Router) {
+		r.bind.validator = v
+	}
+}
+
+// WithMaxBodySize returns a RouterOption that limits the body read by Bind to
+// n bytes, zero or less lifts the limit.
+func WithMaxBodySize(n int64) RouterOption {
+	return func(r *Router) {
+		r.bind.maxBodySize = n
+	}
+}
+
+// WithDisallowUnknownFields returns a RouterOption that makes Bind reject JSON
+// and form fields the target struct does not declare.
+func WithDisallowUnknownFields() RouterOption {
+	return func(r *Router) {
+		r.bind.disallowUnknown = true
+	}
+}
+
+// FieldErrors maps a request field to what is wrong with it. Bind returns it
+// wrapped in a goerror, the error codec writes it as the `error` of the response.
+type FieldErrors map[string]string
+
+func (fe FieldErrors) Error() string {
+	return "invalid fields"
+}
+
+// Values returns the errors keyed by field, like the validator errors.
+func (fe FieldErrors) Values() map[string]string {
+	return fe
+}
+
+// Bind decodes the request into a new T, which must be a struct. The body is
+// read as JSON or as a form by its content type, then the query and the path
+// parameters are set on the fields tagged `query` and `param`, so a path
+// parameter wins over the body. Form fields are tagged `form`, JSON fields use
+// the usual `json` tag.
+//
+// A field that can not be decoded is reported in FieldErrors, then the value
+// is validated with the validator of the router, if any.
+//
+// Usage:
+//
+//	type request struct {
+//		ID    uint64 `param:"id"`
+//		Email string `json:"email" validate:"required,email"`
+//	}
+//
+//	req, err := framework.Bind[request](c)
+func Bind[T any](c Context) (T, error) {
+	var v T
+
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Kind() != reflect.Struct {
+		return v, goerror.NewServerInternal(errors.New("framework: Bind target must be a struct"))
+	}
+
+	cfg := bindConfigOf(c)
+	r := c.Request()
+	fieldErrs := FieldErrors{}
+
+	if err := bindBody(r, &v, cfg, fieldErrs); err != nil {
+		return v, err
+	}
+
+	bindValues(rv, "query", r.URL.Query(), fieldErrs)
+	bindValues(rv, "param", paramValues(r), fieldErrs)
+
+	if len(fieldErrs) > 0 {
+		return v, goerror.NewInvalidInput("Invalid request payload", fieldErrs)
+	}
+
+	if cfg.validator != nil {
+		if err := cfg.validator.Validate(v); err != nil {
+			return v, goerror.NewInvalidInput("Invalid request payload", err)
+		}
+	}
+
+	return v, nil
+}
+
+func bindConfigOf(c Context) BindConfig {
+	if rc, ok := c.(*RouterCtx); ok {
+		return rc.bind
+	}
+
+	return BindConfig{maxBodySize: defaultMaxBodySize}
+}
+
+func bindBody(r *http.Request, v any, cfg BindConfig, fieldErrs FieldErrors) error {
+	if r.Body == nil || r.Body == http.NoBody || r.ContentLength == 0 {
+		return nil
+	}
+
+	if cfg.maxBodySize > 0 {
+		r.Body = http.MaxBytesReader(nil, r.Body, cfg.maxBodySize)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil && r.Header.Get("Content-Type") != "" {
+		return errBindContentType
+	}
+
+	switch mediaType {
+	case "", "application/json":
+		return bindJSON(r.Body, v, cfg, fieldErrs)
+	case "application/x-www-form-urlencoded", "multipart/form-data":
+		if err := r.ParseMultipartForm(defaultMaxBodySize); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+			return bodyError(err)
+		}
+
+		rv := reflect.ValueOf(v).Elem()
+		if cfg.disallowUnknown {
+			known := tagNames(rv.Type(), "form")
+			for key := range r.PostForm {
+				if _, ok := known[key]; !ok {
+					fieldErrs[key] = "unknown field"
+				}
+			}
+		}
+
+		bindValues(rv, "form", r.PostForm, fieldErrs)
+
+		return nil
+	default:
+		return errBindContentType
+	}
+}
+
+func bindJSON(body io.Reader, v any, cfg BindConfig, fieldErrs FieldErrors) error {
+	dec := json.NewDecoder(body)
+	if cfg.disallowUnknown {
+		dec.DisallowUnknownFields()
+	}
+
+	err := dec.Decode(v)
+	if err == nil || errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		fieldErrs[typeErr.Field] = "must be " + kindName(typeErr.Type)
+
+		return nil
+	}
+
+	// the decoder has no typed error for an unknown field
+	if name, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		fieldErrs[strings.Trim(name, `"`)] = "unknown field"
+
+		return nil
+	}
+
+	return bodyError(err)
+}
+
+func bodyError(err error) error {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return errBindTooLarge
+	}
+
+	return errBindMalformed
+}
+
+func paramValues(r *http.Request) url.Values {
+	params := httprouter.ParamsFromContext(r.Context())
+	values := make(url.Values, len(params))
+	for _, p := range params {
+		values.Add(p.Key, p.Value)
+	}
+
+	return values
+}
+
+// bindValues sets the fields tagged with tag from values, the embedded structs
+// are walked as if their fields were declared on rv.
+func bindValues(rv reflect.Value, tag string, values url.Values, fieldErrs FieldErrors) {
+	rt := rv.Type()
+	for i := range rt.NumField() {
+		sf := rt.Field(i)
+		fv := rv.Field(i)
+
+		// like encoding/json, the exported fields of an unexported embedded struct are set too
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			bindValues(fv, tag, values, fieldErrs)
+
+			continue
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+
+		name := tagName(sf, tag)
+		if name == "" {
+			continue
+		}
+
+		vals, ok := values[name]
+		if !ok || len(vals) == 0 {
+			continue
+		}
+
+		if err := setField(fv, vals); err != nil {
+			fieldErrs[name] = "must be " + kindName(fv.Type())
+		}
+	}
+}
+
+func tagNames(rt reflect.Type, tag string) map[string]struct{} {
+	names := make(map[string]struct{})
+	for i := range rt.NumField() {
+		sf := rt.Field(i)
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			for name := range tagNames(sf.Type, tag) {
+				names[name] = struct{}{}
+			}
+
+			continue
+		}
+
+		if name := tagName(sf, tag); name != "" {
+			names[name] = struct{}{}
+		}
+	}
+
+	return names
+}
+
+func tagName(sf reflect.StructField, tag string) string {
+	name, _, _ := strings.Cut(sf.Tag.Get(tag), ",")
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
+func setField(fv reflect.Value, vals []string) error {
+	if fv.Kind() == reflect.Slice && !implementsText(fv) {
+		slice := reflect.MakeSlice(fv.Type(), len(vals), len(vals))
+		for i, val := range vals {
+			if err := setValue(slice.Index(i), val); err != nil {
+				return err
+			}
+		}
+		fv.Set(slice)
+
+		return nil
+	}
+
+	return setValue(fv, vals[0])
+}
+
+func implementsText(fv reflect.Value) bool {
+	_, ok := fv.Addr().Interface().(encoding.TextUnmarshaler)
+
+	return ok
+}
+
+func setValue(fv reflect.Value, val string) error {
+	if fv.Kind() == reflect.Pointer {
+		ptr := reflect.New(fv.Type().Elem())
+		if err := setValue(ptr.Elem(), val); err != nil {
+			return err
+		}
+		fv.Set(ptr)
+
+		return nil
+	}
+
+	if tu, ok := fv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(val))
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(val)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(val, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(val, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(val, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	default:
+		return errors.New("framework: unsupported field type " + fv.Type().String())
+	}
+
+	return nil
+}
+
+// kindName describes a type the way a client of a JSON API thinks of it.
+func kindName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Implements(reflect.TypeFor[encoding.TextUnmarshaler]()) ||
+		reflect.PointerTo(t).Implements(reflect.TypeFor[encoding.TextUnmarshaler]()) {
+		return "a valid " + strings.ToLower(t.Name())
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "an integer"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "a positive integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	case reflect.Struct, reflect.Map:
+		return "an object"
+	default:
+		return "a string"
+	}
+}
@@ -0,0 +1,253 @@
+package framework
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/stretchr/testify/assert"
+)
+
+type validatorFunc func(data any) error
+
+func (f validatorFunc) Validate(data any) error { return f(data) }
+
+type bindPage struct {
+	Limit int    `query:"limit"`
+	Sort  string `query:"sort"`
+}
+
+type bindRequest struct {
+	bindPage
+	ID      uint64     `param:"id"`
+	Name    string     `json:"name" form:"name"`
+	Age     int        `json:"age" form:"age"`
+	Active  *bool      `json:"active" form:"active"`
+	Tags    []string   `json:"tags" form:"tag" query:"tag"`
+	DueAt   *time.Time `json:"due_at" query:"due_at"`
+	Ignored string     `json:"-" query:"-"`
+}
+
+func TestBind(t *testing.T) {
+	active := true
+	dueAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	multipartBody := func() (io.Reader, string) {
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		_ = w.WriteField("name", "ann")
+		_ = w.WriteField("tag", "a")
+		_ = w.WriteField("tag", "b")
+		_ = w.Close()
+
+		return &body, w.FormDataContentType()
+	}
+
+	tests := []struct {
+		name    string
+		c       func() Context
+		want    bindRequest
+		wantErr error
+	}{
+		{
+			name: "ErrorContentType",
+			c: func() Context {
+				tc := NewTestContext(http.MethodPost, "/", strings.NewReader("name=ann"))
+				tc.SetHeader("Content-Type", "text/plain")
+
+				return tc.Build()
+			},
+			wantErr: errBindContentType,
+		},
+		{
+			name: "ErrorMalformed",
+			c: func() Context {
+				tc := NewTestContext(http.MethodPost, "/", strings.NewReader(`{"name":`))
+				tc.SetHeader("Content-Type", "application/json")
+
+				return tc.Build()
+			},
+			wantErr: errBindMalformed,
+		},
+		{
+			name: "ErrorTooLarge",
+			c: func() Context {
+				body := `{"name":"` + strings.Repeat("a", 64) + `"}`
+				tc := NewTestContext(http.MethodPost, "/", strings.NewReader(body))
+				tc.SetHeader("Content-Type", "application/json")
+				tc.SetRouterOptions(WithMaxBodySize(32))
+
+				return tc.Build()
+			},
+			wantErr: errBindTooLarge,
+		},
+		{
+			name: "ErrorJSONFieldType",
+			c: func() Context {
+				tc := NewTestContext(http.MethodPost, "/", strings.NewReader(`{"name":"ann","age":"ten"}`))
+				tc.SetHeader("Content-Type", "application/json")
+
+				return tc.Build()
+			},
+			want: bindRequest{Name: "ann"},
+			wantErr: goerror.NewInvalidInput("Invalid request payload",
+				FieldErrors{"age": "must be an integer"}),
+		},
+		{
+			name: "ErrorJSONUnknownField",
+			c: func() Context {
+				tc := NewTestContext(http.MethodPost, "/", strings.NewReader(`{"name":"ann","role":"admin"}`))
+				tc.SetRouterOptions(WithDisallowUnknownFields())
+
+				return tc.Build()
+			},
+			want: bindRequest{Name: "ann"},
+			wantErr: goerror.NewInvalidInput("Invalid request payload",
+				FieldErrors{"role": "unknown field"}),
+		},
+		{
+			name: "ErrorFormUnknownField",
+			c: func() Context {
+				tc := NewTestContext(http.MethodPost, "/", strings.NewReader("name=ann&role=admin"))
+				tc.SetHeader("Content-Type", "application/x-www-form-urlencoded")
+				tc.SetRouterOptions(WithDisallowUnknownFields())
+
+				return tc.Build()
+			},
+			want: bindRequest{Name: "ann"},
+			wantErr: goerror.NewInvalidInput("Invalid request payload",
+				FieldErrors{"role": "unknown field"}),
+		},
+		{
+			name: "ErrorQueryAndParam",
+			c: func() Context {
+				tc := NewTestContext(http.MethodGet, "/", nil)
+				tc.SetQuery("limit", "many")
+				tc.SetQuery("due_at", "tomorrow")
+				tc.SetParam("id", "-1")
+
+				return tc.Build()
+			},
+			wantErr: goerror.NewInvalidInput("Invalid request payload", FieldErrors{
+				"limit":  "must be an integer",
+				"due_at": "must be a valid time",
+				"id":     "must be a positive integer",
+			}),
+		},
+		{
+			name: "ErrorValidate",
+			c: func() Context {
+				tc := NewTestContext(http.MethodPost, "/", strings.NewReader(`{"name":"ann"}`))
+				tc.SetRouterOptions(WithValidator(validatorFunc(func(any) error { return assert.AnError })))
+
+				return tc.Build()
+			},
+			want:    bindRequest{Name: "ann"},
+			wantErr: goerror.NewInvalidInput("Invalid request payload", assert.AnError),
+		},
+		{
+			name: "SuccessJSON",
+			c: func() Context {
+				body := `{"name":"ann","age":30,"active":true,"tags":["a"],"due_at":"2025-01-02T03:04:05Z"}`
+				tc := NewTestContext(http.MethodPost, "/", strings.NewReader(body))
+				tc.SetHeader("Content-Type", "application/json; charset=utf-8")
+				tc.SetQuery("limit", "10")
+				tc.SetQuery("sort", "name")
+				tc.SetParam("id", "7")
+				tc.SetRouterOptions(WithValidator(validatorFunc(func(any) error { return nil })))
+
+				return tc.Build()
+			},
+			want: bindRequest{
+				bindPage: bindPage{Limit: 10, Sort: "name"},
+				ID:       7,
+				Name:     "ann",
+				Age:      30,
+				Active:   &active,
+				Tags:     []string{"a"},
+				DueAt:    &dueAt,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "SuccessEmptyBody",
+			c: func() Context {
+				tc := NewTestContext(http.MethodGet, "/", nil)
+				tc.SetQuery("tag", "a", "b")
+				tc.SetQuery("due_at", "2025-01-02T03:04:05Z")
+
+				return tc.Build()
+			},
+			want:    bindRequest{Tags: []string{"a", "b"}, DueAt: &dueAt},
+			wantErr: nil,
+		},
+		{
+			name: "SuccessForm",
+			c: func() Context {
+				form := url.Values{"name": {"ann"}, "age": {"30"}, "active": {"true"}}
+				tc := NewTestContext(http.MethodPost, "/", strings.NewReader(form.Encode()))
+				tc.SetHeader("Content-Type", "application/x-www-form-urlencoded")
+
+				return tc.Build()
+			},
+			want:    bindRequest{Name: "ann", Age: 30, Active: &active},
+			wantErr: nil,
+		},
+		{
+			name: "SuccessMultipart",
+			c: func() Context {
+				body, contentType := multipartBody()
+				tc := NewTestContext(http.MethodPost, "/", body)
+				tc.SetHeader("Content-Type", contentType)
+				tc.SetRouterOptions(WithDisallowUnknownFields())
+
+				return tc.Build()
+			},
+			want:    bindRequest{Name: "ann", Tags: []string{"a", "b"}},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Bind[bindRequest](tt.c())
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBind_NotStruct(t *testing.T) {
+	_, err := Bind[string](NewTestContext(http.MethodGet, "/", nil).Build())
+
+	var gerr *goerror.GoError
+	assert.True(t, errors.As(err, &gerr))
+	assert.Equal(t, goerror.CodeInternal, gerr.Code())
+}
+
+func TestRouter_EndpointBindErrors(t *testing.T) {
+	r := NewRouter(WithDisallowUnknownFields())
+	r.Endpoint(http.MethodPost, "/users/:id", func(c Context) (any, error) {
+		return Bind[bindRequest](c)
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/1", strings.NewReader(`{"age":"ten","role":"x"}`))
+	r.ServeHTTP(rec, req)
+
+	var resp errorResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, errorResponse{
+		Message: "Invalid request payload",
+		Error:   map[string]string{"age": "must be an integer"},
+	}, resp)
+}
@@ -0,0 +1,233 @@
+package framework
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shandysiswandi/goreng/codec"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	MediaTypeJSON     = "application/json"
+	MediaTypeXML      = "application/xml"
+	MediaTypeMsgPack  = "application/msgpack"
+	MediaTypeProtobuf = "application/x-protobuf"
+)
+
+// mediaCodec is a codec registered on the router for one media type.
+type mediaCodec struct {
+	mediaType   string // without parameters, matched against the Accept header
+	contentType string // written as the Content-Type of the response
+	codec       codec.Codec
+}
+
+// WithCodec returns a RouterOption that encodes the responses with c when the
+// Accept header of the request prefers mediaType. The media type may carry
+// parameters, like a charset, they are kept in the Content-Type of the
+// response. Registering a media type again replaces its codec.
+//
+// A codec returns an error wrapping errors.ErrUnsupported for a value it can
+// not represent, the router then tries the next media type the client accepts.
+func WithCodec(mediaType string, c codec.Codec) RouterOption {
+	return func(r *Router) {
+		base, _, err := mime.ParseMediaType(mediaType)
+		if err != nil {
+			panic("framework: invalid media type " + mediaType)
+		}
+
+		mc := mediaCodec{mediaType: base, contentType: mediaType, codec: c}
+		for i := range r.codecs {
+			if r.codecs[i].mediaType == base {
+				r.codecs[i] = mc
+
+				return
+			}
+		}
+
+		r.codecs = append(r.codecs, mc)
+	}
+}
+
+// jsonCodec is the codec of the router for application/json, it writes the
+// same bytes as json.Encoder.
+type jsonCodec struct{}
+
+func (jsonCodec) Encode(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (jsonCodec) Decode(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+type xmlCodec struct{}
+
+// NewXMLCodec returns a codec for application/xml. A value encoding/xml can not
+// marshal, like a map, is reported as unsupported.
+func NewXMLCodec() codec.Codec {
+	return xmlCodec{}
+}
+
+func (xmlCodec) Encode(v any) ([]byte, error) {
+	data, err := xml.Marshal(v)
+
+	var unsupported *xml.UnsupportedTypeError
+	if errors.As(err, &unsupported) {
+		return nil, fmt.Errorf("%w: %w", errors.ErrUnsupported, err)
+	}
+
+	return data, err
+}
+
+func (xmlCodec) Decode(data []byte, v any) error {
+	return xml.Unmarshal(data, v)
+}
+
+type protoCodec struct{}
+
+// NewProtoCodec returns a codec for application/x-protobuf. Only a response
+// whose data is a proto.Message is encoded, without the message envelope, an
+// error is encoded as a google.rpc.Status.
+func NewProtoCodec() codec.Codec {
+	return protoCodec{}
+}
+
+func (protoCodec) Encode(v any) ([]byte, error) {
+	switch v := v.(type) {
+	case resultResponse:
+		if m, ok := v.Data.(proto.Message); ok {
+			return proto.Marshal(m)
+		}
+	case errorResponse:
+		if v.status != nil {
+			return proto.Marshal(v.status.Proto())
+		}
+	case proto.Message:
+		return proto.Marshal(v)
+	}
+
+	return nil, fmt.Errorf("%w: %T is not a proto message", errors.ErrUnsupported, v)
+}
+
+func (protoCodec) Decode(data []byte, v any) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%w: %T is not a proto message", errors.ErrUnsupported, v)
+	}
+
+	return proto.Unmarshal(data, m)
+}
+
+// acceptRange is one media range of an Accept header.
+type acceptRange struct {
+	typ, subtype string
+	q            float64
+}
+
+func (ar acceptRange) specificity() int {
+	switch {
+	case ar.typ == "*":
+		return 0
+	case ar.subtype == "*":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// matches reports whether ar accepts mediaType. A client asking for problem+json
+// reads JSON, so the range also accepts a JSON response when the request succeeds.
+func (ar acceptRange) matches(mediaType string) bool {
+	if ar.typ+"/"+ar.subtype == MediaTypeProblem {
+		return mediaType == MediaTypeJSON
+	}
+
+	typ, subtype, _ := strings.Cut(mediaType, "/")
+
+	return (ar.typ == "*" || ar.typ == typ) && (ar.subtype == "*" || ar.subtype == subtype)
+}
+
+func parseAccept(header string) []acceptRange {
+	if strings.TrimSpace(header) == "" {
+		return []acceptRange{{typ: "*", subtype: "*", q: 1}}
+	}
+
+	var ranges []acceptRange
+	for _, part := range strings.Split(header, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		typ, subtype, ok := strings.Cut(mediaType, "/")
+		if !ok || (typ == "*" && subtype != "*") {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil || q < 0 || q > 1 {
+				continue
+			}
+		}
+
+		ranges = append(ranges, acceptRange{typ: typ, subtype: subtype, q: q})
+	}
+
+	return ranges
+}
+
+// negotiate returns the codecs the Accept header allows, the preferred first.
+// Every codec takes the quality of the most specific range matching it, on a
+// tie the codec named more precisely and then the one registered first wins.
+func negotiate(codecs []mediaCodec, accept string) []mediaCodec {
+	ranges := parseAccept(accept)
+
+	type candidate struct {
+		mc          mediaCodec
+		q           float64
+		specificity int
+	}
+
+	var candidates []candidate
+	for _, mc := range codecs {
+		best := candidate{mc: mc, specificity: -1}
+		for _, ar := range ranges {
+			if ar.matches(mc.mediaType) && ar.specificity() > best.specificity {
+				best.q, best.specificity = ar.q, ar.specificity()
+			}
+		}
+
+		if best.specificity >= 0 && best.q > 0 {
+			candidates = append(candidates, best)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].q != candidates[j].q {
+			return candidates[i].q > candidates[j].q
+		}
+
+		return candidates[i].specificity > candidates[j].specificity
+	})
+
+	accepted := make([]mediaCodec, 0, len(candidates))
+	for _, c := range candidates {
+		accepted = append(accepted, c.mc)
+	}
+
+	return accepted
+}
@@ -0,0 +1,268 @@
+package framework
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type codecUser struct {
+	ID   uint64 `json:"id,string"`
+	Name string `json:"name"`
+}
+
+func TestWithCodec(t *testing.T) {
+	r := NewRouter(
+		WithCodec(MediaTypeXML+"; charset=utf-8", NewXMLCodec()),
+		WithCodec(MediaTypeProtobuf, NewProtoCodec()),
+		WithCodec(MediaTypeXML, NewXMLCodec()),
+	)
+
+	assert.Equal(t, []mediaCodec{
+		{mediaType: MediaTypeJSON, contentType: "application/json; charset=utf-8", codec: jsonCodec{}},
+		{mediaType: MediaTypeXML, contentType: MediaTypeXML, codec: xmlCodec{}},
+		{mediaType: MediaTypeProtobuf, contentType: MediaTypeProtobuf, codec: protoCodec{}},
+	}, r.codecs)
+
+	assert.Panics(t, func() { NewRouter(WithCodec("application/", NewXMLCodec())) })
+}
+
+func Test_negotiate(t *testing.T) {
+	codecs := []mediaCodec{
+		{mediaType: MediaTypeJSON},
+		{mediaType: MediaTypeXML},
+		{mediaType: MediaTypeProtobuf},
+	}
+
+	tests := []struct {
+		name   string
+		accept string
+		want   []string
+	}{
+		{name: "Empty", accept: "", want: []string{MediaTypeJSON, MediaTypeXML, MediaTypeProtobuf}},
+		{name: "Any", accept: "*/*", want: []string{MediaTypeJSON, MediaTypeXML, MediaTypeProtobuf}},
+		{name: "Exact", accept: "application/xml", want: []string{MediaTypeXML}},
+		{name: "ExactBeforeAny", accept: "*/*, application/xml", want: []string{
+			MediaTypeXML, MediaTypeJSON, MediaTypeProtobuf,
+		}},
+		{name: "Quality", accept: "application/json;q=0.5, application/x-protobuf", want: []string{
+			MediaTypeProtobuf, MediaTypeJSON,
+		}},
+		{name: "Excluded", accept: "application/*, application/json;q=0", want: []string{
+			MediaTypeXML, MediaTypeProtobuf,
+		}},
+		{name: "Params", accept: "application/json; charset=utf-8", want: []string{MediaTypeJSON}},
+		{name: "Problem", accept: MediaTypeProblem, want: []string{MediaTypeJSON}},
+		{name: "Malformed", accept: "json, */json, application/xml;q=2", want: []string{}},
+		{name: "NoMatch", accept: "text/html", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := []string{}
+			for _, mc := range negotiate(codecs, tt.accept) {
+				got = append(got, mc.mediaType)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_xmlCodec(t *testing.T) {
+	c := NewXMLCodec()
+
+	data, err := c.Encode(resultResponse{Message: "ok", Data: codecUser{ID: 1, Name: "ann"}})
+	assert.NoError(t, err)
+	assert.Equal(t, `<response><message>ok</message><data><ID>1</ID><Name>ann</Name></data></response>`,
+		string(data))
+
+	data, err = c.Encode(errorResponse{Message: "bad", Error: fieldMessages{"b": "two", "a": "one"}})
+	assert.NoError(t, err)
+	assert.Equal(t, `<response><message>bad</message><error><field name="a">one</field>`+
+		`<field name="b">two</field></error></response>`, string(data))
+
+	_, err = c.Encode(map[string]string{"a": "b"})
+	assert.True(t, errors.Is(err, errors.ErrUnsupported))
+
+	var got codecUser
+	assert.NoError(t, c.Decode([]byte(`<codecUser><Name>ann</Name></codecUser>`), &got))
+	assert.Equal(t, codecUser{Name: "ann"}, got)
+}
+
+func Test_protoCodec(t *testing.T) {
+	c := NewProtoCodec()
+	msg := wrapperspb.String("ann")
+	want, _ := proto.Marshal(msg)
+
+	data, err := c.Encode(resultResponse{Message: "ok", Data: msg})
+	assert.NoError(t, err)
+	assert.Equal(t, want, data)
+
+	data, err = c.Encode(msg)
+	assert.NoError(t, err)
+	assert.Equal(t, want, data)
+
+	_, err = c.Encode(resultResponse{Data: codecUser{}})
+	assert.True(t, errors.Is(err, errors.ErrUnsupported))
+
+	_, err = c.Encode(errorResponse{Message: "bad"})
+	assert.True(t, errors.Is(err, errors.ErrUnsupported))
+
+	got := &wrapperspb.StringValue{}
+	assert.NoError(t, c.Decode(want, got))
+	assert.Equal(t, "ann", got.GetValue())
+	assert.True(t, errors.Is(c.Decode(want, &codecUser{}), errors.ErrUnsupported))
+}
+
+func TestRouter_EndpointNegotiation(t *testing.T) {
+	fieldErr := goerror.NewInvalidInput("Invalid request payload", FieldErrors{"age": "must be an integer"})
+	newRouter := func(called *bool, res any, err error) *Router {
+		r := NewRouter(
+			WithCodec(MediaTypeXML, NewXMLCodec()),
+			WithCodec(MediaTypeProtobuf, NewProtoCodec()),
+		)
+		r.Endpoint(http.MethodGet, "/users", func(Context) (any, error) {
+			*called = true
+
+			return res, err
+		})
+
+		return r
+	}
+
+	tests := []struct {
+		name            string
+		accept          string
+		res             any
+		err             error
+		wantCalled      bool
+		wantStatus      int
+		wantContentType string
+		wantBody        func() string
+	}{
+		{
+			name:            "DefaultJSON",
+			accept:          "",
+			res:             codecUser{ID: 1, Name: "ann"},
+			wantCalled:      true,
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json; charset=utf-8",
+			wantBody: func() string {
+				return `{"message":"Successfully","data":{"id":"1","name":"ann"}}` + "\n"
+			},
+		},
+		{
+			name:            "XML",
+			accept:          "application/xml, application/json;q=0.9",
+			res:             codecUser{ID: 1, Name: "ann"},
+			wantCalled:      true,
+			wantStatus:      http.StatusOK,
+			wantContentType: MediaTypeXML,
+			wantBody: func() string {
+				return `<response><message>Successfully</message>` +
+					`<data><ID>1</ID><Name>ann</Name></data></response>`
+			},
+		},
+		{
+			name:            "Protobuf",
+			accept:          "application/x-protobuf",
+			res:             wrapperspb.String("ann"),
+			wantCalled:      true,
+			wantStatus:      http.StatusOK,
+			wantContentType: MediaTypeProtobuf,
+			wantBody: func() string {
+				data, _ := proto.Marshal(wrapperspb.String("ann"))
+
+				return string(data)
+			},
+		},
+		{
+			name:            "ProtobufFallback",
+			accept:          "application/x-protobuf, application/json;q=0.1",
+			res:             codecUser{ID: 1, Name: "ann"},
+			wantCalled:      true,
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json; charset=utf-8",
+			wantBody: func() string {
+				return `{"message":"Successfully","data":{"id":"1","name":"ann"}}` + "\n"
+			},
+		},
+		{
+			name:            "ErrorNotAcceptableBeforeHandler",
+			accept:          "text/html",
+			res:             codecUser{ID: 1},
+			wantCalled:      false,
+			wantStatus:      http.StatusNotAcceptable,
+			wantContentType: "application/json; charset=utf-8",
+			wantBody: func() string {
+				return `{"message":"none of the accepted media types can be produced"}` + "\n"
+			},
+		},
+		{
+			name:            "ErrorNotAcceptableAfterHandler",
+			accept:          "application/x-protobuf",
+			res:             codecUser{ID: 1},
+			wantCalled:      true,
+			wantStatus:      http.StatusNotAcceptable,
+			wantContentType: "application/json; charset=utf-8",
+			wantBody: func() string {
+				return `{"message":"none of the accepted media types can be produced"}` + "\n"
+			},
+		},
+		{
+			name:            "ErrorXML",
+			accept:          "application/xml",
+			err:             fieldErr,
+			wantCalled:      true,
+			wantStatus:      fieldErr.StatusCode(),
+			wantContentType: MediaTypeXML,
+			wantBody: func() string {
+				return `<response><message>Invalid request payload</message>` +
+					`<error><field name="age">must be an integer</field></error></response>`
+			},
+		},
+		{
+			name:            "ErrorProtobuf",
+			accept:          "application/x-protobuf",
+			err:             assert.AnError,
+			wantCalled:      true,
+			wantStatus:      http.StatusInternalServerError,
+			wantContentType: MediaTypeProtobuf,
+			wantBody: func() string {
+				data, _ := proto.Marshal(status.New(codes.Internal, "Internal server error").Proto())
+
+				return string(data)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			called := false
+			r := newRouter(&called, tt.res, tt.err)
+
+			req := httptest.NewRequest(http.MethodGet, "/users", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCalled, called)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantContentType, rec.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantBody(), rec.Body.String())
+			if tt.wantCalled {
+				assert.Equal(t, "Accept", rec.Header().Get("Vary"))
+			}
+		})
+	}
+}
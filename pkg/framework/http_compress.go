@@ -0,0 +1,303 @@
+package framework
+
+import (
+	"compress/gzip"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// The content codings Compress can produce.
+const (
+	EncodingZstd = "zstd"
+	EncodingGzip = "gzip"
+)
+
+const defaultCompressMinSize = 1024
+
+// CompressConfig configures Compress.
+type CompressConfig struct {
+	// MinSize is the smallest body in bytes that is compressed, 1024 when zero.
+	// Smaller bodies would barely shrink and cost the client a decoder.
+	MinSize int
+	// Encodings are the codings offered, the first is preferred when the client
+	// weighs them the same. zstd and then gzip when empty.
+	Encodings []string
+}
+
+// encoder is what the gzip and zstd writers have in common.
+type encoder interface {
+	io.WriteCloser
+	Reset(w io.Writer)
+	Flush() error
+}
+
+var encoderPools = map[string]*sync.Pool{
+	EncodingZstd: {New: func() any {
+		enc, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1)) //nolint:errcheck // the options are valid
+
+		return enc
+	}},
+	EncodingGzip: {New: func() any {
+		return gzip.NewWriter(nil)
+	}},
+}
+
+// Compress returns a middleware that compresses the responses with the coding the
+// Accept-Encoding header of the request prefers. Only text, JSON and XML bodies of
+// at least MinSize bytes are compressed. Event streams, partial content, websocket
+// upgrades and HEAD requests are left as they are.
+//
+// A strong ETag of a compressed response is made weak, as the bytes it was
+// computed for are not the bytes sent. The middleware belongs to the server, after
+// RequestID and Recovery, so ETag on a route sees the body before it is compressed.
+func Compress(cfg CompressConfig) Middleware {
+	if cfg.MinSize <= 0 {
+		cfg.MinSize = defaultCompressMinSize
+	}
+
+	if len(cfg.Encodings) == 0 {
+		cfg.Encodings = []string{EncodingZstd, EncodingGzip}
+	}
+
+	for _, name := range cfg.Encodings {
+		if _, ok := encoderPools[name]; !ok {
+			panic("framework: unsupported encoding " + strconv.Quote(name))
+		}
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodHead || r.Header.Get("Upgrade") != "" {
+				h.ServeHTTP(w, r)
+
+				return
+			}
+
+			w.Header().Add("Vary", "Accept-Encoding")
+
+			encoding := negotiateEncoding(cfg.Encodings, r.Header.Get("Accept-Encoding"))
+			if encoding == "" {
+				h.ServeHTTP(w, r)
+
+				return
+			}
+
+			cw := &compressWriter{ResponseWriter: w, encoding: encoding, minSize: cfg.MinSize}
+			defer cw.close()
+
+			h.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// negotiateEncoding returns the offered coding the Accept-Encoding header weighs
+// the most, or an empty string when it accepts none of them.
+func negotiateEncoding(offered []string, header string) string {
+	weights := make(map[string]float64)
+	for _, part := range strings.Split(header, ",") {
+		coding, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil || q < 0 || q > 1 {
+				continue
+			}
+		}
+
+		weights[coding] = q
+	}
+
+	best, bestQ := "", 0.0
+	for _, name := range offered {
+		q, ok := weights[name]
+		if !ok {
+			q = weights["*"]
+		}
+
+		if q > bestQ {
+			best, bestQ = name, q
+		}
+	}
+
+	return best
+}
+
+// compressible reports whether a body of the content type is worth compressing.
+func compressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case mediaType == "text/event-stream":
+		return false
+	case strings.HasPrefix(mediaType, "text/"):
+		return true
+	case mediaType == "application/javascript":
+		return true
+	default:
+		return strings.HasSuffix(mediaType, "/json") || strings.HasSuffix(mediaType, "+json") ||
+			strings.HasSuffix(mediaType, "/xml") || strings.HasSuffix(mediaType, "+xml")
+	}
+}
+
+// compressWriter holds the body back until it reaches the minimum size, then it
+// sends the headers and compresses from there on. A body that ends or is flushed
+// before that is sent as it is.
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	minSize  int
+
+	code        int
+	buf         []byte
+	enc         encoder
+	wroteHeader bool // by the handler
+	passthrough bool // the headers are sent and the body is not compressed
+}
+
+func (cw *compressWriter) WriteHeader(code int) {
+	if cw.wroteHeader {
+		return
+	}
+
+	cw.wroteHeader = true
+	cw.code = code
+
+	hdr := cw.Header()
+	if code < http.StatusOK || code == http.StatusNoContent || code == http.StatusPartialContent ||
+		code == http.StatusNotModified || hdr.Get("Content-Encoding") != "" ||
+		!compressible(hdr.Get("Content-Type")) {
+		cw.sendPlain()
+
+		return
+	}
+
+	if n, err := strconv.Atoi(hdr.Get("Content-Length")); err == nil && n < cw.minSize {
+		cw.sendPlain()
+	}
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	if !cw.wroteHeader {
+		if cw.Header().Get("Content-Type") == "" {
+			cw.Header().Set("Content-Type", http.DetectContentType(p))
+		}
+
+		cw.WriteHeader(http.StatusOK)
+	}
+
+	switch {
+	case cw.passthrough:
+		return cw.ResponseWriter.Write(p)
+	case cw.enc != nil:
+		return cw.enc.Write(p)
+	}
+
+	cw.buf = append(cw.buf, p...)
+	if len(cw.buf) < cw.minSize {
+		return len(p), nil
+	}
+
+	if err := cw.startEncoder(); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Flush sends what is written so far, a body still under the minimum size is
+// sent without compression as the rest of it may never come.
+func (cw *compressWriter) Flush() {
+	switch {
+	case cw.enc != nil:
+		if err := cw.enc.Flush(); err != nil {
+			log.Println("compress flush", err)
+
+			return
+		}
+	case !cw.passthrough:
+		if !cw.wroteHeader {
+			cw.WriteHeader(http.StatusOK)
+		}
+
+		cw.sendPlain()
+	}
+
+	if err := http.NewResponseController(cw.ResponseWriter).Flush(); err != nil {
+		log.Println("compress flush", err)
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+func (cw *compressWriter) startEncoder() error {
+	hdr := cw.Header()
+	hdr.Set("Content-Encoding", cw.encoding)
+	hdr.Del("Content-Length")
+	if etag := hdr.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		hdr.Set("ETag", "W/"+etag)
+	}
+
+	cw.ResponseWriter.WriteHeader(cw.code)
+
+	//nolint:errcheck,forcetypeassert // the pools only hold encoders
+	cw.enc = encoderPools[cw.encoding].Get().(encoder)
+	cw.enc.Reset(cw.ResponseWriter)
+
+	buf := cw.buf
+	cw.buf = nil
+	_, err := cw.enc.Write(buf)
+
+	return err
+}
+
+// sendPlain sends the headers and what is held back without compression.
+func (cw *compressWriter) sendPlain() {
+	if cw.passthrough {
+		return
+	}
+
+	cw.passthrough = true
+	cw.ResponseWriter.WriteHeader(cw.code)
+
+	if len(cw.buf) > 0 {
+		if _, err := cw.ResponseWriter.Write(cw.buf); err != nil {
+			log.Println("w.Write(buf)", err)
+		}
+		cw.buf = nil
+	}
+}
+
+// close finishes the response once the handler returned.
+func (cw *compressWriter) close() {
+	if cw.enc != nil {
+		if err := cw.enc.Close(); err != nil {
+			log.Println("compress close", err)
+		}
+
+		cw.enc.Reset(nil)
+		encoderPools[cw.encoding].Put(cw.enc)
+		cw.enc = nil
+
+		return
+	}
+
+	if cw.wroteHeader {
+		cw.sendPlain()
+	}
+}
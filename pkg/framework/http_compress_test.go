@@ -0,0 +1,175 @@
+package framework
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_negotiateEncoding(t *testing.T) {
+	offered := []string{EncodingZstd, EncodingGzip}
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{name: "Empty", header: "", want: ""},
+		{name: "Identity", header: "identity", want: ""},
+		{name: "Gzip", header: "gzip, deflate, br", want: EncodingGzip},
+		{name: "PreferredOnTie", header: "gzip, zstd", want: EncodingZstd},
+		{name: "Weighted", header: "zstd;q=0.5, gzip;q=0.8", want: EncodingGzip},
+		{name: "Refused", header: "zstd;q=0, gzip;q=0", want: ""},
+		{name: "Wildcard", header: "*", want: EncodingZstd},
+		{name: "WildcardExcept", header: "*, zstd;q=0", want: EncodingGzip},
+		{name: "InvalidWeight", header: "zstd;q=2, gzip", want: EncodingGzip},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, negotiateEncoding(offered, tt.header))
+		})
+	}
+}
+
+func decompress(t *testing.T, encoding string, body []byte) string {
+	t.Helper()
+
+	var r io.Reader
+	switch encoding {
+	case EncodingGzip:
+		gr, err := gzip.NewReader(bytes.NewReader(body))
+		require.NoError(t, err)
+		r = gr
+	case EncodingZstd:
+		zr, err := zstd.NewReader(bytes.NewReader(body))
+		require.NoError(t, err)
+		defer zr.Close()
+		r = zr
+	default:
+		return string(body)
+	}
+
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return string(out)
+}
+
+func TestCompress(t *testing.T) {
+	large := `{"message":"` + strings.Repeat("a", 2048) + `"}`
+	tests := []struct {
+		name         string
+		method       string
+		accept       string
+		contentType  string
+		etag         string
+		code         int
+		body         string
+		wantEncoding string
+		wantETag     string
+	}{
+		{name: "Gzip", accept: "gzip", contentType: "application/json", body: large, wantEncoding: "gzip"},
+		{name: "Zstd", accept: "gzip, zstd", contentType: "application/json", body: large, wantEncoding: "zstd"},
+		{name: "NotAccepted", accept: "br", contentType: "application/json", body: large},
+		{name: "Small", accept: "gzip", contentType: "application/json", body: `{"message":"ok"}`},
+		{name: "Binary", accept: "gzip", contentType: "image/png", body: large},
+		{name: "Head", method: http.MethodHead, accept: "gzip", contentType: "text/plain", body: large},
+		{name: "Partial", accept: "gzip", contentType: "text/plain", code: http.StatusPartialContent, body: large},
+		{name: "Sniffed", accept: "gzip", body: strings.Repeat("text ", 300), wantEncoding: "gzip"},
+		{
+			name:         "WeakensETag",
+			accept:       "gzip",
+			contentType:  "application/problem+json",
+			etag:         `"v1"`,
+			body:         large,
+			wantEncoding: "gzip",
+			wantETag:     `W/"v1"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := Compress(CompressConfig{})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+				if tt.etag != "" {
+					w.Header().Set("ETag", tt.etag)
+				}
+				if tt.code != 0 {
+					w.WriteHeader(tt.code)
+				}
+				// written in pieces to cross the minimum size halfway
+				_, _ = io.WriteString(w, tt.body[:len(tt.body)/2])
+				_, _ = io.WriteString(w, tt.body[len(tt.body)/2:])
+			}))
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, "/", nil)
+			req.Header.Set("Accept-Encoding", tt.accept)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantEncoding, rec.Header().Get("Content-Encoding"))
+			assert.Equal(t, tt.body, decompress(t, tt.wantEncoding, rec.Body.Bytes()))
+			if tt.wantETag != "" {
+				assert.Equal(t, tt.wantETag, rec.Header().Get("ETag"))
+			}
+			if method == http.MethodGet {
+				assert.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+			}
+		})
+	}
+}
+
+func TestCompress_EventStream(t *testing.T) {
+	h := Compress(CompressConfig{MinSize: 1})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = io.WriteString(w, "data: {}\n\n")
+		w.(http.Flusher).Flush()
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/events", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, "data: {}\n\n", rec.Body.String())
+}
+
+func TestCompress_Flush(t *testing.T) {
+	h := Compress(CompressConfig{MinSize: 4})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = io.WriteString(w, "first chunk")
+		require.NoError(t, http.NewResponseController(w).Flush())
+		_, _ = io.WriteString(w, " and the rest")
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "zstd")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, EncodingZstd, rec.Header().Get("Content-Encoding"))
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, "first chunk and the rest", decompress(t, EncodingZstd, rec.Body.Bytes()))
+}
+
+func TestCompress_UnsupportedEncoding(t *testing.T) {
+	assert.PanicsWithValue(t, `framework: unsupported encoding "br"`, func() {
+		Compress(CompressConfig{Encodings: []string{"br"}})
+	})
+}
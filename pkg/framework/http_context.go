@@ -39,7 +39,8 @@ type Context interface {
 // It wraps an *http.Request and provides utility methods to access
 // its context, headers, body, query parameters, and route parameters.
 type RouterCtx struct {
-	r *http.Request
+	r    *http.Request
+	bind BindConfig
 }
 
 // Context returns the context.Context of the underlying HTTP request.
@@ -84,6 +85,7 @@ type TestCtx struct {
 	param   map[string]string
 	queries map[string][]string
 	headers map[string][]string
+	opts    []RouterOption
 	mu      sync.RWMutex
 }
 
@@ -124,6 +126,13 @@ func (tc *TestCtx) SetContext(ctx context.Context) {
 	tc.r = tc.r.WithContext(ctx)
 }
 
+// SetRouterOptions applies the router options, like WithValidator, to the built context.
+func (tc *TestCtx) SetRouterOptions(opts ...RouterOption) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.opts = append(tc.opts, opts...)
+}
+
 // Build constructs a RouterCtx instance from the configured TestDefault.
 // It sets the query parameters, headers, and route parameters on the HTTP request.
 func (tc *TestCtx) Build() *RouterCtx {
@@ -152,5 +161,8 @@ func (tc *TestCtx) Build() *RouterCtx {
 		})
 	}
 
-	return &RouterCtx{r: tc.r.WithContext(context.WithValue(tc.r.Context(), httprouter.ParamsKey, params))}
+	return &RouterCtx{
+		r:    tc.r.WithContext(context.WithValue(tc.r.Context(), httprouter.ParamsKey, params)),
+		bind: NewRouter(tc.opts...).bind,
+	}
 }
@@ -0,0 +1,84 @@
+package framework
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/rs/cors"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+)
+
+// CORSConfig configures CORS. The methods and headers are the defaults below when empty.
+type CORSConfig struct {
+	// AllowedOrigins are the origins allowed to call the server, "*" allows any
+	// and none is allowed when empty. An origin may have one wildcard, like
+	// https://*.example.com.
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	// MaxAge is how long a browser may cache the answer to a preflight request,
+	// the browser decides when zero.
+	MaxAge time.Duration
+}
+
+var (
+	// DefaultCORSMethods are the methods the routes of a Router are registered with.
+	DefaultCORSMethods = []string{
+		http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete,
+	}
+
+	// DefaultCORSHeaders are the request headers the framework reads.
+	DefaultCORSHeaders = []string{
+		"Accept", "Authorization", "Content-Type", "If-Modified-Since", "If-None-Match", "Last-Event-ID",
+		HeaderAPIKey, HeaderIdempotencyKey, lib.HeaderRequestID,
+	}
+
+	// DefaultCORSExposedHeaders are the response headers the framework writes that a
+	// browser hides from scripts unless told otherwise.
+	DefaultCORSExposedHeaders = []string{
+		"ETag", "Last-Modified", "Retry-After",
+		"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy",
+		HeaderIdempotentReplayed, lib.HeaderRequestID,
+	}
+)
+
+// ErrCORSCredentialsWithAnyOrigin is returned by CORS for credentials allowed from any
+// origin, which browsers refuse.
+var ErrCORSCredentialsWithAnyOrigin = errors.New("framework: cors credentials need explicit origins")
+
+// CORS returns a middleware answering the preflight requests and setting the
+// Access-Control headers of the cross-origin requests from the allowed origins.
+// It should come before JWT, a preflight request never carries a token.
+func CORS(cfg CORSConfig) (Middleware, error) {
+	if cfg.AllowCredentials && slices.Contains(cfg.AllowedOrigins, "*") {
+		return nil, ErrCORSCredentialsWithAnyOrigin
+	}
+
+	opts := cors.Options{
+		AllowedOrigins:   cfg.AllowedOrigins,
+		AllowedMethods:   orDefault(cfg.AllowedMethods, DefaultCORSMethods),
+		AllowedHeaders:   orDefault(cfg.AllowedHeaders, DefaultCORSHeaders),
+		ExposedHeaders:   orDefault(cfg.ExposedHeaders, DefaultCORSExposedHeaders),
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           int(cfg.MaxAge / time.Second),
+	}
+
+	// cors takes no origins as any origin
+	if len(cfg.AllowedOrigins) == 0 {
+		opts.AllowOriginFunc = func(string) bool { return false }
+	}
+
+	return cors.New(opts).Handler, nil
+}
+
+func orDefault(values, def []string) []string {
+	if len(values) == 0 {
+		return def
+	}
+
+	return values
+}
@@ -0,0 +1,126 @@
+package framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCORS(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       CORSConfig
+		method    string
+		header    http.Header
+		wantCode  int
+		wantAllow http.Header // of the headers starting with Access-Control
+	}{
+		{
+			name:      "NoOrigins",
+			cfg:       CORSConfig{},
+			header:    http.Header{"Origin": {"https://app.example.com"}},
+			wantCode:  http.StatusOK,
+			wantAllow: http.Header{},
+		},
+		{
+			name:     "AnyOrigin",
+			cfg:      CORSConfig{AllowedOrigins: []string{"*"}},
+			header:   http.Header{"Origin": {"https://app.example.com"}},
+			wantCode: http.StatusOK,
+			wantAllow: http.Header{
+				"Access-Control-Allow-Origin": {"*"},
+				"Access-Control-Expose-Headers": {"Etag, Last-Modified, Retry-After, Ratelimit-Limit, " +
+					"Ratelimit-Remaining, Ratelimit-Reset, Ratelimit-Policy, Idempotent-Replayed, X-Request-Id"},
+			},
+		},
+		{
+			name: "Credentials",
+			cfg: CORSConfig{
+				AllowedOrigins:   []string{"https://*.example.com"},
+				ExposedHeaders:   []string{"X-Request-ID"},
+				AllowCredentials: true,
+			},
+			header:   http.Header{"Origin": {"https://app.example.com"}},
+			wantCode: http.StatusOK,
+			wantAllow: http.Header{
+				"Access-Control-Allow-Origin":      {"https://app.example.com"},
+				"Access-Control-Allow-Credentials": {"true"},
+				"Access-Control-Expose-Headers":    {"X-Request-Id"},
+			},
+		},
+		{
+			name:      "OtherOrigin",
+			cfg:       CORSConfig{AllowedOrigins: []string{"https://app.example.com"}},
+			header:    http.Header{"Origin": {"https://evil.example.org"}},
+			wantCode:  http.StatusOK,
+			wantAllow: http.Header{},
+		},
+		{
+			name:   "Preflight",
+			cfg:    CORSConfig{AllowedOrigins: []string{"https://app.example.com"}, MaxAge: 10 * time.Minute},
+			method: http.MethodOptions,
+			header: http.Header{
+				"Origin":                         {"https://app.example.com"},
+				"Access-Control-Request-Method":  {http.MethodPatch},
+				"Access-Control-Request-Headers": {"authorization,idempotency-key"},
+			},
+			wantCode: http.StatusNoContent,
+			wantAllow: http.Header{
+				"Access-Control-Allow-Origin":  {"https://app.example.com"},
+				"Access-Control-Allow-Methods": {http.MethodPatch},
+				"Access-Control-Allow-Headers": {"authorization,idempotency-key"},
+				"Access-Control-Max-Age":       {"600"},
+			},
+		},
+		{
+			name:   "PreflightMethodRefused",
+			cfg:    CORSConfig{AllowedOrigins: []string{"*"}, AllowedMethods: []string{http.MethodGet}},
+			method: http.MethodOptions,
+			header: http.Header{
+				"Origin":                        {"https://app.example.com"},
+				"Access-Control-Request-Method": {http.MethodDelete},
+			},
+			wantCode:  http.StatusNoContent,
+			wantAllow: http.Header{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mw, err := CORS(tt.cfg)
+			require.NoError(t, err)
+			h := mw(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, "/", nil)
+			for name, values := range tt.header {
+				req.Header[name] = values
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			got := http.Header{}
+			for name, values := range rec.Header() {
+				if strings.HasPrefix(name, "Access-Control-") {
+					got[name] = values
+				}
+			}
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, tt.wantAllow, got)
+		})
+	}
+}
+
+func TestCORS_CredentialsWithAnyOrigin(t *testing.T) {
+	mw, err := CORS(CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true})
+	assert.Nil(t, mw)
+	assert.ErrorIs(t, err, ErrCORSCredentialsWithAnyOrigin)
+}
@@ -0,0 +1,174 @@
+package framework
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ETag returns a middleware that gives the 200 OK responses of GET and HEAD requests
+// a weak ETag computed from their body, unless the handler set one. A request whose
+// If-None-Match header has the tag is answered with 304 Not Modified and no body.
+//
+// Without If-None-Match, the If-Modified-Since header is compared with the
+// Last-Modified header of the response. An endpoint sets that one by returning
+// data with a LastModified() time.Time method.
+//
+// The whole body is held until the handler returns, so it is meant for endpoints
+// that find a single resource, not for large or streamed responses.
+func ETag() Middleware {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				h.ServeHTTP(w, r)
+
+				return
+			}
+
+			ew := &etagWriter{ResponseWriter: w, code: http.StatusOK}
+			h.ServeHTTP(ew, r)
+
+			if ew.passthrough {
+				return
+			}
+
+			if ew.code == http.StatusOK {
+				if w.Header().Get("ETag") == "" {
+					w.Header().Set("ETag", weakETag(ew.buf))
+				}
+
+				if notModified(r, w.Header()) {
+					writeNotModified(w)
+
+					return
+				}
+			}
+
+			ew.send()
+		})
+	}
+}
+
+// weakETag tags a body by its hash.
+func weakETag(body []byte) string {
+	sum := sha256.Sum256(body)
+
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// notModified reports whether the copy of the client is still current. If-None-Match
+// is compared weakly and wins over If-Modified-Since, as RFC 9110 asks.
+func notModified(r *http.Request, hdr http.Header) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		etag := strings.TrimPrefix(hdr.Get("ETag"), "W/")
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	ims, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+
+	lm, err := http.ParseTime(hdr.Get("Last-Modified"))
+	if err != nil {
+		return false
+	}
+
+	return !lm.After(ims)
+}
+
+// writeNotModified answers 304 with the headers describing the resource and
+// none of those describing a body.
+func writeNotModified(w http.ResponseWriter) {
+	hdr := w.Header()
+	hdr.Del("Content-Type")
+	hdr.Del("Content-Length")
+	hdr.Del("Content-Encoding")
+	w.WriteHeader(http.StatusNotModified)
+}
+
+// setLastModified sets the Last-Modified header when the data has a LastModified
+// method returning a time.
+func setLastModified(w http.ResponseWriter, data any) {
+	lm, ok := data.(interface {
+		LastModified() time.Time
+	})
+	if !ok || lm.LastModified().IsZero() {
+		return
+	}
+
+	w.Header().Set("Last-Modified", lm.LastModified().UTC().Format(http.TimeFormat))
+}
+
+// etagWriter holds the response back until the handler returns. A handler that
+// flushes gets its response sent as it is, without an ETag.
+type etagWriter struct {
+	http.ResponseWriter
+	code        int
+	buf         []byte
+	wroteHeader bool
+	passthrough bool
+}
+
+func (ew *etagWriter) WriteHeader(code int) {
+	if ew.wroteHeader {
+		return
+	}
+
+	ew.wroteHeader = true
+	ew.code = code
+}
+
+func (ew *etagWriter) Write(p []byte) (int, error) {
+	if ew.passthrough {
+		return ew.ResponseWriter.Write(p)
+	}
+
+	if !ew.wroteHeader {
+		ew.WriteHeader(http.StatusOK)
+	}
+
+	ew.buf = append(ew.buf, p...)
+
+	return len(p), nil
+}
+
+func (ew *etagWriter) Flush() {
+	ew.send()
+
+	if err := http.NewResponseController(ew.ResponseWriter).Flush(); err != nil {
+		log.Println("etag flush", err)
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (ew *etagWriter) Unwrap() http.ResponseWriter {
+	return ew.ResponseWriter
+}
+
+// send writes the held response as it is.
+func (ew *etagWriter) send() {
+	if ew.passthrough {
+		return
+	}
+
+	ew.passthrough = true
+	ew.ResponseWriter.WriteHeader(ew.code)
+
+	if len(ew.buf) > 0 {
+		if _, err := ew.ResponseWriter.Write(ew.buf); err != nil {
+			log.Println("w.Write(buf)", err)
+		}
+		ew.buf = nil
+	}
+}
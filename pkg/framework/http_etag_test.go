@@ -0,0 +1,164 @@
+package framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type etagItem struct {
+	Name    string    `json:"name"`
+	Updated time.Time `json:"-"`
+}
+
+func (i etagItem) LastModified() time.Time { return i.Updated }
+
+func TestETag(t *testing.T) {
+	updated := time.Date(2025, 3, 9, 7, 57, 25, 500, time.UTC)
+	r := NewRouter()
+	r.Endpoint(http.MethodGet, "/items/:id", func(c Context) (any, error) {
+		if c.Param("id") == "missing" {
+			return nil, assert.AnError
+		}
+
+		return etagItem{Name: c.Param("id"), Updated: updated}, nil
+	}, ETag())
+	r.Endpoint(http.MethodPost, "/items/:id", func(Context) (any, error) { return etagItem{}, nil }, ETag())
+
+	body := `{"message":"Successfully","data":{"name":"1"}}` + "\n"
+	etag := weakETag([]byte(body))
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		header   http.Header
+		wantCode int
+		wantETag string
+		wantBody string
+	}{
+		{
+			name:     "Tagged",
+			path:     "/items/1",
+			wantCode: http.StatusOK,
+			wantETag: etag,
+			wantBody: body,
+		},
+		{
+			name:     "IfNoneMatch",
+			path:     "/items/1",
+			header:   http.Header{"If-None-Match": {`W/"other", ` + etag}},
+			wantCode: http.StatusNotModified,
+			wantETag: etag,
+		},
+		{
+			name:     "IfNoneMatchStrong",
+			path:     "/items/1",
+			header:   http.Header{"If-None-Match": {etag[2:]}},
+			wantCode: http.StatusNotModified,
+			wantETag: etag,
+		},
+		{
+			name:     "IfNoneMatchChanged",
+			path:     "/items/1",
+			header:   http.Header{"If-None-Match": {`W/"other"`}},
+			wantCode: http.StatusOK,
+			wantETag: etag,
+			wantBody: body,
+		},
+		{
+			name:     "IfModifiedSince",
+			path:     "/items/1",
+			header:   http.Header{"If-Modified-Since": {updated.Format(http.TimeFormat)}},
+			wantCode: http.StatusNotModified,
+			wantETag: etag,
+		},
+		{
+			name:     "IfModifiedSinceEarlier",
+			path:     "/items/1",
+			header:   http.Header{"If-Modified-Since": {updated.Add(-time.Second).Format(http.TimeFormat)}},
+			wantCode: http.StatusOK,
+			wantETag: etag,
+			wantBody: body,
+		},
+		{
+			name: "IfNoneMatchWins",
+			path: "/items/1",
+			header: http.Header{
+				"If-None-Match":     {`W/"other"`},
+				"If-Modified-Since": {updated.Format(http.TimeFormat)},
+			},
+			wantCode: http.StatusOK,
+			wantETag: etag,
+			wantBody: body,
+		},
+		{
+			name:     "Error",
+			path:     "/items/missing",
+			header:   http.Header{"If-None-Match": {"*"}},
+			wantCode: http.StatusInternalServerError,
+			wantBody: `{"message":"Internal server error"}` + "\n",
+		},
+		{
+			name:     "NotGet",
+			method:   http.MethodPost,
+			path:     "/items/1",
+			header:   http.Header{"If-None-Match": {"*"}},
+			wantCode: http.StatusOK,
+			wantBody: `{"message":"Successfully","data":{"name":""}}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, tt.path, nil)
+			for name, values := range tt.header {
+				req.Header[name] = values
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, tt.wantETag, rec.Header().Get("ETag"))
+			assert.Equal(t, tt.wantBody, rec.Body.String())
+			if tt.wantCode == http.StatusNotModified {
+				assert.Empty(t, rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestETag_Compressed(t *testing.T) {
+	r := NewRouter()
+	r.Endpoint(http.MethodGet, "/items/:id", func(Context) (any, error) {
+		return etagItem{Name: string(make([]byte, 2048))}, nil
+	}, ETag())
+	h := Chain(r, Compress(CompressConfig{}))
+
+	req := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, EncodingGzip, rec.Header().Get("Content-Encoding"))
+	etag := rec.Header().Get("ETag")
+
+	// the tag is the one of the body before it was compressed, so it holds for any coding
+	req = httptest.NewRequest(http.MethodGet, "/items/1", nil)
+	req.Header.Set("Accept-Encoding", "zstd")
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.Empty(t, rec.Body.String())
+}
@@ -0,0 +1,152 @@
+package framework
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/shandysiswandi/goreng/goerror"
+)
+
+// RouteMeta describes a route to the middlewares of the router, which run once
+// the route is matched, like JWT letting the public routes through.
+type RouteMeta struct {
+	Public     bool   // Served without an access token.
+	Permission string // Required to call the route, none when empty.
+	RateLimit  string // Class of the rate limit, the default one when empty.
+}
+
+// Route is a route registered on the router.
+type Route struct {
+	Method string
+	Path   string
+	Meta   RouteMeta
+	Doc    *RouteDoc // Nil when the route is not documented, see Group.Doc.
+}
+
+type routeMetaKey struct{}
+
+// RouteMetaFrom returns the metadata of the route matched for the request. It is
+// only there for the middlewares of the router, see Router.Use, a middleware of
+// the server runs before any route is matched.
+func RouteMetaFrom(ctx context.Context) (RouteMeta, bool) {
+	meta, ok := ctx.Value(routeMetaKey{}).(RouteMeta)
+
+	return meta, ok
+}
+
+func withRouteMeta(meta RouteMeta, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), routeMetaKey{}, meta)))
+	})
+}
+
+// Group registers routes under a path prefix, wrapped in the middlewares of the
+// group and described by its metadata. A group is never changed once created,
+// Group, Public, Permission, RateLimit and Doc return a new one.
+//
+// Usage:
+//
+//	rbac := router.Group("/rbac", audit)
+//	rbac.Permission("role.read").Endpoint(http.MethodGet, "/roles", he.FetchRole)
+//	rbac.Permission("role.write").Endpoint(http.MethodPost, "/roles", he.CreateRole)
+type Group struct {
+	router *Router
+	prefix string
+	mws    []Middleware
+	meta   RouteMeta
+	doc    *RouteDoc
+}
+
+// Group returns a group of routes under prefix, mws wrap each of them after the
+// middlewares of the router.
+func (r *Router) Group(prefix string, mws ...Middleware) *Group {
+	return &Group{router: r, prefix: prefix, mws: mws}
+}
+
+// Group returns a group nested in g, its routes are under both prefixes and
+// wrapped in the middlewares of g first.
+func (g *Group) Group(prefix string, mws ...Middleware) *Group {
+	ng := g.clone()
+	ng.prefix += prefix
+	ng.mws = append(ng.mws, mws...)
+	ng.doc = nil
+
+	return ng
+}
+
+// Public returns a copy of g whose routes are served without an access token.
+func (g *Group) Public() *Group {
+	ng := g.clone()
+	ng.meta.Public = true
+
+	return ng
+}
+
+// Permission returns a copy of g whose routes require the permission.
+func (g *Group) Permission(permission string) *Group {
+	ng := g.clone()
+	ng.meta.Permission = permission
+
+	return ng
+}
+
+// RateLimit returns a copy of g whose routes are limited as the class says.
+func (g *Group) RateLimit(class string) *Group {
+	ng := g.clone()
+	ng.meta.RateLimit = class
+
+	return ng
+}
+
+// Endpoint registers h like Router.Endpoint, under the prefix of the group.
+func (g *Group) Endpoint(method, path string, h Handler, mws ...Middleware) {
+	g.Handler(method, path, Chain(g.router.endpoint(h), mws...))
+}
+
+// HandleFunc registers handler like Router.HandleFunc, under the prefix of the group.
+func (g *Group) HandleFunc(method, path string, handler http.HandlerFunc) {
+	g.Handler(method, path, handler)
+}
+
+// Handler registers handler like Router.Handler, under the prefix of the group.
+func (g *Group) Handler(method, path string, handler http.Handler) {
+	g.router.handle(method, g.prefix+path, g.meta, g.doc, Chain(handler, g.mws...))
+}
+
+func (g *Group) clone() *Group {
+	ng := *g
+	ng.mws = append([]Middleware(nil), g.mws...)
+
+	return &ng
+}
+
+// Authorize returns a router middleware that lets a request through to a route
+// requiring a permission only when allowed says the caller has it. The claim of
+// the caller is in the context when JWT runs before it.
+func Authorize(allowed func(ctx context.Context, permission string) (bool, error)) Middleware {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			meta, _ := RouteMetaFrom(r.Context())
+			if meta.Permission == "" {
+				h.ServeHTTP(w, r)
+
+				return
+			}
+
+			ok, err := allowed(r.Context(), meta.Permission)
+			if err != nil {
+				writeError(w, r, http.StatusInternalServerError, goerror.CodeInternal, "Internal server error")
+
+				return
+			}
+
+			if !ok {
+				writeError(w, r, http.StatusForbidden, goerror.CodeForbidden, "permission denied")
+
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package framework
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// traceMiddleware appends name to the X-Trace header of the response and writes
+// the permission of the route it sees to X-Permission.
+func traceMiddleware(name string) Middleware {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("X-Trace", name)
+			if meta, ok := RouteMetaFrom(r.Context()); ok {
+				w.Header().Set("X-Permission", meta.Permission)
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+func TestGroup(t *testing.T) {
+	r := NewRouter()
+	r.Use(traceMiddleware("router"))
+
+	api := r.Group("/api", traceMiddleware("api"))
+	api.Public().Endpoint(http.MethodGet, "/health", func(Context) (any, error) {
+		return map[string]string{"status": "ok"}, nil
+	})
+
+	rbac := api.Group("/rbac", traceMiddleware("rbac"))
+	rbac.Permission("role.read").RateLimit("strict").Endpoint(http.MethodGet, "/roles/:id",
+		func(c Context) (any, error) { return c.Param("id"), nil }, traceMiddleware("route"))
+	rbac.HandleFunc(http.MethodPost, ":batch", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	assert.Equal(t, []Route{
+		{Method: http.MethodGet, Path: "/api/health", Meta: RouteMeta{Public: true}},
+		{
+			Method: http.MethodGet,
+			Path:   "/api/rbac/roles/:id",
+			Meta:   RouteMeta{Permission: "role.read", RateLimit: "strict"},
+		},
+		{Method: http.MethodPost, Path: "/api/rbac:batch", Meta: RouteMeta{}},
+	}, r.Routes())
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		wantStatus     int
+		wantTrace      []string
+		wantPermission string
+	}{
+		{
+			name:       "Public",
+			method:     http.MethodGet,
+			path:       "/api/health",
+			wantStatus: http.StatusOK,
+			wantTrace:  []string{"router", "api"},
+		},
+		{
+			name:           "Nested",
+			method:         http.MethodGet,
+			path:           "/api/rbac/roles/7",
+			wantStatus:     http.StatusOK,
+			wantTrace:      []string{"router", "api", "rbac", "route"},
+			wantPermission: "role.read",
+		},
+		{
+			name:       "CustomMethod",
+			method:     http.MethodPost,
+			path:       "/api/rbac:batch",
+			wantStatus: http.StatusAccepted,
+			wantTrace:  []string{"router", "api", "rbac"},
+		},
+		{
+			name:       "NotFound",
+			method:     http.MethodGet,
+			path:       "/rbac/roles/7",
+			wantStatus: http.StatusNotFound,
+			wantTrace:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantTrace, rec.Header().Values("X-Trace"))
+			assert.Equal(t, tt.wantPermission, rec.Header().Get("X-Permission"))
+		})
+	}
+}
+
+func TestGroup_Immutable(t *testing.T) {
+	g := NewRouter().Group("/a", traceMiddleware("a"))
+	_ = g.Group("/b", traceMiddleware("b")).Public()
+
+	assert.Equal(t, "/a", g.prefix)
+	assert.Len(t, g.mws, 1)
+	assert.Equal(t, RouteMeta{}, g.meta)
+}
+
+func TestRouter_UseAfterRoutes(t *testing.T) {
+	r := NewRouter()
+	r.HandleFunc(http.MethodGet, "/", func(http.ResponseWriter, *http.Request) {})
+
+	assert.Panics(t, func() { r.Use(traceMiddleware("late")) })
+}
+
+func TestJWTPublicRoute(t *testing.T) {
+	r := NewRouter()
+	r.Use(JWT("gostarter.access.token"))
+	r.Group("/auth").Public().HandleFunc(http.MethodPost, "/login",
+		func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/auth/login", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestAuthorize(t *testing.T) {
+	allowed := func(_ context.Context, permission string) (bool, error) {
+		if permission == "boom" {
+			return false, assert.AnError
+		}
+
+		return strings.HasSuffix(permission, ".read"), nil
+	}
+
+	r := NewRouter()
+	r.Use(Authorize(allowed))
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+	r.HandleFunc(http.MethodGet, "/open", ok)
+	r.Group("").Permission("role.read").HandleFunc(http.MethodGet, "/read", ok)
+	r.Group("").Permission("role.write").HandleFunc(http.MethodGet, "/write", ok)
+	r.Group("").Permission("boom").HandleFunc(http.MethodGet, "/boom", ok)
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{name: "NoPermission", path: "/open", wantStatus: http.StatusOK},
+		{name: "Allowed", path: "/read", wantStatus: http.StatusOK},
+		{name: "Denied", path: "/write", wantStatus: http.StatusForbidden},
+		{name: "Error", path: "/boom", wantStatus: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
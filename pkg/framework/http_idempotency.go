@@ -0,0 +1,258 @@
+package framework
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+)
+
+// HeaderIdempotencyKey is the request header carrying the client generated idempotency key.
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+// HeaderIdempotentReplayed is set on responses served from the idempotency store.
+const HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+// idempotencyInFlightTTL is how long a key stays reserved while its request runs,
+// unless the middleware is given another one. It only has to outlive the request,
+// so a key whose request never completes, like one of a crashed instance, is free
+// again soon.
+const idempotencyInFlightTTL = time.Minute
+
+// ErrIdempotencyKeyNotFound is returned by a store when a key vanished between calls.
+var ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+
+// IdempotencyRecord is the state kept for a single idempotency key.
+//
+// A record starts in flight (Done is false) when the first request reserves the key
+// and is completed with the response once the handler returns. Token identifies the
+// reservation, so a request whose reservation expired can not complete or release
+// the one of a retry.
+type IdempotencyRecord struct {
+	Token       string `json:"token,omitempty"`
+	Hash        string `json:"hash"`
+	Done        bool   `json:"done"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// IdempotencyStore persists idempotency records for a limited time.
+type IdempotencyStore interface {
+	// Reserve stores rec under key only when the key does not exist yet.
+	// It returns nil when the key was reserved, otherwise the record already stored.
+	Reserve(ctx context.Context, key string, rec IdempotencyRecord, ttl time.Duration) (*IdempotencyRecord, error)
+
+	// Complete replaces the record of a key reserved with rec.Token, and still in
+	// flight, with the final response. It returns ErrIdempotencyKeyNotFound otherwise.
+	Complete(ctx context.Context, key string, rec IdempotencyRecord, ttl time.Duration) error
+
+	// Release removes the reservation made with token so the request can be executed
+	// again. A key reserved by another request is left as it is.
+	Release(ctx context.Context, key, token string) error
+}
+
+// Idempotency is a middleware that makes POST and PATCH requests carrying an
+// `Idempotency-Key` header safe to retry.
+//
+// The first request with a key is executed and its response is stored for ttl,
+// while it runs the key is reserved for inFlight, a minute when zero or less, and
+// never longer than ttl. inFlight should outlive the slowest route.
+// Retries with the same key and payload receive the stored response, marked with
+// the `Idempotent-Replayed` header. A retry with a different payload, or one that
+// arrives while the first request is still running, is rejected with 409 Conflict.
+// Responses with a 5xx status are not stored, so the client can try again.
+//
+// The body is held in memory to fingerprint the request, so a body larger than
+// maxBodySize is rejected when it comes with a key, zero or less lifts the limit.
+// Routes taking larger bodies, like uploads, are better called without a key.
+//
+// Keys are scoped per authenticated user, so the middleware should be placed after JWT.
+func Idempotency(store IdempotencyStore, ttl, inFlight time.Duration, maxBodySize int64) Middleware {
+	if inFlight <= 0 {
+		inFlight = idempotencyInFlightTTL
+	}
+
+	mi := &middlewareIdempotency{
+		store:       store,
+		ttl:         ttl,
+		inFlight:    min(ttl, inFlight),
+		maxBodySize: maxBodySize,
+	}
+
+	return mi.handle
+}
+
+type middlewareIdempotency struct {
+	store       IdempotencyStore
+	ttl         time.Duration
+	inFlight    time.Duration
+	maxBodySize int64
+}
+
+func (mi *middlewareIdempotency) handle(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(HeaderIdempotencyKey)
+		if key == "" || (r.Method != http.MethodPost && r.Method != http.MethodPatch) {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		if mi.maxBodySize > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, mi.maxBodySize)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			msg := "Request payload malformed"
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				msg = "Request payload too large"
+			}
+			writeError(w, r, http.StatusBadRequest, goerror.CodeInvalidFormat, msg)
+
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := r.Context()
+		key = mi.scopedKey(ctx, key)
+		hash := mi.hash(r, body)
+		token := rand.Text()
+
+		stored, err := mi.store.Reserve(ctx, key, IdempotencyRecord{Token: token, Hash: hash}, mi.inFlight)
+		if err != nil {
+			log.Println("idempotency store reserve", err)
+			writeError(w, r, http.StatusInternalServerError, goerror.CodeInternal, "Internal server error")
+
+			return
+		}
+
+		if stored != nil {
+			mi.replay(w, r, stored, hash)
+
+			return
+		}
+
+		iw := &idempotencyWriter{ResponseWriter: w}
+		defer func() {
+			if p := recover(); p != nil {
+				mi.release(ctx, key, token)
+				panic(p)
+			}
+		}()
+
+		h.ServeHTTP(iw, r)
+
+		if iw.status == 0 || iw.status >= http.StatusInternalServerError {
+			mi.release(ctx, key, token)
+
+			return
+		}
+
+		rec := IdempotencyRecord{
+			Token:       token,
+			Hash:        hash,
+			Done:        true,
+			Status:      iw.status,
+			ContentType: iw.Header().Get("Content-Type"),
+			Body:        iw.body.Bytes(),
+		}
+		// a key left in flight would answer every retry with 409 until it expires
+		if err := mi.store.Complete(context.WithoutCancel(ctx), key, rec, mi.ttl); err != nil {
+			log.Println("idempotency store complete", err)
+			mi.release(ctx, key, token)
+		}
+	})
+}
+
+func (mi *middlewareIdempotency) replay(
+	w http.ResponseWriter, r *http.Request, rec *IdempotencyRecord, hash string,
+) {
+	if rec.Hash != hash {
+		writeError(w, r, http.StatusConflict, goerror.CodeConflict,
+			"idempotency key already used for a different request")
+
+		return
+	}
+
+	if !rec.Done {
+		writeError(w, r, http.StatusConflict, goerror.CodeConflict,
+			"request with this idempotency key is in progress")
+
+		return
+	}
+
+	if rec.ContentType != "" {
+		w.Header().Set("Content-Type", rec.ContentType)
+	}
+	w.Header().Set(HeaderIdempotentReplayed, "true")
+	w.WriteHeader(rec.Status)
+	_, _ = w.Write(rec.Body)
+}
+
+func (mi *middlewareIdempotency) release(ctx context.Context, key, token string) {
+	// the request context may already be canceled when the client went away
+	if err := mi.store.Release(context.WithoutCancel(ctx), key, token); err != nil {
+		log.Println("idempotency store release", err)
+	}
+}
+
+// scopedKey prefixes the key with the authenticated user, so two users
+// can never replay each other's responses.
+func (mi *middlewareIdempotency) scopedKey(ctx context.Context, key string) string {
+	if clm := lib.GetJWTClaim(ctx); clm != nil {
+		return strconv.FormatUint(clm.AuthID, 10) + ":" + key
+	}
+
+	return "anonymous:" + key
+}
+
+// hash fingerprints the request, so a key reused for another payload is detected.
+func (mi *middlewareIdempotency) hash(r *http.Request, body []byte) string {
+	sum := sha256.New()
+	sum.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	sum.Write(body)
+
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+// idempotencyWriter captures the status and body written by the next handler.
+type idempotencyWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (iw *idempotencyWriter) WriteHeader(code int) {
+	if iw.status == 0 {
+		iw.status = code
+	}
+
+	iw.ResponseWriter.WriteHeader(code)
+}
+
+func (iw *idempotencyWriter) Write(b []byte) (int, error) {
+	if iw.status == 0 {
+		iw.status = http.StatusOK
+	}
+
+	iw.body.Write(b)
+
+	return iw.ResponseWriter.Write(b)
+}
+
+func (iw *idempotencyWriter) Unwrap() http.ResponseWriter {
+	return iw.ResponseWriter
+}
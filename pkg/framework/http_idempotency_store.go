@@ -0,0 +1,187 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+)
+
+const idempotencyRedisPrefix = "idempotency:"
+
+// idempotencyRedisComplete sets the record of KEYS[1] to ARGV[2] for ARGV[3]
+// milliseconds, only while it is in flight and reserved with the token ARGV[1].
+var idempotencyRedisComplete = redis.NewScript(`
+local stored = redis.call('GET', KEYS[1])
+if not stored then return 0 end
+local rec = cjson.decode(stored)
+if rec.token ~= ARGV[1] or rec.done then return 0 end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// idempotencyRedisRelease deletes KEYS[1], only while it is in flight and
+// reserved with the token ARGV[1].
+var idempotencyRedisRelease = redis.NewScript(`
+local stored = redis.call('GET', KEYS[1])
+if not stored then return 0 end
+local rec = cjson.decode(stored)
+if rec.token ~= ARGV[1] or rec.done then return 0 end
+return redis.call('DEL', KEYS[1])
+`)
+
+// IdempotencyRedis is an IdempotencyStore backed by Redis. Records expire through the key TTL.
+type IdempotencyRedis struct {
+	client *redis.Client
+}
+
+func NewIdempotencyRedis(client *redis.Client) *IdempotencyRedis {
+	return &IdempotencyRedis{client: client}
+}
+
+func (ir *IdempotencyRedis) Reserve(ctx context.Context, key string, rec IdempotencyRecord, ttl time.Duration) (
+	*IdempotencyRecord, error,
+) {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := ir.client.SetNX(ctx, idempotencyRedisPrefix+key, data, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		return nil, nil //nolint:nilnil // nil record means the key was reserved
+	}
+
+	stored, err := ir.client.Get(ctx, idempotencyRedisPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrIdempotencyKeyNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var out IdempotencyRecord
+	if err := json.Unmarshal(stored, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (ir *IdempotencyRedis) Complete(ctx context.Context, key string, rec IdempotencyRecord, ttl time.Duration) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{idempotencyRedisPrefix + key}
+	set, err := idempotencyRedisComplete.Run(ctx, ir.client, keys, rec.Token, data, ttl.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+
+	if set == 0 {
+		return ErrIdempotencyKeyNotFound
+	}
+
+	return nil
+}
+
+func (ir *IdempotencyRedis) Release(ctx context.Context, key, token string) error {
+	return idempotencyRedisRelease.Run(ctx, ir.client, []string{idempotencyRedisPrefix + key}, token).Err()
+}
+
+// IdempotencySQL is an IdempotencyStore backed by the `idempotency_keys` table.
+// Expired rows are replaced lazily when the same key is reserved again.
+type IdempotencySQL struct {
+	db  *sqlkit.DB
+	now func() time.Time
+}
+
+func NewIdempotencySQL(db *sqlkit.DB) *IdempotencySQL {
+	return &IdempotencySQL{db: db, now: time.Now}
+}
+
+type idempotencyKey struct {
+	Key         string    `db:"idempotency_key"`
+	Hash        string    `db:"request_hash"`
+	Done        bool      `db:"done"`
+	Status      int       `db:"status"`
+	ContentType string    `db:"content_type"`
+	Body        []byte    `db:"body"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
+
+func (idempotencyKey) Table() string {
+	return "idempotency_keys"
+}
+
+func (is *IdempotencySQL) Reserve(ctx context.Context, key string, rec IdempotencyRecord, ttl time.Duration) (
+	*IdempotencyRecord, error,
+) {
+	now := is.now()
+
+	query := `DELETE FROM idempotency_keys WHERE idempotency_key = ? AND expires_at < ?;`
+	if _, err := sqlkit.Exec(ctx, is.db, query, key, now); err != nil {
+		return nil, err
+	}
+
+	query = `INSERT INTO idempotency_keys(idempotency_key, token, request_hash, done, status, content_type, body,
+	expires_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?);`
+	args := []any{key, rec.Token, rec.Hash, rec.Done, rec.Status, rec.ContentType, rec.Body, now.Add(ttl)}
+
+	_, errInsert := sqlkit.Exec(ctx, is.db, query, args...)
+	if errInsert == nil {
+		return nil, nil //nolint:nilnil // nil record means the key was reserved
+	}
+
+	// the insert most likely collided with the primary key, look up the owner
+	stored, err := sqlkit.One[idempotencyKey](ctx, is.db, sqlkit.Ex{"idempotency_key": key})
+	if err != nil {
+		return nil, err
+	}
+
+	if stored == nil {
+		return nil, errInsert
+	}
+
+	return &IdempotencyRecord{
+		Hash:        stored.Hash,
+		Done:        stored.Done,
+		Status:      stored.Status,
+		ContentType: stored.ContentType,
+		Body:        stored.Body,
+	}, nil
+}
+
+func (is *IdempotencySQL) Complete(ctx context.Context, key string, rec IdempotencyRecord, ttl time.Duration) error {
+	query := `UPDATE idempotency_keys SET done = ?, status = ?, content_type = ?, body = ?, expires_at = ?
+	WHERE idempotency_key = ? AND token = ? AND done = false;`
+	args := []any{rec.Done, rec.Status, rec.ContentType, rec.Body, is.now().Add(ttl), key, rec.Token}
+
+	result, err := sqlkit.Exec(ctx, is.db, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrIdempotencyKeyNotFound
+	}
+
+	return nil
+}
+
+func (is *IdempotencySQL) Release(ctx context.Context, key, token string) error {
+	query := `DELETE FROM idempotency_keys WHERE idempotency_key = ? AND token = ? AND done = false;`
+	_, err := sqlkit.Exec(ctx, is.db, query, key, token)
+
+	return err
+}
@@ -0,0 +1,167 @@
+package framework
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shandysiswandi/goreng/telemetry"
+	"github.com/shandysiswandi/gostarter/pkg/sqlkit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencySQL_Reserve(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	rec := IdempotencyRecord{Token: "token", Hash: "hash"}
+	queryDelete := regexp.QuoteMeta(`DELETE FROM idempotency_keys WHERE idempotency_key = ? AND expires_at < ?;`)
+	queryInsert := regexp.QuoteMeta(`INSERT INTO idempotency_keys(idempotency_key, token, request_hash, done`)
+	querySelect := regexp.QuoteMeta(`FROM "idempotency_keys" WHERE ("idempotency_key" = 'k') LIMIT 1`)
+
+	tests := []struct {
+		name    string
+		want    *IdempotencyRecord
+		wantErr error
+		mockFn  func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:    "ErrorDeleteExpired",
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(queryDelete).WithArgs("k", now).WillReturnError(assert.AnError)
+			},
+		},
+		{
+			name:    "ErrorInsertWithoutExisting",
+			want:    nil,
+			wantErr: assert.AnError,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(queryDelete).WithArgs("k", now).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(queryInsert).
+					WithArgs("k", "token", "hash", false, 0, "", []byte(nil), now.Add(time.Minute)).
+					WillReturnError(assert.AnError)
+				mock.ExpectQuery(querySelect).
+					WillReturnRows(sqlmock.NewRows([]string{"idempotency_key"}))
+			},
+		},
+		{
+			name: "Existing",
+			want: &IdempotencyRecord{
+				Hash:        "hash",
+				Done:        true,
+				Status:      201,
+				ContentType: "application/json",
+				Body:        []byte("{}"),
+			},
+			wantErr: nil,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(queryDelete).WithArgs("k", now).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(queryInsert).WillReturnError(assert.AnError)
+
+				row := sqlmock.NewRows([]string{
+					"body", "content_type", "done", "expires_at", "idempotency_key", "request_hash", "status",
+				}).AddRow([]byte("{}"), "application/json", true, now, "k", "hash", 201)
+				mock.ExpectQuery(querySelect).WillReturnRows(row)
+			},
+		},
+		{
+			name:    "Reserved",
+			want:    nil,
+			wantErr: nil,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(queryDelete).WithArgs("k", now).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(queryInsert).
+					WithArgs("k", "token", "hash", false, 0, "", []byte(nil), now.Add(time.Minute)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+			defer db.Close()
+			tt.mockFn(mock)
+
+			is := NewIdempotencySQL(sqlkit.New("mysql", db, telemetry.NewTelemetry().Logger()))
+			is.now = func() time.Time { return now }
+
+			got, err := is.Reserve(context.Background(), "k", rec, time.Minute)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestIdempotencySQL_Complete(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	rec := IdempotencyRecord{
+		Token: "token", Hash: "hash", Done: true, Status: 201, ContentType: "text/plain", Body: []byte("ok"),
+	}
+	query := regexp.QuoteMeta(`UPDATE idempotency_keys SET done = ?, status = ?, content_type = ?, body = ?, ` +
+		`expires_at = ?
+	WHERE idempotency_key = ? AND token = ? AND done = false;`)
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mockFn  func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:    "ErrorExec",
+			wantErr: assert.AnError,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WillReturnError(assert.AnError)
+			},
+		},
+		{
+			name:    "ErrorNotFound",
+			wantErr: ErrIdempotencyKeyNotFound,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name:    "Success",
+			wantErr: nil,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).
+					WithArgs(true, 201, "text/plain", []byte("ok"), now.Add(time.Minute), "k", "token").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+			defer db.Close()
+			tt.mockFn(mock)
+
+			is := NewIdempotencySQL(sqlkit.New("mysql", db, telemetry.NewTelemetry().Logger()))
+			is.now = func() time.Time { return now }
+
+			err := is.Complete(context.Background(), "k", rec, time.Minute)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIdempotencySQL_Release(t *testing.T) {
+	db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	defer db.Close()
+
+	query := regexp.QuoteMeta(`DELETE FROM idempotency_keys WHERE idempotency_key = ? AND token = ? AND ` +
+		`done = false;`)
+	mock.ExpectExec(query).
+		WithArgs("k", "token").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	is := NewIdempotencySQL(sqlkit.New("mysql", db, telemetry.NewTelemetry().Logger()))
+	assert.NoError(t, is.Release(context.Background(), "k", "token"))
+}
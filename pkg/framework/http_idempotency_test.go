@@ -0,0 +1,386 @@
+package framework
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryIdempotency struct {
+	mu          sync.Mutex
+	records     map[string]IdempotencyRecord
+	ttls        map[string]time.Duration
+	err         error
+	completeErr error
+}
+
+func newMemoryIdempotency() *memoryIdempotency {
+	return &memoryIdempotency{records: map[string]IdempotencyRecord{}, ttls: map[string]time.Duration{}}
+}
+
+func (m *memoryIdempotency) Reserve(_ context.Context, key string, rec IdempotencyRecord, ttl time.Duration) (
+	*IdempotencyRecord, error,
+) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	if stored, ok := m.records[key]; ok {
+		return &stored, nil
+	}
+
+	m.records[key] = rec
+	m.ttls[key] = ttl
+
+	return nil, nil //nolint:nilnil // reserved
+}
+
+func (m *memoryIdempotency) Complete(
+	_ context.Context, key string, rec IdempotencyRecord, ttl time.Duration,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.completeErr != nil {
+		return m.completeErr
+	}
+
+	if stored, ok := m.records[key]; !ok || stored.Token != rec.Token || stored.Done {
+		return ErrIdempotencyKeyNotFound
+	}
+
+	m.records[key] = rec
+	m.ttls[key] = ttl
+
+	return nil
+}
+
+func (m *memoryIdempotency) Release(_ context.Context, key, token string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if stored, ok := m.records[key]; !ok || stored.Token != token || stored.Done {
+		return nil
+	}
+
+	delete(m.records, key)
+	delete(m.ttls, key)
+
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	newRequest := func(method, key, body string) *http.Request {
+		req := httptest.NewRequest(method, "/todos", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(HeaderIdempotencyKey, key)
+		}
+
+		return req
+	}
+
+	tests := []struct {
+		name         string
+		store        func() *memoryIdempotency
+		status       int
+		requests     []*http.Request
+		wantStatus   []int
+		wantBody     []string
+		wantReplayed []bool
+		wantCalls    int
+	}{
+		{
+			name:         "WithoutKey",
+			store:        newMemoryIdempotency,
+			status:       http.StatusCreated,
+			requests:     []*http.Request{newRequest(http.MethodPost, "", "a"), newRequest(http.MethodPost, "", "a")},
+			wantStatus:   []int{http.StatusCreated, http.StatusCreated},
+			wantBody:     []string{"a", "a"},
+			wantReplayed: []bool{false, false},
+			wantCalls:    2,
+		},
+		{
+			name:         "IgnoreSafeMethod",
+			store:        newMemoryIdempotency,
+			status:       http.StatusOK,
+			requests:     []*http.Request{newRequest(http.MethodGet, "k", ""), newRequest(http.MethodGet, "k", "")},
+			wantStatus:   []int{http.StatusOK, http.StatusOK},
+			wantBody:     []string{"", ""},
+			wantReplayed: []bool{false, false},
+			wantCalls:    2,
+		},
+		{
+			name:         "ReplayStoredResponse",
+			store:        newMemoryIdempotency,
+			status:       http.StatusCreated,
+			requests:     []*http.Request{newRequest(http.MethodPost, "k", "a"), newRequest(http.MethodPost, "k", "a")},
+			wantStatus:   []int{http.StatusCreated, http.StatusCreated},
+			wantBody:     []string{"a", "a"},
+			wantReplayed: []bool{false, true},
+			wantCalls:    1,
+		},
+		{
+			name:   "ConflictDifferentPayload",
+			store:  newMemoryIdempotency,
+			status: http.StatusCreated,
+			requests: []*http.Request{
+				newRequest(http.MethodPost, "k", "a"),
+				newRequest(http.MethodPost, "k", "b"),
+			},
+			wantStatus: []int{http.StatusCreated, http.StatusConflict},
+			wantBody: []string{
+				"a",
+				`{"message":"idempotency key already used for a different request"}` + "\n",
+			},
+			wantReplayed: []bool{false, false},
+			wantCalls:    1,
+		},
+		{
+			name:         "ServerErrorIsNotStored",
+			store:        newMemoryIdempotency,
+			status:       http.StatusInternalServerError,
+			requests:     []*http.Request{newRequest(http.MethodPost, "k", "a"), newRequest(http.MethodPost, "k", "a")},
+			wantStatus:   []int{http.StatusInternalServerError, http.StatusInternalServerError},
+			wantBody:     []string{"a", "a"},
+			wantReplayed: []bool{false, false},
+			wantCalls:    2,
+		},
+		{
+			name: "ErrorStore",
+			store: func() *memoryIdempotency {
+				m := newMemoryIdempotency()
+				m.err = assert.AnError
+
+				return m
+			},
+			status:       http.StatusCreated,
+			requests:     []*http.Request{newRequest(http.MethodPost, "k", "a")},
+			wantStatus:   []int{http.StatusInternalServerError},
+			wantBody:     []string{`{"message":"Internal server error"}` + "\n"},
+			wantReplayed: []bool{false},
+			wantCalls:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(tt.status)
+				body, _ := io.ReadAll(r.Body)
+				_, _ = w.Write(body)
+			})
+			h := Idempotency(tt.store(), time.Minute, 0, 0)(handler)
+
+			for i, req := range tt.requests {
+				rr := httptest.NewRecorder()
+				h.ServeHTTP(rr, req)
+
+				assert.Equal(t, tt.wantStatus[i], rr.Code)
+				assert.Equal(t, tt.wantBody[i], rr.Body.String())
+				assert.Equal(t, tt.wantReplayed[i], rr.Header().Get(HeaderIdempotentReplayed) == "true")
+			}
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestIdempotency_InFlight(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusCreated)
+	})
+	h := Idempotency(newMemoryIdempotency(), time.Minute, 0, 0)(handler)
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/todos", strings.NewReader("a"))
+		req.Header.Set(HeaderIdempotencyKey, "k")
+
+		return req
+	}
+
+	first := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.ServeHTTP(first, newRequest())
+	}()
+	<-started
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, newRequest())
+	assert.Equal(t, http.StatusConflict, rr.Code)
+	assert.Equal(t, `{"message":"request with this idempotency key is in progress"}`+"\n", rr.Body.String())
+
+	close(release)
+	<-done
+	assert.Equal(t, http.StatusCreated, first.Code)
+
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, newRequest())
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, "true", rr.Header().Get(HeaderIdempotentReplayed))
+}
+
+func TestIdempotency_ScopedByUser(t *testing.T) {
+	store := newMemoryIdempotency()
+	h := Idempotency(store, time.Minute, 0, 0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	for _, authID := range []uint64{1, 2} {
+		req := httptest.NewRequest(http.MethodPost, "/todos", strings.NewReader("a"))
+		req.Header.Set(HeaderIdempotencyKey, "k")
+		req = req.WithContext(lib.SetJWTClaim(req.Context(), lib.NewJWTClaim(authID, "email", time.Time{}, nil)))
+
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Empty(t, rr.Header().Get(HeaderIdempotentReplayed))
+	}
+
+	assert.Len(t, store.records, 2)
+	assert.Contains(t, store.records, "1:k")
+	assert.Contains(t, store.records, "2:k")
+}
+
+func TestIdempotency_ReleaseOnPanic(t *testing.T) {
+	store := newMemoryIdempotency()
+	h := Idempotency(store, time.Minute, 0, 0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/todos", strings.NewReader("a"))
+	req.Header.Set(HeaderIdempotencyKey, "k")
+
+	assert.Panics(t, func() { h.ServeHTTP(httptest.NewRecorder(), req) })
+	assert.Empty(t, store.records)
+}
+
+func TestIdempotency_BodyTooLarge(t *testing.T) {
+	store := newMemoryIdempotency()
+	calls := 0
+	h := Idempotency(store, time.Minute, 0, 4)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/todos", strings.NewReader("too large"))
+	req.Header.Set(HeaderIdempotencyKey, "k")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, `{"message":"Request payload too large"}`+"\n", rr.Body.String())
+	assert.Zero(t, calls)
+	assert.Empty(t, store.records)
+}
+
+func TestIdempotency_TTL(t *testing.T) {
+	store := newMemoryIdempotency()
+	var inFlight time.Duration
+	h := Idempotency(store, time.Hour, 0, 0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inFlight = store.ttls["anonymous:k"]
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/todos", strings.NewReader("a"))
+	req.Header.Set(HeaderIdempotencyKey, "k")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, idempotencyInFlightTTL, inFlight)
+	assert.Equal(t, time.Hour, store.ttls["anonymous:k"])
+}
+
+func TestIdempotency_InFlightTTL(t *testing.T) {
+	tests := []struct {
+		name     string
+		ttl      time.Duration
+		inFlight time.Duration
+		want     time.Duration
+	}{
+		{name: "Configured", ttl: time.Hour, inFlight: 5 * time.Minute, want: 5 * time.Minute},
+		{name: "BoundedByTTL", ttl: time.Minute, inFlight: 5 * time.Minute, want: time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newMemoryIdempotency()
+			var got time.Duration
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = store.ttls["anonymous:k"]
+				w.WriteHeader(http.StatusCreated)
+			})
+			h := Idempotency(store, tt.ttl, tt.inFlight, 0)(handler)
+
+			req := httptest.NewRequest(http.MethodPost, "/todos", strings.NewReader("a"))
+			req.Header.Set(HeaderIdempotencyKey, "k")
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIdempotency_ExpiredReservation(t *testing.T) {
+	// the reservation expires while the handler runs and a retry reserves the key again
+	retry := IdempotencyRecord{Token: "retry", Hash: "hash"}
+	tests := []struct {
+		name   string
+		status int
+	}{
+		{name: "Complete", status: http.StatusCreated},
+		{name: "Release", status: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newMemoryIdempotency()
+			h := Idempotency(store, time.Minute, 0, 0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				store.records["anonymous:k"] = retry
+				w.WriteHeader(tt.status)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/todos", strings.NewReader("a"))
+			req.Header.Set(HeaderIdempotencyKey, "k")
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, retry, store.records["anonymous:k"])
+		})
+	}
+}
+
+func TestIdempotency_ReleaseOnCompleteError(t *testing.T) {
+	store := newMemoryIdempotency()
+	store.completeErr = assert.AnError
+	h := Idempotency(store, time.Minute, 0, 0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/todos", strings.NewReader("a"))
+	req.Header.Set(HeaderIdempotencyKey, "k")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Empty(t, store.records)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/shandysiswandi/goreng/debugger"
+	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/gostarter/internal/lib"
 )
 
@@ -49,7 +50,19 @@ func Recovery(h http.Handler) http.Handler {
 			// Recover from any panics and handle them appropriately.
 			if err := recover(); err != nil && err != http.ErrAbortHandler {
 				// Log the panic message.
-				log.Printf("panic because: %v\n", err)
+				log.Printf("panic because: %v, request id: %s\n", err, lib.GetRequestID(r.Context()))
+
+				// Print the stack trace for debugging purposes.
+				debugger.Stack("/")
+
+				// Report it as a problem when the request asked for problem details.
+				if cfg, ok := problemEnabled(r); ok && r.Header.Get("Connection") != "Upgrade" {
+					writeProblem(w, newProblem(r, cfg, http.StatusInternalServerError,
+						goerror.CodeInternal, "Internal server error"))
+
+					return
+				}
+
 				w.Header().Set("Content-Type", "application/json; charset=utf-8")
 
 				// If the connection is not being upgraded, write a 500 status code.
@@ -57,12 +70,10 @@ func Recovery(h http.Handler) http.Handler {
 					w.WriteHeader(http.StatusInternalServerError)
 				}
 
-				// Print the stack trace for debugging purposes.
-				debugger.Stack("/")
-
 				// Send a default fallback response to the client.
-				_ = json.NewEncoder(w).Encode(map[string]string{
-					"message": "Internal server error",
+				_ = json.NewEncoder(w).Encode(errorResponse{
+					Message:   "Internal server error",
+					RequestID: lib.GetRequestID(r.Context()),
 				})
 			}
 		}()
@@ -72,6 +83,10 @@ func Recovery(h http.Handler) http.Handler {
 	})
 }
 
+// JWT returns a middleware that rejects a request without a valid access token
+// and puts the claim of the token in the context. Used with Router.Use it lets
+// the public routes through, see Group.Public, the skipPaths prefixes are for a
+// middleware of the server, which runs before a route is matched.
 func JWT(audience string, skipPaths ...string) Middleware {
 	mj := &middlewareJWT{
 		audience:  audience,
@@ -81,13 +96,40 @@ func JWT(audience string, skipPaths ...string) Middleware {
 	return mj.handle
 }
 
+// JWTWithWebsocket works like JWT but lets websocket upgrade requests through
+// without a token. Browsers can not set headers on the upgrade, so only put it in
+// front of handlers that authenticate the connection themselves, like the
+// websocket transport of HandlerGQL does with its connection init payload.
+func JWTWithWebsocket(audience string, skipPaths ...string) Middleware {
+	mj := &middlewareJWT{
+		audience:  audience,
+		skipPaths: skipPaths,
+		websocket: true,
+	}
+
+	return mj.handle
+}
+
 type middlewareJWT struct {
 	audience  string
 	skipPaths []string
+	websocket bool
 }
 
 func (mj *middlewareJWT) handle(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if mj.websocket && strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		if meta, ok := RouteMetaFrom(r.Context()); ok && meta.Public {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
 		for _, prefix := range mj.skipPaths {
 			if strings.HasPrefix(r.URL.Path, prefix) {
 				h.ServeHTTP(w, r)
@@ -98,13 +140,13 @@ func (mj *middlewareJWT) handle(h http.Handler) http.Handler {
 
 		clm := lib.ExtractJWTClaim(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
 		if clm == nil {
-			writeJSON(w, map[string]string{"error": "invalid token"}, http.StatusUnauthorized)
+			writeUnauthorized(w, r, "invalid token")
 
 			return
 		}
 
 		if err := clm.Validate(); err != nil {
-			writeJSON(w, map[string]string{"error": "invalid validation token"}, http.StatusUnauthorized)
+			writeUnauthorized(w, r, "invalid validation token")
 
 			return
 		}
@@ -112,3 +154,20 @@ func (mj *middlewareJWT) handle(h http.Handler) http.Handler {
 		h.ServeHTTP(w, r.WithContext(lib.SetJWTClaim(r.Context(), clm)))
 	})
 }
+
+// writeUnauthorized rejects a request without a valid token, the former shape
+// keeps the message under `error`.
+func writeUnauthorized(w http.ResponseWriter, r *http.Request, msg string) {
+	if cfg, ok := problemEnabled(r); ok {
+		writeProblem(w, newProblem(r, cfg, http.StatusUnauthorized, goerror.CodeUnauthorized, msg))
+
+		return
+	}
+
+	resp := map[string]string{"error": msg}
+	if id := lib.GetRequestID(r.Context()); id != "" {
+		resp["request_id"] = id
+	}
+
+	writeJSON(w, resp, http.StatusUnauthorized)
+}
@@ -198,3 +198,39 @@ func TestJWT(t *testing.T) {
 		})
 	}
 }
+
+func TestJWTWithWebsocket(t *testing.T) {
+	tests := []struct {
+		name           string
+		upgrade        string
+		expectedStatus int
+	}{
+		{
+			name:           "ErrorWithoutUpgrade",
+			upgrade:        "",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "SuccessUpgrade",
+			upgrade:        "websocket",
+			expectedStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := JWTWithWebsocket("gostarter.access.token")(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) },
+			))
+
+			req := httptest.NewRequest(http.MethodGet, "/graphql", nil)
+			req.Header.Set("Upgrade", tt.upgrade)
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,533 @@
+package framework
+
+import (
+	"encoding"
+	"encoding/json"
+	"html/template"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+)
+
+const openAPIVersion = "3.1.0"
+
+// RouteDoc documents a route in the OpenAPI document of the router, see
+// Group.Doc. Request and Response are values of the types they describe, their
+// fields are read the way Bind and encoding/json read them.
+type RouteDoc struct {
+	Summary     string
+	Description string
+	Tags        []string // The first segment of the path when empty.
+	// Request is a struct whose fields tagged `query` and `param` are the
+	// parameters of the route and the other fields its body.
+	Request any
+	// Response is the data of a successful response, in the envelope the router
+	// writes it in. A route without one answers 204 No Content.
+	Response     any
+	Status       int    // Of a successful response, 200 or 204 when zero.
+	RequestType  string // Media type of the body, application/json when empty.
+	ResponseType string // Media type of a response the router does not encode, like text/event-stream.
+}
+
+// OpenAPIInfo tells about the API in its OpenAPI document.
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Doc returns a copy of g whose routes are documented by d. A doc describes a
+// single route, it is not carried over to the groups nested in g.
+func (g *Group) Doc(d RouteDoc) *Group {
+	ng := g.clone()
+	ng.doc = &d
+
+	return ng
+}
+
+// Doc returns a group without prefix whose routes are documented by d, for the
+// routes registered on the router itself.
+func (r *Router) Doc(d RouteDoc) *Group {
+	return r.Group("").Doc(d)
+}
+
+// Undocumented returns the routes registered without a RouteDoc, which are left
+// out of the OpenAPI document.
+func (r *Router) Undocumented() []Route {
+	var routes []Route
+	for _, rt := range r.routes {
+		if rt.Doc == nil {
+			routes = append(routes, rt)
+		}
+	}
+
+	return routes
+}
+
+// OpenAPI returns the OpenAPI 3.1 document of the documented routes of r. The
+// responses are described in JSON, the other codecs of the router encode the
+// same shape.
+func (r *Router) OpenAPI(info OpenAPIInfo) ([]byte, error) {
+	doc := openAPIDocument{
+		OpenAPI:    openAPIVersion,
+		Info:       info,
+		Paths:      make(map[string]map[string]openAPIOperation),
+		Components: newOpenAPIComponents(),
+	}
+
+	for _, rt := range r.routes {
+		if rt.Doc == nil {
+			continue
+		}
+
+		path, params := openAPIPath(rt.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]openAPIOperation)
+		}
+
+		doc.Paths[path][strings.ToLower(rt.Method)] = newOpenAPIOperation(rt, params)
+	}
+
+	return json.Marshal(doc)
+}
+
+// OpenAPIHandler returns a handler serving the OpenAPI document of r. The
+// document is built on the first request, once every route is registered.
+func (r *Router) OpenAPIHandler(info OpenAPIInfo) http.Handler {
+	build := sync.OnceValues(func() ([]byte, error) { return r.OpenAPI(info) })
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		doc, err := build()
+		if err != nil {
+			log.Println("router.OpenAPI(info)", err)
+			writeError(w, req, http.StatusInternalServerError, goerror.CodeInternal, "Internal server error")
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if _, err := w.Write(doc); err != nil {
+			log.Println("w.Write(doc)", err)
+		}
+	})
+}
+
+var openAPIDocsPage = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+</head>
+<body>
+<redoc spec-url="{{.SpecURL}}"></redoc>
+<script src="https://cdn.jsdelivr.net/npm/redoc@2.1.5/bundles/redoc.standalone.js"></script>
+</body>
+</html>
+`))
+
+// openAPIDocsPolicy lets the Redoc page load its bundle, fonts and the document.
+const openAPIDocsPolicy = "default-src 'none'; script-src https://cdn.jsdelivr.net; " +
+	"style-src 'unsafe-inline' https://fonts.googleapis.com; font-src https://fonts.gstatic.com; " +
+	"img-src data: https:; worker-src blob:; connect-src 'self'; frame-ancestors 'none'"
+
+// OpenAPIDocsHandler returns a handler serving a Redoc page of the OpenAPI
+// document at specURL, see Router.OpenAPIHandler. It sets the
+// Content-Security-Policy the page needs, over the one of SecureHeaders.
+func OpenAPIDocsHandler(title, specURL string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Security-Policy", openAPIDocsPolicy)
+		err := openAPIDocsPage.Execute(w, struct{ Title, SpecURL string }{Title: title, SpecURL: specURL})
+		if err != nil {
+			log.Println("openAPIDocsPage.Execute(w)", err)
+		}
+	})
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                                 `json:"openapi"`
+	Info       OpenAPIInfo                            `json:"info"`
+	Paths      map[string]map[string]openAPIOperation `json:"paths"`
+	Components openAPIComponents                      `json:"components"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]jsonSchema      `json:"schemas"`
+	Responses       map[string]openAPIResponse `json:"responses"`
+	SecuritySchemes map[string]jsonSchema      `json:"securitySchemes"`
+}
+
+type openAPIOperation struct {
+	Summary     string                     `json:"summary,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+	Security    []map[string][]string      `json:"security,omitempty"`
+	Permission  string                     `json:"x-permission,omitempty"`
+	RateLimit   string                     `json:"x-rate-limit,omitempty"`
+}
+
+type openAPIParameter struct {
+	Name     string     `json:"name"`
+	In       string     `json:"in"`
+	Required bool       `json:"required,omitempty"`
+	Schema   jsonSchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Ref         string                      `json:"$ref,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema jsonSchema `json:"schema"`
+}
+
+// jsonSchema is a JSON Schema, the schema dialect of OpenAPI 3.1.
+type jsonSchema map[string]any
+
+// newOpenAPIComponents returns what the operations refer to: the error
+// responses every route may answer and the access token of the private ones.
+func newOpenAPIComponents() openAPIComponents {
+	return openAPIComponents{
+		Schemas: map[string]jsonSchema{
+			"Error": {
+				"type": "object",
+				"properties": jsonSchema{
+					"message": jsonSchema{"type": "string"},
+					"error":   jsonSchema{"type": "object", "additionalProperties": jsonSchema{"type": "string"}},
+				},
+				"required": []string{"message"},
+			},
+			"Problem": schemaOf(reflect.TypeFor[Problem](), map[reflect.Type]bool{}),
+		},
+		Responses: map[string]openAPIResponse{
+			"Error": {
+				Description: "Error",
+				Content: map[string]openAPIMediaType{
+					"application/json": {Schema: jsonSchema{"$ref": "#/components/schemas/Error"}},
+					MediaTypeProblem:   {Schema: jsonSchema{"$ref": "#/components/schemas/Problem"}},
+				},
+			},
+		},
+		SecuritySchemes: map[string]jsonSchema{
+			"bearerAuth": {"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+		},
+	}
+}
+
+func newOpenAPIOperation(rt Route, pathParams []string) openAPIOperation {
+	d := rt.Doc
+	op := openAPIOperation{
+		Summary:     d.Summary,
+		Description: d.Description,
+		Tags:        d.Tags,
+		Responses:   map[string]openAPIResponse{"default": {Ref: "#/components/responses/Error"}},
+		Permission:  rt.Meta.Permission,
+		RateLimit:   rt.Meta.RateLimit,
+	}
+
+	if len(op.Tags) == 0 {
+		op.Tags = []string{openAPITag(rt.Path)}
+	}
+
+	if !rt.Meta.Public {
+		op.Security = []map[string][]string{{"bearerAuth": {}}}
+	}
+
+	var req reflect.Type
+	if d.Request != nil {
+		req = reflect.TypeOf(d.Request)
+	}
+
+	op.Parameters = openAPIParameters(req, pathParams)
+	op.RequestBody = openAPIBody(req, d.RequestType)
+
+	status, resp := openAPISuccess(d)
+	op.Responses[strconv.Itoa(status)] = resp
+
+	return op
+}
+
+// openAPIPath turns the parameters of an httprouter path, :name and *name, into
+// the {name} of OpenAPI, it returns their names in order.
+func openAPIPath(path string) (string, []string) {
+	segments := strings.Split(path, "/")
+	var params []string
+	for i, seg := range segments {
+		if seg != "" && (seg[0] == ':' || seg[0] == '*') {
+			params = append(params, seg[1:])
+			segments[i] = "{" + seg[1:] + "}"
+		}
+	}
+
+	return strings.Join(segments, "/"), params
+}
+
+// openAPITag is the first segment of the path, before any custom method.
+func openAPITag(path string) string {
+	seg, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	seg, _, _ = strings.Cut(seg, ":")
+
+	return seg
+}
+
+// openAPIParameters returns the path parameters, described by the fields tagged
+// `param` of the request when it has them, followed by its query parameters.
+func openAPIParameters(req reflect.Type, pathParams []string) []openAPIParameter {
+	declared := make(map[string]jsonSchema)
+	var params []openAPIParameter
+
+	eachField(req, func(sf reflect.StructField) {
+		if name := tagName(sf, "param"); name != "" {
+			declared[name] = schemaOf(sf.Type, map[reflect.Type]bool{})
+		}
+
+		if name := tagName(sf, "query"); name != "" {
+			params = append(params, openAPIParameter{
+				Name:     name,
+				In:       "query",
+				Required: isRequired(sf),
+				Schema:   schemaOf(sf.Type, map[reflect.Type]bool{}),
+			})
+		}
+	})
+
+	path := make([]openAPIParameter, 0, len(pathParams)+len(params))
+	for _, name := range pathParams {
+		schema, ok := declared[name]
+		if !ok {
+			schema = jsonSchema{"type": "string"}
+		}
+
+		path = append(path, openAPIParameter{Name: name, In: "path", Required: true, Schema: schema})
+	}
+
+	if len(path)+len(params) == 0 {
+		return nil
+	}
+
+	return append(path, params...)
+}
+
+// openAPIBody describes the fields of the request that are neither query nor
+// path parameters, nil when there is none.
+func openAPIBody(req reflect.Type, mediaType string) *openAPIRequestBody {
+	if req == nil {
+		return nil
+	}
+
+	if mediaType == "" {
+		mediaType = "application/json"
+	}
+
+	tag := "json"
+	if mediaType == "multipart/form-data" || mediaType == "application/x-www-form-urlencoded" {
+		tag = "form"
+	}
+
+	for req.Kind() == reflect.Pointer {
+		req = req.Elem()
+	}
+
+	if req.Kind() != reflect.Struct {
+		return &openAPIRequestBody{
+			Required: true,
+			Content:  map[string]openAPIMediaType{mediaType: {Schema: schemaOf(req, map[reflect.Type]bool{})}},
+		}
+	}
+
+	schema := structSchema(req, tag, map[reflect.Type]bool{})
+	if props, _ := schema["properties"].(jsonSchema); len(props) == 0 {
+		return nil
+	}
+
+	return &openAPIRequestBody{Required: true, Content: map[string]openAPIMediaType{mediaType: {Schema: schema}}}
+}
+
+// openAPISuccess describes the successful response of the route, in the
+// envelope of the router unless the route writes its own media type.
+func openAPISuccess(d *RouteDoc) (int, openAPIResponse) {
+	status := d.Status
+	if status == 0 {
+		status = http.StatusOK
+		if d.Response == nil && d.ResponseType == "" {
+			status = http.StatusNoContent
+		}
+	}
+
+	resp := openAPIResponse{Description: http.StatusText(status)}
+
+	switch {
+	case d.ResponseType != "":
+		resp.Content = map[string]openAPIMediaType{
+			d.ResponseType: {Schema: schemaOf(reflect.TypeOf(d.Response), map[reflect.Type]bool{})},
+		}
+	case d.Response != nil:
+		resp.Content = map[string]openAPIMediaType{
+			"application/json": {Schema: jsonSchema{
+				"type": "object",
+				"properties": jsonSchema{
+					"message": jsonSchema{"type": "string"},
+					"data":    schemaOf(reflect.TypeOf(d.Response), map[reflect.Type]bool{}),
+				},
+				"required": []string{"message", "data"},
+			}},
+		}
+	}
+
+	return status, resp
+}
+
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	fileHeaderType    = reflect.TypeFor[multipart.FileHeader]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// schemaOf describes how encoding/json writes a value of t. visiting holds the
+// structs being described, a type referring to itself is left open.
+func schemaOf(t reflect.Type, visiting map[reflect.Type]bool) jsonSchema {
+	switch {
+	case t == nil:
+		return jsonSchema{}
+	case t == timeType:
+		return jsonSchema{"type": "string", "format": "date-time"}
+	case t == fileHeaderType:
+		return jsonSchema{"type": "string", "format": "binary"}
+	case t.Kind() == reflect.Pointer:
+		return nullable(schemaOf(t.Elem(), visiting))
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return jsonSchema{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return jsonSchema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return jsonSchema{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return jsonSchema{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return jsonSchema{"type": "number"}
+	case reflect.String:
+		return jsonSchema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return jsonSchema{"type": "string", "contentEncoding": "base64"}
+		}
+
+		return jsonSchema{"type": "array", "items": schemaOf(t.Elem(), visiting)}
+	case reflect.Map:
+		return jsonSchema{"type": "object", "additionalProperties": schemaOf(t.Elem(), visiting)}
+	case reflect.Struct:
+		return structSchema(t, "json", visiting)
+	default:
+		return jsonSchema{}
+	}
+}
+
+// structSchema describes the fields of t named by tag, the `query` and `param`
+// fields are parameters of a request rather than properties.
+func structSchema(t reflect.Type, tag string, visiting map[reflect.Type]bool) jsonSchema {
+	if visiting[t] {
+		return jsonSchema{}
+	}
+
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	props := jsonSchema{}
+	required := []string{}
+	eachField(t, func(sf reflect.StructField) {
+		if tagName(sf, "query") != "" || tagName(sf, "param") != "" {
+			return
+		}
+
+		name, opts, _ := strings.Cut(sf.Tag.Get(tag), ",")
+		switch {
+		case name == "-":
+			return
+		case name == "" && tag != "json":
+			return
+		case name == "":
+			name = sf.Name
+		}
+
+		schema := schemaOf(sf.Type, visiting)
+		if slices.Contains(strings.Split(opts, ","), "string") {
+			schema = jsonSchema{"type": "string"}
+		}
+
+		props[name] = schema
+		if isRequired(sf) {
+			required = append(required, name)
+		}
+	})
+
+	schema := jsonSchema{"type": "object", "properties": props}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// eachField calls fn with the exported fields of the struct t, the embedded
+// structs are walked as if their fields were declared on t, like Bind does.
+func eachField(t reflect.Type, fn func(reflect.StructField)) {
+	if t == nil {
+		return
+	}
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct && sf.Tag.Get("json") == "" {
+			eachField(sf.Type, fn)
+
+			continue
+		}
+
+		if sf.IsExported() {
+			fn(sf)
+		}
+	}
+}
+
+// isRequired reports whether the validator of the router requires the field.
+func isRequired(sf reflect.StructField) bool {
+	return slices.Contains(strings.Split(sf.Tag.Get("validate"), ","), "required")
+}
+
+func nullable(s jsonSchema) jsonSchema {
+	if typ, ok := s["type"].(string); ok {
+		s["type"] = []string{typ, "null"}
+	}
+
+	return s
+}
@@ -0,0 +1,200 @@
+package framework
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type openAPIItem struct {
+	ID        uint64     `json:"id,string"`
+	Name      string     `json:"name" validate:"required"`
+	DueAt     *time.Time `json:"due_at"`
+	Tags      []string   `json:"tags,omitempty"`
+	Secret    string     `json:"-"`
+	Children  []openAPIItem
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type openAPIFetch struct {
+	ID    uint64   `param:"id"`
+	Limit int      `query:"limit" validate:"required"`
+	Tags  []string `query:"tags"`
+}
+
+type openAPIUpload struct {
+	File *multipart.FileHeader `form:"file"`
+	Note string                `form:"note"`
+}
+
+func Test_schemaOf(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{name: "Nil", v: nil, want: `{}`},
+		{name: "Integer", v: int64(0), want: `{"type":"integer"}`},
+		{name: "Unsigned", v: uint(0), want: `{"minimum":0,"type":"integer"}`},
+		{name: "Bytes", v: []byte{}, want: `{"contentEncoding":"base64","type":"string"}`},
+		{name: "Map", v: map[string]bool{}, want: `{"additionalProperties":{"type":"boolean"},"type":"object"}`},
+		{name: "Time", v: time.Time{}, want: `{"format":"date-time","type":"string"}`},
+		{name: "Nullable", v: new(float64), want: `{"type":["number","null"]}`},
+		{
+			name: "Struct",
+			v:    openAPIItem{},
+			want: `{"properties":{` +
+				`"Children":{"items":{},"type":"array"},` +
+				`"created_at":{"format":"date-time","type":"string"},` +
+				`"due_at":{"format":"date-time","type":["string","null"]},` +
+				`"id":{"type":"string"},` +
+				`"name":{"type":"string"},` +
+				`"tags":{"items":{"type":"string"},"type":"array"}},` +
+				`"required":["name"],"type":"object"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := json.Marshal(schemaOf(reflect.TypeOf(tt.v), map[reflect.Type]bool{}))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestRouter_OpenAPI(t *testing.T) {
+	r := NewRouter()
+	items := r.Group("/items")
+	items.Doc(RouteDoc{Summary: "Fetch an item", Request: openAPIFetch{}, Response: openAPIItem{}}).
+		Endpoint(http.MethodGet, "/:id", func(Context) (any, error) { return nil, nil })
+	items.Public().Doc(RouteDoc{Request: openAPIUpload{}, RequestType: "multipart/form-data", Status: 201}).
+		Endpoint(http.MethodPost, "/:id/files/*name", func(Context) (any, error) { return nil, nil })
+	items.Permission("item.write").Doc(RouteDoc{Tags: []string{"batch"}}).
+		Endpoint(http.MethodPost, ":batch", func(Context) (any, error) { return nil, nil })
+	r.Doc(RouteDoc{ResponseType: "text/event-stream"}).
+		HandleFunc(http.MethodGet, "/events", func(http.ResponseWriter, *http.Request) {})
+	r.HandleFunc(http.MethodGet, "/undocumented", func(http.ResponseWriter, *http.Request) {})
+
+	assert.Equal(t, []Route{{Method: http.MethodGet, Path: "/undocumented"}}, r.Undocumented())
+
+	raw, err := r.OpenAPI(OpenAPIInfo{Title: "test", Version: "1"})
+	require.NoError(t, err)
+
+	var doc struct {
+		OpenAPI string                                `json:"openapi"`
+		Paths   map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &doc))
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+	assert.Len(t, doc.Paths, 4)
+
+	assert.JSONEq(t, `{
+		"summary": "Fetch an item",
+		"tags": ["items"],
+		"parameters": [
+			{"name": "id", "in": "path", "required": true, "schema": {"type": "integer", "minimum": 0}},
+			{"name": "limit", "in": "query", "required": true, "schema": {"type": "integer"}},
+			{"name": "tags", "in": "query", "schema": {"type": "array", "items": {"type": "string"}}}
+		],
+		"responses": {
+			"default": {"$ref": "#/components/responses/Error"},
+			"200": {"description": "OK", "content": {"application/json": {"schema": {
+				"type": "object",
+				"properties": {"message": {"type": "string"}, "data": {"$ref": "#/items"}},
+				"required": ["message", "data"]
+			}}}}
+		},
+		"security": [{"bearerAuth": []}]
+	}`, replaceData(t, doc.Paths["/items/{id}"]["get"]))
+
+	assert.JSONEq(t, `{
+		"tags": ["items"],
+		"parameters": [
+			{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}},
+			{"name": "name", "in": "path", "required": true, "schema": {"type": "string"}}
+		],
+		"requestBody": {"required": true, "content": {"multipart/form-data": {"schema": {
+			"type": "object",
+			"properties": {"file": {"type": ["string", "null"], "format": "binary"}, "note": {"type": "string"}}
+		}}}},
+		"responses": {
+			"default": {"$ref": "#/components/responses/Error"},
+			"201": {"description": "Created"}
+		}
+	}`, string(doc.Paths["/items/{id}/files/{name}"]["post"]))
+
+	assert.JSONEq(t, `{
+		"tags": ["batch"],
+		"responses": {
+			"default": {"$ref": "#/components/responses/Error"},
+			"204": {"description": "No Content"}
+		},
+		"security": [{"bearerAuth": []}],
+		"x-permission": "item.write"
+	}`, string(doc.Paths["/items:batch"]["post"]))
+
+	assert.JSONEq(t, `{
+		"tags": ["events"],
+		"responses": {
+			"default": {"$ref": "#/components/responses/Error"},
+			"200": {"description": "OK", "content": {"text/event-stream": {"schema": {}}}}
+		},
+		"security": [{"bearerAuth": []}]
+	}`, string(doc.Paths["/events"]["get"]))
+}
+
+// replaceData swaps the schema of the data of a response for a reference, the
+// schemas of the types themselves are covered by Test_schemaOf.
+func replaceData(t *testing.T, raw json.RawMessage) string {
+	t.Helper()
+
+	var op map[string]any
+	require.NoError(t, json.Unmarshal(raw, &op))
+
+	resp := op["responses"].(map[string]any)["200"].(map[string]any)
+	schema := resp["content"].(map[string]any)["application/json"].(map[string]any)["schema"].(map[string]any)
+	schema["properties"].(map[string]any)["data"] = map[string]any{"$ref": "#/items"}
+
+	out, err := json.Marshal(op)
+	require.NoError(t, err)
+
+	return string(out)
+}
+
+func TestRouter_OpenAPIHandler(t *testing.T) {
+	noop := func(http.ResponseWriter, *http.Request) {}
+	r := NewRouter()
+	r.Doc(RouteDoc{Summary: "Health"}).HandleFunc(http.MethodGet, "/health", noop)
+	h := r.OpenAPIHandler(OpenAPIInfo{Title: "test", Version: "1"})
+
+	// routes registered after the handler are in the document
+	r.Doc(RouteDoc{Summary: "Late"}).HandleFunc(http.MethodGet, "/late", noop)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), `"/late"`)
+	assert.Contains(t, rec.Body.String(), `"info":{"title":"test","version":"1"}`)
+}
+
+func TestOpenAPIDocsHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	h := OpenAPIDocsHandler("API <docs>", "/openapi.json")
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), `<title>API &lt;docs&gt;</title>`)
+	assert.Contains(t, rec.Body.String(), `<redoc spec-url="/openapi.json"></redoc>`)
+	assert.Contains(t, rec.Header().Get("Content-Security-Policy"), "script-src https://cdn.jsdelivr.net")
+}
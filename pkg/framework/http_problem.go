@@ -0,0 +1,148 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MediaTypeProblem is the media type of an RFC 9457 problem detail.
+const MediaTypeProblem = "application/problem+json"
+
+// Problem is an RFC 9457 problem detail, the error format of the framework
+// once ProblemDetails is in the chain.
+type Problem struct {
+	Type      string            `json:"type"`
+	Title     string            `json:"title"`
+	Status    int               `json:"status"`
+	Detail    string            `json:"detail,omitempty"`
+	Instance  string            `json:"instance,omitempty"`
+	TraceID   string            `json:"trace_id,omitempty"`
+	RequestID string            `json:"request_id,omitempty"`
+	Errors    map[string]string `json:"errors,omitempty"`
+}
+
+// problemTypes names the problem type of every goerror code, the type URI is
+// the base given to ProblemDetails followed by the name.
+var problemTypes = map[goerror.Code]string{
+	goerror.CodeInvalidFormat: "invalid-format",
+	goerror.CodeInvalidInput:  "invalid-input",
+	goerror.CodeNotFound:      "not-found",
+	goerror.CodeConflict:      "conflict",
+	goerror.CodeUnauthorized:  "unauthorized",
+	goerror.CodeForbidden:     "forbidden",
+	goerror.CodeTimeout:       "timeout",
+	goerror.CodeInternal:      "internal",
+}
+
+type problemKey struct{}
+
+type problemConfig struct {
+	typeBase string
+}
+
+// ProblemDetails returns a middleware that switches every error the framework
+// writes for the request, from the router, the middlewares and the GraphQL POST
+// transport, to application/problem+json. typeBase prefixes the type URI of the
+// problems, like https://gostarter.dev/problems, without it the type is
+// about:blank.
+//
+// Without the middleware the errors keep their former shape, a client can still
+// opt in per request by accepting application/problem+json, which lets the
+// clients migrate one by one. Put it first in the chain, before Recovery, so
+// that a panic is reported as a problem too.
+func ProblemDetails(typeBase string) Middleware {
+	cfg := problemConfig{typeBase: strings.TrimSuffix(typeBase, "/")}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), problemKey{}, cfg)))
+		})
+	}
+}
+
+// problemEnabled reports whether the errors of the request are written as problems.
+func problemEnabled(r *http.Request) (problemConfig, bool) {
+	if cfg, ok := r.Context().Value(problemKey{}).(problemConfig); ok {
+		return cfg, true
+	}
+
+	for _, ar := range parseAccept(r.Header.Get("Accept")) {
+		if ar.typ+"/"+ar.subtype == MediaTypeProblem && ar.q > 0 {
+			return problemConfig{}, true
+		}
+	}
+
+	return problemConfig{}, false
+}
+
+func newProblem(r *http.Request, cfg problemConfig, status int, code goerror.Code, detail string) Problem {
+	p := Problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    detail,
+		Instance:  r.URL.Path,
+		RequestID: lib.GetRequestID(r.Context()),
+	}
+
+	if name, ok := problemTypes[code]; ok && cfg.typeBase != "" {
+		p.Type = cfg.typeBase + "/" + name
+	}
+
+	if sc := trace.SpanContextFromContext(r.Context()); sc.HasTraceID() {
+		p.TraceID = sc.TraceID().String()
+	}
+
+	return p
+}
+
+// problemFromError builds the problem of an error returned by a handler, an
+// error that is not a goerror is an internal one and its message is not shown.
+func problemFromError(r *http.Request, cfg problemConfig, err error) Problem {
+	var gerr *goerror.GoError
+	if !errors.As(err, &gerr) {
+		return newProblem(r, cfg, http.StatusInternalServerError, goerror.CodeInternal, "Internal server error")
+	}
+
+	p := newProblem(r, cfg, gerr.StatusCode(), gerr.Code(), gerr.Msg())
+
+	if errs, ok := validation.AsV10Validator(gerr.Unwrap()); ok {
+		p.Errors = errs.Values()
+	}
+
+	var fieldErrs FieldErrors
+	if errors.As(gerr.Unwrap(), &fieldErrs) {
+		p.Errors = fieldErrs
+	}
+
+	return p
+}
+
+func writeProblem(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", MediaTypeProblem)
+	w.WriteHeader(p.Status)
+	if err := json.NewEncoder(w).Encode(p); err != nil {
+		log.Println("json.NewEncoder(w).Encode(p)", err)
+	}
+}
+
+// writeError writes an error raised by the framework itself, as a problem when
+// the request asked for it, otherwise in the former {message} shape.
+func writeError(w http.ResponseWriter, r *http.Request, status int, code goerror.Code, msg string) {
+	if cfg, ok := problemEnabled(r); ok {
+		writeProblem(w, newProblem(r, cfg, status, code, msg))
+
+		return
+	}
+
+	writeJSON(w, errorResponse{Message: msg, RequestID: lib.GetRequestID(r.Context())}, status)
+}
@@ -0,0 +1,219 @@
+package framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_problemEnabled(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     func() *http.Request
+		wantCfg problemConfig
+		wantOk  bool
+	}{
+		{
+			name:    "Disabled",
+			req:     func() *http.Request { return httptest.NewRequest(http.MethodGet, "/", nil) },
+			wantCfg: problemConfig{},
+			wantOk:  false,
+		},
+		{
+			name: "AcceptExcluded",
+			req: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "/", nil)
+				r.Header.Set("Accept", "application/json, application/problem+json;q=0")
+
+				return r
+			},
+			wantCfg: problemConfig{},
+			wantOk:  false,
+		},
+		{
+			name: "Accept",
+			req: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "/", nil)
+				r.Header.Set("Accept", "application/json, application/problem+json")
+
+				return r
+			},
+			wantCfg: problemConfig{},
+			wantOk:  true,
+		},
+		{
+			name: "Middleware",
+			req: func() *http.Request {
+				var got *http.Request
+				h := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) { got = r })
+				ProblemDetails("https://example.com/problems/")(h).
+					ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+				return got
+			},
+			wantCfg: problemConfig{typeBase: "https://example.com/problems"},
+			wantOk:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg, ok := problemEnabled(tt.req())
+			assert.Equal(t, tt.wantCfg, cfg)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
+
+func Test_problemFromError(t *testing.T) {
+	cfg := problemConfig{typeBase: "https://example.com/problems"}
+	traceID := trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{1}})
+
+	fieldErr := goerror.NewInvalidInput("Invalid request payload", FieldErrors{"age": "must be an integer"})
+
+	tests := []struct {
+		name string
+		err  error
+		want Problem
+	}{
+		{
+			name: "NotGoError",
+			err:  assert.AnError,
+			want: Problem{
+				Type:     "https://example.com/problems/internal",
+				Title:    "Internal Server Error",
+				Status:   http.StatusInternalServerError,
+				Detail:   "Internal server error",
+				Instance: "/users/1",
+				TraceID:  traceID.String(),
+			},
+		},
+		{
+			name: "FieldErrors",
+			err:  fieldErr,
+			want: Problem{
+				Type:     "https://example.com/problems/invalid-input",
+				Title:    http.StatusText(fieldErr.StatusCode()),
+				Status:   fieldErr.StatusCode(),
+				Detail:   "Invalid request payload",
+				Instance: "/users/1",
+				TraceID:  traceID.String(),
+				Errors:   map[string]string{"age": "must be an integer"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+			r = r.WithContext(trace.ContextWithSpanContext(r.Context(), sc))
+
+			assert.Equal(t, tt.want, problemFromError(r, cfg, tt.err))
+		})
+	}
+}
+
+func TestProblemDetails(t *testing.T) {
+	r := NewRouter()
+	r.Endpoint(http.MethodGet, "/users/:id", func(Context) (any, error) {
+		return nil, assert.AnError
+	})
+	r.HandleFunc(http.MethodGet, "/panic", func(http.ResponseWriter, *http.Request) {
+		panic("something went wrong")
+	})
+	h := Chain(r, ProblemDetails(""), Recovery)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		accept     string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "HandlerError",
+			method:     http.MethodGet,
+			path:       "/users/1",
+			wantStatus: http.StatusInternalServerError,
+			wantBody: `{"type":"about:blank","title":"Internal Server Error","status":500,` +
+				`"detail":"Internal server error","instance":"/users/1"}` + "\n",
+		},
+		{
+			name:       "NotFound",
+			method:     http.MethodGet,
+			path:       "/unknown",
+			wantStatus: http.StatusNotFound,
+			wantBody: `{"type":"about:blank","title":"Not Found","status":404,` +
+				`"detail":"endpoint not found","instance":"/unknown"}` + "\n",
+		},
+		{
+			name:       "MethodNotAllowed",
+			method:     http.MethodPost,
+			path:       "/users/1",
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody: `{"type":"about:blank","title":"Method Not Allowed","status":405,` +
+				`"detail":"method not allowed","instance":"/users/1"}` + "\n",
+		},
+		{
+			name:       "NotAcceptable",
+			method:     http.MethodGet,
+			path:       "/users/1",
+			accept:     "text/html",
+			wantStatus: http.StatusNotAcceptable,
+			wantBody: `{"type":"about:blank","title":"Not Acceptable","status":406,` +
+				`"detail":"none of the accepted media types can be produced","instance":"/users/1"}` + "\n",
+		},
+		{
+			name:       "Panic",
+			method:     http.MethodGet,
+			path:       "/panic",
+			wantStatus: http.StatusInternalServerError,
+			wantBody: `{"type":"about:blank","title":"Internal Server Error","status":500,` +
+				`"detail":"Internal server error","instance":"/panic"}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, MediaTypeProblem, rec.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
+
+func TestProblemDetails_AcceptOptIn(t *testing.T) {
+	r := NewRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/unknown", nil)
+	req.Header.Set("Accept", MediaTypeProblem)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	assert.Equal(t, MediaTypeProblem, rec.Header().Get("Content-Type"))
+
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+	assert.Equal(t, `{"message":"endpoint not found"}`+"\n", rec.Body.String())
+	r.Endpoint(http.MethodGet, "/ok", func(Context) (any, error) {
+		return map[string]string{"a": "b"}, nil
+	})
+	req = httptest.NewRequest(http.MethodGet, "/ok", nil)
+	req.Header.Set("Accept", MediaTypeProblem)
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json; charset=utf-8", rec.Header().Get("Content-Type"))
+}
@@ -0,0 +1,320 @@
+package framework
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shandysiswandi/goreng/goerror"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+)
+
+// HeaderAPIKey is the request header carrying the API key of a client.
+const HeaderAPIKey = "X-API-Key"
+
+// DefaultRateClass is the rate limit class of the routes without one.
+const DefaultRateClass = "default"
+
+// Rate is how many requests a client may make in a window.
+type Rate struct {
+	Limit  int
+	Window time.Duration
+}
+
+// ParseRate parses a rate written as limit/window, like 100/1m.
+func ParseRate(s string) (Rate, error) {
+	limit, window, ok := strings.Cut(s, "/")
+	if !ok {
+		return Rate{}, fmt.Errorf("framework: rate %q is not limit/window", s)
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(limit))
+	if err != nil || n < 1 {
+		return Rate{}, fmt.Errorf("framework: rate %q has an invalid limit", s)
+	}
+
+	d, err := time.ParseDuration(strings.TrimSpace(window))
+	if err != nil || d <= 0 {
+		return Rate{}, fmt.Errorf("framework: rate %q has an invalid window", s)
+	}
+
+	return Rate{Limit: n, Window: d}, nil
+}
+
+// RateLimitResult is the decision of a RateLimiter and the state of the key after it.
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration // Until the current window ends.
+	RetryAfter time.Duration // Until a request is allowed again, zero when allowed.
+}
+
+// RateLimiter counts the requests of a key in a sliding window and tells whether
+// one more is allowed. A request that is not allowed is not counted.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, rate Rate) (RateLimitResult, error)
+}
+
+// RateLimitIdentity is what a request is known by when its rate is limited.
+type RateLimitIdentity struct {
+	IP     string
+	AuthID uint64
+	APIKey string // Only once RateLimitConfig.APIKey accepted it.
+}
+
+// RateLimitByIP counts the requests of a client address.
+func RateLimitByIP(id RateLimitIdentity) string {
+	return "ip:" + id.IP
+}
+
+// RateLimitByAuthID counts the requests of a user, a request without a token is not limited.
+func RateLimitByAuthID(id RateLimitIdentity) string {
+	if id.AuthID == 0 {
+		return ""
+	}
+
+	return "auth:" + strconv.FormatUint(id.AuthID, 10)
+}
+
+// RateLimitByAPIKey counts the requests of an API key, a request without one is
+// not limited. The key is hashed so it is never written to the store.
+func RateLimitByAPIKey(id RateLimitIdentity) string {
+	if id.APIKey == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(id.APIKey))
+
+	return "key:" + hex.EncodeToString(sum[:16])
+}
+
+// RateLimitByIdentity counts the requests of the authenticated API key, else of
+// the user, else of the client address.
+func RateLimitByIdentity(id RateLimitIdentity) string {
+	if key := RateLimitByAPIKey(id); key != "" {
+		return key
+	}
+
+	if key := RateLimitByAuthID(id); key != "" {
+		return key
+	}
+
+	return RateLimitByIP(id)
+}
+
+// RateLimitConfig configures RateLimit and UnaryServerRateLimit.
+type RateLimitConfig struct {
+	Limiter RateLimiter
+	// Rates is keyed by rate limit class. A route whose class has no rate is
+	// limited by the DefaultRateClass one, without it the route is not limited.
+	Rates map[string]Rate
+	// Methods is the class of the gRPC methods, keyed by a prefix of their full
+	// method name, the longest prefix wins.
+	Methods map[string]string
+	// Key tells who a request is counted for, RateLimitByIdentity when nil.
+	Key func(RateLimitIdentity) string
+	// APIKey reports whether the API key sent by a client is a valid one. A key
+	// is only counted on its own once accepted, else anyone could send a new key
+	// with every request to never be limited. Without it API keys are ignored.
+	APIKey func(ctx context.Context, key string) bool
+}
+
+func (cfg RateLimitConfig) rate(class string) (string, Rate, bool) {
+	if rate, ok := cfg.Rates[class]; ok {
+		return class, rate, true
+	}
+
+	rate, ok := cfg.Rates[DefaultRateClass]
+
+	return DefaultRateClass, rate, ok
+}
+
+// identity is the identity of a request from ip, sending apiKey.
+func (cfg RateLimitConfig) identity(ctx context.Context, ip, apiKey string) RateLimitIdentity {
+	id := RateLimitIdentity{IP: ip}
+	if apiKey != "" && cfg.APIKey != nil && cfg.APIKey(ctx, apiKey) {
+		id.APIKey = apiKey
+	}
+
+	if clm := lib.GetJWTClaim(ctx); clm != nil {
+		id.AuthID = clm.AuthID
+	}
+
+	return id
+}
+
+func (cfg RateLimitConfig) key(id RateLimitIdentity) string {
+	if cfg.Key == nil {
+		return RateLimitByIdentity(id)
+	}
+
+	return cfg.Key(id)
+}
+
+// allow asks the limiter about a request of the class, ok is false when the
+// request is not limited. The limiter failing lets the request through, an
+// unreachable store must not take the API down with it.
+func (cfg RateLimitConfig) allow(ctx context.Context, class string, id RateLimitIdentity) (
+	rate Rate, res RateLimitResult, ok bool,
+) {
+	class, rate, ok = cfg.rate(class)
+	if !ok {
+		return rate, res, false
+	}
+
+	key := cfg.key(id)
+	if key == "" {
+		return rate, res, false
+	}
+
+	res, err := cfg.Limiter.Allow(ctx, class+":"+key, rate)
+	if err != nil {
+		log.Println("rate limiter allow", err)
+
+		return rate, res, false
+	}
+
+	return rate, res, true
+}
+
+// RateLimit returns a middleware that limits the requests of every client by the
+// rate of the class of the route, see Group.RateLimit. Used as a middleware of
+// the server, where no route is matched yet, every request has the default class.
+//
+// The state of the limit is written in the RateLimit-Limit, RateLimit-Remaining,
+// RateLimit-Reset and RateLimit-Policy headers, a request over the limit is
+// rejected with 429 Too Many Requests and a Retry-After header.
+//
+// Clients are told apart by their token, so the middleware should be placed after JWT.
+func RateLimit(cfg RateLimitConfig) Middleware {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			meta, _ := RouteMetaFrom(r.Context())
+			id := cfg.identity(r.Context(), remoteIP(r.RemoteAddr), r.Header.Get(HeaderAPIKey))
+
+			rate, res, ok := cfg.allow(r.Context(), meta.RateLimit, id)
+			if !ok {
+				h.ServeHTTP(w, r)
+
+				return
+			}
+
+			for name, value := range rateLimitHeaders(rate, res) {
+				w.Header().Set(name, value)
+			}
+
+			if !res.Allowed {
+				writeError(w, r, http.StatusTooManyRequests, goerror.CodeUnknown, "too many requests")
+
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// rateLimitHeaders returns the headers telling a client the state of its limit,
+// shared by HTTP and the metadata of gRPC.
+func rateLimitHeaders(rate Rate, res RateLimitResult) map[string]string {
+	headers := map[string]string{
+		"RateLimit-Limit":     strconv.Itoa(res.Limit),
+		"RateLimit-Remaining": strconv.Itoa(res.Remaining),
+		"RateLimit-Reset":     seconds(res.Reset),
+		"RateLimit-Policy":    strconv.Itoa(rate.Limit) + ";w=" + seconds(rate.Window),
+	}
+
+	if !res.Allowed {
+		headers["Retry-After"] = seconds(res.RetryAfter)
+	}
+
+	return headers
+}
+
+// seconds rounds d up to whole seconds, a client waiting less would be refused again.
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
+
+func remoteIP(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
+
+// slidingWindow approximates a sliding window with the counts of the current and
+// the previous fixed windows, the previous one weighted by how much of it the
+// sliding window still covers. It is what the stores of RateLimiter share.
+type slidingWindow struct {
+	rate    Rate
+	index   int64         // Of the current fixed window since the Unix epoch.
+	elapsed time.Duration // Since the current fixed window started.
+}
+
+func newSlidingWindow(rate Rate, now time.Time) slidingWindow {
+	n, w := now.UnixNano(), rate.Window.Nanoseconds()
+
+	return slidingWindow{rate: rate, index: n / w, elapsed: time.Duration(n % w)}
+}
+
+// weight is the share of the previous fixed window the sliding window covers.
+func (sw slidingWindow) weight() float64 {
+	return 1 - float64(sw.elapsed)/float64(sw.rate.Window)
+}
+
+func (sw slidingWindow) count(prev, cur int64) int64 {
+	return int64(float64(prev)*sw.weight()) + cur
+}
+
+func (sw slidingWindow) allows(prev, cur int64) bool {
+	return sw.count(prev, cur) < int64(sw.rate.Limit)
+}
+
+// result reports the state of the key, cur counts the request when it is allowed.
+func (sw slidingWindow) result(prev, cur int64, allowed bool) RateLimitResult {
+	res := RateLimitResult{
+		Allowed:   allowed,
+		Limit:     sw.rate.Limit,
+		Remaining: max(sw.rate.Limit-int(sw.count(prev, cur)), 0),
+		Reset:     sw.rate.Window - sw.elapsed,
+	}
+
+	if !allowed {
+		res.RetryAfter = sw.retryAfter(prev, cur)
+	}
+
+	return res
+}
+
+// retryAfter is how long until the count of the sliding window drops under the limit.
+func (sw slidingWindow) retryAfter(prev, cur int64) time.Duration {
+	limit, window := float64(sw.rate.Limit), float64(sw.rate.Window)
+
+	// the previous window slides out while the current one stays as it is
+	if cur < int64(sw.rate.Limit) && prev > 0 {
+		at := window * (1 - (limit-float64(cur))/float64(prev))
+
+		return max(time.Duration(at)-sw.elapsed, 0)
+	}
+
+	// the current window has to become the previous one and slide out in turn
+	if cur == 0 {
+		return sw.rate.Window - sw.elapsed
+	}
+
+	at := window * (1 - limit/float64(cur))
+
+	return sw.rate.Window - sw.elapsed + time.Duration(at)
+}
@@ -0,0 +1,131 @@
+package framework
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const rateLimitRedisPrefix = "ratelimit:"
+
+// rateLimitSweepEvery is how often RateLimitMemory forgets the keys it no longer needs.
+const rateLimitSweepEvery = time.Minute
+
+var errRateLimitReply = errors.New("framework: unexpected rate limit reply")
+
+// RateLimitMemory is a RateLimiter keeping the counts in the memory of the
+// process, so every instance of a service limits on its own.
+type RateLimitMemory struct {
+	mu      sync.Mutex
+	windows map[string]*memoryWindow
+	sweepAt time.Time
+	now     func() time.Time
+}
+
+type memoryWindow struct {
+	index     int64
+	prev, cur int64
+	expiresAt time.Time // once the current window is no longer the previous one either
+}
+
+func NewRateLimitMemory() *RateLimitMemory {
+	return &RateLimitMemory{windows: make(map[string]*memoryWindow), now: time.Now}
+}
+
+func (rm *RateLimitMemory) Allow(_ context.Context, key string, rate Rate) (RateLimitResult, error) {
+	now := rm.now()
+	sw := newSlidingWindow(rate, now)
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	rm.sweep(now)
+
+	mw, ok := rm.windows[key]
+	if !ok {
+		mw = &memoryWindow{index: sw.index}
+		rm.windows[key] = mw
+	}
+
+	switch sw.index - mw.index {
+	case 0:
+	case 1:
+		mw.prev, mw.cur = mw.cur, 0
+	default:
+		mw.prev, mw.cur = 0, 0
+	}
+	mw.index = sw.index
+	mw.expiresAt = now.Add(2*rate.Window - sw.elapsed)
+
+	allowed := sw.allows(mw.prev, mw.cur)
+	if allowed {
+		mw.cur++
+	}
+
+	return sw.result(mw.prev, mw.cur, allowed), nil
+}
+
+func (rm *RateLimitMemory) sweep(now time.Time) {
+	if now.Before(rm.sweepAt) {
+		return
+	}
+
+	for key, mw := range rm.windows {
+		if !now.Before(mw.expiresAt) {
+			delete(rm.windows, key)
+		}
+	}
+
+	rm.sweepAt = now.Add(rateLimitSweepEvery)
+}
+
+// rateLimitScript counts a request in the current window of KEYS[1] when the
+// sliding count with the previous window KEYS[2] is under the limit ARGV[1].
+// ARGV[2] is the weight of the previous window and ARGV[3] the TTL of a window.
+var rateLimitScript = redis.NewScript(`
+local cur = tonumber(redis.call('GET', KEYS[1]) or '0')
+local prev = tonumber(redis.call('GET', KEYS[2]) or '0')
+if math.floor(prev * tonumber(ARGV[2])) + cur >= tonumber(ARGV[1]) then
+	return {0, prev, cur}
+end
+cur = redis.call('INCR', KEYS[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return {1, prev, cur}
+`)
+
+// RateLimitRedis is a RateLimiter backed by Redis, shared by every instance of
+// a service. Windows expire through the key TTL.
+type RateLimitRedis struct {
+	client *redis.Client
+	now    func() time.Time
+}
+
+func NewRateLimitRedis(client *redis.Client) *RateLimitRedis {
+	return &RateLimitRedis{client: client, now: time.Now}
+}
+
+func (rr *RateLimitRedis) Allow(ctx context.Context, key string, rate Rate) (RateLimitResult, error) {
+	sw := newSlidingWindow(rate, rr.now())
+
+	// the hash tag keeps both windows of a key in the same slot of a cluster
+	keys := []string{
+		rateLimitRedisPrefix + "{" + key + "}:" + strconv.FormatInt(sw.index, 10),
+		rateLimitRedisPrefix + "{" + key + "}:" + strconv.FormatInt(sw.index-1, 10),
+	}
+
+	reply, err := rateLimitScript.Run(ctx, rr.client, keys,
+		rate.Limit, sw.weight(), (2 * rate.Window).Milliseconds()).Int64Slice()
+	if err != nil {
+		return RateLimitResult{}, err
+	}
+
+	if len(reply) != 3 {
+		return RateLimitResult{}, errRateLimitReply
+	}
+
+	return sw.result(reply[1], reply[2], reply[0] == 1), nil
+}
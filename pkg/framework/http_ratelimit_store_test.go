@@ -0,0 +1,57 @@
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitMemory_Allow(t *testing.T) {
+	rate := Rate{Limit: 2, Window: time.Minute}
+	now := rateLimitNow
+	rm := NewRateLimitMemory()
+	rm.now = func() time.Time { return now }
+
+	allow := func() bool {
+		res, err := rm.Allow(context.Background(), "k", rate)
+		assert.NoError(t, err)
+
+		return res.Allowed
+	}
+
+	assert.True(t, allow())
+	assert.True(t, allow())
+	assert.False(t, allow())
+
+	// the two requests of the previous window still weigh a half each
+	now = now.Add(time.Minute)
+	assert.True(t, allow())
+	assert.False(t, allow())
+
+	// a window later the previous one is the window above
+	now = now.Add(time.Minute)
+	assert.True(t, allow())
+
+	// two windows later nothing is left
+	now = now.Add(2 * time.Minute)
+	assert.True(t, allow())
+	assert.True(t, allow())
+	assert.False(t, allow())
+}
+
+func TestRateLimitMemory_sweep(t *testing.T) {
+	now := rateLimitNow
+	rm := NewRateLimitMemory()
+	rm.now = func() time.Time { return now }
+
+	_, _ = rm.Allow(context.Background(), "short", Rate{Limit: 1, Window: time.Second})
+	_, _ = rm.Allow(context.Background(), "long", Rate{Limit: 1, Window: time.Hour})
+
+	now = now.Add(rateLimitSweepEvery)
+	_, _ = rm.Allow(context.Background(), "long", Rate{Limit: 1, Window: time.Hour})
+
+	assert.Len(t, rm.windows, 1)
+	assert.Contains(t, rm.windows, "long")
+}
@@ -0,0 +1,238 @@
+package framework
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+type rateLimiterFunc func(ctx context.Context, key string, rate Rate) (RateLimitResult, error)
+
+func (f rateLimiterFunc) Allow(ctx context.Context, key string, rate Rate) (RateLimitResult, error) {
+	return f(ctx, key, rate)
+}
+
+// rateLimitNow is 30 seconds into a one minute window.
+var rateLimitNow = time.Unix(1_699_999_980+30, 0)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Rate
+		wantErr bool
+	}{
+		{name: "ErrorNoWindow", s: "100", want: Rate{}, wantErr: true},
+		{name: "ErrorLimit", s: "0/1m", want: Rate{}, wantErr: true},
+		{name: "ErrorWindow", s: "100/minute", want: Rate{}, wantErr: true},
+		{name: "Success", s: "100 / 1m", want: Rate{Limit: 100, Window: time.Minute}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseRate(tt.s)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRateLimitIdentity(t *testing.T) {
+	id := RateLimitIdentity{IP: "192.0.2.1", AuthID: 7, APIKey: "secret"}
+
+	assert.Equal(t, "ip:192.0.2.1", RateLimitByIP(id))
+	assert.Equal(t, "auth:7", RateLimitByAuthID(id))
+	assert.Equal(t, "", RateLimitByAuthID(RateLimitIdentity{}))
+	assert.Equal(t, "key:2bb80d537b1da3e38bd30361aa855686", RateLimitByAPIKey(id))
+	assert.Equal(t, "", RateLimitByAPIKey(RateLimitIdentity{}))
+	assert.Equal(t, RateLimitByAPIKey(id), RateLimitByIdentity(id))
+	assert.Equal(t, "auth:7", RateLimitByIdentity(RateLimitIdentity{IP: "192.0.2.1", AuthID: 7}))
+	assert.Equal(t, "ip:192.0.2.1", RateLimitByIdentity(RateLimitIdentity{IP: "192.0.2.1"}))
+}
+
+func TestRateLimitConfig_identity(t *testing.T) {
+	valid := func(_ context.Context, key string) bool { return key == "secret" }
+	ctxJWT := lib.SetJWTClaim(context.Background(), lib.NewJWTClaim(7, "email", time.Time{}, nil))
+
+	tests := []struct {
+		name   string
+		cfg    RateLimitConfig
+		ctx    context.Context
+		apiKey string
+		want   RateLimitIdentity
+	}{
+		{
+			name:   "AcceptedKey",
+			cfg:    RateLimitConfig{APIKey: valid},
+			ctx:    context.Background(),
+			apiKey: "secret",
+			want:   RateLimitIdentity{IP: "192.0.2.1", APIKey: "secret"},
+		},
+		{
+			name:   "RefusedKey",
+			cfg:    RateLimitConfig{APIKey: valid},
+			ctx:    ctxJWT,
+			apiKey: "forged",
+			want:   RateLimitIdentity{IP: "192.0.2.1", AuthID: 7},
+		},
+		{
+			name:   "KeysNotChecked",
+			cfg:    RateLimitConfig{},
+			ctx:    context.Background(),
+			apiKey: "secret",
+			want:   RateLimitIdentity{IP: "192.0.2.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.cfg.identity(tt.ctx, "192.0.2.1", tt.apiKey))
+		})
+	}
+}
+
+func Test_slidingWindow_result(t *testing.T) {
+	sw := newSlidingWindow(Rate{Limit: 10, Window: time.Minute}, rateLimitNow)
+
+	tests := []struct {
+		name    string
+		prev    int64
+		cur     int64
+		allowed bool
+		want    RateLimitResult
+	}{
+		{
+			name:    "Allowed",
+			prev:    10,
+			cur:     5,
+			allowed: true,
+			want:    RateLimitResult{Allowed: true, Limit: 10, Remaining: 0, Reset: 30 * time.Second},
+		},
+		{
+			name:    "DeniedUntilPreviousSlidesOut",
+			prev:    10,
+			cur:     7,
+			allowed: false,
+			want: RateLimitResult{
+				Allowed:    false,
+				Limit:      10,
+				Remaining:  0,
+				Reset:      30 * time.Second,
+				RetryAfter: 12 * time.Second,
+			},
+		},
+		{
+			name:    "DeniedUntilCurrentSlidesOut",
+			prev:    0,
+			cur:     20,
+			allowed: false,
+			want: RateLimitResult{
+				Allowed:    false,
+				Limit:      10,
+				Remaining:  0,
+				Reset:      30 * time.Second,
+				RetryAfter: 60 * time.Second,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, sw.result(tt.prev, tt.cur, tt.allowed))
+		})
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	limiter := NewRateLimitMemory()
+	limiter.now = func() time.Time { return rateLimitNow }
+
+	r := NewRouter()
+	r.Use(RateLimit(RateLimitConfig{
+		Limiter: limiter,
+		Rates: map[string]Rate{
+			DefaultRateClass: {Limit: 2, Window: time.Minute},
+			"strict":         {Limit: 1, Window: time.Minute},
+		},
+		Key: RateLimitByIP,
+	}))
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+	r.HandleFunc(http.MethodGet, "/open", ok)
+	r.Group("").RateLimit("unknown").HandleFunc(http.MethodGet, "/unknown", ok)
+	r.Group("").RateLimit("strict").HandleFunc(http.MethodGet, "/strict", ok)
+
+	do := func(path, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := do("/open", "192.0.2.1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", rec.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "30", rec.Header().Get("RateLimit-Reset"))
+	assert.Equal(t, "2;w=60", rec.Header().Get("RateLimit-Policy"))
+	assert.Empty(t, rec.Header().Get("Retry-After"))
+
+	// a class without a rate shares the default one
+	assert.Equal(t, http.StatusOK, do("/unknown", "192.0.2.1").Code)
+
+	rec = do("/open", "192.0.2.1")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "30", rec.Header().Get("Retry-After"))
+	assert.Equal(t, `{"message":"too many requests"}`+"\n", rec.Body.String())
+
+	assert.Equal(t, http.StatusOK, do("/open", "192.0.2.2").Code)
+	assert.Equal(t, http.StatusOK, do("/strict", "192.0.2.1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, do("/strict", "192.0.2.1").Code)
+}
+
+func TestRateLimit_NotLimited(t *testing.T) {
+	failing := rateLimiterFunc(func(context.Context, string, Rate) (RateLimitResult, error) {
+		return RateLimitResult{}, assert.AnError
+	})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	rate := Rate{Limit: 1, Window: time.Second}
+
+	tests := []struct {
+		name string
+		cfg  RateLimitConfig
+	}{
+		{
+			name: "NoRate",
+			cfg:  RateLimitConfig{Limiter: failing, Rates: map[string]Rate{"strict": rate}},
+		},
+		{
+			name: "NoKey",
+			cfg: RateLimitConfig{
+				Limiter: failing,
+				Rates:   map[string]Rate{DefaultRateClass: rate},
+				Key:     RateLimitByAuthID,
+			},
+		},
+		{
+			name: "LimiterError",
+			cfg:  RateLimitConfig{Limiter: failing, Rates: map[string]Rate{DefaultRateClass: rate}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			RateLimit(tt.cfg)(ok).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Empty(t, rec.Header().Get("RateLimit-Limit"))
+		})
+	}
+}
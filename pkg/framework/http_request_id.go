@@ -0,0 +1,28 @@
+package framework
+
+import (
+	"net/http"
+
+	"github.com/shandysiswandi/gostarter/internal/lib"
+)
+
+// RequestID returns a middleware that gives every request an ID: the one in the
+// X-Request-ID header when the client sent a valid one, else a new one from gen.
+// The ID is put in the context, see lib.GetRequestID, echoed in the X-Request-ID
+// header of the response and written in the error responses of the framework.
+//
+// Put it first in the chain, before Recovery, so that every other middleware
+// sees the ID and a panic is reported with it too.
+func RequestID(gen func() string) Middleware {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(lib.HeaderRequestID)
+			if !lib.ValidRequestID(id) {
+				id = gen()
+			}
+
+			w.Header().Set(lib.HeaderRequestID, id)
+			h.ServeHTTP(w, r.WithContext(lib.SetRequestID(r.Context(), id)))
+		})
+	}
+}
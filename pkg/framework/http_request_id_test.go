@@ -0,0 +1,85 @@
+package framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{name: "Generated", header: "", want: "generated"},
+		{name: "TooLong", header: strings.Repeat("a", 129), want: "generated"},
+		{name: "Injected", header: "id\nforged log line", want: "generated"},
+		{name: "FromClient", header: "client-id", want: "client-id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			h := RequestID(func() string { return "generated" })(http.HandlerFunc(
+				func(_ http.ResponseWriter, r *http.Request) { got = lib.GetRequestID(r.Context()) }))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(lib.HeaderRequestID, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, rec.Header().Get(lib.HeaderRequestID))
+		})
+	}
+}
+
+func TestRequestID_ErrorResponse(t *testing.T) {
+	r := NewRouter()
+	r.Endpoint(http.MethodGet, "/fail", func(Context) (any, error) { return nil, assert.AnError })
+	h := Chain(r, RequestID(func() string { return "req-1" }))
+
+	tests := []struct {
+		name     string
+		path     string
+		accept   string
+		wantBody string
+	}{
+		{
+			name:     "NotFound",
+			path:     "/missing",
+			wantBody: `{"message":"endpoint not found","request_id":"req-1"}`,
+		},
+		{
+			name:     "Internal",
+			path:     "/fail",
+			wantBody: `{"message":"Internal server error","request_id":"req-1"}`,
+		},
+		{
+			name:   "Problem",
+			path:   "/fail",
+			accept: "application/json, " + MediaTypeProblem,
+			wantBody: `{"type":"about:blank","title":"Internal Server Error","status":500,` +
+				`"detail":"Internal server error","instance":"/fail","request_id":"req-1"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Accept", tt.accept)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
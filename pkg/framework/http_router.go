@@ -3,36 +3,78 @@ package framework
 import (
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"log"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/shandysiswandi/goreng/goerror"
 	"github.com/shandysiswandi/goreng/validation"
+	"github.com/shandysiswandi/gostarter/internal/lib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Handler defines the type for endpoint handlers with context, request, and response writer.
 type Handler func(Context) (any, error)
 
 type errorResponse struct {
-	Message string            `json:"message"`
-	Error   map[string]string `json:"error,omitempty"`
+	XMLName   xml.Name      `json:"-" xml:"response"`
+	Message   string        `json:"message" xml:"message"`
+	Error     fieldMessages `json:"error,omitempty" xml:"error,omitempty"`
+	RequestID string        `json:"request_id,omitempty" xml:"request_id,omitempty"`
+	status    *status.Status
 }
 
 type resultResponse struct {
-	Message string `json:"message"`
-	Data    any    `json:"data"`
+	XMLName xml.Name `json:"-" xml:"response"`
+	Message string   `json:"message" xml:"message"`
+	Data    any      `json:"data" xml:"data"`
+}
+
+// fieldMessages maps a field to its error, as XML it is a list of field elements.
+type fieldMessages map[string]string
+
+func (fm fieldMessages) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	names := make([]string, 0, len(fm))
+	for name := range fm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		field := xml.StartElement{
+			Name: xml.Name{Local: "field"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "name"}, Value: name}},
+		}
+		if err := e.EncodeElement(fm[name], field); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
 }
 
 type Router struct {
 	hr          *httprouter.Router
-	resultCodec func(context.Context, http.ResponseWriter, any)
-	errorCodec  func(context.Context, http.ResponseWriter, error)
+	customs     map[string]http.Handler // keyed by method and path
+	resultCodec func(http.ResponseWriter, *http.Request, []mediaCodec, any)
+	errorCodec  func(http.ResponseWriter, *http.Request, []mediaCodec, error)
+	codecs      []mediaCodec // in the order they were registered
+	bind        BindConfig
+	mws         []Middleware // run after a route is matched, see Use
+	routes      []Route      // in the order they were registered
 }
 
-func NewRouter() *Router {
-	return &Router{
+func NewRouter(opts ...RouterOption) *Router {
+	r := &Router{
 		hr: &httprouter.Router{
 			HandleMethodNotAllowed: true,
 			SaveMatchedRoutePath:   true,
@@ -41,40 +83,116 @@ func NewRouter() *Router {
 		},
 		resultCodec: defaultResultCodec,
 		errorCodec:  defaultErrorCodec,
+		codecs: []mediaCodec{
+			{mediaType: MediaTypeJSON, contentType: "application/json; charset=utf-8", codec: jsonCodec{}},
+		},
+		bind: BindConfig{maxBodySize: defaultMaxBodySize},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Use adds middlewares that run for every route once it is matched, so unlike the
+// middlewares of the server they can read the metadata of the route with
+// RouteMetaFrom. It panics when a route is already registered.
+func (r *Router) Use(mws ...Middleware) {
+	if len(r.routes) > 0 {
+		panic("framework: Use must be called before the routes are registered")
 	}
+
+	r.mws = append(r.mws, mws...)
+}
+
+// Routes returns the routes registered on the router, in the order they were registered.
+func (r *Router) Routes() []Route {
+	return append([]Route(nil), r.routes...)
 }
 
 func (r *Router) Endpoint(method, path string, h Handler, mws ...Middleware) {
-	r.hr.Handler(method, path, Chain(http.HandlerFunc(func(w http.ResponseWriter, rr *http.Request) {
+	r.handle(method, path, RouteMeta{}, nil, Chain(r.endpoint(h), mws...))
+}
+
+func (r *Router) endpoint(h Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, rr *http.Request) {
 		rr.Header.Set("X-Actual-Path", httprouter.ParamsFromContext(rr.Context()).MatchedRoutePath())
-		cc := &RouterCtx{r: rr}
+		cc := &RouterCtx{r: rr, bind: r.bind}
+
+		// refused before the handler runs, so nothing is done for a response no one can read
+		accepted := negotiate(r.codecs, rr.Header.Get("Accept"))
+		if len(accepted) == 0 {
+			writeNotAcceptable(w, rr)
+
+			return
+		}
+
+		if len(r.codecs) > 1 {
+			w.Header().Add("Vary", "Accept")
+		}
 
 		res, err := h(cc)
 		if err != nil {
-			r.errorCodec(rr.Context(), w, err)
+			r.errorCodec(w, rr, accepted, err)
 
 			return
 		}
 
-		r.resultCodec(rr.Context(), w, res)
-	}), mws...))
+		r.resultCodec(w, rr, accepted, res)
+	})
 }
 
 func (r *Router) HandleFunc(method, path string, handler http.HandlerFunc) {
-	r.hr.HandlerFunc(method, path, handler)
+	r.handle(method, path, RouteMeta{}, nil, handler)
 }
 
 func (r *Router) Handler(method, path string, handler http.Handler) {
-	r.hr.Handler(method, path, handler)
+	r.handle(method, path, RouteMeta{}, nil, handler)
 }
 
 func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if h, ok := r.customs[req.Method+" "+req.URL.Path]; ok {
+		params := httprouter.Params{{Key: httprouter.MatchedRoutePathParam, Value: req.URL.Path}}
+		h.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), httprouter.ParamsKey, params)))
+
+		return
+	}
+
 	r.hr.ServeHTTP(w, req)
 }
 
-// defaultResultCodec encodes successful responses into JSON format.
-// It sets the content type to JSON and writes the appropriate HTTP status code.
-func defaultResultCodec(_ context.Context, w http.ResponseWriter, data any) {
+func (r *Router) handle(method, path string, meta RouteMeta, doc *RouteDoc, handler http.Handler) {
+	r.routes = append(r.routes, Route{Method: method, Path: path, Meta: meta, Doc: doc})
+	handler = withRouteMeta(meta, Chain(handler, r.mws...))
+
+	if !isCustomMethod(path) {
+		r.hr.Handler(method, path, handler)
+
+		return
+	}
+
+	if r.customs == nil {
+		r.customs = make(map[string]http.Handler)
+	}
+
+	r.customs[method+" "+path] = handler
+}
+
+// isCustomMethod reports whether the path ends with a custom method, like
+// /todos:batch. httprouter reads that colon as the start of a parameter, so
+// these paths are matched as they are, which is only possible when there is no
+// parameter before the custom method.
+func isCustomMethod(path string) bool {
+	last := path[strings.LastIndexByte(path, '/')+1:]
+
+	return strings.IndexByte(last, ':') > 0 && !strings.ContainsAny(path[:len(path)-len(last)], ":*")
+}
+
+// defaultResultCodec encodes successful responses with the first accepted codec able to
+// represent them. It writes a 406 Not Acceptable when none of them is.
+func defaultResultCodec(w http.ResponseWriter, r *http.Request, accepted []mediaCodec, data any) {
 	code := http.StatusOK
 	if sc, ok := data.(interface {
 		StatusCode() int
@@ -95,38 +213,102 @@ func defaultResultCodec(_ context.Context, w http.ResponseWriter, data any) {
 		msg = m.Message()
 	}
 
-	writeJSON(w, resultResponse{Message: msg, Data: data}, code)
+	setLastModified(w, data)
+
+	if !writeNegotiated(w, accepted, resultResponse{Message: msg, Data: data}, code) {
+		writeNotAcceptable(w, r)
+	}
 }
 
-// defaultErrorCodec encodes error responses into JSON format.
-// It sets the content type to JSON and writes the appropriate HTTP status code.
-func defaultErrorCodec(_ context.Context, w http.ResponseWriter, err error) {
+// defaultErrorCodec encodes error responses with the first accepted codec able to
+// represent them. An error is never refused, it falls back to JSON. When the request
+// asks for problem details the error is written as application/problem+json instead.
+func defaultErrorCodec(w http.ResponseWriter, r *http.Request, accepted []mediaCodec, err error) {
+	if cfg, ok := problemEnabled(r); ok {
+		writeProblem(w, problemFromError(r, cfg, err))
+
+		return
+	}
+
 	var gerr *goerror.GoError
 	if !errors.As(err, &gerr) {
-		writeJSON(w, errorResponse{Message: "Internal server error"}, http.StatusInternalServerError)
+		errResp := errorResponse{
+			Message:   "Internal server error",
+			RequestID: lib.GetRequestID(r.Context()),
+			status:    status.New(codes.Internal, "Internal server error"),
+		}
+		if !writeNegotiated(w, accepted, errResp, http.StatusInternalServerError) {
+			writeJSON(w, errResp, http.StatusInternalServerError)
+		}
 
 		return
 	}
 
-	errResp := errorResponse{Message: gerr.Msg()}
+	errResp := errorResponse{
+		Message:   gerr.Msg(),
+		RequestID: lib.GetRequestID(r.Context()),
+		status:    gerr.GRPCStatus(),
+	}
 
 	if errs, ok := validation.AsV10Validator(gerr.Unwrap()); ok {
 		errResp.Error = errs.Values()
 	}
 
-	writeJSON(w, errResp, gerr.StatusCode())
+	var fieldErrs FieldErrors
+	if errors.As(gerr.Unwrap(), &fieldErrs) {
+		errResp.Error = fieldMessages(fieldErrs)
+	}
+
+	if !writeNegotiated(w, accepted, errResp, gerr.StatusCode()) {
+		writeJSON(w, errResp, gerr.StatusCode())
+	}
+}
+
+// writeNegotiated writes data with the first of the accepted codecs able to encode it,
+// it reports false when all of them said the data is unsupported.
+func writeNegotiated(w http.ResponseWriter, accepted []mediaCodec, data any, code int) bool {
+	for _, mc := range accepted {
+		body, err := mc.codec.Encode(data)
+		if errors.Is(err, errors.ErrUnsupported) {
+			continue
+		}
+
+		if err != nil {
+			log.Println("codec.Encode(data)", mc.mediaType, err)
+			writeJSON(w, errorResponse{Message: "Internal server error"}, http.StatusInternalServerError)
+
+			return true
+		}
+
+		w.Header().Set("Content-Type", mc.contentType)
+		w.WriteHeader(code)
+		if _, err := w.Write(body); err != nil {
+			log.Println("w.Write(body)", err)
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// writeNotAcceptable tells the client none of the media types it accepts can be produced,
+// the message is in JSON as there is no better choice.
+func writeNotAcceptable(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, http.StatusNotAcceptable, goerror.CodeUnknown,
+		"none of the accepted media types can be produced")
 }
 
 // defaultNotFound is the default handler for unregistered routes.
 // It responds with a 404 Not Found status and a JSON error message.
-func defaultNotFound(w http.ResponseWriter, _ *http.Request) {
-	writeJSON(w, errorResponse{Message: "endpoint not found"}, http.StatusNotFound)
+func defaultNotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, http.StatusNotFound, goerror.CodeNotFound, "endpoint not found")
 }
 
 // defaultMethodNotAllowed is the default handler for unsupported HTTP methods.
 // It responds with a 405 Method Not Allowed status and a JSON error message.
-func defaultMethodNotAllowed(w http.ResponseWriter, _ *http.Request) {
-	writeJSON(w, errorResponse{Message: "method not allowed"}, http.StatusMethodNotAllowed)
+func defaultMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, http.StatusMethodNotAllowed, goerror.CodeUnknown, "method not allowed")
 }
 
 // writeJSON is a utility function to write a JSON response to an http.ResponseWriter.
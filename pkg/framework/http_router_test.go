@@ -97,6 +97,30 @@ func TestRouter_Endpoint(t *testing.T) {
 				return NewRouter()
 			},
 		},
+		{
+			name: "SuccessCustomMethod",
+			args: args{
+				method: "POST",
+				path:   "/users:batch",
+				h: func(c Context) (any, error) {
+					if c.Header().Get("X-Actual-Path") != "/users:batch" {
+						return nil, goerror.NewServerInternal(assert.AnError)
+					}
+
+					return testResult{}, nil
+				},
+				body: nil,
+				mws:  nil,
+			},
+			wantStatusCode: 200,
+			mockFn: func(a args) *Router {
+				// the custom method lives next to a parameter of the same collection
+				r := NewRouter()
+				r.Endpoint("POST", "/users/:id/restore", func(Context) (any, error) { return nil, nil })
+
+				return r
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -225,3 +249,24 @@ func Test_writeJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_isCustomMethod(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want bool
+	}{
+		{name: "Static", path: "/users", want: false},
+		{name: "Parameter", path: "/users/:id", want: false},
+		{name: "CatchAll", path: "/files/*path", want: false},
+		{name: "CustomMethod", path: "/users:batch", want: true},
+		{name: "NestedCustomMethod", path: "/v1/users:batch", want: true},
+		{name: "CustomMethodAfterParameter", path: "/users/:id:archive", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, isCustomMethod(tt.path))
+		})
+	}
+}
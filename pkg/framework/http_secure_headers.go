@@ -0,0 +1,80 @@
+package framework
+
+import (
+	"cmp"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// The policies SecureHeaders sends when its config leaves them empty. An API
+// answers with data, so nothing in it may be loaded, framed or sniffed.
+const (
+	DefaultContentSecurityPolicy = "default-src 'none'; frame-ancestors 'none'"
+	DefaultFrameOptions          = "DENY"
+	DefaultReferrerPolicy        = "no-referrer"
+	DefaultHSTSMaxAge            = 365 * 24 * time.Hour
+)
+
+// SecureHeadersConfig configures SecureHeaders, the empty fields take the defaults.
+type SecureHeadersConfig struct {
+	// HSTSMaxAge is how long a browser keeps to HTTPS for the host, HSTS is not
+	// sent when negative. It is only sent on requests that came over HTTPS.
+	HSTSMaxAge            time.Duration
+	HSTSIncludeSubdomains bool
+	ContentSecurityPolicy string
+	FrameOptions          string
+	ReferrerPolicy        string
+}
+
+// SecureHeaders returns a middleware setting the headers that keep a browser from
+// misusing a response: Strict-Transport-Security, Content-Security-Policy,
+// X-Content-Type-Options, X-Frame-Options and Referrer-Policy.
+//
+// A handler serving a page may replace the Content-Security-Policy, see
+// ContentSecurityPolicy.
+func SecureHeaders(cfg SecureHeadersConfig) Middleware {
+	if cfg.HSTSMaxAge == 0 {
+		cfg.HSTSMaxAge = DefaultHSTSMaxAge
+	}
+
+	hsts := ""
+	if cfg.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.FormatInt(int64(cfg.HSTSMaxAge/time.Second), 10)
+		if cfg.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	headers := map[string]string{
+		"Content-Security-Policy": cmp.Or(cfg.ContentSecurityPolicy, DefaultContentSecurityPolicy),
+		"X-Content-Type-Options":  "nosniff",
+		"X-Frame-Options":         cmp.Or(cfg.FrameOptions, DefaultFrameOptions),
+		"Referrer-Policy":         cmp.Or(cfg.ReferrerPolicy, DefaultReferrerPolicy),
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for name, value := range headers {
+				w.Header().Set(name, value)
+			}
+
+			if hsts != "" && (r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https") {
+				w.Header().Set("Strict-Transport-Security", hsts)
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ContentSecurityPolicy returns a middleware replacing the Content-Security-Policy
+// of SecureHeaders, for a handler serving a page that loads scripts or styles.
+func ContentSecurityPolicy(policy string) Middleware {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Security-Policy", policy)
+			h.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package framework
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecureHeaders(t *testing.T) {
+	defaults := http.Header{
+		"Content-Security-Policy": {DefaultContentSecurityPolicy},
+		"X-Content-Type-Options":  {"nosniff"},
+		"X-Frame-Options":         {DefaultFrameOptions},
+		"Referrer-Policy":         {DefaultReferrerPolicy},
+	}
+	withHSTS := func(h http.Header, value string) http.Header {
+		h = h.Clone()
+		h.Set("Strict-Transport-Security", value)
+
+		return h
+	}
+
+	tests := []struct {
+		name   string
+		cfg    SecureHeadersConfig
+		tls    bool
+		header http.Header
+		want   http.Header
+	}{
+		{name: "Defaults", cfg: SecureHeadersConfig{}, want: defaults},
+		{name: "TLS", cfg: SecureHeadersConfig{}, tls: true, want: withHSTS(defaults, "max-age=31536000")},
+		{
+			name:   "ForwardedHTTPS",
+			cfg:    SecureHeadersConfig{HSTSMaxAge: time.Hour, HSTSIncludeSubdomains: true},
+			header: http.Header{"X-Forwarded-Proto": {"https"}},
+			want:   withHSTS(defaults, "max-age=3600; includeSubDomains"),
+		},
+		{name: "NoHSTS", cfg: SecureHeadersConfig{HSTSMaxAge: -1}, tls: true, want: defaults},
+		{
+			name: "Configured",
+			cfg: SecureHeadersConfig{
+				ContentSecurityPolicy: "default-src 'self'",
+				FrameOptions:          "SAMEORIGIN",
+				ReferrerPolicy:        "same-origin",
+			},
+			want: http.Header{
+				"Content-Security-Policy": {"default-src 'self'"},
+				"X-Content-Type-Options":  {"nosniff"},
+				"X-Frame-Options":         {"SAMEORIGIN"},
+				"Referrer-Policy":         {"same-origin"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := SecureHeaders(tt.cfg)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.tls {
+				req.TLS = &tls.ConnectionState{}
+			}
+			for name, values := range tt.header {
+				req.Header[name] = values
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Header())
+		})
+	}
+}
+
+func TestContentSecurityPolicy(t *testing.T) {
+	page := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	h := Chain(page, SecureHeaders(SecureHeadersConfig{}), ContentSecurityPolicy("script-src 'self'"))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/page", nil))
+
+	assert.Equal(t, "script-src 'self'", rec.Header().Get("Content-Security-Policy"))
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+}
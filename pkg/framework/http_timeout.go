@@ -0,0 +1,53 @@
+package framework
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+)
+
+// WriteTimeout returns a middleware giving the routes it wraps d to write their
+// response, instead of the WriteTimeout of the server. Zero lifts the deadline.
+//
+// A stream should rather keep a short deadline and push it back as it writes,
+// with http.ResponseController, so a client that stopped reading is let go.
+func WriteTimeout(d time.Duration) Middleware {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var deadline time.Time
+			if d > 0 {
+				deadline = time.Now().Add(d)
+			}
+
+			err := http.NewResponseController(w).SetWriteDeadline(deadline)
+			if err != nil && !errors.Is(err, http.ErrNotSupported) {
+				log.Println("set write deadline", err)
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ReadTimeout returns a middleware giving the routes it wraps d to read their
+// request body, instead of the ReadTimeout of the server. Zero lifts the deadline.
+//
+// It suits an upload, whose body takes longer to arrive than the other requests.
+func ReadTimeout(d time.Duration) Middleware {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var deadline time.Time
+			if d > 0 {
+				deadline = time.Now().Add(d)
+			}
+
+			err := http.NewResponseController(w).SetReadDeadline(deadline)
+			if err != nil && !errors.Is(err, http.ErrNotSupported) {
+				log.Println("set read deadline", err)
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package framework
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteTimeout(t *testing.T) {
+	slow := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		_, _ = io.WriteString(w, "done")
+	})
+
+	tests := []struct {
+		name    string
+		handler http.Handler
+		wantErr bool
+	}{
+		{name: "ServerTimeout", handler: slow, wantErr: true},
+		{name: "Lifted", handler: Chain(slow, WriteTimeout(0))},
+		{name: "Longer", handler: Chain(slow, WriteTimeout(time.Second))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewUnstartedServer(tt.handler)
+			srv.Config.WriteTimeout = 50 * time.Millisecond
+			srv.Start()
+			defer srv.Close()
+
+			resp, err := srv.Client().Get(srv.URL)
+			if tt.wantErr {
+				if err == nil {
+					_, err = io.ReadAll(resp.Body)
+					resp.Body.Close()
+				}
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "done", string(body))
+		})
+	}
+}
+
+func TestWriteTimeout_NotSupported(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Chain(http.NotFoundHandler(), WriteTimeout(time.Second)).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestReadTimeout(t *testing.T) {
+	read := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			w.WriteHeader(http.StatusRequestTimeout)
+
+			return
+		}
+		_, _ = io.WriteString(w, "done")
+	})
+
+	tests := []struct {
+		name    string
+		handler http.Handler
+		want    int
+	}{
+		{name: "ServerTimeout", handler: read, want: http.StatusRequestTimeout},
+		{name: "Lifted", handler: Chain(read, ReadTimeout(0)), want: http.StatusOK},
+		{name: "Longer", handler: Chain(read, ReadTimeout(time.Second)), want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewUnstartedServer(tt.handler)
+			srv.Config.ReadTimeout = 50 * time.Millisecond
+			srv.Start()
+			defer srv.Close()
+
+			pr, pw := io.Pipe()
+			go func() {
+				_, _ = io.WriteString(pw, "part")
+				time.Sleep(200 * time.Millisecond)
+				_, _ = io.WriteString(pw, "rest")
+				_ = pw.Close()
+			}()
+
+			resp, err := srv.Client().Post(srv.URL, "text/plain", pr)
+			if err != nil {
+				assert.Equal(t, http.StatusRequestTimeout, tt.want)
+
+				return
+			}
+			defer resp.Body.Close()
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+}
+
+func TestReadTimeout_NotSupported(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Chain(http.NotFoundHandler(), ReadTimeout(time.Second)).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,141 @@
+package framework
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrNoClientCA is returned by ClientCAPool for a file without any certificate.
+var ErrNoClientCA = errors.New("framework: no certificate in the client CA file")
+
+// certCheckInterval is how often at most the files of a CertReloader are looked at.
+const certCheckInterval = 10 * time.Second
+
+// CertReloader serves the certificate of a pair of cert and key files and loads
+// it again once either file changes, so a renewed certificate is used without a
+// restart. The files are looked at during a handshake, at most every ten seconds.
+// A pair that fails to load, like one written halfway, keeps the previous
+// certificate in use.
+type CertReloader struct {
+	certFile string
+	keyFile  string
+	now      func() time.Time
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	modTime time.Time // the latest of the two files
+	checked time.Time
+}
+
+// NewCertReloader loads the certificate of the cert and key files.
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	cr := &CertReloader{certFile: certFile, keyFile: keyFile, now: time.Now}
+
+	modTime, err := cr.latestModTime()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cr.load(modTime); err != nil {
+		return nil, err
+	}
+
+	return cr, nil
+}
+
+// GetCertificate is meant for tls.Config.GetCertificate.
+func (cr *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.mu.RLock()
+	cert, due := cr.cert, cr.now().Sub(cr.checked) >= certCheckInterval
+	cr.mu.RUnlock()
+
+	if !due {
+		return cert, nil
+	}
+
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	// another handshake may have checked while the lock was not held
+	if cr.now().Sub(cr.checked) < certCheckInterval {
+		return cr.cert, nil
+	}
+	cr.checked = cr.now()
+
+	modTime, err := cr.latestModTime()
+	if err != nil {
+		log.Println("tls certificate stat", err)
+
+		return cr.cert, nil
+	}
+
+	if modTime.Equal(cr.modTime) {
+		return cr.cert, nil
+	}
+
+	if err := cr.load(modTime); err != nil {
+		log.Println("tls certificate reload", err)
+	}
+
+	return cr.cert, nil
+}
+
+// TLSConfig returns a server config serving the certificate of the reloader.
+func (cr *CertReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: cr.GetCertificate,
+	}
+}
+
+func (cr *CertReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, name := range []string{cr.certFile, cr.keyFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+
+	return latest, nil
+}
+
+// load reads the pair, the caller holds the lock or is the constructor.
+func (cr *CertReloader) load(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+	if err != nil {
+		return fmt.Errorf("framework: load certificate: %w", err)
+	}
+
+	cr.cert = &cert
+	cr.modTime = modTime
+	cr.checked = cr.now()
+
+	return nil
+}
+
+// ClientCAPool reads the PEM certificates of the authorities trusted to sign the
+// certificates of the clients, for a server requiring mutual TLS.
+func ClientCAPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, ErrNoClientCA
+	}
+
+	return pool, nil
+}
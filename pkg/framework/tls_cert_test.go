@@ -0,0 +1,131 @@
+package framework
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestCert writes a self signed certificate for name and its key, their
+// files are dated at modTime.
+func writeTestCert(t *testing.T, dir, name string, modTime time.Time) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	certFile, keyFile = filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+
+	return certFile, keyFile
+}
+
+func commonName(t *testing.T, cert *tls.Certificate) string {
+	t.Helper()
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+
+	return leaf.Subject.CommonName
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Now().Add(-time.Hour)
+	certFile, keyFile := writeTestCert(t, dir, "first", start)
+
+	cr, err := NewCertReloader(certFile, keyFile)
+	require.NoError(t, err)
+
+	now := time.Now()
+	cr.now = func() time.Time { return now }
+
+	cert, err := cr.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "first", commonName(t, cert))
+	assert.Equal(t, uint16(tls.VersionTLS12), cr.TLSConfig().MinVersion)
+
+	// renewed, but not looked at before the interval is over
+	writeTestCert(t, dir, "second", start.Add(time.Minute))
+	cert, err = cr.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "first", commonName(t, cert))
+
+	now = now.Add(certCheckInterval)
+	cert, err = cr.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "second", commonName(t, cert))
+
+	// a pair written halfway keeps the previous certificate
+	require.NoError(t, os.WriteFile(keyFile, []byte("partial"), 0o600))
+	require.NoError(t, os.Chtimes(keyFile, start.Add(2*time.Minute), start.Add(2*time.Minute)))
+	now = now.Add(certCheckInterval)
+	cert, err = cr.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "second", commonName(t, cert))
+
+	// so does a pair that is gone
+	require.NoError(t, os.Remove(certFile))
+	now = now.Add(certCheckInterval)
+	cert, err = cr.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "second", commonName(t, cert))
+}
+
+func TestNewCertReloader_Error(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCert(t, dir, "test", time.Now())
+
+	_, err := NewCertReloader(filepath.Join(dir, "missing.crt"), keyFile)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	require.NoError(t, os.WriteFile(keyFile, []byte("not a key"), 0o600))
+	_, err = NewCertReloader(certFile, keyFile)
+	require.ErrorContains(t, err, "framework: load certificate")
+}
+
+func TestClientCAPool(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCert(t, dir, "ca", time.Now())
+
+	pool, err := ClientCAPool(certFile)
+	require.NoError(t, err)
+	assert.NotNil(t, pool)
+
+	_, err = ClientCAPool(keyFile)
+	require.ErrorIs(t, err, ErrNoClientCA)
+
+	_, err = ClientCAPool(filepath.Join(dir, "missing.crt"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -18,13 +18,23 @@ type Result struct {
 func Update[M Model](ctx context.Context, db *DB, data map[string]any, exps ...Expression) (Result, error) {
 	var m M
 
-	return resultOrError(db.qb.Update(m.Table()).Set(data).Where(exps...).Executor().ExecContext(ctx))
+	query, args, err := db.qb.Update(m.Table()).Set(data).Where(exps...).ToSQL()
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Exec(ctx, db, query, args...)
 }
 
 func Delete[M Model](ctx context.Context, db *DB, exps ...Expression) (Result, error) {
 	var m M
 
-	return resultOrError(db.qb.Delete(m.Table()).Where(exps...).Executor().ExecContext(ctx))
+	query, args, err := db.qb.Delete(m.Table()).Where(exps...).ToSQL()
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Exec(ctx, db, query, args...)
 }
 
 func Exec(ctx context.Context, db *DB, query string, args ...any) (Result, error) {
@@ -3,6 +3,7 @@ package sqlkit
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/shandysiswandi/goreng/telemetry/logger"
 )
@@ -14,24 +15,29 @@ type Querier interface {
 func One[M Model](ctx context.Context, db *DB, exps ...Expression) (*M, error) {
 	var m M
 
-	qq := db.qb.From(m.Table()).Select(m).Where(exps...).Limit(1)
-	query, _, _ := qq.ToSQL()
-
-	db.log.Debug(ctx, "get one data", logger.KeyVal("query", query))
-
-	found, err := qq.ScanStructContext(ctx, &m)
+	query, args, err := db.qb.From(m.Table()).Select(m).Where(exps...).Limit(1).ToSQL()
 	if err != nil {
-		db.log.Error(ctx, "error when scan rows to destination", err)
+		db.log.Error(ctx, "error when generate sql", err)
 
 		return nil, err
 	}
 
-	if !found {
+	db.log.Debug(ctx, "get one data", logger.KeyVal("query", query))
+
+	// through Scan, so the read joins the transaction of ctx when there is one
+	err = db.Scan(ctx, &m, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
 		db.log.Warn(ctx, "get one data is not found", logger.KeyVal("query", query))
 
 		return nil, nil //nolint:nilnil // just a nil result
 	}
 
+	if err != nil {
+		db.log.Error(ctx, "error when scan rows to destination", err)
+
+		return nil, err
+	}
+
 	return &m, nil
 }
 
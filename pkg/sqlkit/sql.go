@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"errors"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/shandysiswandi/goreng/telemetry/logger"
@@ -31,25 +33,64 @@ var (
 
 type Expression = goqu.Expression
 type Ex = goqu.Ex
+type Op = goqu.Op
 
 type Model interface {
 	Table() string
 }
 
 type DB struct {
-	db  *sql.DB
-	log logger.Logger
-	qb  *goqu.Database
+	db     *sql.DB
+	log    logger.Logger
+	qb     *goqu.Database
+	driver string
 }
 
 func New(driver string, db *sql.DB, log logger.Logger) *DB {
 	return &DB{
-		db:  db,
-		log: log,
-		qb:  goqu.New(driver, db),
+		db:     db,
+		log:    log,
+		qb:     goqu.New(driver, db),
+		driver: driver,
 	}
 }
 
+// Driver returns the name of the driver the DB was opened with, for the few
+// queries that differ between MySQL and PostgreSQL.
+func (d *DB) Driver() string {
+	return d.driver
+}
+
+// Rebind returns the query with its ? placeholders numbered as $1, $2... when
+// the driver is PostgreSQL, for the queries built by hand. A ? inside a quoted
+// literal is kept.
+func (d *DB) Rebind(query string) string {
+	if d.driver != PostgresDriver || !strings.Contains(query, "?") {
+		return query
+	}
+
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+
+	quoted := false
+	n := 0
+	for _, r := range query {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == '?' && !quoted:
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
 func (d *DB) Close() error {
 	return d.db.Close()
 }
@@ -181,15 +222,13 @@ func (d *DB) mapDBTagsToStruct(structType reflect.Type) map[string]int {
 }
 
 func (d *DB) scanRow(rows *sql.Rows, val reflect.Value, fm map[string]int, cols []string) error {
-	fields := make([]any, len(cols))
 	fieldPtrs := make([]any, len(cols))
 
 	for i, colName := range cols {
 		if fieldIndex, ok := fm[colName]; ok {
 			field := val.Field(fieldIndex)
 			if field.CanAddr() {
-				fields[i] = field.Addr().Interface()
-				fieldPtrs[i] = &fields[i]
+				fieldPtrs[i] = field.Addr().Interface()
 			}
 		} else {
 			var dummy any // Ignore unmapped columns
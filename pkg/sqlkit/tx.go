@@ -3,6 +3,7 @@ package sqlkit
 import (
 	"context"
 	"database/sql"
+	"strconv"
 )
 
 type Tx interface {
@@ -11,6 +12,9 @@ type Tx interface {
 
 type contextKeySQLTx struct{}
 
+// contextKeySQLSavepoint keeps how deep the savepoints in the context are nested.
+type contextKeySQLSavepoint struct{}
+
 type TxOption func(*sql.TxOptions)
 
 func WithIsolationLevel(l sql.IsolationLevel) TxOption {
@@ -25,7 +29,15 @@ func WithReadOnly(enable bool) TxOption {
 	}
 }
 
+// Transaction runs fn in a transaction, committed when fn returns no error. When
+// the context already carries a transaction, fn runs in a savepoint of it
+// instead, so a failing fn only undoes its own work and the caller decides
+// whether the enclosing transaction goes on.
 func (d *DB) Transaction(ctx context.Context, fn func(ctx context.Context) error, opts ...TxOption) error {
+	if tx, ok := ctx.Value(contextKeySQLTx{}).(*sql.Tx); ok {
+		return d.savepoint(ctx, tx, fn)
+	}
+
 	txOpts := &sql.TxOptions{}
 
 	for _, opt := range opts {
@@ -71,3 +83,37 @@ func (d *DB) Transaction(ctx context.Context, fn func(ctx context.Context) error
 
 	return err
 }
+
+// WithoutTx returns a copy of ctx whose queries run outside of the transaction
+// ctx carries, for a write that must be kept when that transaction rolls back.
+func WithoutTx(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(contextKeySQLTx{}).(*sql.Tx); !ok {
+		return ctx
+	}
+
+	ctx = context.WithValue(ctx, contextKeySQLSavepoint{}, 0)
+
+	return context.WithValue(ctx, contextKeySQLTx{}, struct{}{})
+}
+
+func (d *DB) savepoint(ctx context.Context, tx *sql.Tx, fn func(ctx context.Context) error) error {
+	depth, _ := ctx.Value(contextKeySQLSavepoint{}).(int)
+	name := "sp_" + strconv.Itoa(depth+1)
+	ctx = context.WithValue(ctx, contextKeySQLSavepoint{}, depth+1)
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+
+	if err := fn(ctx); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			d.log.Error(ctx, "error when rollback to savepoint", rbErr)
+		}
+
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+
+	return err
+}